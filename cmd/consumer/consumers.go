@@ -179,22 +179,24 @@ func NewApplication() (*application, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to declare queue: %w", err)
 	}
-	err = client.BindQueue(
-		context.Background(),
-		cfg.RabbitMQConfig.Queue,
-		"invoice.#",
-		cfg.RabbitMQConfig.Exchange,
-		nil,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("failed to bind queue: %w", err)
+	routingPatterns := []string{"invoice.#", "transaction.#"}
+	for _, pattern := range routingPatterns {
+		if err := client.BindQueue(
+			context.Background(),
+			cfg.RabbitMQConfig.Queue,
+			pattern,
+			cfg.RabbitMQConfig.Exchange,
+			nil,
+		); err != nil {
+			return nil, fmt.Errorf("failed to bind queue: %w", err)
+		}
 	}
 	o11y.Logger().Info(
 		context.Background(),
 		"rabbitmq topology configured",
 		observability.String("exchange", cfg.RabbitMQConfig.Exchange),
 		observability.String("queue", cfg.RabbitMQConfig.Queue),
-		observability.String("routing_pattern", "invoice.#"),
+		observability.Any("routing_patterns", routingPatterns),
 		observability.Bool("dlq_enabled", cfg.RabbitMQConfig.DLQExchange != ""),
 	)
 
@@ -26,6 +26,7 @@ import (
 	"github.com/jailtonjunior94/financial/pkg/database"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 	"github.com/jailtonjunior94/financial/pkg/outbox"
+	"github.com/jailtonjunior94/financial/pkg/storage"
 )
 
 func Run() error {
@@ -89,10 +90,18 @@ func Run() error {
 	outboxService := outbox.NewService(outboxRepository, o11y)
 
 	// Create invoice module first — it provides adapters needed by transaction and budget modules
-	invoiceModule := invoice.NewInvoiceModule(dbManager.DB(), o11y, jwtAdapter)
+	invoiceModule, err := invoice.NewInvoiceModule(dbManager.DB(), o11y, jwtAdapter, outboxService)
+	if err != nil {
+		return fmt.Errorf("run: failed to create invoice module: %v", err)
+	}
+
+	blobStore, err := storage.NewBlobStore(cfg.StorageConfig.Driver, cfg.StorageConfig.LocalPath)
+	if err != nil {
+		return fmt.Errorf("run: failed to create blob store: %v", err)
+	}
 
 	// Create transaction module with the InvoiceProviderAdapter from invoice module and CardProvider from card module
-	transactionModule, err := transaction.NewTransactionModule(dbManager.DB(), o11y, jwtAdapter, invoiceModule.InvoiceProviderAdapter, cardModule.CardProvider, outboxService)
+	transactionModule, err := transaction.NewTransactionModule(dbManager.DB(), o11y, jwtAdapter, invoiceModule.InvoiceProviderAdapter, cardModule.CardProvider, categoryModule.TransactionCategoryProviderAdapter, outboxService, blobStore)
 	if err != nil {
 		return fmt.Errorf("run: failed to create transaction module: %v", err)
 	}
@@ -121,6 +130,16 @@ func Run() error {
 	srv.RegisterRouters(categoryModule.CategoryRouter)
 	srv.RegisterRouters(cardModule.CardRouter)
 	srv.RegisterRouters(transactionModule.TransactionRouter)
+	srv.RegisterRouters(transactionModule.RecurringTransactionRouter)
+	srv.RegisterRouters(transactionModule.TagRouter)
+	srv.RegisterRouters(transactionModule.AttachmentRouter)
+	srv.RegisterRouters(transactionModule.CategorizationRuleRouter)
+	srv.RegisterRouters(transactionModule.DuplicateRouter)
+	srv.RegisterRouters(transactionModule.RefundRouter)
+	srv.RegisterRouters(transactionModule.InstallmentGroupRouter)
+	srv.RegisterRouters(transactionModule.ScheduledTransactionRouter)
+	srv.RegisterRouters(transactionModule.ExchangeRateRouter)
+	srv.RegisterRouters(transactionModule.TransactionHistoryRouter)
 	srv.RegisterRouters(paymentMethodModule.PaymentMethodRouter)
 	srv.RegisterRouters(budgetModule.BudgetRouter)
 	srv.RegisterRouters(invoiceModule.InvoiceRouter)
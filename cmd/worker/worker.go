@@ -9,8 +9,19 @@ import (
 	"time"
 
 	"github.com/jailtonjunior94/financial/configs"
+	cardAdapters "github.com/jailtonjunior94/financial/internal/card/infrastructure/adapters"
+	cardRepositories "github.com/jailtonjunior94/financial/internal/card/infrastructure/repositories"
+	invoiceUsecase "github.com/jailtonjunior94/financial/internal/invoice/application/usecase"
+	invoiceAdapters "github.com/jailtonjunior94/financial/internal/invoice/infrastructure/adapters"
+	invoiceJobs "github.com/jailtonjunior94/financial/internal/invoice/infrastructure/jobs"
+	invoiceRepositories "github.com/jailtonjunior94/financial/internal/invoice/infrastructure/repositories"
+	transactionUsecase "github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	transactionJobs "github.com/jailtonjunior94/financial/internal/transaction/infrastructure/jobs"
+	transactionRepositories "github.com/jailtonjunior94/financial/internal/transaction/infrastructure/repositories"
 	"github.com/jailtonjunior94/financial/pkg/database"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
 	pkgjobs "github.com/jailtonjunior94/financial/pkg/jobs"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 	"github.com/jailtonjunior94/financial/pkg/outbox"
 	"github.com/jailtonjunior94/financial/pkg/scheduler"
 
@@ -86,10 +97,61 @@ func Run() error {
 	}
 	outboxDispatcher := outbox.NewDispatcher(dbManager.DB(), uow, rabbitClient, outbox.DefaultDispatcherConfig(cfg.RabbitMQConfig.Exchange), o11y)
 	outboxCleanup := outbox.NewCleaner(uow, outbox.DefaultCleanupConfig(), o11y)
+	outboxService := outbox.NewService(outbox.NewRepository(dbManager.DB(), o11y), o11y)
+	idempotencyCleanup := idempotency.NewCleaner(idempotency.NewRepository(dbManager.DB(), o11y), o11y)
+
+	financialMetrics := metrics.NewFinancialMetrics(o11y)
+	invoiceRepository := invoiceRepositories.NewInvoiceRepository(dbManager.DB(), o11y, financialMetrics)
+	cardProvider := cardAdapters.NewCardProviderAdapter(
+		cardRepositories.NewCardRepository(dbManager.DB(), o11y, financialMetrics),
+		cardAdapters.NewInvoiceCheckerAdapter(invoiceRepository, o11y),
+		o11y,
+	)
+	invoiceItemRepository := invoiceRepositories.NewInvoiceItemRepository(o11y, financialMetrics)
+	closeInvoicesUseCase := invoiceUsecase.NewCloseInvoicesUseCase(
+		uow,
+		invoiceRepository,
+		invoiceItemRepository,
+		cardProvider,
+		outboxService,
+		o11y,
+	)
+
+	transactionMetrics := metrics.NewTransactionMetrics(o11y)
+	revisionRepository := transactionRepositories.NewTransactionRevisionRepository(dbManager.DB(), o11y, transactionMetrics)
+	createTransactionUseCase := transactionUsecase.NewCreateTransactionUseCase(
+		o11y,
+		uow,
+		transactionRepositories.NewTransactionRepository(dbManager.DB(), o11y, transactionMetrics),
+		transactionRepositories.NewTagRepository(dbManager.DB(), o11y, transactionMetrics),
+		transactionRepositories.NewCategorizationRuleRepository(dbManager.DB(), o11y, transactionMetrics),
+		transactionRepositories.NewExchangeRateRepository(dbManager.DB(), o11y, transactionMetrics),
+		revisionRepository,
+		invoiceAdapters.NewInvoiceProviderAdapter(invoiceRepository, invoiceItemRepository, o11y),
+		cardProvider,
+		outboxService,
+	)
+	materializeRecurringUseCase := transactionUsecase.NewMaterializeRecurringTransactionsUseCase(
+		o11y,
+		uow,
+		transactionRepositories.NewRecurringTransactionRepository(dbManager.DB(), o11y, transactionMetrics),
+		createTransactionUseCase,
+	)
+	promoteScheduledUseCase := transactionUsecase.NewPromoteScheduledTransactionsUseCase(
+		o11y,
+		uow,
+		transactionRepositories.NewTransactionRepository(dbManager.DB(), o11y, transactionMetrics),
+		revisionRepository,
+		outboxService,
+	)
 
 	jobsToRegister := []pkgjobs.Job{
 		outbox.NewDispatcherJob(outboxDispatcher, "@every 5s", o11y),
 		outbox.NewCleanupJob(outboxCleanup, "@daily", o11y),
+		idempotency.NewCleanupJob(idempotencyCleanup, "@hourly", o11y),
+		invoiceJobs.NewCloseInvoicesJob(closeInvoicesUseCase, "@hourly", o11y),
+		transactionJobs.NewMaterializeRecurringTransactionsJob(materializeRecurringUseCase, "@hourly", o11y),
+		transactionJobs.NewPromoteScheduledTransactionsJob(promoteScheduledUseCase, "@hourly", o11y),
 	}
 
 	scheduler := scheduler.New(ctx, o11y, pkgjobs.DefaultConfig())
@@ -18,6 +18,7 @@ type (
 		OutboxConfig   OutboxConfig   `mapstructure:",squash"`
 		ConsumerConfig ConsumerConfig `mapstructure:",squash"`
 		WorkerConfig   WorkerConfig   `mapstructure:",squash"`
+		StorageConfig  StorageConfig  `mapstructure:",squash"`
 	}
 
 	DBConfig struct {
@@ -81,6 +82,11 @@ type (
 		DefaultTimeoutSeconds int    `mapstructure:"WORKER_DEFAULT_TIMEOUT_SECONDS"`
 		MaxConcurrentJobs     int    `mapstructure:"WORKER_MAX_CONCURRENT_JOBS"`
 	}
+
+	StorageConfig struct {
+		Driver    string `mapstructure:"STORAGE_DRIVER"` // local
+		LocalPath string `mapstructure:"STORAGE_LOCAL_PATH"`
+	}
 )
 
 func LoadConfig(path string) (*Config, error) {
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_transactions_user_direction;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_direction;
@@ -0,0 +1,7 @@
+ALTER TABLE transactions
+    ADD CONSTRAINT chk_transactions_direction
+        CHECK (direction IN ('INCOME','EXPENSE'));
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_direction
+    ON transactions(user_id, direction, transaction_date DESC)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS uq_invoice_items_transaction;
+
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS fk_invoice_items_transaction;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS transaction_id;
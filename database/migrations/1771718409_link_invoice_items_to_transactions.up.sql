@@ -0,0 +1,12 @@
+ALTER TABLE invoice_items
+    ADD COLUMN IF NOT EXISTS transaction_id UUID;
+
+ALTER TABLE invoice_items
+    ADD CONSTRAINT fk_invoice_items_transaction FOREIGN KEY (transaction_id)
+        REFERENCES transactions(id) ON DELETE RESTRICT;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_invoice_items_transaction
+    ON invoice_items(transaction_id)
+    WHERE deleted_at IS NULL AND transaction_id IS NOT NULL;
+
+COMMENT ON COLUMN invoice_items.transaction_id IS 'Lançamento (transactions) que originou este item';
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_invoices_open_reference_month;
+
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS frozen_at;
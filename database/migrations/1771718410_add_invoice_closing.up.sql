@@ -0,0 +1,8 @@
+ALTER TABLE invoice_items ADD COLUMN IF NOT EXISTS frozen_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN invoices.closing_date IS 'Data de fechamento da fatura (preenchida pelo job de fechamento)';
+COMMENT ON COLUMN invoice_items.frozen_at IS 'Momento em que o item foi congelado pelo fechamento da fatura';
+
+CREATE INDEX IF NOT EXISTS idx_invoices_open_reference_month
+    ON invoices(reference_month)
+    WHERE status = 'open' AND deleted_at IS NULL;
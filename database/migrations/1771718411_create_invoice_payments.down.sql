@@ -0,0 +1,5 @@
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS chk_invoices_paid_amount;
+ALTER TABLE invoices DROP COLUMN IF EXISTS paid_amount;
+
+DROP INDEX IF EXISTS idx_invoice_payments_invoice;
+DROP TABLE IF EXISTS invoice_payments;
@@ -0,0 +1,32 @@
+CREATE TABLE invoice_payments (
+    id           UUID NOT NULL,
+    invoice_id   UUID NOT NULL,
+    user_id      UUID NOT NULL,
+    amount       NUMERIC(19,2) NOT NULL,
+    payment_date DATE NOT NULL,
+    method       VARCHAR(10) NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at   TIMESTAMPTZ,
+    deleted_at   TIMESTAMPTZ,
+
+    CONSTRAINT pk_invoice_payments PRIMARY KEY (id),
+    CONSTRAINT fk_invoice_payments_invoice FOREIGN KEY (invoice_id)
+        REFERENCES invoices(id) ON DELETE CASCADE,
+    CONSTRAINT fk_invoice_payments_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_invoice_payments_amount
+        CHECK (amount > 0),
+    CONSTRAINT chk_invoice_payments_method
+        CHECK (method IN ('pix','ted','boleto'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_payments_invoice
+    ON invoice_payments(invoice_id) WHERE deleted_at IS NULL;
+
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS paid_amount NUMERIC(19,2) NOT NULL DEFAULT 0.00;
+
+ALTER TABLE invoices ADD CONSTRAINT chk_invoices_paid_amount CHECK (paid_amount >= 0);
+
+COMMENT ON TABLE invoice_payments IS 'Pagamentos (totais ou parciais) registrados para uma fatura';
+COMMENT ON COLUMN invoice_payments.method IS 'Meio de pagamento: pix, ted ou boleto';
+COMMENT ON COLUMN invoices.paid_amount IS 'Soma dos pagamentos registrados; saldo restante = total_amount - paid_amount';
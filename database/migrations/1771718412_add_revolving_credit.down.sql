@@ -0,0 +1,16 @@
+DELETE FROM invoice_items WHERE item_type <> 'purchase';
+
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS fk_invoice_items_carried_from_invoice;
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS chk_invoice_items_purchase_category;
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS chk_invoice_items_item_type;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS carried_from_invoice_id;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS item_type;
+ALTER TABLE invoice_items ALTER COLUMN category_id SET NOT NULL;
+
+ALTER TABLE invoices DROP COLUMN IF EXISTS carried_over_at;
+ALTER TABLE invoices DROP COLUMN IF EXISTS minimum_payment;
+
+ALTER TABLE cards DROP CONSTRAINT IF EXISTS chk_cards_minimum_payment_bps;
+ALTER TABLE cards DROP CONSTRAINT IF EXISTS chk_cards_monthly_interest_rate_bps;
+ALTER TABLE cards DROP COLUMN IF EXISTS minimum_payment_bps;
+ALTER TABLE cards DROP COLUMN IF EXISTS monthly_interest_rate_bps;
@@ -0,0 +1,38 @@
+-- Cartões: juros do rotativo e pagamento mínimo, em basis points (1450 = 14,50%)
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS monthly_interest_rate_bps INT;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS minimum_payment_bps INT;
+
+UPDATE cards
+   SET monthly_interest_rate_bps = 0,
+       minimum_payment_bps = 1500
+ WHERE type = 'credit';
+
+ALTER TABLE cards ADD CONSTRAINT chk_cards_monthly_interest_rate_bps
+    CHECK (monthly_interest_rate_bps IS NULL OR (monthly_interest_rate_bps >= 0 AND monthly_interest_rate_bps <= 10000));
+ALTER TABLE cards ADD CONSTRAINT chk_cards_minimum_payment_bps
+    CHECK (minimum_payment_bps IS NULL OR (minimum_payment_bps >= 1 AND minimum_payment_bps <= 10000));
+
+COMMENT ON COLUMN cards.monthly_interest_rate_bps IS 'Juros mensais do rotativo em basis points (1450 = 14,50% a.m.)';
+COMMENT ON COLUMN cards.minimum_payment_bps IS 'Percentual do pagamento mínimo em basis points (1500 = 15%)';
+
+-- Faturas: pagamento mínimo calculado no fechamento e marcação do saldo levado ao rotativo
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS minimum_payment NUMERIC(19,2);
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS carried_over_at TIMESTAMPTZ;
+
+COMMENT ON COLUMN invoices.minimum_payment IS 'Pagamento mínimo calculado no fechamento da fatura';
+COMMENT ON COLUMN invoices.carried_over_at IS 'Momento em que o saldo não pago foi levado ao rotativo na fatura seguinte';
+
+-- Itens: saldo rotativo e IOF não possuem categoria nem lançamento de origem
+ALTER TABLE invoice_items ALTER COLUMN category_id DROP NOT NULL;
+ALTER TABLE invoice_items ADD COLUMN IF NOT EXISTS item_type VARCHAR(20) NOT NULL DEFAULT 'purchase';
+ALTER TABLE invoice_items ADD COLUMN IF NOT EXISTS carried_from_invoice_id UUID;
+
+ALTER TABLE invoice_items ADD CONSTRAINT chk_invoice_items_item_type
+    CHECK (item_type IN ('purchase','revolving','iof'));
+ALTER TABLE invoice_items ADD CONSTRAINT chk_invoice_items_purchase_category
+    CHECK (item_type <> 'purchase' OR category_id IS NOT NULL);
+ALTER TABLE invoice_items ADD CONSTRAINT fk_invoice_items_carried_from_invoice FOREIGN KEY (carried_from_invoice_id)
+    REFERENCES invoices(id) ON DELETE RESTRICT;
+
+COMMENT ON COLUMN invoice_items.item_type IS 'purchase (compra), revolving (saldo rotativo + juros) ou iof';
+COMMENT ON COLUMN invoice_items.carried_from_invoice_id IS 'Fatura cujo saldo não pago originou o item de rotativo/IOF';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_invoices_card_outstanding;
+
+ALTER TABLE cards DROP CONSTRAINT IF EXISTS chk_cards_over_limit_policy;
+ALTER TABLE cards DROP CONSTRAINT IF EXISTS chk_cards_credit_limit;
+
+ALTER TABLE cards DROP COLUMN IF EXISTS over_limit_policy;
+ALTER TABLE cards DROP COLUMN IF EXISTS credit_limit;
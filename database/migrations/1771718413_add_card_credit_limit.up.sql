@@ -0,0 +1,20 @@
+-- Cartões de crédito: limite e política aplicada às compras que excedem o limite disponível
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS credit_limit NUMERIC(19,2);
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS over_limit_policy VARCHAR(10);
+
+UPDATE cards
+   SET over_limit_policy = 'block'
+ WHERE type = 'credit';
+
+ALTER TABLE cards ADD CONSTRAINT chk_cards_credit_limit
+    CHECK (credit_limit IS NULL OR credit_limit >= 0);
+ALTER TABLE cards ADD CONSTRAINT chk_cards_over_limit_policy
+    CHECK (over_limit_policy IS NULL OR over_limit_policy IN ('block','flag'));
+
+COMMENT ON COLUMN cards.credit_limit IS 'Limite total do cartão de crédito (NULL = sem controle de limite)';
+COMMENT ON COLUMN cards.over_limit_policy IS 'block (rejeita) ou flag (aceita e sinaliza) compras acima do limite disponível';
+
+-- Saldo em aberto por cartão: faturas não pagas e não levadas ao rotativo
+CREATE INDEX IF NOT EXISTS idx_invoices_card_outstanding
+    ON invoices (card_id)
+    WHERE deleted_at IS NULL AND status <> 'paid' AND carried_over_at IS NULL;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_recurring_transactions_due;
+DROP INDEX IF EXISTS idx_recurring_transactions_user_id;
+DROP TABLE IF EXISTS recurring_transactions;
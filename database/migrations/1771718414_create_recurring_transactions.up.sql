@@ -0,0 +1,48 @@
+CREATE TABLE recurring_transactions (
+    id               UUID NOT NULL,
+    user_id          UUID NOT NULL,
+    category_id      UUID NOT NULL,
+    subcategory_id   UUID,
+    card_id          UUID,
+    description      VARCHAR(255) NOT NULL,
+    amount           NUMERIC(19,2) NOT NULL,
+    direction        VARCHAR(10) NOT NULL DEFAULT 'EXPENSE',
+    payment_method   VARCHAR(10) NOT NULL,
+    rrule            VARCHAR(255) NOT NULL,
+    start_date       DATE NOT NULL,
+    next_occurrence  DATE,
+    occurrence_count INT NOT NULL DEFAULT 0,
+    active           BOOLEAN NOT NULL DEFAULT true,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at       TIMESTAMPTZ,
+    deleted_at       TIMESTAMPTZ,
+
+    CONSTRAINT pk_recurring_transactions PRIMARY KEY (id),
+    CONSTRAINT fk_recurring_transactions_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_recurring_transactions_category FOREIGN KEY (category_id)
+        REFERENCES categories(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_recurring_transactions_subcategory FOREIGN KEY (subcategory_id)
+        REFERENCES subcategories(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_recurring_transactions_card FOREIGN KEY (card_id)
+        REFERENCES cards(id) ON DELETE RESTRICT,
+    CONSTRAINT chk_recurring_transactions_amount
+        CHECK (amount > 0),
+    CONSTRAINT chk_recurring_transactions_direction
+        CHECK (direction IN ('INCOME', 'EXPENSE')),
+    CONSTRAINT chk_recurring_transactions_payment_method
+        CHECK (payment_method IN ('pix','boleto','ted','debit','credit')),
+    CONSTRAINT chk_recurring_transactions_occurrence_count
+        CHECK (occurrence_count >= 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_recurring_transactions_user_id
+    ON recurring_transactions(user_id) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_recurring_transactions_due
+    ON recurring_transactions(next_occurrence) WHERE active AND deleted_at IS NULL;
+
+COMMENT ON TABLE recurring_transactions IS 'Modelos de transações recorrentes (aluguel, salário, assinaturas) materializados pelo worker';
+COMMENT ON COLUMN recurring_transactions.rrule IS 'Regra de recorrência no formato RRULE (RFC 5545): FREQ, INTERVAL, BYDAY, BYMONTHDAY, COUNT, UNTIL';
+COMMENT ON COLUMN recurring_transactions.next_occurrence IS 'Próxima data a materializar; NULL quando a recorrência terminou';
+COMMENT ON COLUMN recurring_transactions.occurrence_count IS 'Ocorrências já consumidas, usadas para respeitar COUNT';
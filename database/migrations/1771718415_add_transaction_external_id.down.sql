@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS uq_transactions_user_external_id;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS external_id;
@@ -0,0 +1,8 @@
+-- Identificador externo das transações importadas de extratos (OFX: conta + FITID)
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS external_id VARCHAR(255);
+
+COMMENT ON COLUMN transactions.external_id IS 'Identificador da transação no extrato de origem (ofx:<ACCTID>:<FITID>), torna reimportações idempotentes';
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_transactions_user_external_id
+    ON transactions (user_id, external_id)
+    WHERE external_id IS NOT NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transactions_user_subcategory;
+DROP INDEX IF EXISTS idx_transactions_user_card;
+DROP INDEX IF EXISTS idx_transactions_user_amount_id;
+DROP INDEX IF EXISTS idx_transactions_description_trgm;
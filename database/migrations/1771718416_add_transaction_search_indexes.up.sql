@@ -0,0 +1,22 @@
+-- Busca por descrição (ILIKE '%termo%') e ordenação por valor na listagem de transações
+
+-- Índice de trigramas: permite usar índice em ILIKE com curinga no início
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_description_trgm
+    ON transactions USING GIN (description gin_trgm_ops)
+    WHERE deleted_at IS NULL;
+
+-- Keyset pagination ordenada por valor: ORDER BY amount, id com WHERE user_id = ?
+CREATE INDEX IF NOT EXISTS idx_transactions_user_amount_id
+    ON transactions(user_id, amount DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+-- Filtros por cartão e subcategoria
+CREATE INDEX IF NOT EXISTS idx_transactions_user_card
+    ON transactions(user_id, card_id, transaction_date DESC)
+    WHERE deleted_at IS NULL AND card_id IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_transactions_user_subcategory
+    ON transactions(user_id, subcategory_id, transaction_date DESC)
+    WHERE deleted_at IS NULL AND subcategory_id IS NOT NULL;
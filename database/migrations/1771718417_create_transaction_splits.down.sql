@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_transaction_splits_category_id;
+DROP INDEX IF EXISTS idx_transaction_splits_transaction_id;
+DROP TABLE IF EXISTS transaction_splits;
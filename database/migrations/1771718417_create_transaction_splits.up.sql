@@ -0,0 +1,27 @@
+CREATE TABLE transaction_splits (
+    id             UUID NOT NULL,
+    transaction_id UUID NOT NULL,
+    category_id    UUID NOT NULL,
+    subcategory_id UUID,
+    amount         NUMERIC(19,2) NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_transaction_splits PRIMARY KEY (id),
+    CONSTRAINT fk_transaction_splits_transaction FOREIGN KEY (transaction_id)
+        REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_splits_category FOREIGN KEY (category_id)
+        REFERENCES categories(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_transaction_splits_subcategory FOREIGN KEY (subcategory_id)
+        REFERENCES subcategories(id) ON DELETE RESTRICT,
+    CONSTRAINT chk_transaction_splits_amount
+        CHECK (amount > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_transaction_id
+    ON transaction_splits(transaction_id);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_splits_category_id
+    ON transaction_splits(category_id);
+
+COMMENT ON TABLE transaction_splits IS 'Rateio de uma transação entre categorias (ex.: cupom de supermercado com mercado, limpeza e farmácia)';
+COMMENT ON COLUMN transaction_splits.amount IS 'Parte do valor da transação; a soma das partes é igual a transactions.amount. Em compras parceladas cada parcela tem o seu rateio proporcional';
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_transaction_tags_tag_id;
+DROP TABLE IF EXISTS transaction_tags;
+DROP INDEX IF EXISTS uq_tags_user_name;
+DROP TABLE IF EXISTS tags;
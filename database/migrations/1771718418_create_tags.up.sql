@@ -0,0 +1,34 @@
+CREATE TABLE tags (
+    id         UUID NOT NULL,
+    user_id    UUID NOT NULL,
+    name       VARCHAR(50) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+
+    CONSTRAINT pk_tags PRIMARY KEY (id),
+    CONSTRAINT fk_tags_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_tags_user_name
+    ON tags(user_id, name) WHERE deleted_at IS NULL;
+
+CREATE TABLE transaction_tags (
+    transaction_id UUID NOT NULL,
+    tag_id         UUID NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_transaction_tags PRIMARY KEY (transaction_id, tag_id),
+    CONSTRAINT fk_transaction_tags_transaction FOREIGN KEY (transaction_id)
+        REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_tags_tag FOREIGN KEY (tag_id)
+        REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_tags_tag_id
+    ON transaction_tags(tag_id);
+
+COMMENT ON TABLE tags IS 'Catálogo de tags livres por usuário (ex.: ferias-2026, reembolsavel)';
+COMMENT ON COLUMN tags.name IS 'Nome normalizado em minúsculas; único por usuário entre as tags não removidas';
+COMMENT ON TABLE transaction_tags IS 'Associação N:N entre transações e tags; cada parcela de uma compra tem as suas próprias associações';
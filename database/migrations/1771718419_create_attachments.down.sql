@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_attachments_sha256;
+DROP INDEX IF EXISTS uq_attachments_owner_sha256;
+DROP TABLE IF EXISTS attachments;
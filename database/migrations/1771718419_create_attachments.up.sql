@@ -0,0 +1,30 @@
+CREATE TABLE attachments (
+    id           UUID NOT NULL,
+    user_id      UUID NOT NULL,
+    owner_type   VARCHAR(20) NOT NULL,
+    owner_id     UUID NOT NULL,
+    file_name    VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes   BIGINT NOT NULL,
+    sha256       CHAR(64) NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at   TIMESTAMPTZ,
+
+    CONSTRAINT pk_attachments PRIMARY KEY (id),
+    CONSTRAINT fk_attachments_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_attachments_owner_type
+        CHECK (owner_type IN ('transaction', 'invoice')),
+    CONSTRAINT chk_attachments_size_bytes
+        CHECK (size_bytes > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_attachments_owner_sha256
+    ON attachments(owner_type, owner_id, sha256) WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_attachments_sha256
+    ON attachments(sha256) WHERE deleted_at IS NULL;
+
+COMMENT ON TABLE attachments IS 'Comprovantes anexados a transações e faturas; o conteúdo fica no blob store';
+COMMENT ON COLUMN attachments.owner_type IS 'Tipo do dono do anexo: transaction ou invoice';
+COMMENT ON COLUMN attachments.sha256 IS 'Hash do conteúdo; arquivos iguais compartilham o mesmo blob, removido quando o último anexo deixa de usá-lo';
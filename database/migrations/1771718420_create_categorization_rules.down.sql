@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_categorization_rule_tags_tag_id;
+DROP TABLE IF EXISTS categorization_rule_tags;
+DROP INDEX IF EXISTS idx_categorization_rules_user_priority;
+DROP TABLE IF EXISTS categorization_rules;
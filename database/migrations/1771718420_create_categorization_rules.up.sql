@@ -0,0 +1,65 @@
+CREATE TABLE categorization_rules (
+    id                   UUID NOT NULL,
+    user_id              UUID NOT NULL,
+    name                 VARCHAR(100) NOT NULL,
+    priority             INT NOT NULL DEFAULT 0,
+    enabled              BOOLEAN NOT NULL DEFAULT TRUE,
+    description_contains VARCHAR(255),
+    description_regex    VARCHAR(255),
+    min_amount           NUMERIC(19,2),
+    max_amount           NUMERIC(19,2),
+    payment_method       VARCHAR(10),
+    card_id              UUID,
+    category_id          UUID NOT NULL,
+    subcategory_id       UUID,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at           TIMESTAMPTZ,
+    deleted_at           TIMESTAMPTZ,
+
+    CONSTRAINT pk_categorization_rules PRIMARY KEY (id),
+    CONSTRAINT fk_categorization_rules_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_categorization_rules_card FOREIGN KEY (card_id)
+        REFERENCES cards(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_categorization_rules_category FOREIGN KEY (category_id)
+        REFERENCES categories(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_categorization_rules_subcategory FOREIGN KEY (subcategory_id)
+        REFERENCES subcategories(id) ON DELETE RESTRICT,
+    CONSTRAINT chk_categorization_rules_priority
+        CHECK (priority >= 0),
+    CONSTRAINT chk_categorization_rules_payment_method
+        CHECK (payment_method IS NULL OR payment_method IN ('pix','boleto','ted','debit','credit')),
+    CONSTRAINT chk_categorization_rules_amount_range
+        CHECK (min_amount IS NULL OR max_amount IS NULL OR min_amount <= max_amount),
+    CONSTRAINT chk_categorization_rules_condition
+        CHECK (
+            description_contains IS NOT NULL OR description_regex IS NOT NULL OR
+            min_amount IS NOT NULL OR max_amount IS NOT NULL OR
+            payment_method IS NOT NULL OR card_id IS NOT NULL
+        )
+);
+
+CREATE INDEX IF NOT EXISTS idx_categorization_rules_user_priority
+    ON categorization_rules(user_id, priority, created_at) WHERE deleted_at IS NULL;
+
+CREATE TABLE categorization_rule_tags (
+    rule_id    UUID NOT NULL,
+    tag_id     UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_categorization_rule_tags PRIMARY KEY (rule_id, tag_id),
+    CONSTRAINT fk_categorization_rule_tags_rule FOREIGN KEY (rule_id)
+        REFERENCES categorization_rules(id) ON DELETE CASCADE,
+    CONSTRAINT fk_categorization_rule_tags_tag FOREIGN KEY (tag_id)
+        REFERENCES tags(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_categorization_rule_tags_tag_id
+    ON categorization_rule_tags(tag_id);
+
+COMMENT ON TABLE categorization_rules IS 'Regras de categorização automática por usuário, avaliadas em ordem de prioridade quando a transação chega sem categoria';
+COMMENT ON COLUMN categorization_rules.priority IS 'Ordem de avaliação: menor valor primeiro; empates seguem a data de criação. Vale a primeira regra que casar';
+COMMENT ON COLUMN categorization_rules.description_contains IS 'Trecho procurado na descrição, sem diferenciar maiúsculas nem acentos';
+COMMENT ON COLUMN categorization_rules.description_regex IS 'Expressão regular (sintaxe RE2) aplicada à descrição sem diferenciar maiúsculas';
+COMMENT ON COLUMN categorization_rules.min_amount IS 'Valor mínimo da compra (inclusivo); em compras parceladas vale o valor total';
+COMMENT ON TABLE categorization_rule_tags IS 'Tags aplicadas pela regra, somadas às informadas na transação';
@@ -0,0 +1,37 @@
+UPDATE invoices SET carried_over_at = NULL
+ WHERE id IN (SELECT carried_from_invoice_id FROM invoice_items WHERE item_type = 'credit');
+
+WITH removed AS (
+    DELETE FROM invoice_items WHERE item_type IN ('refund','credit')
+    RETURNING invoice_id
+)
+UPDATE invoices i
+   SET total_amount = COALESCE((
+        SELECT SUM(ii.installment_amount)
+          FROM invoice_items ii
+         WHERE ii.invoice_id = i.id
+           AND ii.deleted_at IS NULL
+           AND ii.item_type NOT IN ('refund','credit')
+       ), 0),
+       updated_at = NOW()
+ WHERE i.id IN (SELECT invoice_id FROM removed);
+
+COMMENT ON COLUMN invoices.total_amount IS 'Valor total da fatura (soma dos invoice_items)';
+ALTER TABLE invoices ADD CONSTRAINT chk_invoices_total_amount
+    CHECK (total_amount >= 0);
+
+DROP INDEX IF EXISTS idx_invoice_items_refunded_transaction;
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS chk_invoice_items_credit_link;
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS chk_invoice_items_refund_link;
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS chk_invoice_items_amounts;
+ALTER TABLE invoice_items ADD CONSTRAINT chk_invoice_items_amounts
+    CHECK (total_amount > 0 AND installment_amount > 0);
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS chk_invoice_items_item_type;
+ALTER TABLE invoice_items ADD CONSTRAINT chk_invoice_items_item_type
+    CHECK (item_type IN ('purchase','revolving','iof'));
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS fk_invoice_items_refunded_transaction;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS budget_month;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS refunded_transaction_id;
+
+DROP INDEX IF EXISTS idx_transaction_refunds_transaction;
+DROP TABLE IF EXISTS transaction_refunds;
@@ -0,0 +1,64 @@
+CREATE TABLE transaction_refunds (
+    id             UUID NOT NULL,
+    user_id        UUID NOT NULL,
+    transaction_id UUID NOT NULL,
+    invoice_id     UUID NOT NULL,
+    amount         NUMERIC(19,2) NOT NULL,
+    reason         VARCHAR(255),
+    refund_date    DATE NOT NULL,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_transaction_refunds PRIMARY KEY (id),
+    CONSTRAINT fk_transaction_refunds_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_refunds_transaction FOREIGN KEY (transaction_id)
+        REFERENCES transactions(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_transaction_refunds_invoice FOREIGN KEY (invoice_id)
+        REFERENCES invoices(id) ON DELETE RESTRICT,
+    CONSTRAINT chk_transaction_refunds_amount
+        CHECK (amount > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_refunds_transaction
+    ON transaction_refunds(transaction_id, refund_date);
+
+COMMENT ON TABLE transaction_refunds IS 'Estornos (totais ou parciais) de compras no cartão já faturadas';
+COMMENT ON COLUMN transaction_refunds.invoice_id IS 'Fatura aberta em que o crédito do estorno foi lançado';
+
+-- Itens de estorno: crédito negativo na fatura aberta, vinculado à compra original e
+-- abatido do orçamento no mês da fatura da compra (budget_month)
+ALTER TABLE invoice_items ADD COLUMN IF NOT EXISTS refunded_transaction_id UUID;
+ALTER TABLE invoice_items ADD COLUMN IF NOT EXISTS budget_month DATE;
+
+ALTER TABLE invoice_items ADD CONSTRAINT fk_invoice_items_refunded_transaction FOREIGN KEY (refunded_transaction_id)
+    REFERENCES transactions(id) ON DELETE RESTRICT;
+
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS chk_invoice_items_item_type;
+ALTER TABLE invoice_items ADD CONSTRAINT chk_invoice_items_item_type
+    CHECK (item_type IN ('purchase','revolving','iof','refund','credit'));
+
+ALTER TABLE invoice_items DROP CONSTRAINT IF EXISTS chk_invoice_items_amounts;
+ALTER TABLE invoice_items ADD CONSTRAINT chk_invoice_items_amounts
+    CHECK ((item_type IN ('refund','credit') AND total_amount < 0 AND installment_amount < 0)
+        OR (item_type NOT IN ('refund','credit') AND total_amount > 0 AND installment_amount > 0));
+
+ALTER TABLE invoice_items ADD CONSTRAINT chk_invoice_items_refund_link
+    CHECK (item_type <> 'refund' OR (refunded_transaction_id IS NOT NULL AND category_id IS NOT NULL AND budget_month IS NOT NULL));
+
+ALTER TABLE invoice_items ADD CONSTRAINT chk_invoice_items_credit_link
+    CHECK (item_type <> 'credit' OR carried_from_invoice_id IS NOT NULL);
+
+CREATE INDEX IF NOT EXISTS idx_invoice_items_refunded_transaction
+    ON invoice_items(refunded_transaction_id)
+    WHERE deleted_at IS NULL AND refunded_transaction_id IS NOT NULL;
+
+COMMENT ON COLUMN invoice_items.item_type IS 'purchase (compra), revolving (saldo rotativo + juros), iof, refund (crédito de estorno) ou credit (saldo credor da fatura anterior)';
+COMMENT ON COLUMN invoice_items.refunded_transaction_id IS 'Compra original estornada (apenas itens refund)';
+COMMENT ON COLUMN invoice_items.budget_month IS 'Mês de orçamento em que o item é contabilizado quando difere do mês da fatura';
+
+-- Faturas: um estorno maior que o total da fatura aberta (por exemplo, lançado na fatura
+-- nova e ainda vazia) deixa a fatura com saldo credor. O total pode ficar negativo e o
+-- crédito é levado como item credit no fechamento da fatura seguinte.
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS chk_invoices_total_amount;
+
+COMMENT ON COLUMN invoices.total_amount IS 'Valor total da fatura (soma dos invoice_items); negativo quando há saldo credor';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_installment_payoffs_group;
+DROP TABLE IF EXISTS installment_payoffs;
@@ -0,0 +1,32 @@
+CREATE TABLE installment_payoffs (
+    id                   UUID NOT NULL,
+    user_id              UUID NOT NULL,
+    installment_group_id UUID NOT NULL,
+    transaction_id       UUID NOT NULL,
+    invoice_id           UUID NOT NULL,
+    installments         SMALLINT NOT NULL,
+    original_amount      NUMERIC(19,2) NOT NULL,
+    discount_amount      NUMERIC(19,2) NOT NULL DEFAULT 0,
+    amount               NUMERIC(19,2) NOT NULL,
+    payoff_date          DATE NOT NULL,
+    created_at           TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_installment_payoffs PRIMARY KEY (id),
+    CONSTRAINT fk_installment_payoffs_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT fk_installment_payoffs_transaction FOREIGN KEY (transaction_id)
+        REFERENCES transactions(id) ON DELETE RESTRICT,
+    CONSTRAINT fk_installment_payoffs_invoice FOREIGN KEY (invoice_id)
+        REFERENCES invoices(id) ON DELETE RESTRICT,
+    CONSTRAINT chk_installment_payoffs_installments
+        CHECK (installments >= 1),
+    CONSTRAINT chk_installment_payoffs_amounts
+        CHECK (discount_amount >= 0 AND amount > 0 AND amount + discount_amount = original_amount)
+);
+
+CREATE INDEX IF NOT EXISTS idx_installment_payoffs_group
+    ON installment_payoffs(installment_group_id);
+
+COMMENT ON TABLE installment_payoffs IS 'Antecipações de parcelas: as parcelas futuras de uma compra canceladas e lançadas como um único item na fatura aberta';
+COMMENT ON COLUMN installment_payoffs.transaction_id IS 'Lançamento que consolida as parcelas antecipadas (mesmo installment_group_id da compra)';
+COMMENT ON COLUMN installment_payoffs.original_amount IS 'Soma das parcelas antecipadas, antes do desconto';
@@ -0,0 +1,6 @@
+UPDATE transactions SET status = 'cancelled', updated_at = NOW() WHERE status = 'scheduled';
+
+DROP INDEX IF EXISTS idx_transactions_scheduled_date;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_status;
+ALTER TABLE transactions ADD CONSTRAINT chk_transactions_status
+    CHECK (status IN ('active','cancelled'));
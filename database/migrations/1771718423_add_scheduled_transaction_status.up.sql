@@ -0,0 +1,12 @@
+-- Transações agendadas: lançamentos com data futura (ex.: boleto a vencer) que só contam
+-- no orçamento quando o worker as promove a 'active' na data, ou quando o usuário confirma.
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS transactions_status_check;
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_status;
+ALTER TABLE transactions ADD CONSTRAINT chk_transactions_status
+    CHECK (status IN ('active','cancelled','scheduled'));
+
+CREATE INDEX IF NOT EXISTS idx_transactions_scheduled_date
+    ON transactions(transaction_date)
+    WHERE status = 'scheduled' AND deleted_at IS NULL;
+
+COMMENT ON COLUMN transactions.status IS 'active, cancelled ou scheduled (data futura, aguardando promoção)';
@@ -0,0 +1,14 @@
+DELETE FROM invoice_items WHERE item_type = 'iof' AND transaction_id IS NOT NULL;
+
+DROP INDEX IF EXISTS uq_invoice_items_transaction;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_invoice_items_transaction
+    ON invoice_items(transaction_id)
+    WHERE deleted_at IS NULL AND transaction_id IS NOT NULL;
+
+ALTER TABLE transactions DROP CONSTRAINT IF EXISTS chk_transactions_foreign_amount;
+ALTER TABLE transactions DROP COLUMN IF EXISTS iof_amount;
+ALTER TABLE transactions DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE transactions DROP COLUMN IF EXISTS original_amount;
+ALTER TABLE transactions DROP COLUMN IF EXISTS original_currency;
+
+DROP TABLE IF EXISTS exchange_rates;
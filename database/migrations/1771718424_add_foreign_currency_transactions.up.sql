@@ -0,0 +1,47 @@
+CREATE TABLE exchange_rates (
+    id            UUID NOT NULL,
+    user_id       UUID NOT NULL,
+    currency      CHAR(3) NOT NULL,
+    base_currency CHAR(3) NOT NULL DEFAULT 'BRL',
+    rate          NUMERIC(19,6) NOT NULL,
+    rate_date     DATE NOT NULL,
+    source        VARCHAR(10) NOT NULL DEFAULT 'manual',
+    created_at    TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at    TIMESTAMPTZ,
+
+    CONSTRAINT pk_exchange_rates PRIMARY KEY (id),
+    CONSTRAINT fk_exchange_rates_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT uq_exchange_rates_user_currency_date
+        UNIQUE (user_id, currency, base_currency, rate_date),
+    CONSTRAINT chk_exchange_rates_rate CHECK (rate > 0),
+    CONSTRAINT chk_exchange_rates_currency CHECK (currency <> base_currency),
+    CONSTRAINT chk_exchange_rates_source CHECK (source IN ('manual','csv'))
+);
+
+COMMENT ON TABLE exchange_rates IS 'Cotações informadas pelo usuário (manualmente ou por CSV) para converter compras em moeda estrangeira';
+COMMENT ON COLUMN exchange_rates.rate IS 'Valor de 1 unidade de currency em base_currency';
+COMMENT ON COLUMN exchange_rates.rate_date IS 'Data da cotação; a conversão usa a cotação mais recente até a data da transação';
+
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS original_currency CHAR(3);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS original_amount NUMERIC(19,2);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS exchange_rate NUMERIC(19,6);
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS iof_amount NUMERIC(19,2);
+
+ALTER TABLE transactions ADD CONSTRAINT chk_transactions_foreign_amount
+    CHECK (
+        (original_currency IS NULL AND original_amount IS NULL AND exchange_rate IS NULL AND iof_amount IS NULL)
+        OR (original_currency IS NOT NULL AND original_amount > 0 AND exchange_rate > 0
+            AND (iof_amount IS NULL OR iof_amount > 0))
+    );
+
+COMMENT ON COLUMN transactions.original_currency IS 'Moeda da compra quando diferente da moeda base; amount guarda o valor convertido';
+COMMENT ON COLUMN transactions.original_amount IS 'Valor na moeda original';
+COMMENT ON COLUMN transactions.exchange_rate IS 'Cotação usada na conversão, preservada mesmo que a tabela de cotações mude';
+COMMENT ON COLUMN transactions.iof_amount IS 'IOF da compra internacional no cartão, lançado como item separado na fatura';
+
+-- O IOF é um segundo item da mesma transação na fatura: a unicidade passa a ser por tipo de item.
+DROP INDEX IF EXISTS uq_invoice_items_transaction;
+CREATE UNIQUE INDEX IF NOT EXISTS uq_invoice_items_transaction
+    ON invoice_items(transaction_id, item_type)
+    WHERE deleted_at IS NULL AND transaction_id IS NOT NULL;
@@ -0,0 +1,24 @@
+CREATE TABLE transaction_revisions (
+    id             UUID NOT NULL,
+    transaction_id UUID NOT NULL,
+    user_id        UUID NOT NULL,
+    changed_by     UUID,
+    action         VARCHAR(20) NOT NULL,
+    changes        JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at     TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_transaction_revisions PRIMARY KEY (id),
+    CONSTRAINT fk_transaction_revisions_transaction FOREIGN KEY (transaction_id)
+        REFERENCES transactions(id) ON DELETE CASCADE,
+    CONSTRAINT fk_transaction_revisions_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE,
+    CONSTRAINT chk_transaction_revisions_action
+        CHECK (action IN ('created', 'updated', 'cancelled'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_transaction_revisions_transaction
+    ON transaction_revisions(transaction_id, created_at);
+
+COMMENT ON TABLE transaction_revisions IS 'Histórico imutável de criação, alteração e cancelamento de transações, gravado na mesma unidade de trabalho da alteração';
+COMMENT ON COLUMN transaction_revisions.changed_by IS 'Usuário que fez a alteração; NULL quando feita pelo worker (promoção de transações agendadas)';
+COMMENT ON COLUMN transaction_revisions.changes IS 'Campos alterados: [{"field": ..., "from": ..., "to": ...}]; na criação, "from" é sempre nulo';
@@ -0,0 +1,22 @@
+CREATE TABLE idempotency_keys (
+    user_id          UUID NOT NULL,
+    idempotency_key  VARCHAR(255) NOT NULL,
+    request_hash     CHAR(64) NOT NULL,
+    status_code      INT,
+    response_headers JSONB,
+    response_body    BYTEA,
+    created_at       TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    expires_at       TIMESTAMPTZ NOT NULL,
+
+    CONSTRAINT pk_idempotency_keys PRIMARY KEY (user_id, idempotency_key),
+    CONSTRAINT fk_idempotency_keys_user FOREIGN KEY (user_id)
+        REFERENCES users(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at
+    ON idempotency_keys(expires_at);
+
+COMMENT ON TABLE idempotency_keys IS 'Respostas de requisições enviadas com o header Idempotency-Key, reaproveitadas em retentativas até expires_at';
+COMMENT ON COLUMN idempotency_keys.request_hash IS 'SHA-256 do método, caminho e corpo da requisição original';
+COMMENT ON COLUMN idempotency_keys.response_headers IS 'Headers da resposta reenviados nas retentativas (Content-Type, ETag, Location)';
+COMMENT ON COLUMN idempotency_keys.status_code IS 'Status da resposta; NULL enquanto a requisição original está em andamento';
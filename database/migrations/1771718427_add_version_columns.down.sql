@@ -0,0 +1,4 @@
+ALTER TABLE transactions DROP COLUMN IF EXISTS version;
+ALTER TABLE categories DROP COLUMN IF EXISTS version;
+ALTER TABLE cards DROP COLUMN IF EXISTS version;
+ALTER TABLE budgets DROP COLUMN IF EXISTS version;
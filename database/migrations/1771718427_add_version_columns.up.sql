@@ -0,0 +1,9 @@
+ALTER TABLE budgets ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE cards ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS version INT NOT NULL DEFAULT 1;
+
+COMMENT ON COLUMN budgets.version IS 'Versão para controle de concorrência otimista; incrementada a cada alteração e exposta como ETag';
+COMMENT ON COLUMN cards.version IS 'Versão para controle de concorrência otimista; incrementada a cada alteração e exposta como ETag';
+COMMENT ON COLUMN categories.version IS 'Versão para controle de concorrência otimista; incrementada a cada alteração e exposta como ETag';
+COMMENT ON COLUMN transactions.version IS 'Versão para controle de concorrência otimista; incrementada a cada alteração e exposta como ETag';
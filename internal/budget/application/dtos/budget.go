@@ -147,6 +147,7 @@ type BudgetOutput struct {
 	Items          []BudgetItemOutput `json:"items,omitempty"`
 	CreatedAt      time.Time          `json:"created_at"      example:"2025-01-01T00:00:00Z"`
 	UpdatedAt      time.Time          `json:"updated_at,omitempty" example:"2025-01-20T08:00:00Z"`
+	Version        int                `json:"version"         example:"3"`
 }
 
 // BudgetItemOutput representa a resposta de um item de orçamento.
@@ -174,5 +174,6 @@ func buildBudgetOutput(budget *entities.Budget) *dtos.BudgetOutput {
 		Currency:       string(budget.TotalAmount.Currency()),
 		Items:          items,
 		CreatedAt:      budget.CreatedAt,
+		Version:        budget.Version,
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/budget/domain"
 	"github.com/jailtonjunior94/financial/internal/budget/domain/interfaces"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
@@ -18,7 +19,7 @@ import (
 
 type (
 	DeleteBudgetUseCase interface {
-		Execute(ctx context.Context, userID string, budgetID string) error
+		Execute(ctx context.Context, userID string, budgetID string, expectedVersion *int) error
 	}
 
 	deleteBudgetUseCase struct {
@@ -43,7 +44,7 @@ func NewDeleteBudgetUseCase(
 	}
 }
 
-func (u *deleteBudgetUseCase) Execute(ctx context.Context, userID string, budgetID string) error {
+func (u *deleteBudgetUseCase) Execute(ctx context.Context, userID string, budgetID string, expectedVersion *int) error {
 	ctx, span := u.o11y.Tracer().Start(ctx, "delete_budget_usecase.execute")
 	defer span.End()
 
@@ -85,7 +86,11 @@ func (u *deleteBudgetUseCase) Execute(ctx context.Context, userID string, budget
 			return domain.ErrBudgetNotFound
 		}
 
-		if err := u.budgetRepository.Delete(ctx, id); err != nil {
+		if expectedVersion != nil && *expectedVersion != budget.Version {
+			return customerrors.ErrPreconditionFailed
+		}
+
+		if err := u.budgetRepository.Delete(ctx, id, budget.Version); err != nil {
 			return err
 		}
 
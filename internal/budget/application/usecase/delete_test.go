@@ -12,6 +12,7 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/budget/domain"
 	repositoryMock "github.com/jailtonjunior94/financial/internal/budget/infrastructure/repositories/mocks"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 )
@@ -39,6 +40,8 @@ func (s *DeleteBudgetUseCaseSuite) TestExecute() {
 	validUserID := "550e8400-e29b-41d4-a716-446655440000"
 	validBudgetID := "770e8400-e29b-41d4-a716-446655440002"
 	infraErr := errors.New("database error")
+	currentVersion := 1
+	staleVersion := 2
 
 	referenceMonth, _ := pkgVos.NewReferenceMonth("2026-03")
 	totalAmount, _ := vos.NewMoneyFromFloat(5000.00, vos.CurrencyBRL)
@@ -46,8 +49,9 @@ func (s *DeleteBudgetUseCaseSuite) TestExecute() {
 	budgetIDVO := mustParseUUID(validBudgetID)
 
 	type args struct {
-		userID   string
-		budgetID string
+		userID          string
+		budgetID        string
+		expectedVersion *int
 	}
 	type dependencies func()
 
@@ -69,7 +73,7 @@ func (s *DeleteBudgetUseCaseSuite) TestExecute() {
 					Return(budget, nil).
 					Once()
 				s.repo.EXPECT().
-					Delete(s.ctx, budgetIDVO).
+					Delete(s.ctx, budgetIDVO, currentVersion).
 					Return(nil).
 					Once()
 			},
@@ -77,6 +81,59 @@ func (s *DeleteBudgetUseCaseSuite) TestExecute() {
 				s.NoError(err)
 			},
 		},
+		{
+			name: "should delete budget when If-Match version matches",
+			uow:  &passThroughUoW{},
+			args: args{userID: validUserID, budgetID: validBudgetID, expectedVersion: &currentVersion},
+			dependencies: func() {
+				budget := buildTestBudget(userIDVO, totalAmount, referenceMonth)
+				s.repo.EXPECT().
+					FindByID(s.ctx, userIDVO, budgetIDVO).
+					Return(budget, nil).
+					Once()
+				s.repo.EXPECT().
+					Delete(s.ctx, budgetIDVO, currentVersion).
+					Return(nil).
+					Once()
+			},
+			expect: func(err error) {
+				s.NoError(err)
+			},
+		},
+		{
+			name: "should return precondition failed when If-Match version is stale",
+			uow:  &passThroughUoW{},
+			args: args{userID: validUserID, budgetID: validBudgetID, expectedVersion: &staleVersion},
+			dependencies: func() {
+				budget := buildTestBudget(userIDVO, totalAmount, referenceMonth)
+				s.repo.EXPECT().
+					FindByID(s.ctx, userIDVO, budgetIDVO).
+					Return(budget, nil).
+					Once()
+			},
+			expect: func(err error) {
+				s.ErrorIs(err, customerrors.ErrPreconditionFailed)
+			},
+		},
+		{
+			name: "should return precondition failed when budget changes between read and delete",
+			uow:  &passThroughUoW{},
+			args: args{userID: validUserID, budgetID: validBudgetID, expectedVersion: &currentVersion},
+			dependencies: func() {
+				budget := buildTestBudget(userIDVO, totalAmount, referenceMonth)
+				s.repo.EXPECT().
+					FindByID(s.ctx, userIDVO, budgetIDVO).
+					Return(budget, nil).
+					Once()
+				s.repo.EXPECT().
+					Delete(s.ctx, budgetIDVO, currentVersion).
+					Return(customerrors.ErrPreconditionFailed).
+					Once()
+			},
+			expect: func(err error) {
+				s.ErrorIs(err, customerrors.ErrPreconditionFailed)
+			},
+		},
 		{
 			name: "should return error when budget is not found",
 			uow:  &passThroughUoW{},
@@ -118,7 +175,7 @@ func (s *DeleteBudgetUseCaseSuite) TestExecute() {
 					Return(budget, nil).
 					Once()
 				s.repo.EXPECT().
-					Delete(s.ctx, budgetIDVO).
+					Delete(s.ctx, budgetIDVO, currentVersion).
 					Return(infraErr).
 					Once()
 			},
@@ -155,7 +212,7 @@ func (s *DeleteBudgetUseCaseSuite) TestExecute() {
 		s.Run(scenario.name, func() {
 			scenario.dependencies()
 			uc := NewDeleteBudgetUseCase(scenario.uow, s.obs, s.fm, s.repo)
-			err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.budgetID)
+			err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.budgetID, scenario.args.expectedVersion)
 			scenario.expect(err)
 		})
 	}
@@ -142,5 +142,6 @@ func (u *findBudgetUseCase) Execute(ctx context.Context, userID string, budgetID
 		Items:          items,
 		CreatedAt:      budget.CreatedAt,
 		UpdatedAt:      budget.UpdatedAt.ValueOr(time.Time{}),
+		Version:        budget.Version,
 	}, nil
 }
@@ -141,6 +141,7 @@ func (u *listBudgetsPaginatedUseCase) Execute(
 			Items:          items,
 			CreatedAt:      budget.CreatedAt,
 			UpdatedAt:      budget.UpdatedAt.ValueOr(budget.CreatedAt),
+			Version:        budget.Version,
 		}
 	}
 
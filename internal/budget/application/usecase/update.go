@@ -9,6 +9,7 @@ import (
 	"github.com/jailtonjunior94/financial/internal/budget/domain"
 	"github.com/jailtonjunior94/financial/internal/budget/domain/entities"
 	"github.com/jailtonjunior94/financial/internal/budget/domain/interfaces"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/money"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
@@ -21,7 +22,7 @@ import (
 
 type (
 	UpdateBudgetUseCase interface {
-		Execute(ctx context.Context, userID string, budgetID string, input *dtos.BudgetUpdateInput) (*dtos.BudgetOutput, error)
+		Execute(ctx context.Context, userID string, budgetID string, input *dtos.BudgetUpdateInput, expectedVersion *int) (*dtos.BudgetOutput, error)
 	}
 
 	updateBudgetUseCase struct {
@@ -52,7 +53,7 @@ func NewUpdateBudgetUseCase(
 	}
 }
 
-func (u *updateBudgetUseCase) Execute(ctx context.Context, userID string, budgetID string, input *dtos.BudgetUpdateInput) (*dtos.BudgetOutput, error) {
+func (u *updateBudgetUseCase) Execute(ctx context.Context, userID string, budgetID string, input *dtos.BudgetUpdateInput, expectedVersion *int) (*dtos.BudgetOutput, error) {
 	ctx, span := u.o11y.Tracer().Start(ctx, "update_budget_usecase.execute")
 	defer span.End()
 
@@ -85,7 +86,7 @@ func (u *updateBudgetUseCase) Execute(ctx context.Context, userID string, budget
 
 	var updatedBudget *dtos.BudgetOutput
 	if err := u.uow.Do(ctx, func(ctx context.Context, _ database.DBTX) error {
-		result, err := u.performUpdate(ctx, uid, id, input)
+		result, err := u.performUpdate(ctx, uid, id, input, expectedVersion)
 		if err != nil {
 			return err
 		}
@@ -117,7 +118,7 @@ func (u *updateBudgetUseCase) Execute(ctx context.Context, userID string, budget
 	return updatedBudget, nil
 }
 
-func (u *updateBudgetUseCase) performUpdate(ctx context.Context, uid, id vos.UUID, input *dtos.BudgetUpdateInput) (*dtos.BudgetOutput, error) {
+func (u *updateBudgetUseCase) performUpdate(ctx context.Context, uid, id vos.UUID, input *dtos.BudgetUpdateInput, expectedVersion *int) (*dtos.BudgetOutput, error) {
 	budget, err := u.repository.FindByID(ctx, uid, id)
 	if err != nil {
 		return nil, err
@@ -127,6 +128,10 @@ func (u *updateBudgetUseCase) performUpdate(ctx context.Context, uid, id vos.UUI
 		return nil, domain.ErrBudgetNotFound
 	}
 
+	if expectedVersion != nil && *expectedVersion != budget.Version {
+		return nil, customerrors.ErrPreconditionFailed
+	}
+
 	newTotalAmount, err := money.NewMoney(input.TotalAmount, budget.TotalAmount.Currency())
 	if err != nil {
 		return nil, fmt.Errorf("invalid total_amount: %w", err)
@@ -14,6 +14,7 @@ import (
 	"github.com/jailtonjunior94/financial/internal/budget/domain"
 	"github.com/jailtonjunior94/financial/internal/budget/domain/entities"
 	repositoryMock "github.com/jailtonjunior94/financial/internal/budget/infrastructure/repositories/mocks"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 )
@@ -66,6 +67,7 @@ func (s *UpdateBudgetUseCaseSuite) TestExecute() {
 	newCategoryID := "880e8400-e29b-41d4-a716-446655440003"
 	infraErr := errors.New("database error")
 	replicateErr := errors.New("replicate error")
+	staleVersion := 2
 
 	parsedUserID, _ := vos.NewUUIDFromString(validUserID)
 	parsedCategoryID, _ := vos.NewUUIDFromString(validCategoryID)
@@ -87,9 +89,10 @@ func (s *UpdateBudgetUseCaseSuite) TestExecute() {
 	}
 
 	type args struct {
-		userID   string
-		budgetID string
-		input    *dtos.BudgetUpdateInput
+		userID          string
+		budgetID        string
+		input           *dtos.BudgetUpdateInput
+		expectedVersion *int
 	}
 	type dependencies func()
 	type expect func(output *dtos.BudgetOutput, err error)
@@ -371,6 +374,48 @@ func (s *UpdateBudgetUseCaseSuite) TestExecute() {
 				s.True(errors.Is(err, domain.ErrBudgetNotFound))
 			},
 		},
+		{
+			name: "should return precondition failed when If-Match version is stale",
+			uow:  &passThroughUoW{},
+			args: args{userID: validUserID, budgetID: validBudgetID, input: validInput(), expectedVersion: &staleVersion},
+			dependencies: func() {
+				s.categoryProvider.EXPECT().
+					ValidateCategories(mock.Anything, validUserID, []string{validCategoryID}).
+					Return(nil).
+					Once()
+				s.repo.EXPECT().
+					FindByID(mock.Anything, mock.AnythingOfType("vos.UUID"), mock.AnythingOfType("vos.UUID")).
+					Return(buildExistingBudget(0), nil).
+					Once()
+			},
+			expect: func(output *dtos.BudgetOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, customerrors.ErrPreconditionFailed)
+			},
+		},
+		{
+			name: "should return precondition failed when budget changes before the update",
+			uow:  &passThroughUoW{},
+			args: args{userID: validUserID, budgetID: validBudgetID, input: validInput()},
+			dependencies: func() {
+				s.categoryProvider.EXPECT().
+					ValidateCategories(mock.Anything, validUserID, []string{validCategoryID}).
+					Return(nil).
+					Once()
+				s.repo.EXPECT().
+					FindByID(mock.Anything, mock.AnythingOfType("vos.UUID"), mock.AnythingOfType("vos.UUID")).
+					Return(buildExistingBudget(0), nil).
+					Once()
+				s.repo.EXPECT().
+					Update(mock.Anything, mock.AnythingOfType("*entities.Budget")).
+					Return(customerrors.ErrPreconditionFailed).
+					Once()
+			},
+			expect: func(output *dtos.BudgetOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, customerrors.ErrPreconditionFailed)
+			},
+		},
 		{
 			name: "should return error when category_id is duplicated in input",
 			uow:  &passThroughUoW{},
@@ -589,7 +634,7 @@ func (s *UpdateBudgetUseCaseSuite) TestExecute() {
 				s.categoryProvider,
 				s.replicateUC,
 			)
-			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.budgetID, scenario.args.input)
+			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.budgetID, scenario.args.input, scenario.args.expectedVersion)
 			scenario.expect(output, err)
 		})
 	}
@@ -31,6 +31,8 @@ type Budget struct {
 	SpentAmount    vos.Money
 	PercentageUsed vos.Percentage
 	Items          []*BudgetItem
+	// Version é incrementada a cada atualização e usada no controle de concorrência otimista.
+	Version int
 }
 
 func NewBudget(userID vos.UUID, totalAmount vos.Money, referenceMonth pkgVos.ReferenceMonth) *Budget {
@@ -43,6 +45,7 @@ func NewBudget(userID vos.UUID, totalAmount vos.Money, referenceMonth pkgVos.Ref
 		SpentAmount:    zeroMoney,
 		PercentageUsed: zeroPercentage,
 		Items:          []*BudgetItem{},
+		Version:        1,
 		Base: entity.Base{
 			CreatedAt: time.Now().UTC(),
 		},
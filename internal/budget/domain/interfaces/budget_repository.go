@@ -30,5 +30,5 @@ type BudgetRepository interface {
 	Update(ctx context.Context, budget *entities.Budget) error
 	UpdateItem(ctx context.Context, item *entities.BudgetItem) error
 	DeleteItemsNotIn(ctx context.Context, budgetID vos.UUID, keepIDs []vos.UUID) error
-	Delete(ctx context.Context, id vos.UUID) error
+	Delete(ctx context.Context, id vos.UUID, version int) error
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/budget/application/dtos"
 	"github.com/jailtonjunior94/financial/internal/budget/application/usecase"
+	apihttp "github.com/jailtonjunior94/financial/pkg/api/http"
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/pagination"
@@ -237,6 +238,7 @@ func (h *BudgetHandler) List(w http.ResponseWriter, r *http.Request) {
 //	@Security		BearerAuth
 //	@Param			id	path		string					true	"ID do orçamento"	format(uuid)
 //	@Success		200	{object}	dtos.BudgetOutput		"Dados do orçamento"
+//	@Header		200	{string}	ETag	"Versão atual do recurso"
 //	@Failure		400	{object}	httperrors.ProblemDetail	"ID inválido"
 //	@Failure		401	{object}	httperrors.ProblemDetail	"Não autenticado"
 //	@Failure		404	{object}	httperrors.ProblemDetail	"Orçamento não encontrado"
@@ -291,6 +293,7 @@ func (h *BudgetHandler) Find(w http.ResponseWriter, r *http.Request) {
 		observability.String("budget_id", budgetID),
 	)
 
+	apihttp.SetETag(w, output.Version)
 	responses.JSON(w, http.StatusOK, output)
 }
 
@@ -305,10 +308,13 @@ func (h *BudgetHandler) Find(w http.ResponseWriter, r *http.Request) {
 //	@Security		BearerAuth
 //	@Param			id		path		string					true	"ID do orçamento"	format(uuid)
 //	@Param			request	body		dtos.BudgetUpdateInput	true	"Dados atualizados"
+//	@Param			If-Match	header	string	false	"Versão lida (ETag) para controle de concorrência"
 //	@Success		200		{object}	dtos.BudgetOutput		"Orçamento atualizado"
+//	@Header		200	{string}	ETag	"Nova versão do recurso"
 //	@Failure		400		{object}	httperrors.ProblemDetail	"Dados inválidos"
 //	@Failure		401		{object}	httperrors.ProblemDetail	"Não autenticado"
 //	@Failure		404		{object}	httperrors.ProblemDetail	"Orçamento não encontrado"
+//	@Failure		412		{object}	httperrors.ProblemDetail	"Recurso alterado desde a leitura (If-Match)"
 //	@Failure		500		{object}	httperrors.ProblemDetail	"Erro interno"
 //	@Router			/api/v1/budgets/{id} [put]
 func (h *BudgetHandler) Update(w http.ResponseWriter, r *http.Request) {
@@ -324,6 +330,11 @@ func (h *BudgetHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	budgetID := chi.URLParam(r, "id")
+	expectedVersion, err := apihttp.IfMatch(r)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
 
 	h.o11y.Logger().Info(ctx, "request_received",
 		observability.String("operation", "UpdateBudget"),
@@ -364,7 +375,7 @@ func (h *BudgetHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	output, err := h.updateBudgetUseCase.Execute(ctx, user.ID, budgetID, input)
+	output, err := h.updateBudgetUseCase.Execute(ctx, user.ID, budgetID, input, expectedVersion)
 	if err != nil {
 		h.o11y.Logger().Error(ctx, "request_failed",
 			observability.String("operation", "UpdateBudget"),
@@ -390,6 +401,7 @@ func (h *BudgetHandler) Update(w http.ResponseWriter, r *http.Request) {
 		observability.String("budget_id", budgetID),
 	)
 
+	apihttp.SetETag(w, output.Version)
 	responses.JSON(w, http.StatusOK, output)
 }
 
@@ -401,10 +413,12 @@ func (h *BudgetHandler) Update(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			id	path	string	true	"ID do orçamento"	format(uuid)
+//	@Param			If-Match	header	string	false	"Versão lida (ETag) para controle de concorrência"
 //	@Success		204	"Orçamento removido com sucesso"
 //	@Failure		400	{object}	httperrors.ProblemDetail	"ID inválido"
 //	@Failure		401	{object}	httperrors.ProblemDetail	"Não autenticado"
 //	@Failure		404	{object}	httperrors.ProblemDetail	"Orçamento não encontrado"
+//	@Failure		412	{object}	httperrors.ProblemDetail	"Recurso alterado desde a leitura (If-Match)"
 //	@Failure		500	{object}	httperrors.ProblemDetail	"Erro interno"
 //	@Router			/api/v1/budgets/{id} [delete]
 func (h *BudgetHandler) Delete(w http.ResponseWriter, r *http.Request) {
@@ -420,6 +434,11 @@ func (h *BudgetHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	budgetID := chi.URLParam(r, "id")
+	expectedVersion, err := apihttp.IfMatch(r)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
 
 	h.o11y.Logger().Info(ctx, "request_received",
 		observability.String("operation", "DeleteBudget"),
@@ -430,7 +449,7 @@ func (h *BudgetHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		observability.String("budget_id", budgetID),
 	)
 
-	err = h.deleteBudgetUseCase.Execute(ctx, user.ID, budgetID)
+	err = h.deleteBudgetUseCase.Execute(ctx, user.ID, budgetID, expectedVersion)
 	if err != nil {
 		h.o11y.Logger().Error(ctx, "request_failed",
 			observability.String("operation", "DeleteBudget"),
@@ -7,17 +7,18 @@ import (
 )
 
 type BudgetRouter struct {
-	handlers       *BudgetHandler
-	authMiddleware middlewares.Authorization
+	handlers              *BudgetHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
 }
 
-func NewBudgetRouter(handlers *BudgetHandler, authMiddleware middlewares.Authorization) *BudgetRouter {
-	return &BudgetRouter{handlers: handlers, authMiddleware: authMiddleware}
+func NewBudgetRouter(handlers *BudgetHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *BudgetRouter {
+	return &BudgetRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
 }
 
 func (r BudgetRouter) Register(router chi.Router) {
 	router.Group(func(protected chi.Router) {
-		protected.Use(r.authMiddleware.Authorization)
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
 
 		protected.Get("/api/v1/budgets", r.handlers.List)
 		protected.Post("/api/v1/budgets", r.handlers.Create)
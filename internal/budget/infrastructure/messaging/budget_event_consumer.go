@@ -15,7 +15,7 @@ import (
 	"github.com/jailtonjunior94/financial/pkg/outbox"
 )
 
-// BudgetEventConsumer consumes transaction.created and transaction.reversed events and syncs budget spent amounts.
+// BudgetEventConsumer consumes transaction.created, transaction.updated, transaction.reversed and transaction.refunded events and syncs budget spent amounts.
 type BudgetEventConsumer struct {
 	syncUseCase         usecase.SyncBudgetSpentAmountUseCase
 	processedEventsRepo outbox.ProcessedEventsRepository
@@ -36,14 +36,26 @@ func NewBudgetEventConsumer(
 }
 
 // transactionCreatedPayload mirrors the TransactionCreatedEvent payload contract.
+// transaction.updated adds the old_* fields so the previous category/month is re-synced too.
+// Split transactions list their categories in splits (and old_splits), each one re-synced.
 type transactionCreatedPayload struct {
-	TransactionID  string `json:"transaction_id"`
-	UserID         string `json:"user_id"`
-	CategoryID     string `json:"category_id"`
-	ReferenceMonth string `json:"reference_month"`
+	TransactionID     string                `json:"transaction_id"`
+	UserID            string                `json:"user_id"`
+	CategoryID        string                `json:"category_id"`
+	ReferenceMonth    string                `json:"reference_month"`
+	OldCategoryID     string                `json:"old_category_id,omitempty"`
+	OldReferenceMonth string                `json:"old_reference_month,omitempty"`
+	Splits            []transactionSplitRef `json:"splits,omitempty"`
+	OldSplits         []transactionSplitRef `json:"old_splits,omitempty"`
 }
 
-// Handle implements messaging.Handler for transaction.created and transaction.reversed events.
+// transactionSplitRef is the part of a split the budget needs: its category.
+type transactionSplitRef struct {
+	CategoryID string `json:"category_id"`
+}
+
+// Handle implements messaging.Handler for transaction.created, transaction.updated, transaction.reversed and transaction.refunded events.
+// transaction.refunded carries the month the refunded purchase was billed, whose budget receives the credit.
 func (c *BudgetEventConsumer) Handle(ctx context.Context, msg *messaging.Message) error {
 	ctx, span := c.o11y.Tracer().Start(ctx, "budget_event_consumer.handle")
 	defer span.End()
@@ -96,7 +108,7 @@ func (c *BudgetEventConsumer) Handle(ctx context.Context, msg *messaging.Message
 		return fmt.Errorf("invalid reference_month: %w", err)
 	}
 
-	if err := c.syncUseCase.Execute(ctx, userID, referenceMonth, categoryID); err != nil {
+	if err := c.sync(ctx, userID, referenceMonth, categoryID, payload); err != nil {
 		span.RecordError(err)
 		if deleteErr := c.processedEventsRepo.DeleteClaim(ctx, eventID, consumerName); deleteErr != nil {
 			c.o11y.Logger().Error(ctx, "query_failed",
@@ -124,7 +136,58 @@ func (c *BudgetEventConsumer) Handle(ctx context.Context, msg *messaging.Message
 	return nil
 }
 
+// sync recomputes the budget for the event's category/month and for the categories of
+// its splits and, when the payload carries a previous category, month or splits, for those
+// too. Each category/month pair is synced once.
+func (c *BudgetEventConsumer) sync(ctx context.Context, userID vos.UUID, referenceMonth pkgVos.ReferenceMonth, categoryID vos.UUID, payload transactionCreatedPayload) error {
+	type target struct {
+		month    pkgVos.ReferenceMonth
+		category vos.UUID
+	}
+	targets := []target{{referenceMonth, categoryID}}
+	seen := map[string]bool{referenceMonth.String() + "|" + categoryID.String(): true}
+	add := func(month pkgVos.ReferenceMonth, category, field string) error {
+		if category == "" || seen[month.String()+"|"+category] {
+			return nil
+		}
+		id, err := vos.NewUUIDFromString(category)
+		if err != nil {
+			return fmt.Errorf("invalid %s: %w", field, err)
+		}
+		seen[month.String()+"|"+category] = true
+		targets = append(targets, target{month, id})
+		return nil
+	}
+
+	for _, split := range payload.Splits {
+		if err := add(referenceMonth, split.CategoryID, "splits.category_id"); err != nil {
+			return err
+		}
+	}
+	if payload.OldReferenceMonth != "" {
+		oldReferenceMonth, err := pkgVos.NewReferenceMonth(payload.OldReferenceMonth)
+		if err != nil {
+			return fmt.Errorf("invalid old_reference_month: %w", err)
+		}
+		if err := add(oldReferenceMonth, payload.OldCategoryID, "old_category_id"); err != nil {
+			return err
+		}
+		for _, split := range payload.OldSplits {
+			if err := add(oldReferenceMonth, split.CategoryID, "old_splits.category_id"); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, t := range targets {
+		if err := c.syncUseCase.Execute(ctx, userID, t.month, t.category); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Topics returns the routing keys this consumer handles.
 func (c *BudgetEventConsumer) Topics() []string {
-	return []string{"transaction.created", "transaction.reversed"}
+	return []string{"transaction.created", "transaction.updated", "transaction.reversed", "transaction.refunded"}
 }
@@ -38,11 +38,54 @@ func (s *BudgetEventConsumerSuite) SetupTest() {
 	s.consumer = NewBudgetEventConsumer(s.syncUseCase, s.processedEventsRepo, s.obs)
 }
 
-func (s *BudgetEventConsumerSuite) TestTopics_ShouldReturnBothTransactionTopics() {
+func (s *BudgetEventConsumerSuite) TestTopics_ShouldReturnAllTransactionTopics() {
 	topics := s.consumer.Topics()
-	s.Require().Len(topics, 2)
+	s.Require().Len(topics, 4)
 	s.Contains(topics, "transaction.created")
+	s.Contains(topics, "transaction.updated")
 	s.Contains(topics, "transaction.reversed")
+	s.Contains(topics, "transaction.refunded")
+}
+
+func (s *BudgetEventConsumerSuite) TestHandle_TransactionRefunded_ShouldSyncEachRefundedCategory() {
+	eventID := uuid.New()
+	userID := uuid.New()
+	categoryID := uuid.New()
+	otherCategoryID := uuid.New()
+
+	payload := transactionCreatedPayload{
+		TransactionID:  uuid.New().String(),
+		UserID:         userID.String(),
+		CategoryID:     categoryID.String(),
+		ReferenceMonth: "2026-02",
+		Splits:         []transactionSplitRef{{CategoryID: categoryID.String()}, {CategoryID: otherCategoryID.String()}},
+	}
+	body, _ := json.Marshal(payload)
+
+	msg := &messaging.Message{
+		ID:      eventID.String(),
+		Topic:   "transaction.refunded",
+		Payload: body,
+	}
+
+	expectedUserID, _ := vos.NewUUIDFromString(userID.String())
+	expectedMonth, _ := pkgVos.NewReferenceMonth("2026-02")
+
+	s.processedEventsRepo.EXPECT().
+		TryClaimEvent(mock.Anything, eventID, "budget_event_consumer").
+		Return(true, nil).
+		Once()
+	for _, id := range []uuid.UUID{categoryID, otherCategoryID} {
+		expectedCategoryID, _ := vos.NewUUIDFromString(id.String())
+		s.syncUseCase.EXPECT().
+			Execute(mock.Anything, expectedUserID, expectedMonth, expectedCategoryID).
+			Return(nil).
+			Once()
+	}
+
+	err := s.consumer.Handle(s.ctx, msg)
+
+	s.NoError(err)
 }
 
 func (s *BudgetEventConsumerSuite) TestHandle_ValidPayload_ShouldSyncBudget() {
@@ -231,4 +274,181 @@ func (s *BudgetEventConsumerSuite) TestHandle_TransactionReversedDuplicated_Shou
 	s.NoError(err)
 }
 
+func (s *BudgetEventConsumerSuite) TestHandle_TransactionUpdatedWithCategoryChange_ShouldSyncBothCategories() {
+	eventID := uuid.New()
+	userID := uuid.New()
+	oldCategoryID := uuid.New()
+	newCategoryID := uuid.New()
+
+	payload := transactionCreatedPayload{
+		TransactionID:     uuid.New().String(),
+		UserID:            userID.String(),
+		CategoryID:        newCategoryID.String(),
+		ReferenceMonth:    "2026-03",
+		OldCategoryID:     oldCategoryID.String(),
+		OldReferenceMonth: "2026-03",
+	}
+	body, _ := json.Marshal(payload)
+
+	msg := &messaging.Message{
+		ID:      eventID.String(),
+		Topic:   "transaction.updated",
+		Payload: body,
+	}
+
+	expectedUserID, _ := vos.NewUUIDFromString(userID.String())
+	expectedOldCategoryID, _ := vos.NewUUIDFromString(oldCategoryID.String())
+	expectedNewCategoryID, _ := vos.NewUUIDFromString(newCategoryID.String())
+	expectedMonth, _ := pkgVos.NewReferenceMonth("2026-03")
+
+	s.processedEventsRepo.EXPECT().
+		TryClaimEvent(mock.Anything, eventID, "budget_event_consumer").
+		Return(true, nil).
+		Once()
+	s.syncUseCase.EXPECT().
+		Execute(mock.Anything, expectedUserID, expectedMonth, expectedNewCategoryID).
+		Return(nil).
+		Once()
+	s.syncUseCase.EXPECT().
+		Execute(mock.Anything, expectedUserID, expectedMonth, expectedOldCategoryID).
+		Return(nil).
+		Once()
+
+	err := s.consumer.Handle(s.ctx, msg)
+
+	s.NoError(err)
+}
+
+func (s *BudgetEventConsumerSuite) TestHandle_TransactionUpdatedSameCategory_ShouldSyncOnce() {
+	eventID := uuid.New()
+	userID := uuid.New()
+	categoryID := uuid.New()
+
+	payload := transactionCreatedPayload{
+		TransactionID:     uuid.New().String(),
+		UserID:            userID.String(),
+		CategoryID:        categoryID.String(),
+		ReferenceMonth:    "2026-03",
+		OldCategoryID:     categoryID.String(),
+		OldReferenceMonth: "2026-03",
+	}
+	body, _ := json.Marshal(payload)
+
+	msg := &messaging.Message{
+		ID:      eventID.String(),
+		Topic:   "transaction.updated",
+		Payload: body,
+	}
+
+	s.processedEventsRepo.EXPECT().
+		TryClaimEvent(mock.Anything, eventID, "budget_event_consumer").
+		Return(true, nil).
+		Once()
+	s.syncUseCase.EXPECT().
+		Execute(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil).
+		Once()
+
+	err := s.consumer.Handle(s.ctx, msg)
+
+	s.NoError(err)
+}
+
+func (s *BudgetEventConsumerSuite) TestHandle_SplitTransaction_ShouldSyncEachSplitCategory() {
+	eventID := uuid.New()
+	userID := uuid.New()
+	groceriesID := uuid.New()
+	cleaningID := uuid.New()
+	pharmacyID := uuid.New()
+
+	// A split transaction keeps its first split as category, which is synced only once.
+	body := []byte(fmt.Sprintf(`{
+		"version": "4",
+		"transaction_id": %q,
+		"user_id": %q,
+		"category_id": %q,
+		"reference_month": "2026-03",
+		"amount": 10000,
+		"splits": [
+			{"category_id": %q, "subcategory_id": null, "amount": 6000},
+			{"category_id": %q, "subcategory_id": null, "amount": 2500},
+			{"category_id": %q, "subcategory_id": null, "amount": 1500}
+		]
+	}`, uuid.New(), userID, groceriesID, groceriesID, cleaningID, pharmacyID))
+
+	msg := &messaging.Message{
+		ID:      eventID.String(),
+		Topic:   "transaction.created",
+		Payload: body,
+	}
+
+	expectedUserID, _ := vos.NewUUIDFromString(userID.String())
+	expectedMonth, _ := pkgVos.NewReferenceMonth("2026-03")
+
+	s.processedEventsRepo.EXPECT().
+		TryClaimEvent(mock.Anything, eventID, "budget_event_consumer").
+		Return(true, nil).
+		Once()
+	for _, categoryID := range []uuid.UUID{groceriesID, cleaningID, pharmacyID} {
+		expectedCategoryID, _ := vos.NewUUIDFromString(categoryID.String())
+		s.syncUseCase.EXPECT().
+			Execute(mock.Anything, expectedUserID, expectedMonth, expectedCategoryID).
+			Return(nil).
+			Once()
+	}
+
+	err := s.consumer.Handle(s.ctx, msg)
+
+	s.NoError(err)
+}
+
+func (s *BudgetEventConsumerSuite) TestHandle_TransactionUpdatedWithoutSplits_ShouldSyncOldSplitCategories() {
+	eventID := uuid.New()
+	userID := uuid.New()
+	groceriesID := uuid.New()
+	pharmacyID := uuid.New()
+
+	payload := transactionCreatedPayload{
+		TransactionID:     uuid.New().String(),
+		UserID:            userID.String(),
+		CategoryID:        groceriesID.String(),
+		ReferenceMonth:    "2026-03",
+		OldCategoryID:     groceriesID.String(),
+		OldReferenceMonth: "2026-03",
+		OldSplits: []transactionSplitRef{
+			{CategoryID: groceriesID.String()},
+			{CategoryID: pharmacyID.String()},
+		},
+	}
+	body, _ := json.Marshal(payload)
+
+	msg := &messaging.Message{
+		ID:      eventID.String(),
+		Topic:   "transaction.updated",
+		Payload: body,
+	}
+
+	expectedUserID, _ := vos.NewUUIDFromString(userID.String())
+	expectedGroceriesID, _ := vos.NewUUIDFromString(groceriesID.String())
+	expectedPharmacyID, _ := vos.NewUUIDFromString(pharmacyID.String())
+	expectedMonth, _ := pkgVos.NewReferenceMonth("2026-03")
+
+	s.processedEventsRepo.EXPECT().
+		TryClaimEvent(mock.Anything, eventID, "budget_event_consumer").
+		Return(true, nil).
+		Once()
+	s.syncUseCase.EXPECT().
+		Execute(mock.Anything, expectedUserID, expectedMonth, expectedGroceriesID).
+		Return(nil).
+		Once()
+	s.syncUseCase.EXPECT().
+		Execute(mock.Anything, expectedUserID, expectedMonth, expectedPharmacyID).
+		Return(nil).
+		Once()
+
+	err := s.consumer.Handle(s.ctx, msg)
+
+	s.NoError(err)
+}
+
 var errSyncFailed = fmt.Errorf("sync failed")
@@ -17,6 +17,7 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 	"github.com/jailtonjunior94/financial/pkg/constants"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/helpers"
 	"github.com/jailtonjunior94/financial/pkg/money"
 )
@@ -152,7 +153,8 @@ func (r *budgetRepository) FindByID(ctx context.Context, userID vos.UUID, id vos
 				b.percentage_used,
 				b.created_at,
 				b.updated_at,
-				b.deleted_at
+				b.deleted_at,
+				b.version
 			from budgets b
 			where b.id = $1 and b.user_id = $2 and b.deleted_at is null`
 
@@ -173,6 +175,7 @@ func (r *budgetRepository) FindByID(ctx context.Context, userID vos.UUID, id vos
 		&budget.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&budget.Version,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -233,7 +236,8 @@ func (r *budgetRepository) FindByUserIDAndReferenceMonth(ctx context.Context, us
 				b.percentage_used,
 				b.created_at,
 				b.updated_at,
-				b.deleted_at
+				b.deleted_at,
+				b.version
 			from budgets b
 			where b.user_id = $1
 			  and b.date >= $2
@@ -257,6 +261,7 @@ func (r *budgetRepository) FindByUserIDAndReferenceMonth(ctx context.Context, us
 		&budget.CreatedAt,
 		&updatedAt,
 		&deletedAt,
+		&budget.Version,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -336,7 +341,8 @@ func (r *budgetRepository) ListPaginated(ctx context.Context, params interfaces.
 			percentage_used,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			version
 		FROM budgets
 		WHERE %s
 		ORDER BY date DESC, id DESC
@@ -376,6 +382,7 @@ func (r *budgetRepository) ListPaginated(ctx context.Context, params interfaces.
 			&budget.CreatedAt,
 			&updatedAt,
 			&deletedAt,
+			&budget.Version,
 		)
 		if err != nil {
 			r.fm.RecordRepositoryFailure(ctx, "list_paginated", "budget", "infra", time.Since(start))
@@ -445,10 +452,11 @@ func (r *budgetRepository) Update(ctx context.Context, budget *entities.Budget)
 				amount_goal = $2,
 				amount_used = $3,
 				percentage_used = $4,
-				updated_at = $5
-			where id = $1`
+				updated_at = $5,
+				version = version + 1
+			where id = $1 and version = $6`
 
-	_, err := r.db.ExecContext(
+	result, err := r.db.ExecContext(
 		ctx,
 		query,
 		budget.ID.Value,
@@ -456,30 +464,60 @@ func (r *budgetRepository) Update(ctx context.Context, budget *entities.Budget)
 		budget.SpentAmount.Float(),
 		budget.PercentageUsed.Float(),
 		time.Now().UTC(),
+		budget.Version,
 	)
 	if err != nil {
 		span.RecordError(err)
 		r.fm.RecordRepositoryFailure(ctx, "update", "budget", "infra", time.Since(start))
 		return err
 	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "update", "budget", "infra", time.Since(start))
+		return err
+	}
+
+	// Nenhuma linha com a versão lida: outra requisição alterou o orçamento antes.
+	if affected == 0 {
+		r.fm.RecordRepositoryFailure(ctx, "update", "budget", "business", time.Since(start))
+		return customerrors.ErrPreconditionFailed
+	}
+
+	budget.Version++
 	r.fm.RecordRepositoryQuery(ctx, "update", "budget", time.Since(start))
 	return nil
 }
 
-func (r *budgetRepository) Delete(ctx context.Context, id vos.UUID) error {
+func (r *budgetRepository) Delete(ctx context.Context, id vos.UUID, version int) error {
 	start := time.Now()
 	ctx, span := r.o11y.Tracer().Start(ctx, "budget_repository.delete")
 	defer span.End()
 
 	now := time.Now().UTC()
-	query := `update budgets set deleted_at = $2, updated_at = $2 where id = $1`
+	query := `update budgets set deleted_at = $2, updated_at = $2, version = version + 1 where id = $1 and version = $3`
+
+	result, err := r.db.ExecContext(ctx, query, id.Value, now, version)
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "delete", "budget", "infra", time.Since(start))
+		return err
+	}
 
-	_, err := r.db.ExecContext(ctx, query, id.Value, now)
+	affected, err := result.RowsAffected()
 	if err != nil {
 		span.RecordError(err)
 		r.fm.RecordRepositoryFailure(ctx, "delete", "budget", "infra", time.Since(start))
 		return err
 	}
+
+	// Nenhuma linha com a versão lida: outra requisição alterou o orçamento antes.
+	if affected == 0 {
+		r.fm.RecordRepositoryFailure(ctx, "delete", "budget", "business", time.Since(start))
+		return customerrors.ErrPreconditionFailed
+	}
+
 	r.fm.RecordRepositoryQuery(ctx, "delete", "budget", time.Since(start))
 	return nil
 }
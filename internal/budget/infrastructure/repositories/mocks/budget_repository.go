@@ -42,16 +42,16 @@ func (_m *BudgetRepository) EXPECT() *BudgetRepository_Expecter {
 }
 
 // Delete provides a mock function for the type BudgetRepository
-func (_mock *BudgetRepository) Delete(ctx context.Context, id vos.UUID) error {
-	ret := _mock.Called(ctx, id)
+func (_mock *BudgetRepository) Delete(ctx context.Context, id vos.UUID, version int) error {
+	ret := _mock.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Delete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, int) error); ok {
+		r0 = returnFunc(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -66,11 +66,12 @@ type BudgetRepository_Delete_Call struct {
 // Delete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id vos.UUID
-func (_e *BudgetRepository_Expecter) Delete(ctx interface{}, id interface{}) *BudgetRepository_Delete_Call {
-	return &BudgetRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id)}
+//   - version int
+func (_e *BudgetRepository_Expecter) Delete(ctx interface{}, id interface{}, version interface{}) *BudgetRepository_Delete_Call {
+	return &BudgetRepository_Delete_Call{Call: _e.mock.On("Delete", ctx, id, version)}
 }
 
-func (_c *BudgetRepository_Delete_Call) Run(run func(ctx context.Context, id vos.UUID)) *BudgetRepository_Delete_Call {
+func (_c *BudgetRepository_Delete_Call) Run(run func(ctx context.Context, id vos.UUID, version int)) *BudgetRepository_Delete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -80,20 +81,25 @@ func (_c *BudgetRepository_Delete_Call) Run(run func(ctx context.Context, id vos
 		if args[1] != nil {
 			arg1 = args[1].(vos.UUID)
 		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *BudgetRepository_Delete_Call) Return(err error) *BudgetRepository_Delete_Call {
-	_c.Call.Return(err)
+func (_c *BudgetRepository_Delete_Call) Return(_a0 error) *BudgetRepository_Delete_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *BudgetRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id vos.UUID) error) *BudgetRepository_Delete_Call {
+func (_c *BudgetRepository_Delete_Call) RunAndReturn(run func(ctx context.Context, id vos.UUID, version int) error) *BudgetRepository_Delete_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/auth"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 	"github.com/jailtonjunior94/financial/pkg/outbox"
 
@@ -33,6 +34,7 @@ func NewBudgetModule(
 ) (BudgetModule, error) {
 	errorHandler := httperrors.NewErrorHandler(o11y, ErrorMappings())
 	authMiddleware := middlewares.NewAuthorization(tokenValidator, o11y, errorHandler)
+	idempotencyMiddleware := middlewares.NewIdempotency(idempotency.NewRepository(db, o11y), idempotency.DefaultTTL, o11y, errorHandler)
 
 	unitOfWork, err := uow.NewUnitOfWork(db)
 	if err != nil {
@@ -60,7 +62,7 @@ func NewBudgetModule(
 		listBudgetsPaginatedUseCase,
 	)
 
-	budgetRoutes := budgethttp.NewBudgetRouter(budgetHandler, authMiddleware)
+	budgetRoutes := budgethttp.NewBudgetRouter(budgetHandler, authMiddleware, idempotencyMiddleware)
 
 	var budgetEventConsumer *messaging.BudgetEventConsumer
 	if invoiceCategoryTotal != nil {
@@ -11,35 +11,58 @@ var lastFourDigitsPattern = regexp.MustCompile(`^[0-9]{4}$`)
 
 type (
 	CardInput struct {
-		Name              string `json:"name"                          example:"Nubank Platinum"`
-		Type              string `json:"type"                          example:"credit"`
-		Flag              string `json:"flag"                          example:"mastercard"`
-		LastFourDigits    string `json:"last_four_digits"              example:"7890"`
-		DueDay            *int   `json:"due_day,omitempty"             example:"10"`
-		ClosingOffsetDays *int   `json:"closing_offset_days,omitempty" example:"7"`
+		Name                     string   `json:"name"                                 example:"Nubank Platinum"`
+		Type                     string   `json:"type"                                 example:"credit"`
+		Flag                     string   `json:"flag"                                 example:"mastercard"`
+		LastFourDigits           string   `json:"last_four_digits"                     example:"7890"`
+		DueDay                   *int     `json:"due_day,omitempty"                    example:"10"`
+		ClosingOffsetDays        *int     `json:"closing_offset_days,omitempty"        example:"7"`
+		MonthlyInterestRate      *float64 `json:"monthly_interest_rate,omitempty"      example:"12.5"`
+		MinimumPaymentPercentage *float64 `json:"minimum_payment_percentage,omitempty" example:"15"`
+		CreditLimit              *float64 `json:"credit_limit,omitempty"               example:"5000"`
+		OverLimitPolicy          string   `json:"over_limit_policy,omitempty"          example:"block"`
 	}
 
 	CardUpdateInput struct {
-		Name              string `json:"name"                          example:"Nubank Platinum"`
-		Flag              string `json:"flag"                          example:"mastercard"`
-		LastFourDigits    string `json:"last_four_digits"              example:"7890"`
-		DueDay            *int   `json:"due_day,omitempty"             example:"10"`
-		ClosingOffsetDays *int   `json:"closing_offset_days,omitempty" example:"7"`
+		Name                     string   `json:"name"                                 example:"Nubank Platinum"`
+		Flag                     string   `json:"flag"                                 example:"mastercard"`
+		LastFourDigits           string   `json:"last_four_digits"                     example:"7890"`
+		DueDay                   *int     `json:"due_day,omitempty"                    example:"10"`
+		ClosingOffsetDays        *int     `json:"closing_offset_days,omitempty"        example:"7"`
+		MonthlyInterestRate      *float64 `json:"monthly_interest_rate,omitempty"      example:"12.5"`
+		MinimumPaymentPercentage *float64 `json:"minimum_payment_percentage,omitempty" example:"15"`
+		CreditLimit              *float64 `json:"credit_limit,omitempty"               example:"5000"`
+		OverLimitPolicy          string   `json:"over_limit_policy,omitempty"          example:"block"`
 	}
 
 	CardOutput struct {
-		ID                string    `json:"id"                            example:"550e8400-e29b-41d4-a716-446655440000"`
-		Name              string    `json:"name"                          example:"Nubank Platinum"`
-		Type              string    `json:"type"                          example:"credit"`
-		Flag              string    `json:"flag"                          example:"mastercard"`
-		LastFourDigits    string    `json:"last_four_digits"              example:"7890"`
-		DueDay            *int      `json:"due_day,omitempty"             example:"10"`
-		ClosingOffsetDays *int      `json:"closing_offset_days,omitempty" example:"7"`
-		CreatedAt         time.Time `json:"created_at"                    example:"2025-01-15T10:30:00Z"`
-		UpdatedAt         time.Time `json:"updated_at,omitempty"          example:"2025-01-20T08:00:00Z"`
+		ID                       string    `json:"id"                                   example:"550e8400-e29b-41d4-a716-446655440000"`
+		Name                     string    `json:"name"                                 example:"Nubank Platinum"`
+		Type                     string    `json:"type"                                 example:"credit"`
+		Flag                     string    `json:"flag"                                 example:"mastercard"`
+		LastFourDigits           string    `json:"last_four_digits"                     example:"7890"`
+		DueDay                   *int      `json:"due_day,omitempty"                    example:"10"`
+		ClosingOffsetDays        *int      `json:"closing_offset_days,omitempty"        example:"7"`
+		MonthlyInterestRate      *float64  `json:"monthly_interest_rate,omitempty"      example:"12.5"`
+		MinimumPaymentPercentage *float64  `json:"minimum_payment_percentage,omitempty" example:"15"`
+		CreditLimit              *float64  `json:"credit_limit,omitempty"               example:"5000"`
+		OverLimitPolicy          string    `json:"over_limit_policy,omitempty"          example:"block"`
+		CreatedAt                time.Time `json:"created_at"                           example:"2025-01-15T10:30:00Z"`
+		UpdatedAt                time.Time `json:"updated_at,omitempty"                 example:"2025-01-20T08:00:00Z"`
+		Version                  int       `json:"version"                              example:"3"`
 	}
 )
 
+// CardLimitOutput é a resposta de GET /api/v1/cards/{id}/limit.
+// UsedLimit soma o saldo em aberto de todas as faturas não pagas do cartão, incluindo parcelas futuras.
+type CardLimitOutput struct {
+	CardID          string  `json:"card_id"           example:"550e8400-e29b-41d4-a716-446655440000"`
+	CreditLimit     float64 `json:"credit_limit"      example:"5000"`
+	UsedLimit       float64 `json:"used_limit"        example:"1234.56"`
+	AvailableLimit  float64 `json:"available_limit"   example:"3765.44"`
+	OverLimitPolicy string  `json:"over_limit_policy" example:"block"`
+}
+
 // CardPaginationMeta contém os metadados de paginação para cards.
 type CardPaginationMeta struct {
 	Limit      int     `json:"limit"                  example:"20"`
@@ -91,6 +114,8 @@ func (c *CardInput) Validate() validation.ValidationErrors {
 		if c.ClosingOffsetDays != nil && !validation.IsInRange(*c.ClosingOffsetDays, 1, 31) {
 			errs.Add("closing_offset_days", "must be between 1 and 31")
 		}
+		validateRevolvingTerms(&errs, c.MonthlyInterestRate, c.MinimumPaymentPercentage)
+		validateCreditLimit(&errs, c.CreditLimit, c.OverLimitPolicy)
 	}
 
 	return errs
@@ -125,6 +150,28 @@ func (c *CardUpdateInput) Validate() validation.ValidationErrors {
 	if c.ClosingOffsetDays != nil && !validation.IsInRange(*c.ClosingOffsetDays, 1, 31) {
 		errs.Add("closing_offset_days", "must be between 1 and 31")
 	}
+	validateRevolvingTerms(&errs, c.MonthlyInterestRate, c.MinimumPaymentPercentage)
+	validateCreditLimit(&errs, c.CreditLimit, c.OverLimitPolicy)
 
 	return errs
 }
+
+// validateRevolvingTerms valida os percentuais do crédito rotativo quando informados.
+func validateRevolvingTerms(errs *validation.ValidationErrors, interestRate, minimumPayment *float64) {
+	if interestRate != nil && (*interestRate < 0 || *interestRate > 100) {
+		errs.Add("monthly_interest_rate", "must be between 0 and 100")
+	}
+	if minimumPayment != nil && (*minimumPayment <= 0 || *minimumPayment > 100) {
+		errs.Add("minimum_payment_percentage", "must be greater than 0 and at most 100")
+	}
+}
+
+// validateCreditLimit valida o limite do cartão e a política de compras acima do limite quando informados.
+func validateCreditLimit(errs *validation.ValidationErrors, creditLimit *float64, overLimitPolicy string) {
+	if creditLimit != nil && *creditLimit < 0 {
+		errs.Add("credit_limit", "must not be negative")
+	}
+	if overLimitPolicy != "" && !validation.IsOneOf(overLimitPolicy, []string{"block", "flag"}) {
+		errs.Add("over_limit_policy", "must be 'block' or 'flag'")
+	}
+}
@@ -12,6 +12,10 @@ func intPtr(v int) *int {
 	return &v
 }
 
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
 func TestCardInput_Validate(t *testing.T) {
 	t.Run("should validate credit card with all fields", func(t *testing.T) {
 		input := &dtos.CardInput{
@@ -190,6 +194,46 @@ func TestCardInput_Validate(t *testing.T) {
 		require.True(t, errs.HasErrors())
 	})
 
+	t.Run("should validate credit card with revolving terms", func(t *testing.T) {
+		input := &dtos.CardInput{
+			Name:                     "Nubank",
+			Type:                     "credit",
+			Flag:                     "mastercard",
+			LastFourDigits:           "1234",
+			DueDay:                   intPtr(10),
+			MonthlyInterestRate:      floatPtr(12.5),
+			MinimumPaymentPercentage: floatPtr(15),
+		}
+		errs := input.Validate()
+		require.False(t, errs.HasErrors())
+	})
+
+	t.Run("should return error when monthly_interest_rate is negative", func(t *testing.T) {
+		input := &dtos.CardInput{
+			Name:                "Nubank",
+			Type:                "credit",
+			Flag:                "mastercard",
+			LastFourDigits:      "1234",
+			DueDay:              intPtr(10),
+			MonthlyInterestRate: floatPtr(-1),
+		}
+		errs := input.Validate()
+		require.True(t, errs.HasErrors())
+	})
+
+	t.Run("should return error when minimum_payment_percentage is zero", func(t *testing.T) {
+		input := &dtos.CardInput{
+			Name:                     "Nubank",
+			Type:                     "credit",
+			Flag:                     "mastercard",
+			LastFourDigits:           "1234",
+			DueDay:                   intPtr(10),
+			MinimumPaymentPercentage: floatPtr(0),
+		}
+		errs := input.Validate()
+		require.True(t, errs.HasErrors())
+	})
+
 	t.Run("should ignore due_day for debit card", func(t *testing.T) {
 		input := &dtos.CardInput{
 			Name:           "Nubank Debito",
@@ -276,4 +320,15 @@ func TestCardUpdateInput_Validate(t *testing.T) {
 		errs := input.Validate()
 		require.True(t, errs.HasErrors())
 	})
+
+	t.Run("should return error when minimum_payment_percentage exceeds 100", func(t *testing.T) {
+		input := &dtos.CardUpdateInput{
+			Name:                     "Nubank",
+			Flag:                     "visa",
+			LastFourDigits:           "1234",
+			MinimumPaymentPercentage: floatPtr(120),
+		}
+		errs := input.Validate()
+		require.True(t, errs.HasErrors())
+	})
 }
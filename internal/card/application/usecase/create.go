@@ -7,6 +7,7 @@ import (
 	"github.com/jailtonjunior94/financial/internal/card/application/dtos"
 	"github.com/jailtonjunior94/financial/internal/card/domain/factories"
 	"github.com/jailtonjunior94/financial/internal/card/domain/interfaces"
+	cardVos "github.com/jailtonjunior94/financial/internal/card/domain/vos"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
@@ -52,6 +53,16 @@ func (u *createCardUseCase) Execute(ctx context.Context, userID string, input *d
 		closingOffsetDays = *input.ClosingOffsetDays
 	}
 
+	interestRateBps := 0
+	if input.MonthlyInterestRate != nil {
+		interestRateBps = cardVos.PercentToBps(*input.MonthlyInterestRate)
+	}
+
+	minimumPaymentBps := 0
+	if input.MinimumPaymentPercentage != nil {
+		minimumPaymentBps = cardVos.PercentToBps(*input.MinimumPaymentPercentage)
+	}
+
 	card, err := factories.CreateCard(factories.CreateCardParams{
 		UserID:            userID,
 		Name:              input.Name,
@@ -60,6 +71,10 @@ func (u *createCardUseCase) Execute(ctx context.Context, userID string, input *d
 		LastFourDigits:    input.LastFourDigits,
 		DueDay:            dueDay,
 		ClosingOffsetDays: closingOffsetDays,
+		InterestRateBps:   interestRateBps,
+		MinimumPaymentBps: minimumPaymentBps,
+		CreditLimit:       input.CreditLimit,
+		OverLimitPolicy:   input.OverLimitPolicy,
 	})
 	if err != nil {
 		duration := time.Since(start)
@@ -90,12 +105,22 @@ func (u *createCardUseCase) Execute(ctx context.Context, userID string, input *d
 		Flag:           card.Flag.Value,
 		LastFourDigits: card.LastFourDigits.Value,
 		CreatedAt:      card.CreatedAt.ValueOr(time.Time{}),
+		Version:        card.Version,
 	}
 	if card.Type.IsCredit() {
 		dueDay := card.DueDay.Int()
 		output.DueDay = &dueDay
 		offset := card.ClosingOffsetDays.Int()
 		output.ClosingOffsetDays = &offset
+		interestRate := card.InterestRate.Percent()
+		output.MonthlyInterestRate = &interestRate
+		minimumPayment := card.MinimumPayment.Percent()
+		output.MinimumPaymentPercentage = &minimumPayment
+		if card.CreditLimit.Valid {
+			creditLimit := card.CreditLimit.Float()
+			output.CreditLimit = &creditLimit
+		}
+		output.OverLimitPolicy = card.OverLimitPolicy.String()
 	}
 
 	return output, nil
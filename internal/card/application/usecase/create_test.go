@@ -10,6 +10,7 @@ import (
 
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 	"github.com/jailtonjunior94/financial/internal/card/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/card/domain/entities"
 	repositoryMock "github.com/jailtonjunior94/financial/internal/card/infrastructure/repositories/mocks"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
@@ -111,6 +112,8 @@ func (s *CreateCardUseCaseSuite) TestExecute() {
 				s.Equal("debit", output.Type)
 				s.Nil(output.DueDay)
 				s.Nil(output.ClosingOffsetDays)
+				s.Nil(output.MonthlyInterestRate)
+				s.Nil(output.MinimumPaymentPercentage)
 			},
 		},
 		{
@@ -141,6 +144,98 @@ func (s *CreateCardUseCaseSuite) TestExecute() {
 				s.Equal(7, *output.ClosingOffsetDays)
 			},
 		},
+		{
+			name: "deve criar cartão de crédito com juros e pagamento mínimo do rotativo",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.CardInput{
+					Name:                     "Itaú",
+					Type:                     "credit",
+					Flag:                     "visa",
+					LastFourDigits:           "4321",
+					DueDay:                   intPtrUC(10),
+					MonthlyInterestRate:      floatPtrUC(14.5),
+					MinimumPaymentPercentage: floatPtrUC(20),
+				},
+			},
+			dependencies: dependencies{
+				cardRepository: func() *repositoryMock.CardRepository {
+					s.cardRepository.
+						EXPECT().
+						Save(s.ctx, mock.MatchedBy(func(card *entities.Card) bool {
+							return card.InterestRate.Int() == 1450 && card.MinimumPayment.Int() == 2000
+						})).
+						Return(nil).
+						Once()
+					return s.cardRepository
+				}(),
+			},
+			expect: func(output *dtos.CardOutput, err error) {
+				s.NoError(err)
+				s.Equal(14.5, *output.MonthlyInterestRate)
+				s.Equal(20.0, *output.MinimumPaymentPercentage)
+			},
+		},
+		{
+			name: "deve aplicar pagamento mínimo padrão de 15% no cartão de crédito",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.CardInput{
+					Name:           "C6",
+					Type:           "credit",
+					Flag:           "mastercard",
+					LastFourDigits: "2468",
+					DueDay:         intPtrUC(5),
+				},
+			},
+			dependencies: dependencies{
+				cardRepository: func() *repositoryMock.CardRepository {
+					s.cardRepository.
+						EXPECT().
+						Save(s.ctx, mock.AnythingOfType("*entities.Card")).
+						Return(nil).
+						Once()
+					return s.cardRepository
+				}(),
+			},
+			expect: func(output *dtos.CardOutput, err error) {
+				s.NoError(err)
+				s.Equal(0.0, *output.MonthlyInterestRate)
+				s.Equal(15.0, *output.MinimumPaymentPercentage)
+			},
+		},
+		{
+			name: "deve criar cartão de crédito com limite e política de sinalização",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.CardInput{
+					Name:            "Inter",
+					Type:            "credit",
+					Flag:            "mastercard",
+					LastFourDigits:  "1357",
+					DueDay:          intPtrUC(15),
+					CreditLimit:     floatPtrUC(3500.5),
+					OverLimitPolicy: "flag",
+				},
+			},
+			dependencies: dependencies{
+				cardRepository: func() *repositoryMock.CardRepository {
+					s.cardRepository.
+						EXPECT().
+						Save(s.ctx, mock.MatchedBy(func(card *entities.Card) bool {
+							return card.CreditLimit.Value.Cents() == 350050 && card.OverLimitPolicy.IsFlag()
+						})).
+						Return(nil).
+						Once()
+					return s.cardRepository
+				}(),
+			},
+			expect: func(output *dtos.CardOutput, err error) {
+				s.NoError(err)
+				s.Equal(3500.5, *output.CreditLimit)
+				s.Equal("flag", output.OverLimitPolicy)
+			},
+		},
 		{
 			name: "deve retornar erro com tipo inválido",
 			args: args{
@@ -204,3 +299,7 @@ func (s *CreateCardUseCaseSuite) TestExecute() {
 func intPtrUC(v int) *int {
 	return &v
 }
+
+func floatPtrUC(v float64) *float64 {
+	return &v
+}
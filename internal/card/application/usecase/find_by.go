@@ -111,12 +111,22 @@ func (u *findCardByUseCase) Execute(ctx context.Context, userID, id string) (*dt
 		Flag:           card.Flag.Value,
 		LastFourDigits: card.LastFourDigits.Value,
 		CreatedAt:      card.CreatedAt.ValueOr(time.Time{}),
+		Version:        card.Version,
 	}
 	if card.Type.IsCredit() {
 		dueDay := card.DueDay.Int()
 		output.DueDay = &dueDay
 		offset := card.ClosingOffsetDays.Int()
 		output.ClosingOffsetDays = &offset
+		interestRate := card.InterestRate.Percent()
+		output.MonthlyInterestRate = &interestRate
+		minimumPayment := card.MinimumPayment.Percent()
+		output.MinimumPaymentPercentage = &minimumPayment
+		if card.CreditLimit.Valid {
+			creditLimit := card.CreditLimit.Float()
+			output.CreditLimit = &creditLimit
+		}
+		output.OverLimitPolicy = card.OverLimitPolicy.String()
 	}
 	if !card.UpdatedAt.ValueOr(time.Time{}).IsZero() {
 		output.UpdatedAt = card.UpdatedAt.ValueOr(time.Time{})
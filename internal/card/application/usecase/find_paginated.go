@@ -134,12 +134,22 @@ func (u *findCardPaginatedUseCase) Execute(
 			Flag:           card.Flag.Value,
 			LastFourDigits: card.LastFourDigits.Value,
 			CreatedAt:      card.CreatedAt.ValueOr(time.Time{}),
+			Version:        card.Version,
 		}
 		if card.Type.IsCredit() {
 			dueDay := card.DueDay.Int()
 			cardOutput.DueDay = &dueDay
 			offset := card.ClosingOffsetDays.Int()
 			cardOutput.ClosingOffsetDays = &offset
+			interestRate := card.InterestRate.Percent()
+			cardOutput.MonthlyInterestRate = &interestRate
+			minimumPayment := card.MinimumPayment.Percent()
+			cardOutput.MinimumPaymentPercentage = &minimumPayment
+			if card.CreditLimit.Valid {
+				creditLimit := card.CreditLimit.Float()
+				cardOutput.CreditLimit = &creditLimit
+			}
+			cardOutput.OverLimitPolicy = card.OverLimitPolicy.String()
 		}
 		if !card.UpdatedAt.ValueOr(time.Time{}).IsZero() {
 			cardOutput.UpdatedAt = card.UpdatedAt.ValueOr(time.Time{})
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/jailtonjunior94/financial/internal/card/application/dtos"
+	cardDomain "github.com/jailtonjunior94/financial/internal/card/domain"
+	"github.com/jailtonjunior94/financial/internal/card/domain/interfaces"
+	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+)
+
+type (
+	GetCardLimitUseCase interface {
+		Execute(ctx context.Context, userID, id string) (*dtos.CardLimitOutput, error)
+	}
+
+	getCardLimitUseCase struct {
+		o11y           observability.Observability
+		repository     interfaces.CardRepository
+		invoiceChecker interfaces.InvoiceChecker
+		metrics        *metrics.CardMetrics
+	}
+)
+
+func NewGetCardLimitUseCase(
+	o11y observability.Observability,
+	repository interfaces.CardRepository,
+	invoiceChecker interfaces.InvoiceChecker,
+	metrics *metrics.CardMetrics,
+) GetCardLimitUseCase {
+	return &getCardLimitUseCase{
+		o11y:           o11y,
+		repository:     repository,
+		invoiceChecker: invoiceChecker,
+		metrics:        metrics,
+	}
+}
+
+// Execute retorna o limite do cartão, o valor comprometido nas faturas não pagas
+// (incluindo parcelas futuras) e o limite disponível.
+func (u *getCardLimitUseCase) Execute(ctx context.Context, userID, id string) (*dtos.CardLimitOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "get_card_limit_usecase.execute")
+	defer span.End()
+
+	start := time.Now()
+
+	user, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationLimit, time.Since(start), metrics.ClassifyError(err))
+		span.RecordError(err)
+		return nil, err
+	}
+
+	cardID, err := vos.NewUUIDFromString(id)
+	if err != nil {
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationLimit, time.Since(start), metrics.ClassifyError(err))
+		span.RecordError(err)
+		return nil, err
+	}
+
+	card, err := u.repository.FindByIDOnly(ctx, cardID)
+	if err != nil {
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationLimit, time.Since(start), metrics.ClassifyError(err))
+		span.RecordError(err)
+		u.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "GetCardLimit"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "card"),
+			observability.String("user_id", userID),
+			observability.String("card_id", id),
+			observability.Error(err),
+		)
+		return nil, err
+	}
+
+	if card == nil {
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationLimit, time.Since(start), metrics.ErrorTypeNotFound)
+		span.RecordError(cardDomain.ErrCardNotFound)
+		return nil, cardDomain.ErrCardNotFound
+	}
+
+	if card.UserID.String() != user.String() {
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationLimit, time.Since(start), "authorization")
+		span.RecordError(customErrors.ErrForbidden)
+		u.o11y.Logger().Warn(ctx, "card ownership mismatch",
+			observability.String("operation", "GetCardLimit"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "card"),
+			observability.String("user_id", userID),
+			observability.String("card_id", id),
+		)
+		return nil, customErrors.ErrForbidden
+	}
+
+	if !card.HasCreditLimit() {
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationLimit, time.Since(start), metrics.ErrorTypeValidation)
+		span.RecordError(cardDomain.ErrCardWithoutCreditLimit)
+		return nil, cardDomain.ErrCardWithoutCreditLimit
+	}
+
+	outstanding, err := u.invoiceChecker.OutstandingAmount(ctx, card.ID)
+	if err != nil {
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationLimit, time.Since(start), metrics.ClassifyError(err))
+		span.RecordError(err)
+		return nil, err
+	}
+
+	available, err := card.AvailableLimit(outstanding)
+	if err != nil {
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationLimit, time.Since(start), metrics.ClassifyError(err))
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.metrics.RecordOperation(ctx, metrics.OperationLimit, time.Since(start))
+	return &dtos.CardLimitOutput{
+		CardID:          card.ID.String(),
+		CreditLimit:     card.CreditLimit.Float(),
+		UsedLimit:       outstanding.Float(),
+		AvailableLimit:  available.Float(),
+		OverLimitPolicy: card.OverLimitPolicy.String(),
+	}, nil
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/jailtonjunior94/financial/internal/card/application/dtos"
+	domain "github.com/jailtonjunior94/financial/internal/card/domain"
+	"github.com/jailtonjunior94/financial/internal/card/domain/entities"
+	repositoryMock "github.com/jailtonjunior94/financial/internal/card/infrastructure/repositories/mocks"
+	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+type GetCardLimitUseCaseSuite struct {
+	suite.Suite
+
+	ctx            context.Context
+	obs            observability.Observability
+	repo           *repositoryMock.CardRepository
+	invoiceChecker *repositoryMock.InvoiceChecker
+	cardMetrics    *metrics.CardMetrics
+}
+
+func TestGetCardLimitUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(GetCardLimitUseCaseSuite))
+}
+
+func (s *GetCardLimitUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = repositoryMock.NewCardRepository(s.T())
+	s.invoiceChecker = repositoryMock.NewInvoiceChecker(s.T())
+	s.cardMetrics = metrics.NewTestCardMetrics()
+}
+
+func (s *GetCardLimitUseCaseSuite) TestExecute() {
+	const validUserID = "550e8400-e29b-41d4-a716-446655440000"
+	const validCardID = "660e8400-e29b-41d4-a716-446655440001"
+
+	cardWithLimit := func(userID string, cents int64) *entities.Card {
+		card := buildCreditCard(s.T(), userID)
+		limit, _ := vos.NewMoney(cents, vos.CurrencyBRL)
+		s.Require().NoError(card.UpdateCreditLimit(&limit, "block"))
+		return card
+	}
+
+	scenarios := []struct {
+		name       string
+		setupMocks func()
+		expect     func(output *dtos.CardLimitOutput, err error)
+	}{
+		{
+			name: "should return available limit discounting outstanding invoices",
+			setupMocks: func() {
+				card := cardWithLimit(validUserID, 500000)
+				outstanding, _ := vos.NewMoney(123456, vos.CurrencyBRL)
+				s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(card, nil).Once()
+				s.invoiceChecker.EXPECT().OutstandingAmount(mock.Anything, card.ID).Return(outstanding, nil).Once()
+			},
+			expect: func(output *dtos.CardLimitOutput, err error) {
+				s.NoError(err)
+				s.Equal(5000.0, output.CreditLimit)
+				s.Equal(1234.56, output.UsedLimit)
+				s.Equal(3765.44, output.AvailableLimit)
+				s.Equal("block", output.OverLimitPolicy)
+			},
+		},
+		{
+			name: "should return error when card has no credit limit",
+			setupMocks: func() {
+				card := buildCreditCard(s.T(), validUserID)
+				s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(card, nil).Once()
+			},
+			expect: func(output *dtos.CardLimitOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, domain.ErrCardWithoutCreditLimit)
+			},
+		},
+		{
+			name: "should return error when card not found",
+			setupMocks: func() {
+				s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(nil, nil).Once()
+			},
+			expect: func(output *dtos.CardLimitOutput, err error) {
+				s.ErrorIs(err, domain.ErrCardNotFound)
+			},
+		},
+		{
+			name: "should return forbidden when card belongs to another user",
+			setupMocks: func() {
+				card := cardWithLimit("770e8400-e29b-41d4-a716-446655440099", 100000)
+				s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(card, nil).Once()
+			},
+			expect: func(output *dtos.CardLimitOutput, err error) {
+				s.ErrorIs(err, customErrors.ErrForbidden)
+			},
+		},
+		{
+			name: "should return error when invoice checker fails",
+			setupMocks: func() {
+				card := cardWithLimit(validUserID, 100000)
+				s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(card, nil).Once()
+				s.invoiceChecker.EXPECT().OutstandingAmount(mock.Anything, card.ID).Return(vos.Money{}, errors.New("database error")).Once()
+			},
+			expect: func(output *dtos.CardLimitOutput, err error) {
+				s.Nil(output)
+				s.Contains(err.Error(), "database error")
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.setupMocks()
+			uc := NewGetCardLimitUseCase(s.obs, s.repo, s.invoiceChecker, s.cardMetrics)
+			output, err := uc.Execute(s.ctx, validUserID, validCardID)
+			scenario.expect(output, err)
+		})
+	}
+}
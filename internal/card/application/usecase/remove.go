@@ -15,7 +15,7 @@ import (
 
 type (
 	RemoveCardUseCase interface {
-		Execute(ctx context.Context, userID, id string) error
+		Execute(ctx context.Context, userID, id string, expectedVersion *int) error
 	}
 
 	removeCardUseCase struct {
@@ -40,7 +40,7 @@ func NewRemoveCardUseCase(
 	}
 }
 
-func (u *removeCardUseCase) Execute(ctx context.Context, userID, id string) error {
+func (u *removeCardUseCase) Execute(ctx context.Context, userID, id string, expectedVersion *int) error {
 	ctx, span := u.o11y.Tracer().Start(ctx, "remove_card_usecase.execute")
 	defer span.End()
 
@@ -113,6 +113,21 @@ func (u *removeCardUseCase) Execute(ctx context.Context, userID, id string) erro
 		return customErrors.ErrForbidden
 	}
 
+	if expectedVersion != nil && *expectedVersion != card.Version {
+		duration := time.Since(start)
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationDelete, duration, "business")
+
+		span.RecordError(customErrors.ErrPreconditionFailed)
+		u.o11y.Logger().Warn(ctx, "card version mismatch",
+			observability.String("operation", "RemoveCard"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "card"),
+			observability.String("user_id", userID),
+			observability.String("card_id", id),
+		)
+		return customErrors.ErrPreconditionFailed
+	}
+
 	if card.Type.IsCredit() {
 		hasOpen, err := u.invoiceChecker.HasOpenInvoices(ctx, card.ID)
 		if err != nil {
@@ -46,8 +46,9 @@ func (s *RemoveCardUseCaseSuite) TestExecute() {
 	const validCardID = "660e8400-e29b-41d4-a716-446655440001"
 
 	type args struct {
-		userID string
-		cardID string
+		userID          string
+		cardID          string
+		expectedVersion *int
 	}
 
 	type dependencies struct {
@@ -162,13 +163,42 @@ func (s *RemoveCardUseCaseSuite) TestExecute() {
 				s.ErrorIs(err, customErrors.ErrForbidden)
 			},
 		},
+		{
+			name: "should return precondition failed when If-Match version is stale",
+			args: args{userID: validUserID, cardID: validCardID, expectedVersion: intPtrUC(2)},
+			dependencies: dependencies{
+				setupMocks: func() {
+					debitCard := buildDebitCard(s.T(), validUserID)
+					s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(debitCard, nil).Once()
+				},
+			},
+			expect: func(err error) {
+				s.ErrorIs(err, customErrors.ErrPreconditionFailed)
+			},
+		},
+		{
+			name: "should return precondition failed when card changes between read and delete",
+			args: args{userID: validUserID, cardID: validCardID, expectedVersion: intPtrUC(1)},
+			dependencies: dependencies{
+				setupMocks: func() {
+					debitCard := buildDebitCard(s.T(), validUserID)
+					s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(debitCard, nil).Once()
+					s.repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(card *entities.Card) bool {
+						return card.Version == 1 && card.DeletedAt.Ptr() != nil
+					})).Return(customErrors.ErrPreconditionFailed).Once()
+				},
+			},
+			expect: func(err error) {
+				s.ErrorIs(err, customErrors.ErrPreconditionFailed)
+			},
+		},
 	}
 
 	for _, scenario := range scenarios {
 		s.Run(scenario.name, func() {
 			scenario.dependencies.setupMocks()
 			uc := NewRemoveCardUseCase(s.obs, s.repo, s.invoiceChecker, s.cardMetrics)
-			err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.cardID)
+			err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.cardID, scenario.args.expectedVersion)
 			scenario.expect(err)
 		})
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/jailtonjunior94/financial/internal/card/application/dtos"
 	cardDomain "github.com/jailtonjunior94/financial/internal/card/domain"
 	"github.com/jailtonjunior94/financial/internal/card/domain/interfaces"
+	cardVos "github.com/jailtonjunior94/financial/internal/card/domain/vos"
 	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
@@ -16,7 +17,7 @@ import (
 
 type (
 	UpdateCardUseCase interface {
-		Execute(ctx context.Context, userID, id string, input *dtos.CardUpdateInput) (*dtos.CardOutput, error)
+		Execute(ctx context.Context, userID, id string, input *dtos.CardUpdateInput, expectedVersion *int) (*dtos.CardOutput, error)
 	}
 
 	updateCardUseCase struct {
@@ -38,7 +39,7 @@ func NewUpdateCardUseCase(
 	}
 }
 
-func (u *updateCardUseCase) Execute(ctx context.Context, userID, id string, input *dtos.CardUpdateInput) (*dtos.CardOutput, error) {
+func (u *updateCardUseCase) Execute(ctx context.Context, userID, id string, input *dtos.CardUpdateInput, expectedVersion *int) (*dtos.CardOutput, error) {
 	ctx, span := u.o11y.Tracer().Start(ctx, "update_card_usecase.execute")
 	defer span.End()
 
@@ -104,6 +105,20 @@ func (u *updateCardUseCase) Execute(ctx context.Context, userID, id string, inpu
 		return nil, customErrors.ErrForbidden
 	}
 
+	if expectedVersion != nil && *expectedVersion != card.Version {
+		duration := time.Since(start)
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationUpdate, duration, "business")
+		span.RecordError(customErrors.ErrPreconditionFailed)
+		u.o11y.Logger().Warn(ctx, "card version mismatch",
+			observability.String("operation", "UpdateCard"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "card"),
+			observability.String("user_id", userID),
+			observability.String("card_id", id),
+		)
+		return nil, customErrors.ErrPreconditionFailed
+	}
+
 	dueDay := card.DueDay.Int()
 	if input.DueDay != nil {
 		dueDay = *input.DueDay
@@ -114,6 +129,42 @@ func (u *updateCardUseCase) Execute(ctx context.Context, userID, id string, inpu
 		closingOffsetDays = *input.ClosingOffsetDays
 	}
 
+	interestRateBps := card.InterestRate.Int()
+	if input.MonthlyInterestRate != nil {
+		interestRateBps = cardVos.PercentToBps(*input.MonthlyInterestRate)
+	}
+
+	minimumPaymentBps := card.MinimumPayment.Int()
+	if !card.MinimumPayment.Valid {
+		minimumPaymentBps = cardVos.DefaultMinimumPaymentBps
+	}
+	if input.MinimumPaymentPercentage != nil {
+		minimumPaymentBps = cardVos.PercentToBps(*input.MinimumPaymentPercentage)
+	}
+
+	var creditLimit *vos.Money
+	if card.CreditLimit.Valid {
+		creditLimit = &card.CreditLimit.Value
+	}
+	if input.CreditLimit != nil {
+		amount, err := vos.NewMoneyFromFloat(*input.CreditLimit, vos.CurrencyBRL)
+		if err != nil {
+			duration := time.Since(start)
+			u.metrics.RecordOperationFailure(ctx, metrics.OperationUpdate, duration, metrics.ClassifyError(err))
+			span.RecordError(err)
+			return nil, err
+		}
+		creditLimit = &amount
+	}
+
+	overLimitPolicy := card.OverLimitPolicy.String()
+	if overLimitPolicy == "" {
+		overLimitPolicy = cardVos.OverLimitPolicyBlock
+	}
+	if input.OverLimitPolicy != "" {
+		overLimitPolicy = input.OverLimitPolicy
+	}
+
 	if err := card.Update(input.Name, input.Flag, input.LastFourDigits, dueDay, closingOffsetDays); err != nil {
 		duration := time.Since(start)
 		u.metrics.RecordOperationFailure(ctx, metrics.OperationUpdate, duration, metrics.ClassifyError(err))
@@ -129,6 +180,36 @@ func (u *updateCardUseCase) Execute(ctx context.Context, userID, id string, inpu
 		return nil, err
 	}
 
+	if err := card.UpdateRevolvingTerms(interestRateBps, minimumPaymentBps); err != nil {
+		duration := time.Since(start)
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationUpdate, duration, metrics.ClassifyError(err))
+		span.RecordError(err)
+		u.o11y.Logger().Error(ctx, "validation_failed",
+			observability.String("operation", "UpdateCard"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "card"),
+			observability.String("user_id", userID),
+			observability.String("card_id", id),
+			observability.Error(err),
+		)
+		return nil, err
+	}
+
+	if err := card.UpdateCreditLimit(creditLimit, overLimitPolicy); err != nil {
+		duration := time.Since(start)
+		u.metrics.RecordOperationFailure(ctx, metrics.OperationUpdate, duration, metrics.ClassifyError(err))
+		span.RecordError(err)
+		u.o11y.Logger().Error(ctx, "validation_failed",
+			observability.String("operation", "UpdateCard"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "card"),
+			observability.String("user_id", userID),
+			observability.String("card_id", id),
+			observability.Error(err),
+		)
+		return nil, err
+	}
+
 	if err := u.repository.Update(ctx, card); err != nil {
 		duration := time.Since(start)
 		u.metrics.RecordOperationFailure(ctx, metrics.OperationUpdate, duration, metrics.ClassifyError(err))
@@ -154,12 +235,22 @@ func (u *updateCardUseCase) Execute(ctx context.Context, userID, id string, inpu
 		Flag:           card.Flag.Value,
 		LastFourDigits: card.LastFourDigits.Value,
 		CreatedAt:      card.CreatedAt.ValueOr(time.Time{}),
+		Version:        card.Version,
 	}
 	if card.Type.IsCredit() {
 		dueDay := card.DueDay.Int()
 		output.DueDay = &dueDay
 		offset := card.ClosingOffsetDays.Int()
 		output.ClosingOffsetDays = &offset
+		interestRate := card.InterestRate.Percent()
+		output.MonthlyInterestRate = &interestRate
+		minimumPayment := card.MinimumPayment.Percent()
+		output.MinimumPaymentPercentage = &minimumPayment
+		if card.CreditLimit.Valid {
+			creditLimit := card.CreditLimit.Float()
+			output.CreditLimit = &creditLimit
+		}
+		output.OverLimitPolicy = card.OverLimitPolicy.String()
 	}
 	if !card.UpdatedAt.ValueOr(time.Time{}).IsZero() {
 		output.UpdatedAt = card.UpdatedAt.ValueOr(time.Time{})
@@ -12,6 +12,7 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
 	"github.com/jailtonjunior94/financial/internal/card/application/dtos"
 	cardDomain "github.com/jailtonjunior94/financial/internal/card/domain"
+	"github.com/jailtonjunior94/financial/internal/card/domain/entities"
 	repositoryMock "github.com/jailtonjunior94/financial/internal/card/infrastructure/repositories/mocks"
 	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
@@ -40,9 +41,10 @@ func (s *UpdateCardUseCaseSuite) TestExecute() {
 	const validCardID = "660e8400-e29b-41d4-a716-446655440001"
 
 	type args struct {
-		userID string
-		cardID string
-		input  *dtos.CardUpdateInput
+		userID          string
+		cardID          string
+		input           *dtos.CardUpdateInput
+		expectedVersion *int
 	}
 
 	type dependencies struct {
@@ -82,6 +84,33 @@ func (s *UpdateCardUseCaseSuite) TestExecute() {
 				s.NotNil(output.DueDay)
 			},
 		},
+		{
+			name: "should update credit limit and keep policy when omitted",
+			args: args{
+				userID: validUserID,
+				cardID: validCardID,
+				input: &dtos.CardUpdateInput{
+					Name:           "Nubank",
+					Flag:           "visa",
+					LastFourDigits: "9999",
+					CreditLimit:    floatPtrUC(8000),
+				},
+			},
+			dependencies: dependencies{
+				setupMocks: func() {
+					creditCard := buildCreditCard(s.T(), validUserID)
+					s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(creditCard, nil).Once()
+					s.repo.EXPECT().Update(mock.Anything, mock.MatchedBy(func(card *entities.Card) bool {
+						return card.CreditLimit.Value.Cents() == 800000 && card.OverLimitPolicy.String() == "block"
+					})).Return(nil).Once()
+				},
+			},
+			expect: func(output *dtos.CardOutput, err error) {
+				s.NoError(err)
+				s.Equal(8000.0, *output.CreditLimit)
+				s.Equal("block", output.OverLimitPolicy)
+			},
+		},
 		{
 			name: "should update debit card successfully",
 			args: args{
@@ -198,6 +227,66 @@ func (s *UpdateCardUseCaseSuite) TestExecute() {
 				s.ErrorIs(err, customErrors.ErrForbidden)
 			},
 		},
+		{
+			name: "should return the new version when If-Match matches",
+			args: args{
+				userID:          validUserID,
+				cardID:          validCardID,
+				input:           &dtos.CardUpdateInput{Name: "Name", Flag: "visa", LastFourDigits: "1234"},
+				expectedVersion: intPtrUC(1),
+			},
+			dependencies: dependencies{
+				setupMocks: func() {
+					creditCard := buildCreditCard(s.T(), validUserID)
+					s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(creditCard, nil).Once()
+					s.repo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*entities.Card")).
+						Run(func(_ context.Context, card *entities.Card) { card.Version++ }).
+						Return(nil).Once()
+				},
+			},
+			expect: func(output *dtos.CardOutput, err error) {
+				s.NoError(err)
+				s.Equal(2, output.Version)
+			},
+		},
+		{
+			name: "should return precondition failed when If-Match version is stale",
+			args: args{
+				userID:          validUserID,
+				cardID:          validCardID,
+				input:           &dtos.CardUpdateInput{Name: "Name", Flag: "visa", LastFourDigits: "1234"},
+				expectedVersion: intPtrUC(2),
+			},
+			dependencies: dependencies{
+				setupMocks: func() {
+					creditCard := buildCreditCard(s.T(), validUserID)
+					s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(creditCard, nil).Once()
+				},
+			},
+			expect: func(output *dtos.CardOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, customErrors.ErrPreconditionFailed)
+			},
+		},
+		{
+			name: "should return precondition failed when card changes before the update",
+			args: args{
+				userID: validUserID,
+				cardID: validCardID,
+				input:  &dtos.CardUpdateInput{Name: "Name", Flag: "visa", LastFourDigits: "1234"},
+			},
+			dependencies: dependencies{
+				setupMocks: func() {
+					creditCard := buildCreditCard(s.T(), validUserID)
+					s.repo.EXPECT().FindByIDOnly(mock.Anything, mock.AnythingOfType("vos.UUID")).Return(creditCard, nil).Once()
+					s.repo.EXPECT().Update(mock.Anything, mock.AnythingOfType("*entities.Card")).Return(customErrors.ErrPreconditionFailed).Once()
+				},
+			},
+			expect: func(output *dtos.CardOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, customErrors.ErrPreconditionFailed)
+			},
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -205,7 +294,7 @@ func (s *UpdateCardUseCaseSuite) TestExecute() {
 			scenario.dependencies.setupMocks()
 			cardMetrics := metrics.NewTestCardMetrics()
 			uc := NewUpdateCardUseCase(s.obs, s.repo, cardMetrics)
-			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.cardID, scenario.args.input)
+			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.cardID, scenario.args.input, scenario.args.expectedVersion)
 			scenario.expect(output, err)
 		})
 	}
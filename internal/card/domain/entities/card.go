@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sharedVos "github.com/JailtonJunior94/devkit-go/pkg/vos"
+	domain "github.com/jailtonjunior94/financial/internal/card/domain"
 	"github.com/jailtonjunior94/financial/internal/card/domain/vos"
 )
 
@@ -16,9 +17,14 @@ type Card struct {
 	LastFourDigits    vos.LastFourDigits
 	DueDay            vos.DueDay
 	ClosingOffsetDays vos.ClosingOffsetDays
+	InterestRate      vos.MonthlyInterestRate
+	MinimumPayment    vos.MinimumPayment
+	CreditLimit       vos.CreditLimit
+	OverLimitPolicy   vos.OverLimitPolicy
 	CreatedAt         sharedVos.NullableTime
 	UpdatedAt         sharedVos.NullableTime
 	DeletedAt         sharedVos.NullableTime
+	Version           int
 }
 
 func NewCard(
@@ -37,10 +43,14 @@ func NewCard(
 		Flag:           flag,
 		LastFourDigits: lastFourDigits,
 		CreatedAt:      sharedVos.NewNullableTime(time.Now()),
+		Version:        1,
 	}
 	if cardType.IsCredit() {
 		card.DueDay = dueDay
 		card.ClosingOffsetDays = closingOffsetDays
+		card.InterestRate = vos.NewDefaultMonthlyInterestRate()
+		card.MinimumPayment = vos.NewDefaultMinimumPayment()
+		card.OverLimitPolicy = vos.NewDefaultOverLimitPolicy()
 	}
 	return card, nil
 }
@@ -77,6 +87,64 @@ func (c *Card) Update(name, flag, lastFourDigits string, dueDay, closingOffsetDa
 	return nil
 }
 
+// UpdateRevolvingTerms atualiza os juros do rotativo e o percentual do pagamento mínimo.
+// Cartões de débito não possuem fatura, portanto os valores são ignorados.
+func (c *Card) UpdateRevolvingTerms(interestRateBps, minimumPaymentBps int) error {
+	if !c.Type.IsCredit() {
+		return nil
+	}
+	rate, err := vos.NewMonthlyInterestRate(interestRateBps)
+	if err != nil {
+		return err
+	}
+	minimum, err := vos.NewMinimumPayment(minimumPaymentBps)
+	if err != nil {
+		return err
+	}
+	c.InterestRate = rate
+	c.MinimumPayment = minimum
+	c.UpdatedAt = sharedVos.NewNullableTime(time.Now())
+	return nil
+}
+
+// UpdateCreditLimit define o limite do cartão e a política para compras acima do limite disponível.
+// limit nil remove o controle de limite. Cartões de débito ignoram os valores.
+func (c *Card) UpdateCreditLimit(limit *sharedVos.Money, policy string) error {
+	if !c.Type.IsCredit() {
+		return nil
+	}
+	overLimitPolicy, err := vos.NewOverLimitPolicy(policy)
+	if err != nil {
+		return err
+	}
+	creditLimit := vos.CreditLimit{}
+	if limit != nil {
+		creditLimit, err = vos.NewCreditLimit(*limit)
+		if err != nil {
+			return err
+		}
+	}
+	c.CreditLimit = creditLimit
+	c.OverLimitPolicy = overLimitPolicy
+	c.UpdatedAt = sharedVos.NewNullableTime(time.Now())
+	return nil
+}
+
+// HasCreditLimit indica se o cartão controla limite.
+func (c *Card) HasCreditLimit() bool {
+	return c.Type.IsCredit() && c.CreditLimit.Valid
+}
+
+// AvailableLimit retorna o limite disponível: limite total menos o saldo em aberto nas faturas.
+// Pode ser negativo quando encargos do rotativo ultrapassam o limite, ou superar o limite
+// quando estornos deixam saldo credor nas faturas.
+func (c *Card) AvailableLimit(outstanding sharedVos.Money) (sharedVos.Money, error) {
+	if !c.HasCreditLimit() {
+		return sharedVos.Money{}, domain.ErrCardWithoutCreditLimit
+	}
+	return c.CreditLimit.Value.Subtract(outstanding)
+}
+
 func (c *Card) Delete() *Card {
 	c.DeletedAt = sharedVos.NewNullableTime(time.Now())
 	return c
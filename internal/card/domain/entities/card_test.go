@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sharedVos "github.com/JailtonJunior94/devkit-go/pkg/vos"
+	domain "github.com/jailtonjunior94/financial/internal/card/domain"
 	"github.com/jailtonjunior94/financial/internal/card/domain/entities"
 	"github.com/jailtonjunior94/financial/internal/card/domain/vos"
 )
@@ -103,6 +104,57 @@ func TestCardUpdate(t *testing.T) {
 	})
 }
 
+func TestCardCreditLimit(t *testing.T) {
+	t.Run("should compute available limit from outstanding balance", func(t *testing.T) {
+		card := createCreditCard(t)
+		limit, _ := sharedVos.NewMoney(500000, sharedVos.CurrencyBRL)
+		outstanding, _ := sharedVos.NewMoney(123456, sharedVos.CurrencyBRL)
+
+		require.NoError(t, card.UpdateCreditLimit(&limit, "block"))
+		available, err := card.AvailableLimit(outstanding)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(376544), available.Cents())
+	})
+
+	t.Run("should return negative available limit when outstanding exceeds limit", func(t *testing.T) {
+		card := createCreditCard(t)
+		limit, _ := sharedVos.NewMoney(100000, sharedVos.CurrencyBRL)
+		outstanding, _ := sharedVos.NewMoney(105000, sharedVos.CurrencyBRL)
+
+		require.NoError(t, card.UpdateCreditLimit(&limit, "flag"))
+		available, err := card.AvailableLimit(outstanding)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(-5000), available.Cents())
+	})
+
+	t.Run("should return error when card has no credit limit", func(t *testing.T) {
+		card := createCreditCard(t)
+		outstanding, _ := sharedVos.NewMoney(0, sharedVos.CurrencyBRL)
+
+		_, err := card.AvailableLimit(outstanding)
+
+		require.ErrorIs(t, err, domain.ErrCardWithoutCreditLimit)
+	})
+
+	t.Run("should ignore credit limit on debit card", func(t *testing.T) {
+		card := createDebitCard(t)
+		limit, _ := sharedVos.NewMoney(100000, sharedVos.CurrencyBRL)
+
+		require.NoError(t, card.UpdateCreditLimit(&limit, "block"))
+		require.False(t, card.HasCreditLimit())
+	})
+
+	t.Run("should reject invalid over limit policy", func(t *testing.T) {
+		card := createCreditCard(t)
+
+		err := card.UpdateCreditLimit(nil, "ignore")
+
+		require.ErrorIs(t, err, domain.ErrInvalidOverLimitPolicy)
+	})
+}
+
 func TestCardDelete(t *testing.T) {
 	t.Run("should soft delete card", func(t *testing.T) {
 		card := createCreditCard(t)
@@ -3,10 +3,13 @@ package domain
 import "errors"
 
 var (
-	ErrCardNotFound          = errors.New("card not found")
-	ErrCardHasOpenInvoices   = errors.New("card has open invoices")
-	ErrInvalidCardType       = errors.New("invalid card type: must be 'credit' or 'debit'")
-	ErrInvalidCardFlag       = errors.New("invalid card flag")
-	ErrInvalidLastFourDigits = errors.New("invalid last four digits: must be exactly 4 numeric digits")
-	ErrDueDayRequired        = errors.New("due_day is required for credit cards")
+	ErrCardNotFound           = errors.New("card not found")
+	ErrCardHasOpenInvoices    = errors.New("card has open invoices")
+	ErrInvalidCardType        = errors.New("invalid card type: must be 'credit' or 'debit'")
+	ErrInvalidCardFlag        = errors.New("invalid card flag")
+	ErrInvalidLastFourDigits  = errors.New("invalid last four digits: must be exactly 4 numeric digits")
+	ErrDueDayRequired         = errors.New("due_day is required for credit cards")
+	ErrInvalidCreditLimit     = errors.New("invalid credit limit: must not be negative")
+	ErrInvalidOverLimitPolicy = errors.New("invalid over limit policy: must be 'block' or 'flag'")
+	ErrCardWithoutCreditLimit = errors.New("card has no credit limit configured")
 )
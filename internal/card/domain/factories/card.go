@@ -17,6 +17,10 @@ type CreateCardParams struct {
 	LastFourDigits    string
 	DueDay            int
 	ClosingOffsetDays int
+	InterestRateBps   int      // Juros mensais do rotativo (0 = sem juros)
+	MinimumPaymentBps int      // Pagamento mínimo (0 = padrão de 15%)
+	CreditLimit       *float64 // Limite total (nil = sem controle de limite)
+	OverLimitPolicy   string   // block ou flag ("" = block)
 }
 
 func CreateCard(params CreateCardParams) (*entities.Card, error) {
@@ -52,6 +56,10 @@ func CreateCard(params CreateCardParams) (*entities.Card, error) {
 
 	var dueDay vos.DueDay
 	var closingOffsetDays vos.ClosingOffsetDays
+	var interestRate vos.MonthlyInterestRate
+	var minimumPayment vos.MinimumPayment
+	var creditLimit vos.CreditLimit
+	var overLimitPolicy vos.OverLimitPolicy
 
 	if cardType.IsCredit() {
 		dueDay, err = vos.NewDueDay(params.DueDay)
@@ -67,6 +75,40 @@ func CreateCard(params CreateCardParams) (*entities.Card, error) {
 				return nil, err
 			}
 		}
+
+		interestRate, err = vos.NewMonthlyInterestRate(params.InterestRateBps)
+		if err != nil {
+			return nil, err
+		}
+
+		if params.MinimumPaymentBps == 0 {
+			minimumPayment = vos.NewDefaultMinimumPayment()
+		} else {
+			minimumPayment, err = vos.NewMinimumPayment(params.MinimumPaymentBps)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if params.CreditLimit != nil {
+			amount, err := sharedVos.NewMoneyFromFloat(*params.CreditLimit, sharedVos.CurrencyBRL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid credit_limit: %w", err)
+			}
+			creditLimit, err = vos.NewCreditLimit(amount)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if params.OverLimitPolicy == "" {
+			overLimitPolicy = vos.NewDefaultOverLimitPolicy()
+		} else {
+			overLimitPolicy, err = vos.NewOverLimitPolicy(params.OverLimitPolicy)
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	card, err := entities.NewCard(user, cardName, cardType, cardFlag, digits, dueDay, closingOffsetDays)
@@ -74,6 +116,13 @@ func CreateCard(params CreateCardParams) (*entities.Card, error) {
 		return nil, fmt.Errorf("error creating card: %w", err)
 	}
 
+	if cardType.IsCredit() {
+		card.InterestRate = interestRate
+		card.MinimumPayment = minimumPayment
+		card.CreditLimit = creditLimit
+		card.OverLimitPolicy = overLimitPolicy
+	}
+
 	card.ID = id
 	return card, nil
 }
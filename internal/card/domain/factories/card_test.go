@@ -145,4 +145,74 @@ func TestCreateCard(t *testing.T) {
 		require.Error(t, err)
 		require.ErrorIs(t, err, domain.ErrInvalidLastFourDigits)
 	})
+	t.Run("should create credit card with credit limit and flag policy", func(t *testing.T) {
+		creditLimit := 5000.0
+		params := factories.CreateCardParams{
+			UserID:          "550e8400-e29b-41d4-a716-446655440000",
+			Name:            "Nubank",
+			Type:            "credit",
+			Flag:            "visa",
+			LastFourDigits:  "1234",
+			DueDay:          15,
+			CreditLimit:     &creditLimit,
+			OverLimitPolicy: "flag",
+		}
+
+		card, err := factories.CreateCard(params)
+
+		require.NoError(t, err)
+		require.True(t, card.HasCreditLimit())
+		require.Equal(t, int64(500000), card.CreditLimit.Value.Cents())
+		require.True(t, card.OverLimitPolicy.IsFlag())
+	})
+
+	t.Run("should default over limit policy to block without credit limit", func(t *testing.T) {
+		params := factories.CreateCardParams{
+			UserID:         "550e8400-e29b-41d4-a716-446655440000",
+			Name:           "Nubank",
+			Type:           "credit",
+			Flag:           "visa",
+			LastFourDigits: "1234",
+			DueDay:         15,
+		}
+
+		card, err := factories.CreateCard(params)
+
+		require.NoError(t, err)
+		require.False(t, card.HasCreditLimit())
+		require.Equal(t, "block", card.OverLimitPolicy.String())
+	})
+
+	t.Run("should return error for negative credit limit", func(t *testing.T) {
+		creditLimit := -1.0
+		params := factories.CreateCardParams{
+			UserID:         "550e8400-e29b-41d4-a716-446655440000",
+			Name:           "Nubank",
+			Type:           "credit",
+			Flag:           "visa",
+			LastFourDigits: "1234",
+			DueDay:         15,
+			CreditLimit:    &creditLimit,
+		}
+
+		_, err := factories.CreateCard(params)
+
+		require.ErrorIs(t, err, domain.ErrInvalidCreditLimit)
+	})
+
+	t.Run("should return error for invalid over limit policy", func(t *testing.T) {
+		params := factories.CreateCardParams{
+			UserID:          "550e8400-e29b-41d4-a716-446655440000",
+			Name:            "Nubank",
+			Type:            "credit",
+			Flag:            "visa",
+			LastFourDigits:  "1234",
+			DueDay:          15,
+			OverLimitPolicy: "ignore",
+		}
+
+		_, err := factories.CreateCard(params)
+
+		require.ErrorIs(t, err, domain.ErrInvalidOverLimitPolicy)
+	})
 }
@@ -8,4 +8,6 @@ import (
 
 type InvoiceChecker interface {
 	HasOpenInvoices(ctx context.Context, cardID vos.UUID) (bool, error)
+	// OutstandingAmount soma o saldo ainda não pago das faturas do cartão, que consome o limite.
+	OutstandingAmount(ctx context.Context, cardID vos.UUID) (vos.Money, error)
 }
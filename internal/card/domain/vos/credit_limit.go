@@ -0,0 +1,63 @@
+package vos
+
+import (
+	"fmt"
+
+	sharedVos "github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	domain "github.com/jailtonjunior94/financial/internal/card/domain"
+)
+
+const (
+	// OverLimitPolicyBlock rejeita compras acima do limite disponível.
+	OverLimitPolicyBlock = "block"
+	// OverLimitPolicyFlag aceita a compra e sinaliza que o limite foi excedido.
+	OverLimitPolicyFlag = "flag"
+)
+
+// CreditLimit representa o limite total de um cartão de crédito.
+// Valid = false indica cartão sem controle de limite.
+type CreditLimit struct {
+	Value sharedVos.Money
+	Valid bool
+}
+
+// NewCreditLimit cria um limite validado; o valor não pode ser negativo.
+func NewCreditLimit(amount sharedVos.Money) (CreditLimit, error) {
+	if amount.IsNegative() {
+		return CreditLimit{}, fmt.Errorf("invalid credit limit: %w", domain.ErrInvalidCreditLimit)
+	}
+	return CreditLimit{Value: amount, Valid: true}, nil
+}
+
+// Float retorna o limite em reais.
+func (l CreditLimit) Float() float64 {
+	return l.Value.Float()
+}
+
+// OverLimitPolicy define o tratamento de compras que excedem o limite disponível.
+type OverLimitPolicy struct {
+	Value string
+}
+
+// NewOverLimitPolicy cria uma política validada (block ou flag).
+func NewOverLimitPolicy(policy string) (OverLimitPolicy, error) {
+	if policy != OverLimitPolicyBlock && policy != OverLimitPolicyFlag {
+		return OverLimitPolicy{}, fmt.Errorf("invalid over limit policy: %w", domain.ErrInvalidOverLimitPolicy)
+	}
+	return OverLimitPolicy{Value: policy}, nil
+}
+
+// NewDefaultOverLimitPolicy cria a política padrão, que rejeita compras acima do limite.
+func NewDefaultOverLimitPolicy() OverLimitPolicy {
+	return OverLimitPolicy{Value: OverLimitPolicyBlock}
+}
+
+// IsFlag indica se compras acima do limite devem ser aceitas e sinalizadas.
+func (p OverLimitPolicy) IsFlag() bool {
+	return p.Value == OverLimitPolicyFlag
+}
+
+func (p OverLimitPolicy) String() string {
+	return p.Value
+}
@@ -0,0 +1,85 @@
+package vos
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultMonthlyInterestRateBps mantém o rotativo sem juros até o usuário configurar a taxa do cartão.
+	DefaultMonthlyInterestRateBps = 0
+	// DefaultMinimumPaymentBps é o mínimo de 15% praticado pelos emissores brasileiros.
+	DefaultMinimumPaymentBps = 1500
+	MaxRateBps               = 10000
+)
+
+var (
+	ErrMonthlyInterestRateInvalid = errors.New("monthly interest rate must be between 0% and 100%")
+	ErrMinimumPaymentInvalid      = errors.New("minimum payment percentage must be greater than 0% and at most 100%")
+)
+
+// MonthlyInterestRate representa os juros mensais do rotativo em basis points.
+// Exemplo: 1450 → 14,50% a.m.
+type MonthlyInterestRate struct {
+	Value int
+	Valid bool
+}
+
+// NewMonthlyInterestRate cria uma taxa de juros validada a partir de basis points.
+func NewMonthlyInterestRate(bps int) (MonthlyInterestRate, error) {
+	if bps < 0 || bps > MaxRateBps {
+		return MonthlyInterestRate{}, fmt.Errorf("invalid monthly interest rate: %w", ErrMonthlyInterestRateInvalid)
+	}
+	return MonthlyInterestRate{Value: bps, Valid: true}, nil
+}
+
+// NewDefaultMonthlyInterestRate cria a taxa padrão (sem juros).
+func NewDefaultMonthlyInterestRate() MonthlyInterestRate {
+	return MonthlyInterestRate{Value: DefaultMonthlyInterestRateBps, Valid: true}
+}
+
+// Int retorna a taxa em basis points.
+func (r MonthlyInterestRate) Int() int {
+	return r.Value
+}
+
+// Percent retorna a taxa em percentual (1450 → 14.5).
+func (r MonthlyInterestRate) Percent() float64 {
+	return float64(r.Value) / 100
+}
+
+// MinimumPayment representa o percentual do pagamento mínimo da fatura em basis points.
+// Exemplo: 1500 → 15%.
+type MinimumPayment struct {
+	Value int
+	Valid bool
+}
+
+// NewMinimumPayment cria um percentual de pagamento mínimo validado a partir de basis points.
+func NewMinimumPayment(bps int) (MinimumPayment, error) {
+	if bps < 1 || bps > MaxRateBps {
+		return MinimumPayment{}, fmt.Errorf("invalid minimum payment: %w", ErrMinimumPaymentInvalid)
+	}
+	return MinimumPayment{Value: bps, Valid: true}, nil
+}
+
+// NewDefaultMinimumPayment cria o percentual padrão de 15%.
+func NewDefaultMinimumPayment() MinimumPayment {
+	return MinimumPayment{Value: DefaultMinimumPaymentBps, Valid: true}
+}
+
+// Int retorna o percentual em basis points.
+func (m MinimumPayment) Int() int {
+	return m.Value
+}
+
+// Percent retorna o percentual (1500 → 15).
+func (m MinimumPayment) Percent() float64 {
+	return float64(m.Value) / 100
+}
+
+// PercentToBps converte um percentual informado pelo usuário (14.5) para basis points (1450).
+func PercentToBps(percent float64) int {
+	return int(math.Round(percent * 100))
+}
@@ -0,0 +1,113 @@
+package vos_test
+
+import (
+	"testing"
+
+	"github.com/jailtonjunior94/financial/internal/card/domain/vos"
+	"github.com/stretchr/testify/suite"
+)
+
+type RevolvingRatesVOSuite struct {
+	suite.Suite
+}
+
+func TestRevolvingRatesVOSuite(t *testing.T) {
+	suite.Run(t, new(RevolvingRatesVOSuite))
+}
+
+func (s *RevolvingRatesVOSuite) TestNewMonthlyInterestRate() {
+	scenarios := []struct {
+		name   string
+		input  int
+		expect func(rate vos.MonthlyInterestRate, err error)
+	}{
+		{
+			name:  "deve aceitar taxa zero",
+			input: 0,
+			expect: func(rate vos.MonthlyInterestRate, err error) {
+				s.NoError(err)
+				s.True(rate.Valid)
+				s.Equal(0, rate.Int())
+			},
+		},
+		{
+			name:  "deve criar taxa de 14,50% a.m.",
+			input: 1450,
+			expect: func(rate vos.MonthlyInterestRate, err error) {
+				s.NoError(err)
+				s.Equal(1450, rate.Int())
+				s.Equal(14.5, rate.Percent())
+			},
+		},
+		{
+			name:  "deve retornar erro para taxa negativa",
+			input: -1,
+			expect: func(rate vos.MonthlyInterestRate, err error) {
+				s.ErrorIs(err, vos.ErrMonthlyInterestRateInvalid)
+				s.False(rate.Valid)
+			},
+		},
+		{
+			name:  "deve retornar erro para taxa acima de 100%",
+			input: 10001,
+			expect: func(rate vos.MonthlyInterestRate, err error) {
+				s.ErrorIs(err, vos.ErrMonthlyInterestRateInvalid)
+				s.False(rate.Valid)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			rate, err := vos.NewMonthlyInterestRate(scenario.input)
+			scenario.expect(rate, err)
+		})
+	}
+}
+
+func (s *RevolvingRatesVOSuite) TestNewMinimumPayment() {
+	scenarios := []struct {
+		name   string
+		input  int
+		expect func(minimum vos.MinimumPayment, err error)
+	}{
+		{
+			name:  "deve criar mínimo de 15%",
+			input: 1500,
+			expect: func(minimum vos.MinimumPayment, err error) {
+				s.NoError(err)
+				s.True(minimum.Valid)
+				s.Equal(15.0, minimum.Percent())
+			},
+		},
+		{
+			name:  "deve aceitar 100%",
+			input: 10000,
+			expect: func(minimum vos.MinimumPayment, err error) {
+				s.NoError(err)
+				s.Equal(10000, minimum.Int())
+			},
+		},
+		{
+			name:  "deve retornar erro para zero",
+			input: 0,
+			expect: func(minimum vos.MinimumPayment, err error) {
+				s.ErrorIs(err, vos.ErrMinimumPaymentInvalid)
+				s.False(minimum.Valid)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			minimum, err := vos.NewMinimumPayment(scenario.input)
+			scenario.expect(minimum, err)
+		})
+	}
+}
+
+func (s *RevolvingRatesVOSuite) TestPercentToBps() {
+	s.Equal(1450, vos.PercentToBps(14.5))
+	s.Equal(1500, vos.PercentToBps(15))
+	s.Equal(899, vos.PercentToBps(8.99))
+}
@@ -10,11 +10,14 @@ import (
 // ErrorMappings returns the HTTP status mappings for card domain errors.
 func ErrorMappings() map[error]httperrors.ErrorMapping {
 	return map[error]httperrors.ErrorMapping{
-		domain.ErrCardNotFound:          {Status: http.StatusNotFound, Message: "Card not found"},
-		domain.ErrCardHasOpenInvoices:   {Status: http.StatusUnprocessableEntity, Message: "Card has open invoices and cannot be deleted"},
-		domain.ErrInvalidCardType:       {Status: http.StatusBadRequest, Message: "Invalid card type"},
-		domain.ErrInvalidCardFlag:       {Status: http.StatusBadRequest, Message: "Invalid card flag"},
-		domain.ErrInvalidLastFourDigits: {Status: http.StatusBadRequest, Message: "Invalid last four digits"},
-		domain.ErrDueDayRequired:        {Status: http.StatusBadRequest, Message: "Due day is required for credit cards"},
+		domain.ErrCardNotFound:           {Status: http.StatusNotFound, Message: "Card not found"},
+		domain.ErrCardHasOpenInvoices:    {Status: http.StatusUnprocessableEntity, Message: "Card has open invoices and cannot be deleted"},
+		domain.ErrInvalidCardType:        {Status: http.StatusBadRequest, Message: "Invalid card type"},
+		domain.ErrInvalidCardFlag:        {Status: http.StatusBadRequest, Message: "Invalid card flag"},
+		domain.ErrInvalidLastFourDigits:  {Status: http.StatusBadRequest, Message: "Invalid last four digits"},
+		domain.ErrDueDayRequired:         {Status: http.StatusBadRequest, Message: "Due day is required for credit cards"},
+		domain.ErrInvalidCreditLimit:     {Status: http.StatusBadRequest, Message: "Credit limit cannot be negative"},
+		domain.ErrInvalidOverLimitPolicy: {Status: http.StatusBadRequest, Message: "Over limit policy must be 'block' or 'flag'"},
+		domain.ErrCardWithoutCreditLimit: {Status: http.StatusUnprocessableEntity, Message: "Card has no credit limit configured"},
 	}
 }
@@ -13,15 +13,18 @@ import (
 
 type cardProviderAdapter struct {
 	cardRepository interfaces.CardRepository
+	invoiceChecker interfaces.InvoiceChecker
 	o11y           observability.Observability
 }
 
 func NewCardProviderAdapter(
 	cardRepository interfaces.CardRepository,
+	invoiceChecker interfaces.InvoiceChecker,
 	o11y observability.Observability,
 ) invoiceInterfaces.CardProvider {
 	return &cardProviderAdapter{
 		cardRepository: cardRepository,
+		invoiceChecker: invoiceChecker,
 		o11y:           o11y,
 	}
 }
@@ -48,5 +51,51 @@ func (a *cardProviderAdapter) GetCardBillingInfo(
 		CardID:            card.ID,
 		DueDay:            card.DueDay.Value,
 		ClosingOffsetDays: card.ClosingOffsetDays.Value,
+		InterestRateBps:   card.InterestRate.Int(),
+		MinimumPaymentBps: card.MinimumPayment.Int(),
 	}, nil
 }
+
+func (a *cardProviderAdapter) GetCardLimit(
+	ctx context.Context,
+	userID vos.UUID,
+	cardID vos.UUID,
+) (*invoiceInterfaces.CardLimitInfo, error) {
+	ctx, span := a.o11y.Tracer().Start(ctx, "card_provider_adapter.get_card_limit")
+	defer span.End()
+
+	card, err := a.cardRepository.FindByID(ctx, userID, cardID)
+	if err != nil {
+		a.o11y.Logger().Error(ctx, "failed to find card", observability.Error(err))
+		return nil, err
+	}
+
+	if card == nil {
+		return nil, cardDomain.ErrCardNotFound
+	}
+
+	info := &invoiceInterfaces.CardLimitInfo{
+		CardID:          card.ID,
+		HasLimit:        card.HasCreditLimit(),
+		OverLimitPolicy: card.OverLimitPolicy.String(),
+	}
+	if !info.HasLimit {
+		return info, nil
+	}
+
+	outstanding, err := a.invoiceChecker.OutstandingAmount(ctx, card.ID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	available, err := card.AvailableLimit(outstanding)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	info.CreditLimit = card.CreditLimit.Value
+	info.AvailableLimit = available
+	return info, nil
+}
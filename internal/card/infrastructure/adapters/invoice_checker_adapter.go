@@ -52,3 +52,23 @@ func (a *invoiceCheckerAdapter) HasOpenInvoices(ctx context.Context, cardID vos.
 
 	return false, nil
 }
+
+func (a *invoiceCheckerAdapter) OutstandingAmount(ctx context.Context, cardID vos.UUID) (vos.Money, error) {
+	ctx, span := a.o11y.Tracer().Start(ctx, "invoice_checker_adapter.outstanding_amount")
+	defer span.End()
+
+	amount, err := a.invoiceRepo.SumOutstandingByCard(ctx, cardID)
+	if err != nil {
+		span.RecordError(err)
+		a.o11y.Logger().Error(ctx, "invoice_check_failed",
+			observability.String("operation", "OutstandingAmount"),
+			observability.String("layer", "adapter"),
+			observability.String("entity", "invoice"),
+			observability.String("card_id", cardID.String()),
+			observability.Error(err),
+		)
+		return vos.Money{}, err
+	}
+
+	return amount, nil
+}
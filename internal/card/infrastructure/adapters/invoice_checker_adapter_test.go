@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
 	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 	"github.com/jailtonjunior94/financial/internal/card/infrastructure/adapters"
@@ -81,6 +82,32 @@ func (m *mockInvoiceRepository) FindStatus(ctx context.Context, invoiceID vos.UU
 	panic("not implemented")
 }
 
+func (m *mockInvoiceRepository) ListOpenUntil(ctx context.Context, referenceMonth pkgVos.ReferenceMonth, after *invoiceInterfaces.OpenInvoiceCursor, limit int) ([]*entities.Invoice, error) {
+	panic("not implemented")
+}
+
+func (m *mockInvoiceRepository) Close(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error) {
+	panic("not implemented")
+}
+
+func (m *mockInvoiceRepository) ApplyPayment(ctx context.Context, tx database.DBTX, invoice *entities.Invoice, amount vos.Money) (bool, error) {
+	panic("not implemented")
+}
+
+func (m *mockInvoiceRepository) MarkCarriedOver(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error) {
+	panic("not implemented")
+}
+
+func (m *mockInvoiceRepository) SumOutstandingByCard(ctx context.Context, cardID vos.UUID) (vos.Money, error) {
+	args := m.Called(ctx, cardID)
+	return args.Get(0).(vos.Money), args.Error(1)
+}
+
+func (m *mockInvoiceRepository) SumCategoryByUserAndMonth(ctx context.Context, userID vos.UUID, referenceMonth pkgVos.ReferenceMonth, categoryID vos.UUID) (vos.Money, error) {
+	args := m.Called(ctx, userID, referenceMonth, categoryID)
+	return args.Get(0).(vos.Money), args.Error(1)
+}
+
 func TestInvoiceCheckerAdapter_HasOpenInvoices(t *testing.T) {
 	cardID, _ := vos.NewUUIDFromString("550e8400-e29b-41d4-a716-446655440000")
 
@@ -152,3 +179,33 @@ func TestInvoiceCheckerAdapter_HasOpenInvoices(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 }
+
+func TestInvoiceCheckerAdapter_OutstandingAmount(t *testing.T) {
+	cardID, _ := vos.NewUUIDFromString("550e8400-e29b-41d4-a716-446655440000")
+
+	t.Run("should return outstanding amount from repository", func(t *testing.T) {
+		obs := fake.NewProvider()
+		mockRepo := &mockInvoiceRepository{}
+		outstanding, _ := vos.NewMoney(152030, vos.CurrencyBRL)
+		mockRepo.On("SumOutstandingByCard", mock.Anything, cardID).Return(outstanding, nil).Once()
+
+		checker := adapters.NewInvoiceCheckerAdapter(mockRepo, obs)
+		amount, err := checker.OutstandingAmount(context.Background(), cardID)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(152030), amount.Cents())
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("should return error when repository fails", func(t *testing.T) {
+		obs := fake.NewProvider()
+		mockRepo := &mockInvoiceRepository{}
+		mockRepo.On("SumOutstandingByCard", mock.Anything, cardID).Return(vos.Money{}, errors.New("db error")).Once()
+
+		checker := adapters.NewInvoiceCheckerAdapter(mockRepo, obs)
+		_, err := checker.OutstandingAmount(context.Background(), cardID)
+
+		require.Error(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/card/application/dtos"
 	"github.com/jailtonjunior94/financial/internal/card/application/usecase"
+	apihttp "github.com/jailtonjunior94/financial/pkg/api/http"
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/pagination"
@@ -25,6 +26,7 @@ type CardHandler struct {
 	findCardByUseCase        usecase.FindCardByUseCase
 	updateCardUseCase        usecase.UpdateCardUseCase
 	removeCardUseCase        usecase.RemoveCardUseCase
+	getCardLimitUseCase      usecase.GetCardLimitUseCase
 }
 
 func NewCardHandler(
@@ -35,6 +37,7 @@ func NewCardHandler(
 	findCardByUseCase usecase.FindCardByUseCase,
 	updateCardUseCase usecase.UpdateCardUseCase,
 	removeCardUseCase usecase.RemoveCardUseCase,
+	getCardLimitUseCase usecase.GetCardLimitUseCase,
 ) *CardHandler {
 	return &CardHandler{
 		o11y:                     o11y,
@@ -44,6 +47,7 @@ func NewCardHandler(
 		updateCardUseCase:        updateCardUseCase,
 		findCardByUseCase:        findCardByUseCase,
 		removeCardUseCase:        removeCardUseCase,
+		getCardLimitUseCase:      getCardLimitUseCase,
 	}
 }
 
@@ -242,6 +246,7 @@ func (h *CardHandler) Find(w http.ResponseWriter, r *http.Request) {
 //	@Security		BearerAuth
 //	@Param			id	path		string					true	"ID do cartão"	format(uuid)
 //	@Success		200	{object}	dtos.CardOutput			"Dados do cartão"
+//	@Header		200	{string}	ETag	"Versão atual do recurso"
 //	@Failure		401	{object}	httperrors.ProblemDetail	"Não autenticado"
 //	@Failure		403	{object}	httperrors.ProblemDetail	"Sem permissão"
 //	@Failure		404	{object}	httperrors.ProblemDetail	"Cartão não encontrado"
@@ -296,6 +301,67 @@ func (h *CardHandler) FindBy(w http.ResponseWriter, r *http.Request) {
 		observability.String("card_id", cardID),
 	)
 
+	apihttp.SetETag(w, output.Version)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Limit godoc
+//
+//	@Summary		Consultar limite do cartão
+//	@Description	Retorna o limite total, o valor comprometido e o limite disponível do cartão de crédito.
+//	@Description	O valor comprometido soma o saldo não pago de todas as faturas do cartão, incluindo parcelas futuras;
+//	@Description	pagamentos de fatura liberam limite.
+//	@Tags			cards
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string					true	"ID do cartão"	format(uuid)
+//	@Success		200	{object}	dtos.CardLimitOutput		"Limite do cartão"
+//	@Failure		401	{object}	httperrors.ProblemDetail	"Não autenticado"
+//	@Failure		403	{object}	httperrors.ProblemDetail	"Sem permissão"
+//	@Failure		404	{object}	httperrors.ProblemDetail	"Cartão não encontrado"
+//	@Failure		422	{object}	httperrors.ProblemDetail	"Cartão sem limite configurado"
+//	@Failure		500	{object}	httperrors.ProblemDetail	"Erro interno"
+//	@Router			/api/v1/cards/{id}/limit [get]
+func (h *CardHandler) Limit(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "card_handler.limit")
+	defer span.End()
+
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	cardID := chi.URLParam(r, "id")
+
+	output, err := h.getCardLimitUseCase.Execute(ctx, user.ID, cardID)
+	if err != nil {
+		h.o11y.Logger().Error(ctx, "request_failed",
+			observability.String("operation", "GetCardLimit"),
+			observability.String("layer", "handler"),
+			observability.String("entity", "card"),
+			observability.String("correlation_id", correlationID),
+			observability.String("user_id", user.ID),
+			observability.String("card_id", cardID),
+			observability.String("error_type", "business"),
+			observability.String("error_code", "GET_CARD_LIMIT_FAILED"),
+			observability.Error(err),
+		)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	h.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "GetCardLimit"),
+		observability.String("layer", "handler"),
+		observability.String("entity", "card"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", user.ID),
+		observability.String("card_id", cardID),
+	)
+
 	responses.JSON(w, http.StatusOK, output)
 }
 
@@ -309,11 +375,14 @@ func (h *CardHandler) FindBy(w http.ResponseWriter, r *http.Request) {
 //	@Security		BearerAuth
 //	@Param			id		path		string						true	"ID do cartão"	format(uuid)
 //	@Param			request	body		dtos.CardUpdateInput		true	"Dados atualizados do cartão"
+//	@Param			If-Match	header	string	false	"Versão lida (ETag) para controle de concorrência"
 //	@Success		200		{object}	dtos.CardOutput				"Cartão atualizado com sucesso"
+//	@Header		200	{string}	ETag	"Nova versão do recurso"
 //	@Failure		400		{object}	httperrors.ProblemDetail	"Dados inválidos"
 //	@Failure		401		{object}	httperrors.ProblemDetail	"Não autenticado"
 //	@Failure		403		{object}	httperrors.ProblemDetail	"Sem permissão"
 //	@Failure		404		{object}	httperrors.ProblemDetail	"Cartão não encontrado"
+//	@Failure		412		{object}	httperrors.ProblemDetail	"Recurso alterado desde a leitura (If-Match)"
 //	@Failure		500		{object}	httperrors.ProblemDetail	"Erro interno"
 //	@Router			/api/v1/cards/{id} [put]
 func (h *CardHandler) Update(w http.ResponseWriter, r *http.Request) {
@@ -329,6 +398,11 @@ func (h *CardHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	cardID := chi.URLParam(r, "id")
+	expectedVersion, err := apihttp.IfMatch(r)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
 
 	h.o11y.Logger().Info(ctx, "request_received",
 		observability.String("operation", "UpdateCard"),
@@ -369,7 +443,7 @@ func (h *CardHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	output, err := h.updateCardUseCase.Execute(ctx, user.ID, cardID, input)
+	output, err := h.updateCardUseCase.Execute(ctx, user.ID, cardID, input, expectedVersion)
 	if err != nil {
 		h.o11y.Logger().Error(ctx, "request_failed",
 			observability.String("operation", "UpdateCard"),
@@ -395,6 +469,7 @@ func (h *CardHandler) Update(w http.ResponseWriter, r *http.Request) {
 		observability.String("card_id", cardID),
 	)
 
+	apihttp.SetETag(w, output.Version)
 	responses.JSON(w, http.StatusOK, output)
 }
 
@@ -406,9 +481,11 @@ func (h *CardHandler) Update(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			id	path	string	true	"ID do cartão"	format(uuid)
+//	@Param			If-Match	header	string	false	"Versão lida (ETag) para controle de concorrência"
 //	@Success		204	"Cartão removido com sucesso"
 //	@Failure		401	{object}	httperrors.ProblemDetail	"Não autenticado"
 //	@Failure		404	{object}	httperrors.ProblemDetail	"Cartão não encontrado"
+//	@Failure		412	{object}	httperrors.ProblemDetail	"Recurso alterado desde a leitura (If-Match)"
 //	@Failure		422	{object}	httperrors.ProblemDetail	"Cartão com faturas em aberto"
 //	@Failure		500	{object}	httperrors.ProblemDetail	"Erro interno"
 //	@Router			/api/v1/cards/{id} [delete]
@@ -425,6 +502,11 @@ func (h *CardHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	cardID := chi.URLParam(r, "id")
+	expectedVersion, err := apihttp.IfMatch(r)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
 
 	h.o11y.Logger().Info(ctx, "request_received",
 		observability.String("operation", "DeleteCard"),
@@ -435,7 +517,7 @@ func (h *CardHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		observability.String("card_id", cardID),
 	)
 
-	if err := h.removeCardUseCase.Execute(ctx, user.ID, cardID); err != nil {
+	if err := h.removeCardUseCase.Execute(ctx, user.ID, cardID, expectedVersion); err != nil {
 		h.o11y.Logger().Error(ctx, "request_failed",
 			observability.String("operation", "DeleteCard"),
 			observability.String("layer", "handler"),
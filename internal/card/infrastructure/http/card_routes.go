@@ -7,23 +7,26 @@ import (
 )
 
 type CardRouter struct {
-	handlers       *CardHandler
-	authMiddleware middlewares.Authorization
+	handlers              *CardHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
 }
 
-func NewCardRouter(handlers *CardHandler, authMiddleware middlewares.Authorization) *CardRouter {
+func NewCardRouter(handlers *CardHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *CardRouter {
 	return &CardRouter{
-		handlers:       handlers,
-		authMiddleware: authMiddleware,
+		handlers:              handlers,
+		authMiddleware:        authMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
 	}
 }
 
 func (r CardRouter) Register(router chi.Router) {
 	router.Group(func(protected chi.Router) {
-		protected.Use(r.authMiddleware.Authorization)
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
 
 		protected.Get("/api/v1/cards", r.handlers.Find)
 		protected.Get("/api/v1/cards/{id}", r.handlers.FindBy)
+		protected.Get("/api/v1/cards/{id}/limit", r.handlers.Limit)
 		protected.Post("/api/v1/cards", r.handlers.Create)
 		protected.Put("/api/v1/cards/{id}", r.handlers.Update)
 		protected.Delete("/api/v1/cards/{id}", r.handlers.Delete)
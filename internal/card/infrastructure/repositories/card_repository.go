@@ -8,6 +8,7 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/card/domain/entities"
 	"github.com/jailtonjunior94/financial/internal/card/domain/interfaces"
+	cardVos "github.com/jailtonjunior94/financial/internal/card/domain/vos"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
@@ -50,9 +51,14 @@ func (r *cardRepository) List(ctx context.Context, userID vos.UUID) ([]*entities
 				last_four_digits,
 				due_day,
 				closing_offset_days,
+				monthly_interest_rate_bps,
+				minimum_payment_bps,
+				credit_limit,
+				over_limit_policy,
 				created_at,
 				updated_at,
-				deleted_at
+				deleted_at,
+				version
 			from
 				cards
 			where
@@ -85,6 +91,10 @@ func (r *cardRepository) List(ctx context.Context, userID vos.UUID) ([]*entities
 		var card entities.Card
 		var dueDayNull sql.NullInt32
 		var closingOffsetNull sql.NullInt32
+		var interestRateNull sql.NullInt32
+		var minimumPaymentNull sql.NullInt32
+		var creditLimitNull sql.NullString
+		var overLimitPolicyNull sql.NullString
 
 		err := rows.Scan(
 			&card.ID.Value,
@@ -95,9 +105,14 @@ func (r *cardRepository) List(ctx context.Context, userID vos.UUID) ([]*entities
 			&card.LastFourDigits.Value,
 			&dueDayNull,
 			&closingOffsetNull,
+			&interestRateNull,
+			&minimumPaymentNull,
+			&creditLimitNull,
+			&overLimitPolicyNull,
 			&card.CreatedAt,
 			&card.UpdatedAt,
 			&card.DeletedAt,
+			&card.Version,
 		)
 		if err != nil {
 			span.RecordError(err)
@@ -117,6 +132,16 @@ func (r *cardRepository) List(ctx context.Context, userID vos.UUID) ([]*entities
 		if closingOffsetNull.Valid {
 			card.ClosingOffsetDays.Value = int(closingOffsetNull.Int32)
 		}
+		if interestRateNull.Valid {
+			card.InterestRate = cardVos.MonthlyInterestRate{Value: int(interestRateNull.Int32), Valid: true}
+		}
+		if minimumPaymentNull.Valid {
+			card.MinimumPayment = cardVos.MinimumPayment{Value: int(minimumPaymentNull.Int32), Valid: true}
+		}
+		if err := applyCreditLimit(&card, creditLimitNull, overLimitPolicyNull); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
 		cards = append(cards, &card)
 	}
 
@@ -179,9 +204,14 @@ func (r *cardRepository) ListPaginated(ctx context.Context, params interfaces.Li
 			last_four_digits,
 			due_day,
 			closing_offset_days,
+			monthly_interest_rate_bps,
+			minimum_payment_bps,
+			credit_limit,
+			over_limit_policy,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			version
 		FROM cards
 		WHERE %s
 		ORDER BY name ASC, id ASC
@@ -213,6 +243,10 @@ func (r *cardRepository) ListPaginated(ctx context.Context, params interfaces.Li
 		var card entities.Card
 		var dueDayNull sql.NullInt32
 		var closingOffsetNull sql.NullInt32
+		var interestRateNull sql.NullInt32
+		var minimumPaymentNull sql.NullInt32
+		var creditLimitNull sql.NullString
+		var overLimitPolicyNull sql.NullString
 
 		err := rows.Scan(
 			&card.ID.Value,
@@ -223,9 +257,14 @@ func (r *cardRepository) ListPaginated(ctx context.Context, params interfaces.Li
 			&card.LastFourDigits.Value,
 			&dueDayNull,
 			&closingOffsetNull,
+			&interestRateNull,
+			&minimumPaymentNull,
+			&creditLimitNull,
+			&overLimitPolicyNull,
 			&card.CreatedAt,
 			&card.UpdatedAt,
 			&card.DeletedAt,
+			&card.Version,
 		)
 		if err != nil {
 			span.RecordError(err)
@@ -245,6 +284,16 @@ func (r *cardRepository) ListPaginated(ctx context.Context, params interfaces.Li
 		if closingOffsetNull.Valid {
 			card.ClosingOffsetDays.Value = int(closingOffsetNull.Int32)
 		}
+		if interestRateNull.Valid {
+			card.InterestRate = cardVos.MonthlyInterestRate{Value: int(interestRateNull.Int32), Valid: true}
+		}
+		if minimumPaymentNull.Valid {
+			card.MinimumPayment = cardVos.MinimumPayment{Value: int(minimumPaymentNull.Int32), Valid: true}
+		}
+		if err := applyCreditLimit(&card, creditLimitNull, overLimitPolicyNull); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
 		cards = append(cards, &card)
 	}
 
@@ -270,3 +319,18 @@ func (r *cardRepository) ListPaginated(ctx context.Context, params interfaces.Li
 	r.fm.RecordRepositoryQuery(ctx, "list_paginated", "card", time.Since(start))
 	return cards, nil
 }
+
+// applyCreditLimit converte as colunas de limite (nulas em cartões de débito ou sem limite) para o cartão.
+func applyCreditLimit(card *entities.Card, creditLimit, overLimitPolicy sql.NullString) error {
+	if creditLimit.Valid {
+		amount, err := vos.NewMoneyFromString(creditLimit.String, vos.CurrencyBRL)
+		if err != nil {
+			return fmt.Errorf("invalid credit_limit for card %s: %w", card.ID.String(), err)
+		}
+		card.CreditLimit = cardVos.CreditLimit{Value: amount, Valid: true}
+	}
+	if overLimitPolicy.Valid {
+		card.OverLimitPolicy = cardVos.OverLimitPolicy{Value: overLimitPolicy.String}
+	}
+	return nil
+}
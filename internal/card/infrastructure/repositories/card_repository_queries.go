@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/jailtonjunior94/financial/internal/card/domain/entities"
+	cardVos "github.com/jailtonjunior94/financial/internal/card/domain/vos"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
@@ -33,9 +34,14 @@ func (r *cardRepository) FindByIDOnly(ctx context.Context, id vos.UUID) (*entiti
 				last_four_digits,
 				due_day,
 				closing_offset_days,
+				monthly_interest_rate_bps,
+				minimum_payment_bps,
+				credit_limit,
+				over_limit_policy,
 				created_at,
 				updated_at,
-				deleted_at
+				deleted_at,
+				version
 			from
 				cards
 			where
@@ -45,6 +51,10 @@ func (r *cardRepository) FindByIDOnly(ctx context.Context, id vos.UUID) (*entiti
 	var card entities.Card
 	var dueDayNull sql.NullInt32
 	var closingOffsetNull sql.NullInt32
+	var interestRateNull sql.NullInt32
+	var minimumPaymentNull sql.NullInt32
+	var creditLimitNull sql.NullString
+	var overLimitPolicyNull sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, id.String()).Scan(
 		&card.ID.Value,
@@ -55,9 +65,14 @@ func (r *cardRepository) FindByIDOnly(ctx context.Context, id vos.UUID) (*entiti
 		&card.LastFourDigits.Value,
 		&dueDayNull,
 		&closingOffsetNull,
+		&interestRateNull,
+		&minimumPaymentNull,
+		&creditLimitNull,
+		&overLimitPolicyNull,
 		&card.CreatedAt,
 		&card.UpdatedAt,
 		&card.DeletedAt,
+		&card.Version,
 	)
 
 	if err != nil {
@@ -89,6 +104,16 @@ func (r *cardRepository) FindByIDOnly(ctx context.Context, id vos.UUID) (*entiti
 	if closingOffsetNull.Valid {
 		card.ClosingOffsetDays.Value = int(closingOffsetNull.Int32)
 	}
+	if interestRateNull.Valid {
+		card.InterestRate = cardVos.MonthlyInterestRate{Value: int(interestRateNull.Int32), Valid: true}
+	}
+	if minimumPaymentNull.Valid {
+		card.MinimumPayment = cardVos.MinimumPayment{Value: int(minimumPaymentNull.Int32), Valid: true}
+	}
+	if err := applyCreditLimit(&card, creditLimitNull, overLimitPolicyNull); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
 
 	r.o11y.Logger().Debug(ctx, "query_completed",
 		observability.String("operation", "find_by_id_only"),
@@ -122,9 +147,14 @@ func (r *cardRepository) FindByID(ctx context.Context, userID, id vos.UUID) (*en
 				last_four_digits,
 				due_day,
 				closing_offset_days,
+				monthly_interest_rate_bps,
+				minimum_payment_bps,
+				credit_limit,
+				over_limit_policy,
 				created_at,
 				updated_at,
-				deleted_at
+				deleted_at,
+				version
 			from
 				cards
 			where
@@ -135,6 +165,10 @@ func (r *cardRepository) FindByID(ctx context.Context, userID, id vos.UUID) (*en
 	var card entities.Card
 	var dueDayNull sql.NullInt32
 	var closingOffsetNull sql.NullInt32
+	var interestRateNull sql.NullInt32
+	var minimumPaymentNull sql.NullInt32
+	var creditLimitNull sql.NullString
+	var overLimitPolicyNull sql.NullString
 
 	err := r.db.QueryRowContext(ctx, query, userID.String(), id.String()).Scan(
 		&card.ID.Value,
@@ -145,9 +179,14 @@ func (r *cardRepository) FindByID(ctx context.Context, userID, id vos.UUID) (*en
 		&card.LastFourDigits.Value,
 		&dueDayNull,
 		&closingOffsetNull,
+		&interestRateNull,
+		&minimumPaymentNull,
+		&creditLimitNull,
+		&overLimitPolicyNull,
 		&card.CreatedAt,
 		&card.UpdatedAt,
 		&card.DeletedAt,
+		&card.Version,
 	)
 
 	if err != nil {
@@ -181,6 +220,16 @@ func (r *cardRepository) FindByID(ctx context.Context, userID, id vos.UUID) (*en
 	if closingOffsetNull.Valid {
 		card.ClosingOffsetDays.Value = int(closingOffsetNull.Int32)
 	}
+	if interestRateNull.Valid {
+		card.InterestRate = cardVos.MonthlyInterestRate{Value: int(interestRateNull.Int32), Valid: true}
+	}
+	if minimumPaymentNull.Valid {
+		card.MinimumPayment = cardVos.MinimumPayment{Value: int(minimumPaymentNull.Int32), Valid: true}
+	}
+	if err := applyCreditLimit(&card, creditLimitNull, overLimitPolicyNull); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
 
 	r.o11y.Logger().Debug(ctx, "query_completed",
 		observability.String("operation", "find_by_id"),
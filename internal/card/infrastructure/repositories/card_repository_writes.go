@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/jailtonjunior94/financial/internal/card/domain/entities"
+	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 )
@@ -31,12 +32,16 @@ func (r *cardRepository) Save(ctx context.Context, card *entities.Card) error {
 					last_four_digits,
 					due_day,
 					closing_offset_days,
+					monthly_interest_rate_bps,
+					minimum_payment_bps,
+					credit_limit,
+					over_limit_policy,
 					created_at,
 					updated_at,
 					deleted_at
 				)
 				values
-					($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+					($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
 
 	stmt, err := r.db.PrepareContext(ctx, query)
 	if err != nil {
@@ -59,9 +64,19 @@ func (r *cardRepository) Save(ctx context.Context, card *entities.Card) error {
 
 	var dueDay any
 	var closingOffset any
+	var interestRate any
+	var minimumPayment any
+	var creditLimit any
+	var overLimitPolicy any
 	if card.Type.IsCredit() {
 		dueDay = card.DueDay.Value
 		closingOffset = card.ClosingOffsetDays.Value
+		interestRate = card.InterestRate.Int()
+		minimumPayment = card.MinimumPayment.Int()
+		overLimitPolicy = card.OverLimitPolicy.String()
+		if card.CreditLimit.Valid {
+			creditLimit = card.CreditLimit.Float()
+		}
 	}
 
 	_, err = stmt.ExecContext(
@@ -74,6 +89,10 @@ func (r *cardRepository) Save(ctx context.Context, card *entities.Card) error {
 		card.LastFourDigits.Value,
 		dueDay,
 		closingOffset,
+		interestRate,
+		minimumPayment,
+		creditLimit,
+		overLimitPolicy,
 		card.CreatedAt.Ptr(),
 		card.UpdatedAt.Ptr(),
 		card.DeletedAt.Ptr(),
@@ -121,11 +140,17 @@ func (r *cardRepository) Update(ctx context.Context, card *entities.Card) error
 				last_four_digits = $3,
 				due_day = $4,
 				closing_offset_days = $5,
-				updated_at = $6,
-				deleted_at = $7
+				monthly_interest_rate_bps = $6,
+				minimum_payment_bps = $7,
+				credit_limit = $8,
+				over_limit_policy = $9,
+				updated_at = $10,
+				deleted_at = $11,
+				version = version + 1
 			where
-				id = $8
-				and user_id = $9`
+				id = $12
+				and user_id = $13
+				and version = $14`
 
 	stmt, err := r.db.PrepareContext(ctx, query)
 	if err != nil {
@@ -148,22 +173,37 @@ func (r *cardRepository) Update(ctx context.Context, card *entities.Card) error
 
 	var dueDay any
 	var closingOffset any
+	var interestRate any
+	var minimumPayment any
+	var creditLimit any
+	var overLimitPolicy any
 	if card.Type.IsCredit() {
 		dueDay = card.DueDay.Value
 		closingOffset = card.ClosingOffsetDays.Value
+		interestRate = card.InterestRate.Int()
+		minimumPayment = card.MinimumPayment.Int()
+		overLimitPolicy = card.OverLimitPolicy.String()
+		if card.CreditLimit.Valid {
+			creditLimit = card.CreditLimit.Float()
+		}
 	}
 
-	_, err = stmt.ExecContext(
+	result, err := stmt.ExecContext(
 		ctx,
 		card.Name.Value,
 		card.Flag.Value,
 		card.LastFourDigits.Value,
 		dueDay,
 		closingOffset,
+		interestRate,
+		minimumPayment,
+		creditLimit,
+		overLimitPolicy,
 		card.UpdatedAt.Ptr(),
 		card.DeletedAt.Ptr(),
 		card.ID.Value,
 		card.UserID.Value,
+		card.Version,
 	)
 	if err != nil {
 		span.RecordError(err)
@@ -178,6 +218,26 @@ func (r *cardRepository) Update(ctx context.Context, card *entities.Card) error
 		return err
 	}
 
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "update", "card", "infra", time.Since(start))
+		return err
+	}
+
+	if affected == 0 {
+		r.o11y.Logger().Warn(ctx, "version_conflict",
+			observability.String("operation", "update"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "card"),
+			observability.String("user_id", card.UserID.String()),
+			observability.Int("version", card.Version),
+		)
+		r.fm.RecordRepositoryFailure(ctx, "update", "card", "business", time.Since(start))
+		return customErrors.ErrPreconditionFailed
+	}
+	card.Version++
+
 	r.o11y.Logger().Debug(ctx, "query_completed",
 		observability.String("operation", "update"),
 		observability.String("layer", "repository"),
@@ -103,3 +103,69 @@ func (_c *InvoiceChecker_HasOpenInvoices_Call) RunAndReturn(run func(ctx context
 	_c.Call.Return(run)
 	return _c
 }
+
+// OutstandingAmount provides a mock function for the type InvoiceChecker
+func (_mock *InvoiceChecker) OutstandingAmount(ctx context.Context, cardID vos.UUID) (vos.Money, error) {
+	ret := _mock.Called(ctx, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OutstandingAmount")
+	}
+
+	var r0 vos.Money
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID) (vos.Money, error)); ok {
+		return returnFunc(ctx, cardID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID) vos.Money); ok {
+		r0 = returnFunc(ctx, cardID)
+	} else {
+		r0 = ret.Get(0).(vos.Money)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, vos.UUID) error); ok {
+		r1 = returnFunc(ctx, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InvoiceChecker_OutstandingAmount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OutstandingAmount'
+type InvoiceChecker_OutstandingAmount_Call struct {
+	*mock.Call
+}
+
+// OutstandingAmount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cardID vos.UUID
+func (_e *InvoiceChecker_Expecter) OutstandingAmount(ctx interface{}, cardID interface{}) *InvoiceChecker_OutstandingAmount_Call {
+	return &InvoiceChecker_OutstandingAmount_Call{Call: _e.mock.On("OutstandingAmount", ctx, cardID)}
+}
+
+func (_c *InvoiceChecker_OutstandingAmount_Call) Run(run func(ctx context.Context, cardID vos.UUID)) *InvoiceChecker_OutstandingAmount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 vos.UUID
+		if args[1] != nil {
+			arg1 = args[1].(vos.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceChecker_OutstandingAmount_Call) Return(money vos.Money, err error) *InvoiceChecker_OutstandingAmount_Call {
+	_c.Call.Return(money, err)
+	return _c
+}
+
+func (_c *InvoiceChecker_OutstandingAmount_Call) RunAndReturn(run func(ctx context.Context, cardID vos.UUID) (vos.Money, error)) *InvoiceChecker_OutstandingAmount_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/auth"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
@@ -29,6 +30,7 @@ func NewCardModule(
 ) CardModule {
 	errorHandler := httperrors.NewErrorHandler(o11y, ErrorMappings())
 	authMiddleware := middlewares.NewAuthorization(tokenValidator, o11y, errorHandler)
+	idempotencyMiddleware := middlewares.NewIdempotency(idempotency.NewRepository(db, o11y), idempotency.DefaultTTL, o11y, errorHandler)
 
 	cardMetrics := metrics.NewCardMetrics(o11y)
 	financialMetrics := metrics.NewFinancialMetrics(o11y)
@@ -41,6 +43,7 @@ func NewCardModule(
 	createCardUsecase := usecase.NewCreateCardUseCase(o11y, cardRepository, cardMetrics)
 	updateCardUsecase := usecase.NewUpdateCardUseCase(o11y, cardRepository, cardMetrics)
 	removeCardUsecase := usecase.NewRemoveCardUseCase(o11y, cardRepository, invoiceChecker, cardMetrics)
+	getCardLimitUsecase := usecase.NewGetCardLimitUseCase(o11y, cardRepository, invoiceChecker, cardMetrics)
 
 	cardHandler := http.NewCardHandler(
 		o11y,
@@ -50,10 +53,11 @@ func NewCardModule(
 		findCardByUsecase,
 		updateCardUsecase,
 		removeCardUsecase,
+		getCardLimitUsecase,
 	)
 
-	cardRouter := http.NewCardRouter(cardHandler, authMiddleware)
-	cardProvider := adapters.NewCardProviderAdapter(cardRepository, o11y)
+	cardRouter := http.NewCardRouter(cardHandler, authMiddleware, idempotencyMiddleware)
+	cardProvider := adapters.NewCardProviderAdapter(cardRepository, invoiceChecker, o11y)
 
 	return CardModule{
 		CardRouter:   cardRouter,
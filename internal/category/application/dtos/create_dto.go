@@ -17,6 +17,7 @@ type (
 		Name          string              `json:"name"                example:"Alimentacao"`
 		Sequence      uint                `json:"sequence"            example:"1"`
 		CreatedAt     time.Time           `json:"created_at"          example:"2025-01-15T10:30:00Z"`
+		Version       int                 `json:"version"             example:"3"`
 		Subcategories []SubcategoryOutput `json:"subcategories,omitempty"`
 	}
 )
@@ -54,5 +54,6 @@ func (u *createCategoryUseCase) Execute(ctx context.Context, userID string, inpu
 		Name:      category.Name.String(),
 		Sequence:  category.Sequence.Value(),
 		CreatedAt: category.CreatedAt.ValueOr(time.Time{}),
+		Version:   category.Version,
 	}, nil
 }
@@ -84,6 +84,7 @@ func (u *findCategoryByUseCase) Execute(ctx context.Context, userID, id string)
 		Name:          category.Name.String(),
 		Sequence:      category.Sequence.Value(),
 		CreatedAt:     category.CreatedAt.ValueOr(time.Time{}),
+		Version:       category.Version,
 		Subcategories: subOutputs,
 	}, nil
 }
@@ -99,6 +99,7 @@ func (u *findCategoryPaginatedUseCase) Execute(ctx context.Context, input FindCa
 			Name:      category.Name.String(),
 			Sequence:  category.Sequence.Value(),
 			CreatedAt: category.CreatedAt.ValueOr(time.Time{}),
+			Version:   category.Version,
 		}
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	categorydomain "github.com/jailtonjunior94/financial/internal/category/domain"
 	"github.com/jailtonjunior94/financial/internal/category/domain/interfaces"
+	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
@@ -15,7 +16,7 @@ import (
 
 type (
 	RemoveCategoryUseCase interface {
-		Execute(ctx context.Context, userID, id string) error
+		Execute(ctx context.Context, userID, id string, expectedVersion *int) error
 	}
 
 	removeCategoryUseCase struct {
@@ -46,7 +47,7 @@ func NewRemoveCategoryUseCase(
 	}
 }
 
-func (u *removeCategoryUseCase) Execute(ctx context.Context, userID, id string) error {
+func (u *removeCategoryUseCase) Execute(ctx context.Context, userID, id string, expectedVersion *int) error {
 	ctx, span := u.o11y.Tracer().Start(ctx, "remove_category_usecase.execute")
 	defer span.End()
 
@@ -69,6 +70,10 @@ func (u *removeCategoryUseCase) Execute(ctx context.Context, userID, id string)
 		return categorydomain.ErrCategoryNotFound
 	}
 
+	if expectedVersion != nil && *expectedVersion != category.Version {
+		return customErrors.ErrPreconditionFailed
+	}
+
 	return u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
 		subcatRepo := u.subcatRepoFactory(tx)
 		catRepo := u.catRepoFactory(tx)
@@ -77,6 +82,6 @@ func (u *removeCategoryUseCase) Execute(ctx context.Context, userID, id string)
 			return err
 		}
 
-		return catRepo.SoftDelete(ctx, category.ID)
+		return catRepo.SoftDelete(ctx, category.ID, category.Version)
 	})
 }
@@ -13,15 +13,21 @@ import (
 	categorydomain "github.com/jailtonjunior94/financial/internal/category/domain"
 	"github.com/jailtonjunior94/financial/internal/category/domain/interfaces"
 	mocks "github.com/jailtonjunior94/financial/internal/category/infrastructure/repositories/mocks"
+	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 )
 
 // mockUnitOfWork is a simple inline mock for uow.UnitOfWork.
+// When run is set, fn is executed and its error returned instead of returnErr.
 type mockUnitOfWork struct {
 	returnErr error
+	run       bool
 }
 
 func (m *mockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, tx database.DBTX) error) error {
+	if m.run {
+		return fn(ctx, nil)
+	}
 	return m.returnErr
 }
 
@@ -47,13 +53,15 @@ func (s *RemoveCategoryUseCaseSuite) SetupTest() {
 
 func (s *RemoveCategoryUseCaseSuite) TestExecute() {
 	type args struct {
-		userID     string
-		categoryID string
+		userID          string
+		categoryID      string
+		expectedVersion *int
 	}
 
 	type dependencies struct {
-		categoryRepository *mocks.CategoryRepository
-		uow                *mockUnitOfWork
+		categoryRepository    *mocks.CategoryRepository
+		subcategoryRepository *mocks.SubcategoryRepository
+		uow                   *mockUnitOfWork
 	}
 
 	scenarios := []struct {
@@ -132,14 +140,62 @@ func (s *RemoveCategoryUseCaseSuite) TestExecute() {
 				s.Contains(err.Error(), "invalid UUID")
 			},
 		},
+		{
+			name: "deve retornar precondition failed quando a versão do If-Match estiver desatualizada",
+			args: args{
+				userID:          "550e8400-e29b-41d4-a716-446655440000",
+				categoryID:      "660e8400-e29b-41d4-a716-446655440001",
+				expectedVersion: func() *int { v := 2; return &v }(),
+			},
+			dependencies: dependencies{
+				categoryRepository: func() *mocks.CategoryRepository {
+					userID, _ := vos.NewUUIDFromString("550e8400-e29b-41d4-a716-446655440000")
+					categoryID, _ := vos.NewUUIDFromString("660e8400-e29b-41d4-a716-446655440001")
+					category := createCategoryForTest("660e8400-e29b-41d4-a716-446655440001", "Transport", 1)
+					s.categoryRepository.EXPECT().FindByID(s.ctx, userID, categoryID).Return(category, nil).Once()
+					return s.categoryRepository
+				}(),
+				uow: &mockUnitOfWork{},
+			},
+			expect: func(err error) {
+				s.ErrorIs(err, customErrors.ErrPreconditionFailed)
+			},
+		},
+		{
+			name: "deve retornar precondition failed quando a categoria mudar entre a leitura e a remoção",
+			args: args{
+				userID:          "550e8400-e29b-41d4-a716-446655440000",
+				categoryID:      "660e8400-e29b-41d4-a716-446655440001",
+				expectedVersion: func() *int { v := 1; return &v }(),
+			},
+			dependencies: func() dependencies {
+				userID, _ := vos.NewUUIDFromString("550e8400-e29b-41d4-a716-446655440000")
+				categoryID, _ := vos.NewUUIDFromString("660e8400-e29b-41d4-a716-446655440001")
+				category := createCategoryForTest("660e8400-e29b-41d4-a716-446655440001", "Transport", 1)
+				subcategoryRepository := mocks.NewSubcategoryRepository(s.T())
+				s.categoryRepository.EXPECT().FindByID(s.ctx, userID, categoryID).Return(category, nil).Once()
+				subcategoryRepository.EXPECT().SoftDeleteByCategoryID(s.ctx, categoryID).Return(nil).Once()
+				s.categoryRepository.EXPECT().SoftDelete(s.ctx, categoryID, 1).Return(customErrors.ErrPreconditionFailed).Once()
+				return dependencies{
+					categoryRepository:    s.categoryRepository,
+					subcategoryRepository: subcategoryRepository,
+					uow:                   &mockUnitOfWork{run: true},
+				}
+			}(),
+			expect: func(err error) {
+				s.ErrorIs(err, customErrors.ErrPreconditionFailed)
+			},
+		},
 	}
 
 	for _, scenario := range scenarios {
 		s.Run(scenario.name, func() {
 			catFactory := func(tx database.DBTX) interfaces.CategoryRepository { return scenario.dependencies.categoryRepository }
-			subcatFactory := func(tx database.DBTX) interfaces.SubcategoryRepository { return nil }
+			subcatFactory := func(tx database.DBTX) interfaces.SubcategoryRepository {
+				return scenario.dependencies.subcategoryRepository
+			}
 			uc := NewRemoveCategoryUseCase(s.obs, s.fm, scenario.dependencies.uow, scenario.dependencies.categoryRepository, catFactory, subcatFactory)
-			err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.categoryID)
+			err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.categoryID, scenario.args.expectedVersion)
 			scenario.expect(err)
 		})
 	}
@@ -7,6 +7,7 @@ import (
 	"github.com/jailtonjunior94/financial/internal/category/application/dtos"
 	categorydomain "github.com/jailtonjunior94/financial/internal/category/domain"
 	"github.com/jailtonjunior94/financial/internal/category/domain/interfaces"
+	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
@@ -15,7 +16,7 @@ import (
 
 type (
 	UpdateCategoryUseCase interface {
-		Execute(ctx context.Context, userID, id string, input *dtos.CategoryInput) (*dtos.CategoryOutput, error)
+		Execute(ctx context.Context, userID, id string, input *dtos.CategoryInput, expectedVersion *int) (*dtos.CategoryOutput, error)
 	}
 
 	updateCategoryUseCase struct {
@@ -37,7 +38,7 @@ func NewUpdateCategoryUseCase(
 	}
 }
 
-func (u *updateCategoryUseCase) Execute(ctx context.Context, userID, id string, input *dtos.CategoryInput) (*dtos.CategoryOutput, error) {
+func (u *updateCategoryUseCase) Execute(ctx context.Context, userID, id string, input *dtos.CategoryInput, expectedVersion *int) (*dtos.CategoryOutput, error) {
 	ctx, span := u.o11y.Tracer().Start(ctx, "update_category_usecase.execute")
 	defer span.End()
 
@@ -60,6 +61,10 @@ func (u *updateCategoryUseCase) Execute(ctx context.Context, userID, id string,
 		return nil, categorydomain.ErrCategoryNotFound
 	}
 
+	if expectedVersion != nil && *expectedVersion != category.Version {
+		return nil, customErrors.ErrPreconditionFailed
+	}
+
 	if err := category.Update(input.Name, input.Sequence); err != nil {
 		return nil, err
 	}
@@ -73,5 +78,6 @@ func (u *updateCategoryUseCase) Execute(ctx context.Context, userID, id string,
 		Name:      category.Name.String(),
 		Sequence:  category.Sequence.Value(),
 		CreatedAt: category.CreatedAt.ValueOr(time.Time{}),
+		Version:   category.Version,
 	}, nil
 }
@@ -13,7 +13,9 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 	"github.com/jailtonjunior94/financial/internal/category/application/dtos"
 	categorydomain "github.com/jailtonjunior94/financial/internal/category/domain"
+	"github.com/jailtonjunior94/financial/internal/category/domain/entities"
 	mocks "github.com/jailtonjunior94/financial/internal/category/infrastructure/repositories/mocks"
+	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 )
 
@@ -39,9 +41,10 @@ func (s *UpdateCategoryUseCaseSuite) SetupTest() {
 
 func (s *UpdateCategoryUseCaseSuite) TestExecute() {
 	type args struct {
-		userID     string
-		categoryID string
-		input      *dtos.CategoryInput
+		userID          string
+		categoryID      string
+		input           *dtos.CategoryInput
+		expectedVersion *int
 	}
 
 	type dependencies struct {
@@ -131,12 +134,65 @@ func (s *UpdateCategoryUseCaseSuite) TestExecute() {
 				s.Contains(err.Error(), "database connection failed")
 			},
 		},
+		{
+			name: "deve retornar precondition failed quando a versão do If-Match estiver desatualizada",
+			args: args{
+				userID:     "550e8400-e29b-41d4-a716-446655440000",
+				categoryID: "660e8400-e29b-41d4-a716-446655440001",
+				input: &dtos.CategoryInput{
+					Name:     "Transport",
+					Sequence: 1,
+				},
+				expectedVersion: func() *int { v := 2; return &v }(),
+			},
+			dependencies: dependencies{
+				categoryRepository: func() *mocks.CategoryRepository {
+					userID, _ := vos.NewUUIDFromString("550e8400-e29b-41d4-a716-446655440000")
+					categoryID, _ := vos.NewUUIDFromString("660e8400-e29b-41d4-a716-446655440001")
+					category := createCategoryForTest("660e8400-e29b-41d4-a716-446655440001", "Transport", 1)
+					s.categoryRepository.EXPECT().FindByID(s.ctx, userID, categoryID).Return(category, nil).Once()
+					return s.categoryRepository
+				}(),
+			},
+			expect: func(output *dtos.CategoryOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, customErrors.ErrPreconditionFailed)
+			},
+		},
+		{
+			name: "deve retornar a nova versão quando o If-Match corresponder",
+			args: args{
+				userID:     "550e8400-e29b-41d4-a716-446655440000",
+				categoryID: "660e8400-e29b-41d4-a716-446655440001",
+				input: &dtos.CategoryInput{
+					Name:     "Transport",
+					Sequence: 1,
+				},
+				expectedVersion: func() *int { v := 1; return &v }(),
+			},
+			dependencies: dependencies{
+				categoryRepository: func() *mocks.CategoryRepository {
+					userID, _ := vos.NewUUIDFromString("550e8400-e29b-41d4-a716-446655440000")
+					categoryID, _ := vos.NewUUIDFromString("660e8400-e29b-41d4-a716-446655440001")
+					category := createCategoryForTest("660e8400-e29b-41d4-a716-446655440001", "Transport", 1)
+					s.categoryRepository.EXPECT().FindByID(s.ctx, userID, categoryID).Return(category, nil).Once()
+					s.categoryRepository.EXPECT().Update(s.ctx, mock.AnythingOfType("*entities.Category")).
+						Run(func(_ context.Context, category *entities.Category) { category.Version++ }).
+						Return(nil).Once()
+					return s.categoryRepository
+				}(),
+			},
+			expect: func(output *dtos.CategoryOutput, err error) {
+				s.NoError(err)
+				s.Equal(2, output.Version)
+			},
+		},
 	}
 
 	for _, scenario := range scenarios {
 		s.Run(scenario.name, func() {
 			uc := NewUpdateCategoryUseCase(s.obs, s.fm, scenario.dependencies.categoryRepository)
-			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.categoryID, scenario.args.input)
+			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.categoryID, scenario.args.input, scenario.args.expectedVersion)
 			scenario.expect(output, err)
 		})
 	}
@@ -15,6 +15,7 @@ type Category struct {
 	CreatedAt sharedVos.NullableTime
 	UpdatedAt sharedVos.NullableTime
 	DeletedAt sharedVos.NullableTime
+	Version   int
 }
 
 func NewCategory(userID sharedVos.UUID, name vos.CategoryName, sequence vos.CategorySequence) (*Category, error) {
@@ -23,6 +24,7 @@ func NewCategory(userID sharedVos.UUID, name vos.CategoryName, sequence vos.Cate
 		UserID:    userID,
 		Sequence:  sequence,
 		CreatedAt: sharedVos.NewNullableTime(time.Now()),
+		Version:   1,
 	}
 	return category, nil
 }
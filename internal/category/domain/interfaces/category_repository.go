@@ -27,5 +27,5 @@ type CategoryRepository interface {
 	FindByID(ctx context.Context, userID, id vos.UUID) (*entities.Category, error)
 	Save(ctx context.Context, category *entities.Category) error
 	Update(ctx context.Context, category *entities.Category) error
-	SoftDelete(ctx context.Context, id vos.UUID) error
+	SoftDelete(ctx context.Context, id vos.UUID, version int) error
 }
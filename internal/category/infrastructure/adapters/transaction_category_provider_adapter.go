@@ -0,0 +1,86 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+// UncategorizedCategoryName is the name of the category that receives imported entries
+// without a matching category.
+const UncategorizedCategoryName = "Uncategorized"
+
+// maxCategorySequence mirrors the upper bound of vos.CategorySequence.
+const maxCategorySequence = 1000
+
+// TransactionCategoryProviderAdapter implements transactionInterfaces.CategoryProvider.
+type TransactionCategoryProviderAdapter struct {
+	db   database.DBTX
+	o11y observability.Observability
+	fm   *metrics.FinancialMetrics
+}
+
+// NewTransactionCategoryProviderAdapter creates a new TransactionCategoryProviderAdapter.
+func NewTransactionCategoryProviderAdapter(
+	db database.DBTX,
+	o11y observability.Observability,
+	fm *metrics.FinancialMetrics,
+) *TransactionCategoryProviderAdapter {
+	return &TransactionCategoryProviderAdapter{db: db, o11y: o11y, fm: fm}
+}
+
+// FindOrCreateUncategorized returns the oldest "Uncategorized" category of the user or
+// creates it at the end of the user's list, in a single statement.
+func (a *TransactionCategoryProviderAdapter) FindOrCreateUncategorized(ctx context.Context, userID vos.UUID) (vos.UUID, error) {
+	start := time.Now()
+	ctx, span := a.o11y.Tracer().Start(ctx, "transaction_category_provider_adapter.find_or_create_uncategorized")
+	defer span.End()
+
+	newID, err := vos.NewUUID()
+	if err != nil {
+		return vos.UUID{}, err
+	}
+
+	query := `
+		WITH existing AS (
+			SELECT id
+			FROM categories
+			WHERE user_id = $1 AND name = $2 AND deleted_at IS NULL
+			ORDER BY created_at ASC
+			LIMIT 1
+		), inserted AS (
+			INSERT INTO categories (id, user_id, name, sequence, created_at)
+			SELECT $3, $1, $2,
+			       LEAST(COALESCE((SELECT MAX(sequence) FROM categories WHERE user_id = $1 AND deleted_at IS NULL), 0) + 1, $4),
+			       NOW()
+			WHERE NOT EXISTS (SELECT 1 FROM existing)
+			RETURNING id
+		)
+		SELECT id FROM existing
+		UNION ALL
+		SELECT id FROM inserted`
+
+	var categoryID vos.UUID
+	err = a.db.QueryRowContext(ctx, query, userID.Value, UncategorizedCategoryName, newID.Value, maxCategorySequence).Scan(&categoryID.Value)
+	if err != nil {
+		span.RecordError(err)
+		a.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "FindOrCreateUncategorized"),
+			observability.String("layer", "adapter"),
+			observability.String("entity", "category"),
+			observability.String("user_id", userID.String()),
+			observability.Error(err),
+		)
+		a.fm.RecordRepositoryFailure(ctx, "find_or_create_uncategorized", "category", "infra", time.Since(start))
+		return vos.UUID{}, fmt.Errorf("transaction_category_provider_adapter.find_or_create_uncategorized: %w", err)
+	}
+
+	a.fm.RecordRepositoryQuery(ctx, "find_or_create_uncategorized", "category", time.Since(start))
+	return categoryID, nil
+}
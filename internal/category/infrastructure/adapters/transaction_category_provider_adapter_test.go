@@ -0,0 +1,85 @@
+package adapters_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/category/infrastructure/adapters"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+type TransactionCategoryProviderAdapterSuite struct {
+	suite.Suite
+	ctx context.Context
+	obs *fake.Provider
+	fm  *metrics.FinancialMetrics
+}
+
+func TestTransactionCategoryProviderAdapterSuite(t *testing.T) {
+	suite.Run(t, new(TransactionCategoryProviderAdapterSuite))
+}
+
+func (s *TransactionCategoryProviderAdapterSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.fm = metrics.NewFinancialMetrics(s.obs)
+}
+
+func (s *TransactionCategoryProviderAdapterSuite) TestFindOrCreateUncategorized() {
+	userID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+
+	scenarios := []struct {
+		name   string
+		setup  func(mock sqlmock.Sqlmock)
+		expect func(id vos.UUID, err error)
+	}{
+		{
+			name: "should return the category found or created",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("WITH existing AS")).
+					WithArgs(userID.Value, adapters.UncategorizedCategoryName, sqlmock.AnyArg(), 1000).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(categoryID.Value))
+			},
+			expect: func(id vos.UUID, err error) {
+				s.NoError(err)
+				s.Equal(categoryID, id)
+			},
+		},
+		{
+			name: "should return error when the query fails",
+			setup: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("WITH existing AS")).
+					WillReturnError(errors.New("db error"))
+			},
+			expect: func(id vos.UUID, err error) {
+				s.Error(err)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			db, mock, err := sqlmock.New()
+			s.Require().NoError(err)
+			defer func() {
+				if closeErr := db.Close(); closeErr != nil {
+					s.T().Logf("TestFindOrCreateUncategorized: failed to close db: %v", closeErr)
+				}
+			}()
+
+			scenario.setup(mock)
+			adapter := adapters.NewTransactionCategoryProviderAdapter(db, s.obs, s.fm)
+			id, err := adapter.FindOrCreateUncategorized(s.ctx, userID)
+			scenario.expect(id, err)
+			s.NoError(mock.ExpectationsWereMet())
+		})
+	}
+}
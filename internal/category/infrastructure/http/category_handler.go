@@ -6,6 +6,7 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/category/application/dtos"
 	"github.com/jailtonjunior94/financial/internal/category/application/usecase"
+	apihttp "github.com/jailtonjunior94/financial/pkg/api/http"
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
@@ -177,5 +178,6 @@ func (h *CategoryHandler) FindBy(w http.ResponseWriter, r *http.Request) {
 		observability.String("category_id", categoryID),
 	)
 
+	apihttp.SetETag(w, output.Version)
 	responses.JSON(w, http.StatusOK, output)
 }
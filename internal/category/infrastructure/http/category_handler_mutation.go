@@ -5,6 +5,7 @@ import (
 	"net/http"
 
 	"github.com/jailtonjunior94/financial/internal/category/application/dtos"
+	apihttp "github.com/jailtonjunior94/financial/pkg/api/http"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
@@ -27,6 +28,11 @@ func (h *CategoryHandler) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	categoryID := chi.URLParam(r, "id")
+	expectedVersion, err := apihttp.IfMatch(r)
+	if err != nil {
+		h.deps.ErrorHandler.HandleError(w, r, err)
+		return
+	}
 
 	h.deps.O11y.Logger().Info(ctx, "request_received",
 		observability.String("operation", "UpdateCategory"),
@@ -48,7 +54,7 @@ func (h *CategoryHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	output, err := h.deps.UpdateCategoryUseCase.Execute(ctx, user.ID, categoryID, input)
+	output, err := h.deps.UpdateCategoryUseCase.Execute(ctx, user.ID, categoryID, input, expectedVersion)
 	if err != nil {
 		h.deps.ErrorHandler.HandleError(w, r, err)
 		return
@@ -63,6 +69,7 @@ func (h *CategoryHandler) Update(w http.ResponseWriter, r *http.Request) {
 		observability.String("category_id", categoryID),
 	)
 
+	apihttp.SetETag(w, output.Version)
 	responses.JSON(w, http.StatusOK, output)
 }
 
@@ -79,6 +86,11 @@ func (h *CategoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
 	}
 
 	categoryID := chi.URLParam(r, "id")
+	expectedVersion, err := apihttp.IfMatch(r)
+	if err != nil {
+		h.deps.ErrorHandler.HandleError(w, r, err)
+		return
+	}
 
 	h.deps.O11y.Logger().Info(ctx, "request_received",
 		observability.String("operation", "DeleteCategory"),
@@ -89,7 +101,7 @@ func (h *CategoryHandler) Delete(w http.ResponseWriter, r *http.Request) {
 		observability.String("category_id", categoryID),
 	)
 
-	if err := h.deps.RemoveCategoryUseCase.Execute(ctx, user.ID, categoryID); err != nil {
+	if err := h.deps.RemoveCategoryUseCase.Execute(ctx, user.ID, categoryID, expectedVersion); err != nil {
 		h.deps.ErrorHandler.HandleError(w, r, err)
 		return
 	}
@@ -19,6 +19,7 @@ import (
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/auth"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 	"github.com/stretchr/testify/assert"
 )
@@ -53,19 +54,23 @@ func (s *stubFindCategoryBy) Execute(_ context.Context, _, _ string) (*dtos.Cate
 }
 
 type stubUpdateCategory struct {
-	output *dtos.CategoryOutput
-	err    error
+	output          *dtos.CategoryOutput
+	err             error
+	expectedVersion *int
 }
 
-func (s *stubUpdateCategory) Execute(_ context.Context, _, _ string, _ *dtos.CategoryInput) (*dtos.CategoryOutput, error) {
+func (s *stubUpdateCategory) Execute(_ context.Context, _, _ string, _ *dtos.CategoryInput, expectedVersion *int) (*dtos.CategoryOutput, error) {
+	s.expectedVersion = expectedVersion
 	return s.output, s.err
 }
 
 type stubRemoveCategory struct {
-	err error
+	err    error
+	called bool
 }
 
-func (s *stubRemoveCategory) Execute(_ context.Context, _, _ string) error {
+func (s *stubRemoveCategory) Execute(_ context.Context, _, _ string, _ *int) error {
+	s.called = true
 	return s.err
 }
 
@@ -281,6 +286,25 @@ func TestCategoryHandler_FindBy_Success(t *testing.T) {
 	assert.Equal(t, "Transport", result.Name)
 }
 
+func TestCategoryHandler_FindBy_SetsETag(t *testing.T) {
+	output := &dtos.CategoryOutput{ID: testCategoryID, Name: "Transport", Sequence: 1, Version: 3}
+	handler := newCategoryTestHandler(
+		&stubCreateCategory{},
+		&stubFindCategoryPaginated{},
+		&stubFindCategoryBy{output: output},
+		&stubUpdateCategory{},
+		&stubRemoveCategory{},
+	)
+
+	req := makeAuthenticatedCategoryChiRequest(http.MethodGet, "/api/v1/categories/"+testCategoryID, "id", testCategoryID, nil, testUserID)
+	w := httptest.NewRecorder()
+
+	handler.FindBy(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+}
+
 func TestCategoryHandler_FindBy_NotFound(t *testing.T) {
 	handler := newCategoryTestHandler(
 		&stubCreateCategory{},
@@ -339,6 +363,50 @@ func TestCategoryHandler_Update_Success(t *testing.T) {
 	assert.Equal(t, "Transport Updated", result.Name)
 }
 
+func TestCategoryHandler_Update_PassesIfMatchVersion(t *testing.T) {
+	output := &dtos.CategoryOutput{ID: testCategoryID, Name: "Transport Updated", Sequence: 2, Version: 4}
+	updateUC := &stubUpdateCategory{output: output}
+	handler := newCategoryTestHandler(
+		&stubCreateCategory{},
+		&stubFindCategoryPaginated{},
+		&stubFindCategoryBy{},
+		updateUC,
+		&stubRemoveCategory{},
+	)
+
+	body := map[string]interface{}{"name": "Transport Updated", "sequence": 2}
+	req := makeAuthenticatedCategoryChiRequest(http.MethodPut, "/api/v1/categories/"+testCategoryID, "id", testCategoryID, body, testUserID)
+	req.Header.Set("If-Match", `"3"`)
+	w := httptest.NewRecorder()
+
+	handler.Update(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	if assert.NotNil(t, updateUC.expectedVersion) {
+		assert.Equal(t, 3, *updateUC.expectedVersion)
+	}
+	assert.Equal(t, `"4"`, w.Header().Get("ETag"))
+}
+
+func TestCategoryHandler_Update_PreconditionFailed(t *testing.T) {
+	handler := newCategoryTestHandler(
+		&stubCreateCategory{},
+		&stubFindCategoryPaginated{},
+		&stubFindCategoryBy{},
+		&stubUpdateCategory{err: customerrors.ErrPreconditionFailed},
+		&stubRemoveCategory{},
+	)
+
+	body := map[string]interface{}{"name": "Transport Updated", "sequence": 2}
+	req := makeAuthenticatedCategoryChiRequest(http.MethodPut, "/api/v1/categories/"+testCategoryID, "id", testCategoryID, body, testUserID)
+	req.Header.Set("If-Match", `"1"`)
+	w := httptest.NewRecorder()
+
+	handler.Update(w, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+}
+
 func TestCategoryHandler_Update_InvalidInput(t *testing.T) {
 	handler := newCategoryTestHandler(
 		&stubCreateCategory{},
@@ -430,6 +498,26 @@ func TestCategoryHandler_Delete_NotFound(t *testing.T) {
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
 
+func TestCategoryHandler_Delete_MalformedIfMatch(t *testing.T) {
+	removeUC := &stubRemoveCategory{}
+	handler := newCategoryTestHandler(
+		&stubCreateCategory{},
+		&stubFindCategoryPaginated{},
+		&stubFindCategoryBy{},
+		&stubUpdateCategory{},
+		removeUC,
+	)
+
+	req := makeAuthenticatedCategoryChiRequest(http.MethodDelete, "/api/v1/categories/"+testCategoryID, "id", testCategoryID, nil, testUserID)
+	req.Header.Set("If-Match", `W/"1"`)
+	w := httptest.NewRecorder()
+
+	handler.Delete(w, req)
+
+	assert.Equal(t, http.StatusPreconditionFailed, w.Code)
+	assert.False(t, removeUC.called)
+}
+
 func TestCategoryHandler_Delete_UseCaseError(t *testing.T) {
 	handler := newCategoryTestHandler(
 		&stubCreateCategory{},
@@ -6,26 +6,29 @@ import (
 )
 
 type CategoryRouter struct {
-	categoryHandler    *CategoryHandler
-	subcategoryHandler *SubcategoryHandler
-	authMiddleware     middlewares.Authorization
+	categoryHandler       *CategoryHandler
+	subcategoryHandler    *SubcategoryHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
 }
 
 func NewCategoryRouter(
 	categoryHandler *CategoryHandler,
 	subcategoryHandler *SubcategoryHandler,
 	authMiddleware middlewares.Authorization,
+	idempotencyMiddleware middlewares.Idempotency,
 ) *CategoryRouter {
 	return &CategoryRouter{
-		categoryHandler:    categoryHandler,
-		subcategoryHandler: subcategoryHandler,
-		authMiddleware:     authMiddleware,
+		categoryHandler:       categoryHandler,
+		subcategoryHandler:    subcategoryHandler,
+		authMiddleware:        authMiddleware,
+		idempotencyMiddleware: idempotencyMiddleware,
 	}
 }
 
 func (r CategoryRouter) Register(router chi.Router) {
 	router.Group(func(protected chi.Router) {
-		protected.Use(r.authMiddleware.Authorization)
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
 
 		protected.Get("/api/v1/categories", r.categoryHandler.Find)
 		protected.Post("/api/v1/categories", r.categoryHandler.Create)
@@ -9,6 +9,7 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/category/domain/entities"
 	"github.com/jailtonjunior94/financial/internal/category/domain/interfaces"
+	customErrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
@@ -47,7 +48,7 @@ func (r *categoryRepository) ListPaginated(ctx context.Context, params interface
 	}
 
 	query := fmt.Sprintf(`
-SELECT id, user_id, name, sequence, created_at, updated_at, deleted_at
+SELECT id, user_id, name, sequence, created_at, updated_at, deleted_at, version
 FROM categories
 WHERE %s
 ORDER BY sequence ASC, id ASC
@@ -81,6 +82,7 @@ LIMIT $%d`, whereClause, len(args)+1)
 			&category.CreatedAt,
 			&category.UpdatedAt,
 			&category.DeletedAt,
+			&category.Version,
 		); err != nil {
 			span.RecordError(err)
 			r.fm.RecordRepositoryFailure(ctx, "list_paginated", "category", "infra", time.Since(start))
@@ -105,7 +107,7 @@ func (r *categoryRepository) FindByID(ctx context.Context, userID, id vos.UUID)
 	defer span.End()
 
 	query := `
-SELECT id, user_id, name, sequence, created_at, updated_at, deleted_at
+SELECT id, user_id, name, sequence, created_at, updated_at, deleted_at, version
 FROM categories
 WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL`
 
@@ -118,6 +120,7 @@ WHERE id = $1 AND user_id = $2 AND deleted_at IS NULL`
 		&category.CreatedAt,
 		&category.UpdatedAt,
 		&category.DeletedAt,
+		&category.Version,
 	)
 
 	if errors.Is(err, sql.ErrNoRows) {
@@ -164,14 +167,15 @@ func (r *categoryRepository) Update(ctx context.Context, category *entities.Cate
 	ctx, span := r.o11y.Tracer().Start(ctx, "category_repository.update")
 	defer span.End()
 
-	query := `UPDATE categories SET name = $1, sequence = $2, updated_at = $3
-          WHERE id = $4 AND deleted_at IS NULL`
+	query := `UPDATE categories SET name = $1, sequence = $2, updated_at = $3, version = version + 1
+          WHERE id = $4 AND version = $5 AND deleted_at IS NULL`
 
-	_, err := r.db.ExecContext(ctx, query,
+	result, err := r.db.ExecContext(ctx, query,
 		category.Name.Value,
 		category.Sequence.Sequence,
 		category.UpdatedAt.Ptr(),
 		category.ID.Value,
+		category.Version,
 	)
 	if err != nil {
 		span.RecordError(err)
@@ -179,18 +183,32 @@ func (r *categoryRepository) Update(ctx context.Context, category *entities.Cate
 		return fmt.Errorf("category_repository.update: %w", err)
 	}
 
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "update", "category", "infra", time.Since(start))
+		return fmt.Errorf("category_repository.update: %w", err)
+	}
+	if affected == 0 {
+		r.fm.RecordRepositoryFailure(ctx, "update", "category", "business", time.Since(start))
+		return customErrors.ErrPreconditionFailed
+	}
+	category.Version++
+
 	r.fm.RecordRepositoryQuery(ctx, "update", "category", time.Since(start))
 	return nil
 }
 
-func (r *categoryRepository) SoftDelete(ctx context.Context, id vos.UUID) error {
+func (r *categoryRepository) SoftDelete(ctx context.Context, id vos.UUID, version int) error {
 	start := time.Now()
 	ctx, span := r.o11y.Tracer().Start(ctx, "category_repository.soft_delete")
 	defer span.End()
 
-	_, err := r.db.ExecContext(ctx,
-		`UPDATE categories SET deleted_at = NOW(), updated_at = NOW() WHERE id = $1 AND deleted_at IS NULL`,
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE categories SET deleted_at = NOW(), updated_at = NOW(), version = version + 1
+          WHERE id = $1 AND version = $2 AND deleted_at IS NULL`,
 		id,
+		version,
 	)
 	if err != nil {
 		span.RecordError(err)
@@ -198,6 +216,17 @@ func (r *categoryRepository) SoftDelete(ctx context.Context, id vos.UUID) error
 		return fmt.Errorf("category_repository.soft_delete: %w", err)
 	}
 
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "soft_delete", "category", "infra", time.Since(start))
+		return fmt.Errorf("category_repository.soft_delete: %w", err)
+	}
+	if affected == 0 {
+		r.fm.RecordRepositoryFailure(ctx, "soft_delete", "category", "business", time.Since(start))
+		return customErrors.ErrPreconditionFailed
+	}
+
 	r.fm.RecordRepositoryQuery(ctx, "soft_delete", "category", time.Since(start))
 	return nil
 }
@@ -240,16 +240,16 @@ func (_c *CategoryRepository_Save_Call) RunAndReturn(run func(ctx context.Contex
 }
 
 // SoftDelete provides a mock function for the type CategoryRepository
-func (_mock *CategoryRepository) SoftDelete(ctx context.Context, id vos.UUID) error {
-	ret := _mock.Called(ctx, id)
+func (_mock *CategoryRepository) SoftDelete(ctx context.Context, id vos.UUID, version int) error {
+	ret := _mock.Called(ctx, id, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SoftDelete")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID) error); ok {
-		r0 = returnFunc(ctx, id)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, int) error); ok {
+		r0 = returnFunc(ctx, id, version)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -264,11 +264,12 @@ type CategoryRepository_SoftDelete_Call struct {
 // SoftDelete is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id vos.UUID
-func (_e *CategoryRepository_Expecter) SoftDelete(ctx interface{}, id interface{}) *CategoryRepository_SoftDelete_Call {
-	return &CategoryRepository_SoftDelete_Call{Call: _e.mock.On("SoftDelete", ctx, id)}
+//   - version int
+func (_e *CategoryRepository_Expecter) SoftDelete(ctx interface{}, id interface{}, version interface{}) *CategoryRepository_SoftDelete_Call {
+	return &CategoryRepository_SoftDelete_Call{Call: _e.mock.On("SoftDelete", ctx, id, version)}
 }
 
-func (_c *CategoryRepository_SoftDelete_Call) Run(run func(ctx context.Context, id vos.UUID)) *CategoryRepository_SoftDelete_Call {
+func (_c *CategoryRepository_SoftDelete_Call) Run(run func(ctx context.Context, id vos.UUID, version int)) *CategoryRepository_SoftDelete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -278,20 +279,25 @@ func (_c *CategoryRepository_SoftDelete_Call) Run(run func(ctx context.Context,
 		if args[1] != nil {
 			arg1 = args[1].(vos.UUID)
 		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *CategoryRepository_SoftDelete_Call) Return(err error) *CategoryRepository_SoftDelete_Call {
-	_c.Call.Return(err)
+func (_c *CategoryRepository_SoftDelete_Call) Return(_a0 error) *CategoryRepository_SoftDelete_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *CategoryRepository_SoftDelete_Call) RunAndReturn(run func(ctx context.Context, id vos.UUID) error) *CategoryRepository_SoftDelete_Call {
+func (_c *CategoryRepository_SoftDelete_Call) RunAndReturn(run func(ctx context.Context, id vos.UUID, version int) error) *CategoryRepository_SoftDelete_Call {
 	_c.Call.Return(run)
 	return _c
 }
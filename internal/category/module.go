@@ -13,6 +13,7 @@ import (
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/auth"
 	pkginterfaces "github.com/jailtonjunior94/financial/pkg/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
@@ -21,8 +22,9 @@ import (
 )
 
 type CategoryModule struct {
-	CategoryRouter          *http.CategoryRouter
-	CategoryProviderAdapter pkginterfaces.CategoryProvider
+	CategoryRouter                     *http.CategoryRouter
+	CategoryProviderAdapter            pkginterfaces.CategoryProvider
+	TransactionCategoryProviderAdapter *adapters.TransactionCategoryProviderAdapter
 }
 
 func NewCategoryModule(db *sql.DB, o11y observability.Observability, tokenValidator auth.TokenValidator) (CategoryModule, error) {
@@ -56,6 +58,7 @@ func NewCategoryModule(db *sql.DB, o11y observability.Observability, tokenValida
 	removeSubcategory := usecase.NewRemoveSubcategoryUseCase(o11y, fm, categoryRepo, subcategoryRepo)
 
 	authMiddleware := middlewares.NewAuthorization(tokenValidator, o11y, errorHandler)
+	idempotencyMiddleware := middlewares.NewIdempotency(idempotency.NewRepository(db, o11y), idempotency.DefaultTTL, o11y, errorHandler)
 
 	categoryHandler := http.NewCategoryHandler(http.CategoryHandlerDeps{
 		O11y:                         o11y,
@@ -79,7 +82,12 @@ func NewCategoryModule(db *sql.DB, o11y observability.Observability, tokenValida
 		RemoveSubcategoryUseCase:          removeSubcategory,
 	})
 
-	router := http.NewCategoryRouter(categoryHandler, subcategoryHandler, authMiddleware)
+	router := http.NewCategoryRouter(categoryHandler, subcategoryHandler, authMiddleware, idempotencyMiddleware)
 	categoryProviderAdapter := adapters.NewCategoryProviderAdapter(db, o11y, fm)
-	return CategoryModule{CategoryRouter: router, CategoryProviderAdapter: categoryProviderAdapter}, nil
+	transactionCategoryProviderAdapter := adapters.NewTransactionCategoryProviderAdapter(db, o11y, fm)
+	return CategoryModule{
+		CategoryRouter:                     router,
+		CategoryProviderAdapter:            categoryProviderAdapter,
+		TransactionCategoryProviderAdapter: transactionCategoryProviderAdapter,
+	}, nil
 }
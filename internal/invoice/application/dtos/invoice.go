@@ -115,33 +115,90 @@ func (i *PurchaseUpdateInput) Validate() validation.ValidationErrors {
 
 // InvoiceOutput representa a resposta de uma fatura.
 type InvoiceOutput struct {
-	ID             string              `json:"id"              example:"550e8400-e29b-41d4-a716-446655440000"`
-	UserID         string              `json:"user_id"         example:"660e8400-e29b-41d4-a716-446655440001"`
-	CardID         string              `json:"card_id"         example:"770e8400-e29b-41d4-a716-446655440002"`
-	ReferenceMonth string              `json:"reference_month" example:"2025-01"`    // YYYY-MM
-	DueDate        string              `json:"due_date"        example:"2025-01-10"` // YYYY-MM-DD
-	TotalAmount    string              `json:"total_amount"    example:"9999.00"`
-	Currency       string              `json:"currency"        example:"BRL" enums:"BRL,USD,EUR"`
-	ItemCount      int                 `json:"item_count"      example:"12"`
-	Items          []InvoiceItemOutput `json:"items,omitempty"`
-	CreatedAt      time.Time           `json:"created_at"      example:"2025-01-01T00:00:00Z"`
-	UpdatedAt      time.Time           `json:"updated_at,omitempty" example:"2025-01-20T08:00:00Z"`
+	ID               string              `json:"id"                   example:"550e8400-e29b-41d4-a716-446655440000"`
+	UserID           string              `json:"user_id"              example:"660e8400-e29b-41d4-a716-446655440001"`
+	CardID           string              `json:"card_id"              example:"770e8400-e29b-41d4-a716-446655440002"`
+	ReferenceMonth   string              `json:"reference_month"      example:"2025-01"`    // YYYY-MM
+	DueDate          string              `json:"due_date"             example:"2025-01-10"` // YYYY-MM-DD
+	TotalAmount      string              `json:"total_amount"         example:"9999.00"`
+	PaidAmount       string              `json:"paid_amount"          example:"4000.00"`                   // Soma dos pagamentos registrados
+	RemainingBalance string              `json:"remaining_balance"    example:"5999.00"`                   // total_amount - paid_amount
+	MinimumPayment   *string             `json:"minimum_payment,omitempty" example:"1499.85"`              // Calculado no fechamento
+	CarriedOverAt    *time.Time          `json:"carried_over_at,omitempty" example:"2025-02-04T03:00:00Z"` // Saldo levado ao rotativo
+	Status           string              `json:"status"               example:"closed" enums:"open,closed,paid"`
+	Currency         string              `json:"currency"             example:"BRL" enums:"BRL,USD,EUR"`
+	ItemCount        int                 `json:"item_count"           example:"12"`
+	Items            []InvoiceItemOutput `json:"items,omitempty"`
+	CreatedAt        time.Time           `json:"created_at"           example:"2025-01-01T00:00:00Z"`
+	UpdatedAt        time.Time           `json:"updated_at,omitempty" example:"2025-01-20T08:00:00Z"`
 }
 
 // InvoiceItemOutput representa a resposta de um item de fatura.
 type InvoiceItemOutput struct {
-	ID                string    `json:"id"                 example:"880e8400-e29b-41d4-a716-446655440003"`
-	InvoiceID         string    `json:"invoice_id"         example:"550e8400-e29b-41d4-a716-446655440000"`
-	CategoryID        string    `json:"category_id"        example:"660e8400-e29b-41d4-a716-446655440001"`
-	PurchaseDate      string    `json:"purchase_date"      example:"2025-01-15"` // YYYY-MM-DD
-	Description       string    `json:"description"        example:"iPhone 16 Pro"`
-	TotalAmount       string    `json:"total_amount"       example:"9999.00"` // Valor total da compra original
-	InstallmentNumber int       `json:"installment_number" example:"3"`       // 1 a N
-	InstallmentTotal  int       `json:"installment_total"  example:"12"`      // Total de parcelas
-	InstallmentAmount string    `json:"installment_amount" example:"833.25"`  // Valor desta parcela
-	InstallmentLabel  string    `json:"installment_label"  example:"3/12"`    // Ex: "3/12" ou "À vista"
-	CreatedAt         time.Time `json:"created_at"         example:"2025-01-01T00:00:00Z"`
-	UpdatedAt         time.Time `json:"updated_at,omitempty" example:"2025-01-20T08:00:00Z"`
+	ID                    string    `json:"id"                 example:"880e8400-e29b-41d4-a716-446655440003"`
+	InvoiceID             string    `json:"invoice_id"         example:"550e8400-e29b-41d4-a716-446655440000"`
+	TransactionID         *string   `json:"transaction_id,omitempty" example:"990e8400-e29b-41d4-a716-446655440004"` // Lançamento de origem
+	CategoryID            string    `json:"category_id,omitempty" example:"660e8400-e29b-41d4-a716-446655440001"`    // Vazio para encargos do rotativo
+	ItemType              string    `json:"item_type"          example:"purchase" enums:"purchase,revolving,iof,refund"`
+	CarriedFromInvoiceID  *string   `json:"carried_from_invoice_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // Fatura de origem do saldo rotativo
+	RefundedTransactionID *string   `json:"refunded_transaction_id,omitempty" example:"550e8400-e29b-41d4-a716-446655440000"` // Compra estornada (apenas estornos)
+	PurchaseDate          string    `json:"purchase_date"      example:"2025-01-15"`                                          // YYYY-MM-DD
+	Description           string    `json:"description"        example:"iPhone 16 Pro"`
+	TotalAmount           string    `json:"total_amount"       example:"9999.00"` // Valor total da compra original
+	InstallmentNumber     int       `json:"installment_number" example:"3"`       // 1 a N
+	InstallmentTotal      int       `json:"installment_total"  example:"12"`      // Total de parcelas
+	InstallmentAmount     string    `json:"installment_amount" example:"833.25"`  // Valor desta parcela
+	InstallmentLabel      string    `json:"installment_label"  example:"3/12"`    // Ex: "3/12" ou "À vista"
+	CreatedAt             time.Time `json:"created_at"         example:"2025-01-01T00:00:00Z"`
+	UpdatedAt             time.Time `json:"updated_at,omitempty" example:"2025-01-20T08:00:00Z"`
+}
+
+// InvoicePaymentInput representa o input para registrar um pagamento de fatura.
+type InvoicePaymentInput struct {
+	Amount      string `json:"amount"       example:"1500.00"`    // String decimal (e.g., "1500.00")
+	PaymentDate string `json:"payment_date" example:"2025-01-10"` // YYYY-MM-DD
+	Method      string `json:"method"       example:"pix" enums:"pix,ted,boleto"`
+}
+
+// Validate valida os campos do input.
+func (i *InvoicePaymentInput) Validate() validation.ValidationErrors {
+	var errs validation.ValidationErrors
+
+	// Amount
+	if !validation.IsRequired(i.Amount) {
+		errs.Add("amount", "is required")
+	} else if !validation.IsMoney(i.Amount) {
+		errs.Add("amount", "must be a valid monetary value")
+	}
+
+	// PaymentDate
+	if !validation.IsRequired(i.PaymentDate) {
+		errs.Add("payment_date", "is required")
+	} else if !validation.IsDate(i.PaymentDate) {
+		errs.Add("payment_date", "must be in YYYY-MM-DD format")
+	}
+
+	// Method
+	if !validation.IsRequired(i.Method) {
+		errs.Add("method", "is required")
+	} else if !validation.IsOneOf(i.Method, []string{"pix", "ted", "boleto"}) {
+		errs.Add("method", "must be pix, ted, or boleto")
+	}
+
+	return errs
+}
+
+// InvoicePaymentOutput representa a resposta ao registrar um pagamento de fatura.
+type InvoicePaymentOutput struct {
+	ID               string    `json:"id"                example:"990e8400-e29b-41d4-a716-446655440005"`
+	InvoiceID        string    `json:"invoice_id"        example:"550e8400-e29b-41d4-a716-446655440000"`
+	Amount           string    `json:"amount"            example:"1500.00"`
+	PaymentDate      string    `json:"payment_date"      example:"2025-01-10"` // YYYY-MM-DD
+	Method           string    `json:"method"            example:"pix" enums:"pix,ted,boleto"`
+	InvoiceStatus    string    `json:"invoice_status"    example:"closed" enums:"closed,paid"`
+	PaidAmount       string    `json:"paid_amount"       example:"4000.00"`
+	RemainingBalance string    `json:"remaining_balance" example:"5999.00"`
+	CreatedAt        time.Time `json:"created_at"        example:"2025-01-10T12:00:00Z"`
 }
 
 // InvoiceListOutput representa uma lista resumida de faturas.
@@ -0,0 +1,407 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/events"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/factories"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+// closeInvoicesPageSize limita quantas faturas abertas são carregadas por consulta.
+const closeInvoicesPageSize = 500
+
+type (
+	CloseInvoicesUseCase interface {
+		Execute(ctx context.Context, now time.Time) (int, error)
+	}
+
+	closeInvoicesUseCase struct {
+		uow                   uow.UnitOfWork
+		invoiceRepository     interfaces.InvoiceRepository
+		invoiceItemRepository interfaces.InvoiceItemRepository
+		cardProvider          interfaces.CardProvider
+		outboxService         outbox.Service
+		o11y                  observability.Observability
+	}
+
+	// cardBilling agrupa os calculadores de faturamento e de rotativo de um cartão.
+	cardBilling struct {
+		invoice   *factories.InvoiceCalculator
+		revolving *factories.RevolvingCalculator
+	}
+)
+
+// NewCloseInvoicesUseCase cria o caso de uso que fecha as faturas cuja data de fechamento já passou.
+func NewCloseInvoicesUseCase(
+	unitOfWork uow.UnitOfWork,
+	invoiceRepository interfaces.InvoiceRepository,
+	invoiceItemRepository interfaces.InvoiceItemRepository,
+	cardProvider interfaces.CardProvider,
+	outboxService outbox.Service,
+	o11y observability.Observability,
+) CloseInvoicesUseCase {
+	return &closeInvoicesUseCase{
+		uow:                   unitOfWork,
+		invoiceRepository:     invoiceRepository,
+		invoiceItemRepository: invoiceItemRepository,
+		cardProvider:          cardProvider,
+		outboxService:         outboxService,
+		o11y:                  o11y,
+	}
+}
+
+// Execute fecha as faturas abertas cuja data de fechamento (dia de fechamento do cartão
+// no mês de referência) é anterior à data de now. Ao fechar, o saldo não pago da fatura
+// anterior já vencida é lançado com juros e IOF, o saldo credor da fatura anterior é abatido
+// e o pagamento mínimo é calculado.
+// Retorna quantas faturas foram fechadas. Falhas em uma fatura não interrompem as demais;
+// os erros são agregados no retorno.
+func (u *closeInvoicesUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "close_invoices_usecase.execute")
+	defer span.End()
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	billings := make(map[string]*cardBilling)
+	evaluated, closed := 0, 0
+	var errs []error
+
+	// Percorre todas as faturas abertas por páginas: faturas cujo fechamento ainda não chegou
+	// não podem ocupar a página e esconder as que já podem ser fechadas.
+	var after *interfaces.OpenInvoiceCursor
+	for {
+		invoices, err := u.invoiceRepository.ListOpenUntil(ctx, pkgVos.NewReferenceMonthFromDate(today), after, closeInvoicesPageSize)
+		if err != nil {
+			span.RecordError(err)
+			return closed, errors.Join(append(errs, err)...)
+		}
+
+		for _, invoice := range invoices {
+			ok, err := u.evaluate(ctx, billings, invoice, today, now)
+			if err != nil {
+				span.RecordError(err)
+				errs = append(errs, err)
+				continue
+			}
+			if ok {
+				closed++
+			}
+		}
+		evaluated += len(invoices)
+
+		if len(invoices) < closeInvoicesPageSize {
+			break
+		}
+		last := invoices[len(invoices)-1]
+		after = &interfaces.OpenInvoiceCursor{ReferenceMonth: last.ReferenceMonth, ID: last.ID}
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "CloseInvoices"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "invoice"),
+		observability.Int("evaluated", evaluated),
+		observability.Int("closed", closed),
+	)
+
+	return closed, errors.Join(errs...)
+}
+
+// evaluate fecha a fatura quando a data de fechamento do cartão já passou.
+// Faturas de cartões sem dados de faturamento são ignoradas com um aviso.
+func (u *closeInvoicesUseCase) evaluate(
+	ctx context.Context,
+	billings map[string]*cardBilling,
+	invoice *entities.Invoice,
+	today, now time.Time,
+) (bool, error) {
+	billing, err := u.billingFor(ctx, billings, invoice)
+	if err != nil {
+		u.o11y.Logger().Warn(ctx, "invoice_closing_skipped",
+			observability.String("operation", "CloseInvoices"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "invoice"),
+			observability.String("invoice_id", invoice.ID.String()),
+			observability.String("card_id", invoice.CardID.String()),
+			observability.Error(err),
+		)
+		return false, nil
+	}
+
+	closingDate := billing.invoice.CalculateClosingDate(invoice.ReferenceMonth)
+	if !today.After(closingDate) {
+		return false, nil
+	}
+
+	ok, err := u.close(ctx, invoice, billing.revolving, closingDate, today, now)
+	if err != nil {
+		u.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "CloseInvoices"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "invoice"),
+			observability.String("invoice_id", invoice.ID.String()),
+			observability.Error(err),
+		)
+		return false, err
+	}
+	return ok, nil
+}
+
+// billingFor retorna os calculadores do cartão, consultando o cartão uma única vez por execução.
+func (u *closeInvoicesUseCase) billingFor(
+	ctx context.Context,
+	cache map[string]*cardBilling,
+	invoice *entities.Invoice,
+) (*cardBilling, error) {
+	key := invoice.CardID.String()
+	if billing, ok := cache[key]; ok {
+		return billing, nil
+	}
+
+	billingInfo, err := u.cardProvider.GetCardBillingInfo(ctx, invoice.UserID, invoice.CardID)
+	if err != nil {
+		return nil, err
+	}
+
+	calculator, err := factories.NewInvoiceCalculator(billingInfo.DueDay, billingInfo.ClosingOffsetDays)
+	if err != nil {
+		return nil, err
+	}
+
+	billing := &cardBilling{
+		invoice:   calculator,
+		revolving: factories.NewRevolvingCalculator(billingInfo.InterestRateBps, billingInfo.MinimumPaymentBps),
+	}
+	cache[key] = billing
+	return billing, nil
+}
+
+// close fecha a fatura, lança os encargos do rotativo ou o saldo credor da fatura anterior,
+// congela seus itens e publica invoice.closed na mesma transação. Retorna false quando outra
+// execução já havia fechado a fatura.
+func (u *closeInvoicesUseCase) close(
+	ctx context.Context,
+	invoice *entities.Invoice,
+	revolving *factories.RevolvingCalculator,
+	closingDate, today, now time.Time,
+) (bool, error) {
+	if err := invoice.Close(closingDate); err != nil {
+		return false, err
+	}
+
+	previous, err := u.invoiceRepository.FindByUserAndCardAndMonth(ctx, invoice.UserID, invoice.CardID, invoice.ReferenceMonth.AddMonths(-1))
+	if err != nil {
+		return false, err
+	}
+
+	carried, err := u.revolvingCharges(invoice, previous, revolving, today, now)
+	if err != nil {
+		return false, err
+	}
+	if len(carried) > 0 {
+		if err := invoice.AddRevolvingCharges(carried); err != nil {
+			return false, err
+		}
+	}
+
+	credit, err := u.carriedCredit(invoice, previous, now)
+	if err != nil {
+		return false, err
+	}
+	if credit != nil {
+		if err := invoice.AddCarriedCredit(credit); err != nil {
+			return false, err
+		}
+		carried = append(carried, credit)
+	}
+
+	minimum, err := revolving.MinimumPayment(invoice.TotalAmount)
+	if err != nil {
+		return false, err
+	}
+	invoice.SetMinimumPayment(minimum)
+
+	closed := false
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		// Os itens levados entram antes do fechamento: só faturas abertas recebem itens
+		if len(carried) > 0 {
+			if err := u.invoiceItemRepository.InsertItems(ctx, tx, carried); err != nil {
+				return err
+			}
+			if err := u.invoiceItemRepository.RecalculateTotals(ctx, tx, []vos.UUID{invoice.ID}); err != nil {
+				return err
+			}
+		}
+
+		ok, err := u.invoiceRepository.Close(ctx, tx, invoice)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// Desfaz os itens levados: a fatura já foi fechada por outra execução
+			return domain.ErrInvoiceNotOpen
+		}
+
+		if len(carried) > 0 {
+			marked, err := u.invoiceRepository.MarkCarriedOver(ctx, tx, previous)
+			if err != nil {
+				return err
+			}
+			if !marked {
+				// A fatura anterior recebeu pagamento ou já foi levada ao rotativo por outra execução
+				return domain.ErrInvoiceCarriedOver
+			}
+		}
+
+		if err := u.invoiceItemRepository.FreezeItems(ctx, tx, invoice.ID, now); err != nil {
+			return err
+		}
+
+		event := events.NewInvoiceClosedEvent(
+			invoice.ID,
+			invoice.UserID,
+			invoice.CardID,
+			invoice.ReferenceMonth,
+			closingDate,
+			invoice.DueDate,
+			invoice.TotalAmount,
+			minimum,
+		)
+		aggregateID, _ := uuid.Parse(invoice.ID.String())
+		if err := u.outboxService.SaveDomainEvent(
+			ctx,
+			tx,
+			aggregateID,
+			"invoice",
+			event.EventType(),
+			outbox.JSONBPayload(event.Payload()),
+		); err != nil {
+			return err
+		}
+
+		closed = true
+		return nil
+	})
+	if errors.Is(err, domain.ErrInvoiceNotOpen) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return closed, nil
+}
+
+// revolvingCharges monta os encargos do rotativo quando a fatura anterior do cartão venceu
+// com saldo não pago: um item com saldo + juros do mês e outro com o IOF do período financiado
+// (do vencimento anterior até o vencimento da fatura que está fechando).
+// A fatura anterior é marcada como levada ao rotativo; retorna nil quando não há saldo a levar.
+func (u *closeInvoicesUseCase) revolvingCharges(
+	invoice, previous *entities.Invoice,
+	revolving *factories.RevolvingCalculator,
+	today, now time.Time,
+) ([]*entities.InvoiceItem, error) {
+	if previous == nil ||
+		previous.Status != entities.InvoiceStatusClosed ||
+		previous.IsCarriedOver() ||
+		previous.RemainingBalance().IsZero() ||
+		!today.After(previous.DueDate) {
+		return nil, nil
+	}
+
+	balance, err := previous.CarryOver(now)
+	if err != nil {
+		return nil, err
+	}
+
+	days := int(invoice.DueDate.Sub(previous.DueDate).Hours() / 24)
+	charges, err := revolving.CarryOver(balance, days)
+	if err != nil {
+		return nil, err
+	}
+	carried, err := charges.Carried()
+	if err != nil {
+		return nil, err
+	}
+
+	month := previous.ReferenceMonth.String()
+	items := make([]*entities.InvoiceItem, 0, 2)
+
+	revolvingItem, err := u.newChargeItem(invoice, previous, entities.InvoiceItemTypeRevolving,
+		fmt.Sprintf("Saldo rotativo da fatura %s", month), carried)
+	if err != nil {
+		return nil, err
+	}
+	items = append(items, revolvingItem)
+
+	if charges.IOF.IsPositive() {
+		iofItem, err := u.newChargeItem(invoice, previous, entities.InvoiceItemTypeIOF,
+			fmt.Sprintf("IOF rotativo da fatura %s", month), charges.IOF)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, iofItem)
+	}
+
+	return items, nil
+}
+
+// carriedCredit monta o item de saldo credor quando a fatura anterior do cartão fechou com
+// estornos acima do total. O crédito abate a fatura que está fechando, sem aguardar o vencimento.
+// A fatura anterior é marcada como levada; retorna nil quando não há crédito a levar.
+func (u *closeInvoicesUseCase) carriedCredit(invoice, previous *entities.Invoice, now time.Time) (*entities.InvoiceItem, error) {
+	if previous == nil ||
+		previous.Status != entities.InvoiceStatusClosed ||
+		previous.IsCarriedOver() ||
+		previous.CreditBalance().IsZero() {
+		return nil, nil
+	}
+
+	credit, err := previous.CarryOverCredit(now)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := entities.NewCarriedCreditItem(invoice.ID, previous.ID, previous.DueDate,
+		fmt.Sprintf("Saldo credor da fatura %s", previous.ReferenceMonth.String()), credit)
+	if err != nil {
+		return nil, err
+	}
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	item.SetID(id)
+	return item, nil
+}
+
+// newChargeItem cria um encargo do rotativo datado no vencimento da fatura de origem.
+func (u *closeInvoicesUseCase) newChargeItem(
+	invoice, previous *entities.Invoice,
+	itemType, description string,
+	amount vos.Money,
+) (*entities.InvoiceItem, error) {
+	item, err := entities.NewRevolvingChargeItem(invoice.ID, previous.ID, itemType, previous.DueDate, description, amount)
+	if err != nil {
+		return nil, err
+	}
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	item.SetID(id)
+	return item, nil
+}
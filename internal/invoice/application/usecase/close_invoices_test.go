@@ -0,0 +1,363 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type mockUnitOfWork struct{}
+
+func (m *mockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, tx database.DBTX) error) error {
+	return fn(ctx, nil)
+}
+
+type CloseInvoicesUseCaseSuite struct {
+	suite.Suite
+	ctx           context.Context
+	obs           *fake.Provider
+	repo          *invoiceMocks.InvoiceRepository
+	itemRepo      *invoiceMocks.InvoiceItemRepository
+	cardProvider  *invoiceMocks.CardProvider
+	outboxService *outboxMocks.Service
+}
+
+func TestCloseInvoicesUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CloseInvoicesUseCaseSuite))
+}
+
+func (s *CloseInvoicesUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = invoiceMocks.NewInvoiceRepository(s.T())
+	s.itemRepo = invoiceMocks.NewInvoiceItemRepository(s.T())
+	s.cardProvider = invoiceMocks.NewCardProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func makeOpenInvoice(userID, cardID vos.UUID, month string) *entities.Invoice {
+	id, _ := vos.NewUUID()
+	refMonth, _ := pkgVos.NewReferenceMonth(month)
+	invoice := entities.NewInvoice(userID, cardID, refMonth, time.Date(refMonth.Year(), refMonth.Month(), 10, 0, 0, 0, 0, time.UTC), vos.CurrencyBRL)
+	invoice.SetID(id)
+	invoice.Status = entities.InvoiceStatusOpen
+	return invoice
+}
+
+func (s *CloseInvoicesUseCaseSuite) TestExecute() {
+	userID, _ := vos.NewUUID()
+	cardID, _ := vos.NewUUID()
+	// due_day 10, closing_offset_days 7 → fecha no dia 3; juros de 10% a.m. e mínimo de 15%
+	billingInfo := &interfaces.CardBillingInfo{CardID: cardID, DueDay: 10, ClosingOffsetDays: 7, InterestRateBps: 1000, MinimumPaymentBps: 1500}
+
+	type dependencies func()
+	type expect func(closed int, err error)
+
+	scenarios := []struct {
+		name         string
+		now          time.Time
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name: "should close invoices whose closing date has passed and emit invoice.closed",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				february := makeOpenInvoice(userID, cardID, "2026-02")
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{february, march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(nil, nil).Twice()
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, mock.MatchedBy(func(inv *entities.Invoice) bool {
+					return inv.Status == entities.InvoiceStatusClosed && inv.ClosingDate != nil && inv.MinimumPayment != nil
+				})).Return(true, nil).Twice()
+				s.itemRepo.EXPECT().FreezeItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "invoice", "invoice.closed",
+					mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+						return payload["closing_date"] == "2026-02-03" || payload["closing_date"] == "2026-03-03"
+					})).Return(nil).Twice()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(2, closed)
+			},
+		},
+		{
+			name: "should carry unpaid balance with interest and IOF into the closing invoice",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				february := makeClosedInvoice(userID, cardID, 1000, 400)
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				march.TotalAmount, _ = vos.NewMoneyFromFloat(200, vos.CurrencyBRL)
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.MatchedBy(func(month pkgVos.ReferenceMonth) bool {
+					return month.String() == "2026-02"
+				})).Return(february, nil).Once()
+				// saldo 600 + juros 10% = 660; total 200 + 660 + 3,66 de IOF; mínimo = 15% do total
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, mock.MatchedBy(func(inv *entities.Invoice) bool {
+					return inv.TotalAmount.Cents() == 86366 && inv.MinimumPayment.Cents() == 12955
+				})).Return(true, nil).Once()
+				s.repo.EXPECT().MarkCarriedOver(mock.Anything, mock.Anything, mock.MatchedBy(func(inv *entities.Invoice) bool {
+					return inv.ID == february.ID && inv.IsCarriedOver()
+				})).Return(true, nil).Once()
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []*entities.InvoiceItem) bool {
+					// IOF: 0.38% + 28 dias × 0.0082% = 0.6096% de 600,00
+					return len(items) == 2 &&
+						items[0].Type == entities.InvoiceItemTypeRevolving && items[0].InstallmentAmount.Cents() == 66000 &&
+						items[1].Type == entities.InvoiceItemTypeIOF && items[1].InstallmentAmount.Cents() == 366 &&
+						*items[0].CarriedFromInvoiceID == february.ID
+				})).Return(nil).Once()
+				s.itemRepo.EXPECT().RecalculateTotals(mock.Anything, mock.Anything, []vos.UUID{march.ID}).Return(nil).Once()
+				s.itemRepo.EXPECT().FreezeItems(mock.Anything, mock.Anything, march.ID, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "invoice", "invoice.closed",
+					mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+						return payload["minimum_payment"] == int64(12955)
+					})).Return(nil).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(1, closed)
+			},
+		},
+		{
+			name: "should close an empty invoice left with a credit balance by a refund",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				transactionID, _ := vos.NewUUID()
+				categoryID, _ := vos.NewUUID()
+				amount, _ := vos.NewMoneyFromFloat(150, vos.CurrencyBRL)
+				refund, _ := entities.NewRefundItem(march.ID, transactionID, categoryID, time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC),
+					"Estorno", amount, pkgVos.NewReferenceMonthFromDate(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)))
+				_ = march.AddItem(refund)
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(nil, nil).Once()
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, mock.MatchedBy(func(inv *entities.Invoice) bool {
+					return inv.TotalAmount.Cents() == -15000 && inv.MinimumPayment.IsZero() &&
+						inv.RemainingBalance().IsZero() && inv.CreditBalance().Cents() == 15000
+				})).Return(true, nil).Once()
+				s.itemRepo.EXPECT().FreezeItems(mock.Anything, mock.Anything, march.ID, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "invoice", "invoice.closed",
+					mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+						return payload["total_amount"] == int64(-15000) && payload["minimum_payment"] == int64(0)
+					})).Return(nil).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(1, closed)
+			},
+		},
+		{
+			name: "should carry the credit balance of the previous invoice into the closing invoice",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				february := makeClosedInvoice(userID, cardID, -150, 0)
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				march.TotalAmount, _ = vos.NewMoneyFromFloat(200, vos.CurrencyBRL)
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(february, nil).Once()
+				// 200 - 150 de crédito = 50; mínimo = 15% de 50
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, mock.MatchedBy(func(inv *entities.Invoice) bool {
+					return inv.TotalAmount.Cents() == 5000 && inv.MinimumPayment.Cents() == 750
+				})).Return(true, nil).Once()
+				s.repo.EXPECT().MarkCarriedOver(mock.Anything, mock.Anything, mock.MatchedBy(func(inv *entities.Invoice) bool {
+					return inv.ID == february.ID && inv.IsCarriedOver() && inv.CreditBalance().IsZero()
+				})).Return(true, nil).Once()
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []*entities.InvoiceItem) bool {
+					return len(items) == 1 &&
+						items[0].IsCarriedCredit() && items[0].InstallmentAmount.Cents() == -15000 &&
+						!items[0].HasCategory() && *items[0].CarriedFromInvoiceID == february.ID
+				})).Return(nil).Once()
+				s.itemRepo.EXPECT().RecalculateTotals(mock.Anything, mock.Anything, []vos.UUID{march.ID}).Return(nil).Once()
+				s.itemRepo.EXPECT().FreezeItems(mock.Anything, mock.Anything, march.ID, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "invoice", "invoice.closed", mock.Anything).Return(nil).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(1, closed)
+			},
+		},
+		{
+			name: "should not carry balance of a fully paid previous invoice",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				february := makeClosedInvoice(userID, cardID, 1000, 0)
+				february.PaidAmount = february.TotalAmount
+				february.Status = entities.InvoiceStatusPaid
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(february, nil).Once()
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+				s.itemRepo.EXPECT().FreezeItems(mock.Anything, mock.Anything, march.ID, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "invoice", "invoice.closed", mock.Anything).Return(nil).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(1, closed)
+			},
+		},
+		{
+			name: "should roll back closing when previous invoice changed before carry-over",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				february := makeClosedInvoice(userID, cardID, 1000, 400)
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(february, nil).Once()
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.itemRepo.EXPECT().RecalculateTotals(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+				s.repo.EXPECT().MarkCarriedOver(mock.Anything, mock.Anything, february).Return(false, nil).Once()
+			},
+			expect: func(closed int, err error) {
+				s.ErrorIs(err, domain.ErrInvoiceCarriedOver)
+				s.Equal(0, closed)
+			},
+		},
+		{
+			name: "should not carry balance into an invoice closed by a concurrent run",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				february := makeClosedInvoice(userID, cardID, 1000, 400)
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(february, nil).Once()
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.Anything).Return(domain.ErrInvoiceNotOpen).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(0, closed)
+			},
+		},
+		{
+			name: "should keep invoice open on its closing day",
+			now:  time.Date(2026, 3, 3, 23, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(0, closed)
+			},
+		},
+		{
+			name: "should not count invoice already closed by a concurrent run",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(nil, nil).Once()
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(0, closed)
+			},
+		},
+		{
+			name: "should skip invoices whose card billing info is unavailable",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(nil, errors.New("card not found")).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(0, closed)
+			},
+		},
+		{
+			name: "should report error when outbox fails",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(nil, nil).Once()
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, mock.Anything).Return(true, nil).Once()
+				s.itemRepo.EXPECT().FreezeItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("outbox error")).Once()
+			},
+			expect: func(closed int, err error) {
+				s.Error(err)
+				s.Equal(0, closed)
+			},
+		},
+		{
+			name: "should keep paging when a full page holds only invoices not yet closable",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				laterCardID, _ := vos.NewUUID()
+				// due_day 20, closing_offset_days 7 → fecha no dia 13
+				laterBilling := &interfaces.CardBillingInfo{CardID: laterCardID, DueDay: 20, ClosingOffsetDays: 7, InterestRateBps: 1000, MinimumPaymentBps: 1500}
+				firstPage := make([]*entities.Invoice, closeInvoicesPageSize)
+				for i := range firstPage {
+					firstPage[i] = makeOpenInvoice(userID, laterCardID, "2026-03")
+				}
+				last := firstPage[len(firstPage)-1]
+				march := makeOpenInvoice(userID, cardID, "2026-03")
+
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, (*interfaces.OpenInvoiceCursor)(nil), closeInvoicesPageSize).Return(firstPage, nil).Once()
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.MatchedBy(func(after *interfaces.OpenInvoiceCursor) bool {
+					return after != nil && after.ID == last.ID && after.ReferenceMonth.String() == "2026-03"
+				}), closeInvoicesPageSize).Return([]*entities.Invoice{march}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, laterCardID).Return(laterBilling, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userID, cardID).Return(billingInfo, nil).Once()
+				s.repo.EXPECT().FindByUserAndCardAndMonth(mock.Anything, userID, cardID, mock.Anything).Return(nil, nil).Once()
+				s.repo.EXPECT().Close(mock.Anything, mock.Anything, march).Return(true, nil).Once()
+				s.itemRepo.EXPECT().FreezeItems(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "invoice", "invoice.closed", mock.Anything).Return(nil).Once()
+			},
+			expect: func(closed int, err error) {
+				s.NoError(err)
+				s.Equal(1, closed)
+			},
+		},
+		{
+			name: "should propagate error when listing open invoices fails",
+			now:  time.Date(2026, 3, 4, 1, 0, 0, 0, time.UTC),
+			dependencies: func() {
+				s.repo.EXPECT().ListOpenUntil(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(closed int, err error) {
+				s.Error(err)
+				s.Equal(0, closed)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			s.SetupTest()
+			scenario.dependencies()
+			uc := NewCloseInvoicesUseCase(&mockUnitOfWork{}, s.repo, s.itemRepo, s.cardProvider, s.outboxService, s.obs)
+			closed, err := uc.Execute(s.ctx, scenario.now)
+			scenario.expect(closed, err)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+)
+
+// optionalString converte um UUID opcional para o formato da resposta.
+func optionalString(id *vos.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
+
+// optionalAmount formata um valor opcional para a resposta.
+func optionalAmount(m *vos.Money) *string {
+	if m == nil {
+		return nil
+	}
+	value := fmt.Sprintf("%.2f", m.Float())
+	return &value
+}
+
+// itemCategoryID omite a categoria dos encargos do rotativo e do saldo credor, que não pertencem a nenhuma.
+func itemCategoryID(item *entities.InvoiceItem) string {
+	if !item.HasCategory() {
+		return ""
+	}
+	return item.CategoryID.String()
+}
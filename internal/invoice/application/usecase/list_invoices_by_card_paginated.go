@@ -104,32 +104,41 @@ func (u *listInvoicesByCardPaginatedUseCase) Execute(
 		items := make([]dtos.InvoiceItemOutput, len(invoice.Items))
 		for j, item := range invoice.Items {
 			items[j] = dtos.InvoiceItemOutput{
-				ID:                item.ID.String(),
-				InvoiceID:         item.InvoiceID.String(),
-				CategoryID:        item.CategoryID.String(),
-				PurchaseDate:      item.PurchaseDate.Format("2006-01-02"),
-				Description:       item.Description,
-				TotalAmount:       fmt.Sprintf("%.2f", item.TotalAmount.Float()),
-				InstallmentNumber: item.InstallmentNumber,
-				InstallmentTotal:  item.InstallmentTotal,
-				InstallmentAmount: fmt.Sprintf("%.2f", item.InstallmentAmount.Float()),
-				InstallmentLabel:  item.InstallmentLabel(),
-				CreatedAt:         item.CreatedAt,
-				UpdatedAt:         item.UpdatedAt.ValueOr(item.CreatedAt),
+				ID:                    item.ID.String(),
+				InvoiceID:             item.InvoiceID.String(),
+				TransactionID:         optionalString(item.TransactionID),
+				CategoryID:            itemCategoryID(item),
+				ItemType:              item.Type,
+				CarriedFromInvoiceID:  optionalString(item.CarriedFromInvoiceID),
+				RefundedTransactionID: optionalString(item.RefundedTransactionID),
+				PurchaseDate:          item.PurchaseDate.Format("2006-01-02"),
+				Description:           item.Description,
+				TotalAmount:           fmt.Sprintf("%.2f", item.TotalAmount.Float()),
+				InstallmentNumber:     item.InstallmentNumber,
+				InstallmentTotal:      item.InstallmentTotal,
+				InstallmentAmount:     fmt.Sprintf("%.2f", item.InstallmentAmount.Float()),
+				InstallmentLabel:      item.InstallmentLabel(),
+				CreatedAt:             item.CreatedAt,
+				UpdatedAt:             item.UpdatedAt.ValueOr(item.CreatedAt),
 			}
 		}
 		output[i] = dtos.InvoiceOutput{
-			ID:             invoice.ID.String(),
-			UserID:         invoice.UserID.String(),
-			CardID:         invoice.CardID.String(),
-			ReferenceMonth: invoice.ReferenceMonth.String(),
-			DueDate:        invoice.DueDate.Format("2006-01-02"),
-			TotalAmount:    fmt.Sprintf("%.2f", invoice.TotalAmount.Float()),
-			Currency:       string(invoice.TotalAmount.Currency()),
-			ItemCount:      len(invoice.Items),
-			Items:          items,
-			CreatedAt:      invoice.CreatedAt,
-			UpdatedAt:      invoice.UpdatedAt.ValueOr(invoice.CreatedAt),
+			ID:               invoice.ID.String(),
+			UserID:           invoice.UserID.String(),
+			CardID:           invoice.CardID.String(),
+			ReferenceMonth:   invoice.ReferenceMonth.String(),
+			DueDate:          invoice.DueDate.Format("2006-01-02"),
+			TotalAmount:      fmt.Sprintf("%.2f", invoice.TotalAmount.Float()),
+			PaidAmount:       fmt.Sprintf("%.2f", invoice.PaidAmount.Float()),
+			RemainingBalance: fmt.Sprintf("%.2f", invoice.RemainingBalance().Float()),
+			MinimumPayment:   optionalAmount(invoice.MinimumPayment),
+			CarriedOverAt:    invoice.CarriedOverAt,
+			Status:           invoice.Status,
+			Currency:         string(invoice.TotalAmount.Currency()),
+			ItemCount:        len(invoice.Items),
+			Items:            items,
+			CreatedAt:        invoice.CreatedAt,
+			UpdatedAt:        invoice.UpdatedAt.ValueOr(invoice.CreatedAt),
 		}
 	}
 	return &ListInvoicesByCardPaginatedOutput{
@@ -0,0 +1,195 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/events"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	invoiceVos "github.com/jailtonjunior94/financial/internal/invoice/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/constants"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+type (
+	RegisterInvoicePaymentUseCase interface {
+		Execute(ctx context.Context, userID, cardID, invoiceID string, input *dtos.InvoicePaymentInput) (*dtos.InvoicePaymentOutput, error)
+	}
+
+	registerInvoicePaymentUseCase struct {
+		uow                      uow.UnitOfWork
+		invoiceRepository        interfaces.InvoiceRepository
+		invoicePaymentRepository interfaces.InvoicePaymentRepository
+		outboxService            outbox.Service
+		o11y                     observability.Observability
+	}
+)
+
+// NewRegisterInvoicePaymentUseCase cria o caso de uso que registra pagamentos (totais ou parciais) de fatura.
+func NewRegisterInvoicePaymentUseCase(
+	unitOfWork uow.UnitOfWork,
+	invoiceRepository interfaces.InvoiceRepository,
+	invoicePaymentRepository interfaces.InvoicePaymentRepository,
+	outboxService outbox.Service,
+	o11y observability.Observability,
+) RegisterInvoicePaymentUseCase {
+	return &registerInvoicePaymentUseCase{
+		uow:                      unitOfWork,
+		invoiceRepository:        invoiceRepository,
+		invoicePaymentRepository: invoicePaymentRepository,
+		outboxService:            outboxService,
+		o11y:                     o11y,
+	}
+}
+
+// Execute registra o pagamento na fatura fechada do cartão. Quando o saldo restante zera,
+// a fatura passa a paga e invoice.paid é publicado no outbox na mesma transação.
+func (u *registerInvoicePaymentUseCase) Execute(
+	ctx context.Context,
+	userID, cardID, invoiceID string,
+	input *dtos.InvoicePaymentInput,
+) (*dtos.InvoicePaymentOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "register_invoice_payment_usecase.execute")
+	defer span.End()
+
+	id, err := vos.NewUUIDFromString(invoiceID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid invoice ID: %w", err)
+	}
+
+	amount, err := vos.NewMoneyFromString(input.Amount, constants.DefaultCurrency)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	paymentDate, err := time.Parse("2006-01-02", input.PaymentDate)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid payment_date: %w", err)
+	}
+
+	method, err := invoiceVos.NewInvoicePaymentMethod(input.Method)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	invoice, err := u.invoiceRepository.FindByID(ctx, id)
+	if err != nil {
+		u.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "RegisterInvoicePayment"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "invoice"),
+			observability.Error(err),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+	if invoice == nil {
+		span.RecordError(domain.ErrInvoiceNotFound)
+		return nil, domain.ErrInvoiceNotFound
+	}
+	if invoice.UserID.String() != userID || invoice.CardID.String() != cardID {
+		span.RecordError(domain.ErrInvoiceNotOwned)
+		return nil, domain.ErrInvoiceNotOwned
+	}
+
+	payment, err := entities.NewInvoicePayment(invoice.ID, invoice.UserID, amount, paymentDate, method)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	paymentID, err := vos.NewUUID()
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	payment.SetID(paymentID)
+
+	if err := invoice.RegisterPayment(payment); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		applied, err := u.invoiceRepository.ApplyPayment(ctx, tx, invoice, payment.Amount)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			// Outro pagamento foi registrado concorrentemente e alterou o saldo
+			return domain.ErrPaymentExceedsBalance
+		}
+
+		if err := u.invoicePaymentRepository.Insert(ctx, tx, payment); err != nil {
+			return err
+		}
+
+		// O status gravado vem do banco: pagamentos concorrentes podem ter quitado a fatura
+		if !invoice.IsPaid() {
+			return nil
+		}
+
+		event := events.NewInvoicePaidEvent(
+			invoice.ID,
+			invoice.UserID,
+			invoice.CardID,
+			invoice.ReferenceMonth,
+			payment.PaymentDate,
+			invoice.TotalAmount,
+			invoice.PaidAmount,
+		)
+		aggregateID, _ := uuid.Parse(invoice.ID.String())
+		return u.outboxService.SaveDomainEvent(
+			ctx,
+			tx,
+			aggregateID,
+			"invoice",
+			event.EventType(),
+			outbox.JSONBPayload(event.Payload()),
+		)
+	})
+	if err != nil {
+		u.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "RegisterInvoicePayment"),
+			observability.String("layer", "usecase"),
+			observability.String("entity", "invoice"),
+			observability.String("invoice_id", invoice.ID.String()),
+			observability.Error(err),
+		)
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "RegisterInvoicePayment"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "invoice"),
+		observability.String("invoice_id", invoice.ID.String()),
+		observability.String("status", invoice.Status),
+	)
+
+	return &dtos.InvoicePaymentOutput{
+		ID:               payment.ID.String(),
+		InvoiceID:        invoice.ID.String(),
+		Amount:           fmt.Sprintf("%.2f", payment.Amount.Float()),
+		PaymentDate:      payment.PaymentDate.Format("2006-01-02"),
+		Method:           payment.Method.String(),
+		InvoiceStatus:    invoice.Status,
+		PaidAmount:       fmt.Sprintf("%.2f", invoice.PaidAmount.Float()),
+		RemainingBalance: fmt.Sprintf("%.2f", invoice.RemainingBalance().Float()),
+		CreatedAt:        payment.CreatedAt,
+	}, nil
+}
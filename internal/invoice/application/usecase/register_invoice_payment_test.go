@@ -0,0 +1,270 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type RegisterInvoicePaymentUseCaseSuite struct {
+	suite.Suite
+	ctx           context.Context
+	obs           *fake.Provider
+	repo          *invoiceMocks.InvoiceRepository
+	paymentRepo   *invoiceMocks.InvoicePaymentRepository
+	outboxService *outboxMocks.Service
+}
+
+func TestRegisterInvoicePaymentUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(RegisterInvoicePaymentUseCaseSuite))
+}
+
+func (s *RegisterInvoicePaymentUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = invoiceMocks.NewInvoiceRepository(s.T())
+	s.paymentRepo = invoiceMocks.NewInvoicePaymentRepository(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func makeClosedInvoice(userID, cardID vos.UUID, total float64, paid float64) *entities.Invoice {
+	invoice := makeOpenInvoice(userID, cardID, "2026-02")
+	invoice.TotalAmount, _ = vos.NewMoneyFromFloat(total, vos.CurrencyBRL)
+	invoice.PaidAmount, _ = vos.NewMoneyFromFloat(paid, vos.CurrencyBRL)
+	_ = invoice.Close(time.Date(2026, 2, 3, 0, 0, 0, 0, time.UTC))
+	return invoice
+}
+
+func (s *RegisterInvoicePaymentUseCaseSuite) TestExecute() {
+	userID, _ := vos.NewUUID()
+	cardID, _ := vos.NewUUID()
+
+	type args struct {
+		input   *dtos.InvoicePaymentInput
+		invoice *entities.Invoice
+	}
+
+	type dependencies func(a args)
+	type expect func(output *dtos.InvoicePaymentOutput, err error)
+
+	scenarios := []struct {
+		name         string
+		args         args
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name: "should register partial payment and keep invoice closed",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "400.00", PaymentDate: "2026-02-10", Method: "pix"},
+				invoice: makeClosedInvoice(userID, cardID, 1000, 0),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+				s.repo.EXPECT().ApplyPayment(mock.Anything, mock.Anything, a.invoice, mock.Anything).Return(true, nil).Once()
+				s.paymentRepo.EXPECT().Insert(mock.Anything, mock.Anything, mock.MatchedBy(func(p *entities.InvoicePayment) bool {
+					return p.Amount.Cents() == 40000 && p.Method.String() == "pix"
+				})).Return(nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.NoError(err)
+				s.Equal(entities.InvoiceStatusClosed, output.InvoiceStatus)
+				s.Equal("400.00", output.PaidAmount)
+				s.Equal("600.00", output.RemainingBalance)
+			},
+		},
+		{
+			name: "should mark invoice as paid and emit invoice.paid when balance reaches zero",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "600.00", PaymentDate: "2026-02-10", Method: "boleto"},
+				invoice: makeClosedInvoice(userID, cardID, 1000, 400),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+				s.repo.EXPECT().ApplyPayment(mock.Anything, mock.Anything, mock.MatchedBy(func(inv *entities.Invoice) bool {
+					return inv.Status == entities.InvoiceStatusPaid
+				}), mock.Anything).Return(true, nil).Once()
+				s.paymentRepo.EXPECT().Insert(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "invoice", "invoice.paid",
+					mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+						return payload["paid_amount"] == int64(100000) && payload["paid_at"] == "2026-02-10"
+					})).Return(nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.NoError(err)
+				s.Equal(entities.InvoiceStatusPaid, output.InvoiceStatus)
+				s.Equal("0.00", output.RemainingBalance)
+			},
+		},
+		{
+			name: "should emit invoice.paid when a concurrent partial payment settled the invoice",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "50.00", PaymentDate: "2026-02-10", Method: "pix"},
+				invoice: makeClosedInvoice(userID, cardID, 100, 0),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+				// a outra metade foi paga entre a leitura e o update: o banco devolve a fatura paga
+				s.repo.EXPECT().ApplyPayment(mock.Anything, mock.Anything, a.invoice, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ database.DBTX, inv *entities.Invoice, _ vos.Money) (bool, error) {
+						inv.Status = entities.InvoiceStatusPaid
+						inv.PaidAmount, _ = vos.NewMoneyFromFloat(100, vos.CurrencyBRL)
+						return true, nil
+					}).Once()
+				s.paymentRepo.EXPECT().Insert(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "invoice", "invoice.paid",
+					mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+						return payload["paid_amount"] == int64(10000)
+					})).Return(nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.NoError(err)
+				s.Equal(entities.InvoiceStatusPaid, output.InvoiceStatus)
+				s.Equal("100.00", output.PaidAmount)
+				s.Equal("0.00", output.RemainingBalance)
+			},
+		},
+		{
+			name: "should reject payment on an open invoice",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "100.00", PaymentDate: "2026-02-10", Method: "ted"},
+				invoice: makeOpenInvoice(userID, cardID, "2026-03"),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.ErrorIs(err, domain.ErrInvoiceNotClosed)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject payment on an invoice closed with a credit balance",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "10.00", PaymentDate: "2026-02-10", Method: "pix"},
+				invoice: makeClosedInvoice(userID, cardID, -150, 0),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.ErrorIs(err, domain.ErrPaymentExceedsBalance)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject payment above the remaining balance",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "700.00", PaymentDate: "2026-02-10", Method: "pix"},
+				invoice: makeClosedInvoice(userID, cardID, 1000, 400),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.ErrorIs(err, domain.ErrPaymentExceedsBalance)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject payment when a concurrent payment changed the balance",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "100.00", PaymentDate: "2026-02-10", Method: "pix"},
+				invoice: makeClosedInvoice(userID, cardID, 1000, 0),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+				s.repo.EXPECT().ApplyPayment(mock.Anything, mock.Anything, a.invoice, mock.Anything).Return(false, nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.ErrorIs(err, domain.ErrPaymentExceedsBalance)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject payment on invoice whose balance was carried over",
+			args: args{
+				input: &dtos.InvoicePaymentInput{Amount: "100.00", PaymentDate: "2026-03-12", Method: "pix"},
+				invoice: func() *entities.Invoice {
+					invoice := makeClosedInvoice(userID, cardID, 1000, 0)
+					_, _ = invoice.CarryOver(time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC))
+					return invoice
+				}(),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.ErrorIs(err, domain.ErrInvoiceCarriedOver)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject payment on invoice from another user",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "100.00", PaymentDate: "2026-02-10", Method: "pix"},
+				invoice: makeClosedInvoice(cardID, cardID, 1000, 0),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.ErrorIs(err, domain.ErrInvoiceNotOwned)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject unknown payment method",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "100.00", PaymentDate: "2026-02-10", Method: "credit"},
+				invoice: makeClosedInvoice(userID, cardID, 1000, 0),
+			},
+			dependencies: func(a args) {},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.ErrorIs(err, domain.ErrInvalidInvoicePaymentMethod)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should propagate outbox error",
+			args: args{
+				input:   &dtos.InvoicePaymentInput{Amount: "1000.00", PaymentDate: "2026-02-10", Method: "pix"},
+				invoice: makeClosedInvoice(userID, cardID, 1000, 0),
+			},
+			dependencies: func(a args) {
+				s.repo.EXPECT().FindByID(mock.Anything, a.invoice.ID).Return(a.invoice, nil).Once()
+				s.repo.EXPECT().ApplyPayment(mock.Anything, mock.Anything, a.invoice, mock.Anything).Return(true, nil).Once()
+				s.paymentRepo.EXPECT().Insert(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("outbox error")).Once()
+			},
+			expect: func(output *dtos.InvoicePaymentOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			s.SetupTest()
+			scenario.dependencies(scenario.args)
+			uc := NewRegisterInvoicePaymentUseCase(&mockUnitOfWork{}, s.repo, s.paymentRepo, s.outboxService, s.obs)
+			output, err := uc.Execute(s.ctx, userID.String(), cardID.String(), scenario.args.invoice.ID.String(), scenario.args.input)
+			scenario.expect(output, err)
+		})
+	}
+}
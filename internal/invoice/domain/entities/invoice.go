@@ -11,6 +11,13 @@ import (
 	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
 )
 
+// Status possíveis de uma fatura.
+const (
+	InvoiceStatusOpen   = "open"
+	InvoiceStatusClosed = "closed"
+	InvoiceStatusPaid   = "paid"
+)
+
 // Invoice é o Aggregate Root que representa uma fatura mensal de cartão.
 type Invoice struct {
 	entity.Base
@@ -18,7 +25,11 @@ type Invoice struct {
 	CardID         vos.UUID
 	ReferenceMonth pkgVos.ReferenceMonth
 	DueDate        time.Time
+	ClosingDate    *time.Time
 	TotalAmount    vos.Money
+	PaidAmount     vos.Money  // Soma dos pagamentos registrados
+	MinimumPayment *vos.Money // Pagamento mínimo calculado no fechamento
+	CarriedOverAt  *time.Time // Quando o saldo não pago foi levado ao rotativo da fatura seguinte
 	Status         string
 	Items          []*InvoiceItem
 }
@@ -39,6 +50,7 @@ func NewInvoice(
 		ReferenceMonth: referenceMonth,
 		DueDate:        dueDate,
 		TotalAmount:    zeroMoney,
+		PaidAmount:     zeroMoney,
 		Items:          []*InvoiceItem{},
 		Base: entity.Base{
 			CreatedAt: time.Now().UTC(),
@@ -155,6 +167,161 @@ func (inv *Invoice) recalculateTotalAmount() {
 	inv.TotalAmount = total
 }
 
+// Close fecha a fatura na data de fechamento informada.
+// Apenas faturas abertas podem ser fechadas; a partir daqui os itens ficam congelados.
+func (inv *Invoice) Close(closingDate time.Time) error {
+	if inv.Status != "" && inv.Status != InvoiceStatusOpen {
+		return domain.ErrInvoiceNotOpen
+	}
+
+	inv.Status = InvoiceStatusClosed
+	inv.ClosingDate = &closingDate
+	inv.UpdatedAt = vos.NewNullableTime(time.Now().UTC())
+
+	return nil
+}
+
+// SetMinimumPayment registra o pagamento mínimo calculado no fechamento.
+func (inv *Invoice) SetMinimumPayment(minimum vos.Money) {
+	inv.MinimumPayment = &minimum
+}
+
+// AddRevolvingCharges lança os encargos do rotativo na fatura somando-os ao total atual.
+// Os itens existentes não precisam estar carregados.
+func (inv *Invoice) AddRevolvingCharges(items []*InvoiceItem) error {
+	for _, item := range items {
+		if item == nil || !item.IsRevolvingCharge() {
+			return domain.ErrInvalidInvoiceItemType
+		}
+		total, err := inv.TotalAmount.Add(item.InstallmentAmount)
+		if err != nil {
+			return err
+		}
+		inv.TotalAmount = total
+		inv.Items = append(inv.Items, item)
+	}
+	inv.UpdatedAt = vos.NewNullableTime(time.Now().UTC())
+
+	return nil
+}
+
+// AddCarriedCredit lança na fatura o saldo credor trazido da fatura anterior, abatendo o total atual.
+func (inv *Invoice) AddCarriedCredit(item *InvoiceItem) error {
+	if item == nil || !item.IsCarriedCredit() {
+		return domain.ErrInvalidInvoiceItemType
+	}
+	total, err := inv.TotalAmount.Add(item.InstallmentAmount)
+	if err != nil {
+		return err
+	}
+	inv.TotalAmount = total
+	inv.Items = append(inv.Items, item)
+	inv.UpdatedAt = vos.NewNullableTime(time.Now().UTC())
+
+	return nil
+}
+
+// CarryOver leva o saldo não pago da fatura fechada ao rotativo e retorna o valor financiado.
+// A partir daqui a fatura não aceita mais pagamentos: o saldo passa a ser cobrado na fatura seguinte.
+func (inv *Invoice) CarryOver(carriedAt time.Time) (vos.Money, error) {
+	if inv.Status != InvoiceStatusClosed {
+		return vos.Money{}, domain.ErrInvoiceNotClosed
+	}
+	if inv.CarriedOverAt != nil {
+		return vos.Money{}, domain.ErrInvoiceCarriedOver
+	}
+
+	balance := inv.RemainingBalance()
+	inv.CarriedOverAt = &carriedAt
+	inv.UpdatedAt = vos.NewNullableTime(time.Now().UTC())
+
+	return balance, nil
+}
+
+// CarryOverCredit leva o saldo credor da fatura fechada para a fatura seguinte e retorna o crédito.
+// Como no rotativo, a fatura é marcada como levada e o crédito passa a pertencer à seguinte.
+func (inv *Invoice) CarryOverCredit(carriedAt time.Time) (vos.Money, error) {
+	if inv.Status != InvoiceStatusClosed {
+		return vos.Money{}, domain.ErrInvoiceNotClosed
+	}
+	if inv.CarriedOverAt != nil {
+		return vos.Money{}, domain.ErrInvoiceCarriedOver
+	}
+
+	credit := inv.CreditBalance()
+	inv.CarriedOverAt = &carriedAt
+	inv.UpdatedAt = vos.NewNullableTime(time.Now().UTC())
+
+	return credit, nil
+}
+
+// IsCarriedOver indica se o saldo da fatura já foi levado ao rotativo.
+func (inv *Invoice) IsCarriedOver() bool {
+	return inv.CarriedOverAt != nil
+}
+
+// RemainingBalance retorna o saldo ainda não pago da fatura.
+// Depois de levado ao rotativo o saldo pertence à fatura seguinte e aqui passa a zero.
+func (inv *Invoice) RemainingBalance() vos.Money {
+	remaining, err := inv.TotalAmount.Subtract(inv.PaidAmount)
+	if err != nil || remaining.IsNegative() || inv.CarriedOverAt != nil {
+		zero, _ := vos.NewMoney(0, inv.TotalAmount.Currency())
+		return zero
+	}
+	return remaining
+}
+
+// CreditBalance retorna o saldo credor da fatura: o quanto os estornos superam o total das compras.
+// Depois de levado à fatura seguinte o crédito pertence a ela e aqui passa a zero.
+func (inv *Invoice) CreditBalance() vos.Money {
+	credit, err := inv.PaidAmount.Subtract(inv.TotalAmount)
+	if err != nil || !credit.IsPositive() || inv.CarriedOverAt != nil {
+		zero, _ := vos.NewMoney(0, inv.TotalAmount.Currency())
+		return zero
+	}
+	return credit
+}
+
+// RegisterPayment registra um pagamento na fatura fechada.
+// O pagamento não pode exceder o saldo restante; quando o saldo zera a fatura passa a paga.
+func (inv *Invoice) RegisterPayment(payment *InvoicePayment) error {
+	if payment == nil {
+		return domain.ErrInvalidPaymentAmount
+	}
+	switch inv.Status {
+	case InvoiceStatusPaid:
+		return domain.ErrInvoiceAlreadyPaid
+	case InvoiceStatusClosed:
+	default:
+		return domain.ErrInvoiceNotClosed
+	}
+	if inv.CarriedOverAt != nil {
+		return domain.ErrInvoiceCarriedOver
+	}
+
+	if payment.Amount.GreaterThan(inv.RemainingBalance()) {
+		return domain.ErrPaymentExceedsBalance
+	}
+
+	paid, err := inv.PaidAmount.Add(payment.Amount)
+	if err != nil {
+		return err
+	}
+
+	inv.PaidAmount = paid
+	if inv.RemainingBalance().IsZero() {
+		inv.Status = InvoiceStatusPaid
+	}
+	inv.UpdatedAt = vos.NewNullableTime(time.Now().UTC())
+
+	return nil
+}
+
+// IsPaid indica se a fatura foi quitada.
+func (inv *Invoice) IsPaid() bool {
+	return inv.Status == InvoiceStatusPaid
+}
+
 // IsEmpty verifica se a fatura não tem itens.
 func (inv *Invoice) IsEmpty() bool {
 	return len(inv.Items) == 0
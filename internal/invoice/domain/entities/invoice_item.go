@@ -8,20 +8,36 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 
 	"github.com/jailtonjunior94/financial/internal/invoice/domain"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+// Tipos de item de fatura.
+const (
+	InvoiceItemTypePurchase  = "purchase"  // Compra ou parcela lançada no cartão
+	InvoiceItemTypeRevolving = "revolving" // Saldo não pago da fatura anterior acrescido de juros
+	InvoiceItemTypeIOF       = "iof"       // IOF sobre o saldo do rotativo ou sobre uma compra internacional
+	InvoiceItemTypeRefund    = "refund"    // Estorno (crédito) de uma compra já faturada
+	InvoiceItemTypeCredit    = "credit"    // Saldo credor da fatura anterior (estornos acima do total)
 )
 
 // InvoiceItem representa uma compra/parcela lançada no cartão.
 // Nota: Mutações devem passar pelo Invoice (aggregate root).
 type InvoiceItem struct {
 	entity.Base
-	InvoiceID         vos.UUID
-	CategoryID        vos.UUID
-	PurchaseDate      time.Time
-	Description       string
-	TotalAmount       vos.Money // Valor total da compra original
-	InstallmentNumber int       // Parcela atual (1 a N)
-	InstallmentTotal  int       // Total de parcelas (1 para à vista)
-	InstallmentAmount vos.Money // Valor desta parcela
+	InvoiceID            vos.UUID
+	TransactionID        *vos.UUID // Lançamento de origem (nil para itens legados e encargos)
+	CategoryID           vos.UUID  // Vazio para encargos do rotativo
+	Type                 string
+	CarriedFromInvoiceID *vos.UUID // Fatura de origem do saldo (encargos do rotativo e saldo credor)
+	PurchaseDate         time.Time
+	Description          string
+	TotalAmount          vos.Money // Valor total da compra original
+	InstallmentNumber    int       // Parcela atual (1 a N)
+	InstallmentTotal     int       // Total de parcelas (1 para à vista)
+	InstallmentAmount    vos.Money // Valor desta parcela
+	// Campos exclusivos de estornos
+	RefundedTransactionID *vos.UUID              // Compra estornada
+	BudgetMonth           *pkgVos.ReferenceMonth // Mês do orçamento que recebe o crédito (o da fatura da compra)
 }
 
 // validateInvoiceItemFields valida os campos de um InvoiceItem.
@@ -72,6 +88,7 @@ func NewInvoiceItem(
 	return &InvoiceItem{
 		InvoiceID:         invoiceID,
 		CategoryID:        categoryID,
+		Type:              InvoiceItemTypePurchase,
 		PurchaseDate:      purchaseDate,
 		Description:       description,
 		TotalAmount:       totalAmount,
@@ -84,6 +101,156 @@ func NewInvoiceItem(
 	}, nil
 }
 
+// NewRevolvingChargeItem cria um encargo do rotativo (saldo + juros ou IOF)
+// vinculado à fatura de origem do saldo não pago. Encargos não têm categoria.
+func NewRevolvingChargeItem(
+	invoiceID vos.UUID,
+	carriedFromInvoiceID vos.UUID,
+	itemType string,
+	purchaseDate time.Time,
+	description string,
+	amount vos.Money,
+) (*InvoiceItem, error) {
+	if itemType != InvoiceItemTypeRevolving && itemType != InvoiceItemTypeIOF {
+		return nil, domain.ErrInvalidInvoiceItemType
+	}
+	if err := validateInvoiceItemFields(description, amount, amount, 1, 1); err != nil {
+		return nil, err
+	}
+
+	return &InvoiceItem{
+		InvoiceID:            invoiceID,
+		Type:                 itemType,
+		CarriedFromInvoiceID: &carriedFromInvoiceID,
+		PurchaseDate:         purchaseDate,
+		Description:          description,
+		TotalAmount:          amount,
+		InstallmentNumber:    1,
+		InstallmentTotal:     1,
+		InstallmentAmount:    amount,
+		Base: entity.Base{
+			CreatedAt: time.Now().UTC(),
+		},
+	}, nil
+}
+
+// NewPurchaseIOFItem cria o IOF de uma compra internacional, lançado na mesma fatura
+// e na mesma categoria da compra e vinculado ao seu lançamento.
+func NewPurchaseIOFItem(
+	invoiceID vos.UUID,
+	transactionID vos.UUID,
+	categoryID vos.UUID,
+	purchaseDate time.Time,
+	description string,
+	amount vos.Money,
+) (*InvoiceItem, error) {
+	if err := validateInvoiceItemFields(description, amount, amount, 1, 1); err != nil {
+		return nil, err
+	}
+
+	return &InvoiceItem{
+		InvoiceID:         invoiceID,
+		TransactionID:     &transactionID,
+		CategoryID:        categoryID,
+		Type:              InvoiceItemTypeIOF,
+		PurchaseDate:      purchaseDate,
+		Description:       description,
+		TotalAmount:       amount,
+		InstallmentNumber: 1,
+		InstallmentTotal:  1,
+		InstallmentAmount: amount,
+		Base: entity.Base{
+			CreatedAt: time.Now().UTC(),
+		},
+	}, nil
+}
+
+// NewRefundItem cria o crédito de um estorno na fatura aberta do cartão.
+// O valor é informado positivo e gravado negativo, abatendo o total da fatura;
+// o orçamento devolvido é o de budgetMonth, mês em que a compra foi faturada.
+func NewRefundItem(
+	invoiceID vos.UUID,
+	refundedTransactionID vos.UUID,
+	categoryID vos.UUID,
+	refundDate time.Time,
+	description string,
+	amount vos.Money,
+	budgetMonth pkgVos.ReferenceMonth,
+) (*InvoiceItem, error) {
+	if err := validateInvoiceItemFields(description, amount, amount, 1, 1); err != nil {
+		return nil, err
+	}
+
+	credit := amount.Negate()
+	return &InvoiceItem{
+		InvoiceID:             invoiceID,
+		CategoryID:            categoryID,
+		Type:                  InvoiceItemTypeRefund,
+		PurchaseDate:          refundDate,
+		Description:           description,
+		TotalAmount:           credit,
+		InstallmentNumber:     1,
+		InstallmentTotal:      1,
+		InstallmentAmount:     credit,
+		RefundedTransactionID: &refundedTransactionID,
+		BudgetMonth:           &budgetMonth,
+		Base: entity.Base{
+			CreatedAt: time.Now().UTC(),
+		},
+	}, nil
+}
+
+// NewCarriedCreditItem cria o saldo credor levado da fatura anterior, vinculado à fatura de origem.
+// O valor é informado positivo e gravado negativo; como os encargos, o crédito não tem categoria.
+func NewCarriedCreditItem(
+	invoiceID vos.UUID,
+	carriedFromInvoiceID vos.UUID,
+	purchaseDate time.Time,
+	description string,
+	amount vos.Money,
+) (*InvoiceItem, error) {
+	if err := validateInvoiceItemFields(description, amount, amount, 1, 1); err != nil {
+		return nil, err
+	}
+
+	credit := amount.Negate()
+	return &InvoiceItem{
+		InvoiceID:            invoiceID,
+		Type:                 InvoiceItemTypeCredit,
+		CarriedFromInvoiceID: &carriedFromInvoiceID,
+		PurchaseDate:         purchaseDate,
+		Description:          description,
+		TotalAmount:          credit,
+		InstallmentNumber:    1,
+		InstallmentTotal:     1,
+		InstallmentAmount:    credit,
+		Base: entity.Base{
+			CreatedAt: time.Now().UTC(),
+		},
+	}, nil
+}
+
+// IsRefund indica se o item é o crédito de um estorno.
+func (i *InvoiceItem) IsRefund() bool {
+	return i.Type == InvoiceItemTypeRefund
+}
+
+// IsRevolvingCharge indica se o item é um encargo do rotativo e não uma compra.
+// O IOF de uma compra internacional não é encargo do rotativo: não vem de outra fatura.
+func (i *InvoiceItem) IsRevolvingCharge() bool {
+	return i.Type == InvoiceItemTypeRevolving || (i.Type == InvoiceItemTypeIOF && i.CarriedFromInvoiceID != nil)
+}
+
+// IsCarriedCredit indica se o item é o saldo credor trazido da fatura anterior.
+func (i *InvoiceItem) IsCarriedCredit() bool {
+	return i.Type == InvoiceItemTypeCredit
+}
+
+// HasCategory indica se o item pertence a uma categoria: encargos do rotativo e saldo credor não pertencem.
+func (i *InvoiceItem) HasCategory() bool {
+	return !i.IsRevolvingCharge() && !i.IsCarriedCredit()
+}
+
 // IsInstallment retorna se este item é parcelado.
 func (i *InvoiceItem) IsInstallment() bool {
 	return i.InstallmentTotal > 1
@@ -0,0 +1,46 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/entity"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain"
+	invoiceVos "github.com/jailtonjunior94/financial/internal/invoice/domain/vos"
+)
+
+// InvoicePayment representa um pagamento (total ou parcial) de uma fatura.
+// Nota: deve ser registrado via Invoice.RegisterPayment (aggregate root).
+type InvoicePayment struct {
+	entity.Base
+	InvoiceID   vos.UUID
+	UserID      vos.UUID
+	Amount      vos.Money
+	PaymentDate time.Time
+	Method      invoiceVos.InvoicePaymentMethod
+}
+
+// NewInvoicePayment cria um novo pagamento de fatura com validações.
+func NewInvoicePayment(
+	invoiceID vos.UUID,
+	userID vos.UUID,
+	amount vos.Money,
+	paymentDate time.Time,
+	method invoiceVos.InvoicePaymentMethod,
+) (*InvoicePayment, error) {
+	if amount.IsNegative() || amount.IsZero() {
+		return nil, domain.ErrInvalidPaymentAmount
+	}
+
+	return &InvoicePayment{
+		InvoiceID:   invoiceID,
+		UserID:      userID,
+		Amount:      amount,
+		PaymentDate: paymentDate,
+		Method:      method,
+		Base: entity.Base{
+			CreatedAt: time.Now().UTC(),
+		},
+	}, nil
+}
@@ -9,6 +9,15 @@ var (
 	ErrInvoiceAlreadyExistsForMonth = errors.New("invoice already exists for this card and month")
 	ErrInvoiceHasNoItems            = errors.New("invoice must have at least one item")
 	ErrInvoiceNegativeTotal         = errors.New("invoice total amount cannot be negative")
+	ErrInvoiceNotOpen               = errors.New("invoice is not open")
+	ErrInvoiceNotClosed             = errors.New("invoice must be closed before it can be paid")
+	ErrInvoiceAlreadyPaid           = errors.New("invoice is already paid")
+	ErrInvoiceCarriedOver           = errors.New("invoice balance was carried over to the next invoice")
+
+	// InvoicePayment errors.
+	ErrInvalidPaymentAmount        = errors.New("payment amount must be greater than zero")
+	ErrPaymentExceedsBalance       = errors.New("payment amount exceeds the invoice remaining balance")
+	ErrInvalidInvoicePaymentMethod = errors.New("invalid payment method: must be pix, ted or boleto")
 
 	// InvoiceItem errors.
 	ErrInvoiceItemNotFound      = errors.New("invoice item not found")
@@ -19,4 +28,5 @@ var (
 	ErrInvalidCategoryID        = errors.New("invalid category ID")
 	ErrInvalidCardID            = errors.New("invalid card ID")
 	ErrEmptyDescription         = errors.New("description cannot be empty")
+	ErrInvalidInvoiceItemType   = errors.New("invalid invoice item type")
 )
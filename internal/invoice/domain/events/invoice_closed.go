@@ -0,0 +1,76 @@
+package events
+
+import (
+	"time"
+
+	sharedVos "github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+// InvoiceClosedSchemaVersion é a versão do schema do payload deste evento.
+// Incrementar quando o contrato mudar de forma incompatível.
+const InvoiceClosedSchemaVersion = "1"
+
+// InvoiceClosedEvent é emitido pelo job de fechamento quando uma fatura aberta
+// passa da data de fechamento do cartão e tem seus itens congelados.
+type InvoiceClosedEvent struct {
+	invoiceID      sharedVos.UUID
+	userID         sharedVos.UUID
+	cardID         sharedVos.UUID
+	referenceMonth pkgVos.ReferenceMonth
+	closingDate    time.Time
+	dueDate        time.Time
+	totalAmount    sharedVos.Money
+	minimumPayment sharedVos.Money
+}
+
+// NewInvoiceClosedEvent cria um novo evento InvoiceClosed.
+func NewInvoiceClosedEvent(
+	invoiceID sharedVos.UUID,
+	userID sharedVos.UUID,
+	cardID sharedVos.UUID,
+	referenceMonth pkgVos.ReferenceMonth,
+	closingDate time.Time,
+	dueDate time.Time,
+	totalAmount sharedVos.Money,
+	minimumPayment sharedVos.Money,
+) *InvoiceClosedEvent {
+	return &InvoiceClosedEvent{
+		invoiceID:      invoiceID,
+		userID:         userID,
+		cardID:         cardID,
+		referenceMonth: referenceMonth,
+		closingDate:    closingDate,
+		dueDate:        dueDate,
+		totalAmount:    totalAmount,
+		minimumPayment: minimumPayment,
+	}
+}
+
+// EventType retorna o identificador do tipo do evento.
+func (e *InvoiceClosedEvent) EventType() string {
+	return "invoice.closed"
+}
+
+// IdempotencyKey retorna a chave de deduplicação.
+// Uma fatura só é fechada uma vez, então o ID basta.
+func (e *InvoiceClosedEvent) IdempotencyKey() string {
+	return e.invoiceID.String()
+}
+
+// Payload retorna os dados do evento como map para serialização no outbox.
+func (e *InvoiceClosedEvent) Payload() map[string]any {
+	return map[string]any{
+		"version":         InvoiceClosedSchemaVersion,
+		"invoice_id":      e.invoiceID.String(),
+		"user_id":         e.userID.String(),
+		"card_id":         e.cardID.String(),
+		"reference_month": e.referenceMonth.String(),
+		"closing_date":    e.closingDate.Format("2006-01-02"),
+		"due_date":        e.dueDate.Format("2006-01-02"),
+		"total_amount":    e.totalAmount.Cents(),
+		"minimum_payment": e.minimumPayment.Cents(),
+		"currency":        e.totalAmount.Currency().String(),
+	}
+}
@@ -0,0 +1,45 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/events"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+func TestInvoiceClosedEvent(t *testing.T) {
+	invoiceID, _ := vos.NewUUID()
+	userID, _ := vos.NewUUID()
+	cardID, _ := vos.NewUUID()
+	total, _ := vos.NewMoneyFromFloat(1234.56, vos.CurrencyBRL)
+	minimum, _ := vos.NewMoneyFromFloat(185.18, vos.CurrencyBRL)
+	refMonth, _ := pkgVos.NewReferenceMonth("2026-03")
+	closingDate := time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	e := events.NewInvoiceClosedEvent(invoiceID, userID, cardID, refMonth, closingDate, dueDate, total, minimum)
+
+	t.Run("EventType should return invoice.closed", func(t *testing.T) {
+		require.Equal(t, "invoice.closed", e.EventType())
+	})
+
+	t.Run("IdempotencyKey should return invoice_id as string", func(t *testing.T) {
+		require.Equal(t, invoiceID.String(), e.IdempotencyKey())
+	})
+
+	t.Run("Payload should carry closing data", func(t *testing.T) {
+		payload := e.Payload()
+		require.Equal(t, events.InvoiceClosedSchemaVersion, payload["version"])
+		require.Equal(t, invoiceID.String(), payload["invoice_id"])
+		require.Equal(t, cardID.String(), payload["card_id"])
+		require.Equal(t, "2026-03", payload["reference_month"])
+		require.Equal(t, "2026-03-03", payload["closing_date"])
+		require.Equal(t, "2026-03-10", payload["due_date"])
+		require.Equal(t, int64(123456), payload["total_amount"])
+		require.Equal(t, int64(18518), payload["minimum_payment"])
+	})
+}
@@ -0,0 +1,71 @@
+package events
+
+import (
+	"time"
+
+	sharedVos "github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+// InvoicePaidSchemaVersion é a versão do schema do payload deste evento.
+// Incrementar quando o contrato mudar de forma incompatível.
+const InvoicePaidSchemaVersion = "1"
+
+// InvoicePaidEvent é emitido quando os pagamentos registrados zeram o saldo da fatura.
+type InvoicePaidEvent struct {
+	invoiceID      sharedVos.UUID
+	userID         sharedVos.UUID
+	cardID         sharedVos.UUID
+	referenceMonth pkgVos.ReferenceMonth
+	paidAt         time.Time
+	totalAmount    sharedVos.Money
+	paidAmount     sharedVos.Money
+}
+
+// NewInvoicePaidEvent cria um novo evento InvoicePaid.
+func NewInvoicePaidEvent(
+	invoiceID sharedVos.UUID,
+	userID sharedVos.UUID,
+	cardID sharedVos.UUID,
+	referenceMonth pkgVos.ReferenceMonth,
+	paidAt time.Time,
+	totalAmount sharedVos.Money,
+	paidAmount sharedVos.Money,
+) *InvoicePaidEvent {
+	return &InvoicePaidEvent{
+		invoiceID:      invoiceID,
+		userID:         userID,
+		cardID:         cardID,
+		referenceMonth: referenceMonth,
+		paidAt:         paidAt,
+		totalAmount:    totalAmount,
+		paidAmount:     paidAmount,
+	}
+}
+
+// EventType retorna o identificador do tipo do evento.
+func (e *InvoicePaidEvent) EventType() string {
+	return "invoice.paid"
+}
+
+// IdempotencyKey retorna a chave de deduplicação.
+// Uma fatura só é quitada uma vez, então o ID basta.
+func (e *InvoicePaidEvent) IdempotencyKey() string {
+	return e.invoiceID.String()
+}
+
+// Payload retorna os dados do evento como map para serialização no outbox.
+func (e *InvoicePaidEvent) Payload() map[string]any {
+	return map[string]any{
+		"version":         InvoicePaidSchemaVersion,
+		"invoice_id":      e.invoiceID.String(),
+		"user_id":         e.userID.String(),
+		"card_id":         e.cardID.String(),
+		"reference_month": e.referenceMonth.String(),
+		"paid_at":         e.paidAt.Format("2006-01-02"),
+		"total_amount":    e.totalAmount.Cents(),
+		"paid_amount":     e.paidAmount.Cents(),
+		"currency":        e.totalAmount.Currency().String(),
+	}
+}
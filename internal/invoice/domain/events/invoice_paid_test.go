@@ -0,0 +1,42 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/events"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+func TestInvoicePaidEvent(t *testing.T) {
+	invoiceID, _ := vos.NewUUID()
+	userID, _ := vos.NewUUID()
+	cardID, _ := vos.NewUUID()
+	total, _ := vos.NewMoneyFromFloat(1234.56, vos.CurrencyBRL)
+	refMonth, _ := pkgVos.NewReferenceMonth("2026-03")
+	paidAt := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	e := events.NewInvoicePaidEvent(invoiceID, userID, cardID, refMonth, paidAt, total, total)
+
+	t.Run("EventType should return invoice.paid", func(t *testing.T) {
+		require.Equal(t, "invoice.paid", e.EventType())
+	})
+
+	t.Run("IdempotencyKey should return invoice_id as string", func(t *testing.T) {
+		require.Equal(t, invoiceID.String(), e.IdempotencyKey())
+	})
+
+	t.Run("Payload should carry payment data", func(t *testing.T) {
+		payload := e.Payload()
+		require.Equal(t, events.InvoicePaidSchemaVersion, payload["version"])
+		require.Equal(t, invoiceID.String(), payload["invoice_id"])
+		require.Equal(t, userID.String(), payload["user_id"])
+		require.Equal(t, "2026-03", payload["reference_month"])
+		require.Equal(t, "2026-03-09", payload["paid_at"])
+		require.Equal(t, int64(123456), payload["total_amount"])
+		require.Equal(t, int64(123456), payload["paid_amount"])
+	})
+}
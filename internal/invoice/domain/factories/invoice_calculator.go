@@ -86,3 +86,13 @@ func (c *InvoiceCalculator) CalculateInstallmentMonths(purchaseDate time.Time, i
 func (c *InvoiceCalculator) CalculateDueDate(referenceMonth pkgVos.ReferenceMonth) time.Time {
 	return time.Date(referenceMonth.Year(), referenceMonth.Month(), c.dueDay, 0, 0, 0, 0, time.UTC)
 }
+
+// CalculateClosingDate returns the closing date of the invoice for a given reference month.
+//
+// The closing day is clamped to the last day of the month (e.g. closing day 30 in February).
+// Purchases made up to and including this date belong to the invoice.
+func (c *InvoiceCalculator) CalculateClosingDate(referenceMonth pkgVos.ReferenceMonth) time.Time {
+	lastDay := time.Date(referenceMonth.Year(), referenceMonth.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	closingDay := min(c.ClosingDay(), lastDay)
+	return time.Date(referenceMonth.Year(), referenceMonth.Month(), closingDay, 0, 0, 0, 0, time.UTC)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/jailtonjunior94/financial/internal/invoice/domain/factories"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
 )
 
 func date(year, month, day int) time.Time {
@@ -95,4 +96,18 @@ func TestInvoiceCalculator(t *testing.T) {
 		require.Equal(t, "2027-01", months[0].String())
 		require.Equal(t, "2027-02", months[1].String())
 	})
+
+	t.Run("should return closing date on the closing day of the reference month", func(t *testing.T) {
+		calc, err := factories.NewInvoiceCalculator(10, 7)
+		require.NoError(t, err)
+		month, _ := pkgVos.NewReferenceMonth("2026-03")
+		require.Equal(t, date(2026, 3, 3), calc.CalculateClosingDate(month))
+	})
+
+	t.Run("should clamp closing date to the last day of february", func(t *testing.T) {
+		calc, err := factories.NewInvoiceCalculator(31, 1)
+		require.NoError(t, err)
+		month, _ := pkgVos.NewReferenceMonth("2026-02")
+		require.Equal(t, date(2026, 2, 28), calc.CalculateClosingDate(month))
+	})
 }
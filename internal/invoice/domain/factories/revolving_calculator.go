@@ -0,0 +1,90 @@
+package factories
+
+import (
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+)
+
+const (
+	// defaultMinimumPaymentBps is applied when the card has no minimum payment configured (15%).
+	defaultMinimumPaymentBps = 1500
+
+	// IOF on revolving credit (Decreto 6.306/2007), expressed in millionths:
+	// a fixed 0.38% plus 0.0082% per day, with the daily part capped at 365 days.
+	iofFixedMillionths = 3800
+	iofDailyMillionths = 82
+	iofMaxDays         = 365
+)
+
+// RevolvingCharges is the breakdown of an unpaid balance carried to the next invoice.
+type RevolvingCharges struct {
+	Principal vos.Money
+	Interest  vos.Money
+	IOF       vos.Money
+}
+
+// Carried returns the amount posted as the revolving item (principal + interest).
+// IOF is posted as a separate item.
+func (c RevolvingCharges) Carried() (vos.Money, error) {
+	return c.Principal.Add(c.Interest)
+}
+
+// RevolvingCalculator computes minimum payment and revolving charges for a card.
+//
+// All rates are in basis points (1450 = 14.50%) and all results are rounded
+// half-up to the cent.
+type RevolvingCalculator struct {
+	interestRateBps   int
+	minimumPaymentBps int
+}
+
+// NewRevolvingCalculator creates a RevolvingCalculator for a specific card.
+// A non-positive minimumPaymentBps falls back to the 15% default.
+func NewRevolvingCalculator(interestRateBps, minimumPaymentBps int) *RevolvingCalculator {
+	if interestRateBps < 0 {
+		interestRateBps = 0
+	}
+	if minimumPaymentBps <= 0 {
+		minimumPaymentBps = defaultMinimumPaymentBps
+	}
+	return &RevolvingCalculator{interestRateBps: interestRateBps, minimumPaymentBps: minimumPaymentBps}
+}
+
+// MinimumPayment returns the minimum payment for an invoice total, never above the total.
+func (c *RevolvingCalculator) MinimumPayment(total vos.Money) (vos.Money, error) {
+	if !total.IsPositive() {
+		return vos.NewMoney(0, total.Currency())
+	}
+	cents := roundDiv(total.Cents()*int64(c.minimumPaymentBps), 10000)
+	if cents > total.Cents() {
+		cents = total.Cents()
+	}
+	return vos.NewMoney(cents, total.Currency())
+}
+
+// CarryOver computes interest and IOF for an unpaid balance financed for the given days.
+func (c *RevolvingCalculator) CarryOver(balance vos.Money, days int) (RevolvingCharges, error) {
+	if days < 0 {
+		days = 0
+	}
+	if days > iofMaxDays {
+		days = iofMaxDays
+	}
+
+	interest, err := vos.NewMoney(roundDiv(balance.Cents()*int64(c.interestRateBps), 10000), balance.Currency())
+	if err != nil {
+		return RevolvingCharges{}, err
+	}
+
+	iofMillionths := int64(iofFixedMillionths + iofDailyMillionths*days)
+	iof, err := vos.NewMoney(roundDiv(balance.Cents()*iofMillionths, 1000000), balance.Currency())
+	if err != nil {
+		return RevolvingCharges{}, err
+	}
+
+	return RevolvingCharges{Principal: balance, Interest: interest, IOF: iof}, nil
+}
+
+// roundDiv divides non-negative integers rounding half-up.
+func roundDiv(numerator, denominator int64) int64 {
+	return (numerator + denominator/2) / denominator
+}
@@ -0,0 +1,76 @@
+package factories_test
+
+import (
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/factories"
+)
+
+func brl(cents int64) vos.Money {
+	m, _ := vos.NewMoney(cents, vos.CurrencyBRL)
+	return m
+}
+
+func TestRevolvingCalculator(t *testing.T) {
+	t.Run("should compute minimum payment using card percentage", func(t *testing.T) {
+		calc := factories.NewRevolvingCalculator(0, 2000)
+		minimum, err := calc.MinimumPayment(brl(123456))
+		require.NoError(t, err)
+		require.Equal(t, int64(24691), minimum.Cents())
+	})
+
+	t.Run("should default minimum payment to 15% when not configured", func(t *testing.T) {
+		calc := factories.NewRevolvingCalculator(0, 0)
+		minimum, err := calc.MinimumPayment(brl(100000))
+		require.NoError(t, err)
+		require.Equal(t, int64(15000), minimum.Cents())
+	})
+
+	t.Run("should cap minimum payment at the invoice total", func(t *testing.T) {
+		calc := factories.NewRevolvingCalculator(0, 10000)
+		minimum, err := calc.MinimumPayment(brl(999))
+		require.NoError(t, err)
+		require.Equal(t, int64(999), minimum.Cents())
+	})
+
+	t.Run("should return zero minimum payment for empty invoice", func(t *testing.T) {
+		calc := factories.NewRevolvingCalculator(0, 1500)
+		minimum, err := calc.MinimumPayment(brl(0))
+		require.NoError(t, err)
+		require.True(t, minimum.IsZero())
+	})
+
+	t.Run("should compute interest and IOF on carried balance", func(t *testing.T) {
+		calc := factories.NewRevolvingCalculator(1450, 1500)
+		charges, err := calc.CarryOver(brl(100000), 30)
+		require.NoError(t, err)
+		require.Equal(t, int64(100000), charges.Principal.Cents())
+		require.Equal(t, int64(14500), charges.Interest.Cents())
+		// 0.38% + 30 × 0.0082% = 0.626%
+		require.Equal(t, int64(626), charges.IOF.Cents())
+
+		carried, err := charges.Carried()
+		require.NoError(t, err)
+		require.Equal(t, int64(114500), carried.Cents())
+	})
+
+	t.Run("should charge only IOF when card has no interest rate", func(t *testing.T) {
+		calc := factories.NewRevolvingCalculator(0, 1500)
+		charges, err := calc.CarryOver(brl(50000), 0)
+		require.NoError(t, err)
+		require.True(t, charges.Interest.IsZero())
+		require.Equal(t, int64(190), charges.IOF.Cents())
+	})
+
+	t.Run("should cap daily IOF at 365 days", func(t *testing.T) {
+		calc := factories.NewRevolvingCalculator(0, 1500)
+		capped, err := calc.CarryOver(brl(100000), 365)
+		require.NoError(t, err)
+		beyond, err := calc.CarryOver(brl(100000), 400)
+		require.NoError(t, err)
+		require.Equal(t, capped.IOF.Cents(), beyond.IOF.Cents())
+	})
+}
@@ -13,6 +13,18 @@ type CardBillingInfo struct {
 	CardID            vos.UUID
 	DueDay            int // Dia de vencimento da fatura (1-31)
 	ClosingOffsetDays int // Quantos dias ANTES do vencimento fecha a fatura (padrão brasileiro: 7)
+	InterestRateBps   int // Juros mensais do rotativo em basis points (1450 = 14,50% a.m.)
+	MinimumPaymentBps int // Percentual do pagamento mínimo em basis points (1500 = 15%)
+}
+
+// CardLimitInfo contém o limite do cartão de crédito e quanto dele ainda está disponível.
+// HasLimit = false indica cartão sem controle de limite.
+type CardLimitInfo struct {
+	CardID          vos.UUID
+	HasLimit        bool
+	CreditLimit     vos.Money
+	AvailableLimit  vos.Money // Limite total menos o saldo em aberto das faturas (pode ser negativo)
+	OverLimitPolicy string    // block (rejeita) ou flag (aceita e sinaliza)
 }
 
 // CardProvider é uma porta de domínio que abstrai o acesso a dados do cartão.
@@ -21,4 +33,8 @@ type CardProvider interface {
 	// GetCardBillingInfo obtém informações de faturamento do cartão
 	// Valida que o cartão pertence ao usuário
 	GetCardBillingInfo(ctx context.Context, userID vos.UUID, cardID vos.UUID) (*CardBillingInfo, error)
+
+	// GetCardLimit obtém o limite e o limite disponível do cartão
+	// Valida que o cartão pertence ao usuário
+	GetCardLimit(ctx context.Context, userID vos.UUID, cardID vos.UUID) (*CardLimitInfo, error)
 }
@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+)
+
+// InvoiceItemRepository define as operações de escrita dos itens de fatura
+// originados por lançamentos. Todas recebem a transação corrente para que
+// itens e totais sejam gravados na mesma unidade de trabalho do lançamento.
+type InvoiceItemRepository interface {
+	// InsertItems cria os itens vinculados aos lançamentos
+	InsertItems(ctx context.Context, tx database.DBTX, items []*entities.InvoiceItem) error
+
+	// UpdateItemByTransaction atualiza o item vinculado ao lançamento.
+	// Para compras parceladas o total_amount original é preservado.
+	UpdateItemByTransaction(ctx context.Context, tx database.DBTX, item *entities.InvoiceItem) error
+
+	// DeleteItemsByTransactionIDs remove (soft delete) os itens dos lançamentos
+	// e retorna as faturas afetadas.
+	DeleteItemsByTransactionIDs(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID) ([]vos.UUID, error)
+
+	// FreezeItems congela os itens ativos da fatura no fechamento;
+	// itens congelados não são mais alterados nem removidos.
+	FreezeItems(ctx context.Context, tx database.DBTX, invoiceID vos.UUID, frozenAt time.Time) error
+
+	// RecalculateTotals recalcula o total_amount das faturas a partir dos itens ativos
+	RecalculateTotals(ctx context.Context, tx database.DBTX, invoiceIDs []vos.UUID) error
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+)
+
+// InvoicePaymentRepository define a persistência dos pagamentos de fatura.
+// Recebe a transação corrente para que o pagamento e o saldo da fatura
+// sejam gravados na mesma unidade de trabalho.
+type InvoicePaymentRepository interface {
+	// Insert registra um pagamento de fatura
+	Insert(ctx context.Context, tx database.DBTX, payment *entities.InvoicePayment) error
+}
@@ -3,6 +3,7 @@ package interfaces
 import (
 	"context"
 
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 
 	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
@@ -27,6 +28,12 @@ type ListInvoicesByMonthParams struct {
 	Cursor         pagination.Cursor
 }
 
+// OpenInvoiceCursor posiciona ListOpenUntil logo após a última fatura da página anterior.
+type OpenInvoiceCursor struct {
+	ReferenceMonth pkgVos.ReferenceMonth
+	ID             vos.UUID
+}
+
 // InvoiceRepository define as operações de persistência de Invoice.
 type InvoiceRepository interface {
 	// Insert cria uma nova fatura
@@ -89,4 +96,36 @@ type InvoiceRepository interface {
 	// FindStatus returns the status of an invoice by ID.
 	// Returns ("", nil) if not found.
 	FindStatus(ctx context.Context, invoiceID vos.UUID) (string, error)
+
+	// SumOutstandingByCard soma o saldo em aberto das faturas do cartão que consomem limite
+	// (abertas e fechadas não pagas, exceto as levadas ao rotativo)
+	SumOutstandingByCard(ctx context.Context, cardID vos.UUID) (vos.Money, error)
+
+	// SumCategoryByUserAndMonth soma as parcelas de uma categoria nas faturas do usuário no mês,
+	// contando apenas a parte da categoria nos lançamentos rateados
+	SumCategoryByUserAndMonth(
+		ctx context.Context,
+		userID vos.UUID,
+		referenceMonth pkgVos.ReferenceMonth,
+		categoryID vos.UUID,
+	) (vos.Money, error)
+
+	// ListOpenUntil busca faturas abertas com mês de referência até o mês informado
+	// (mais antigas primeiro), sem carregar os itens. Pagina por (reference_month, id) a partir
+	// de after; nil busca a primeira página
+	ListOpenUntil(ctx context.Context, referenceMonth pkgVos.ReferenceMonth, after *OpenInvoiceCursor, limit int) ([]*entities.Invoice, error)
+
+	// Close fecha a fatura e grava o pagamento mínimo dentro da transação informada.
+	// Retorna false se a fatura já não estava mais aberta.
+	Close(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error)
+
+	// ApplyPayment soma o pagamento ao valor pago dentro da transação e decide o status no banco:
+	// a fatura passa a paga quando o valor pago alcança o total. Status e valor pago gravados
+	// voltam para invoice, já com pagamentos concorrentes.
+	// Retorna false se a fatura não está mais fechada ou se o pagamento excederia o total.
+	ApplyPayment(ctx context.Context, tx database.DBTX, invoice *entities.Invoice, amount vos.Money) (bool, error)
+
+	// MarkCarriedOver marca o saldo da fatura como levado ao rotativo dentro da transação.
+	// Retorna false se a fatura foi paga, já teve o saldo levado ou recebeu pagamento concorrente.
+	MarkCarriedOver(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error)
 }
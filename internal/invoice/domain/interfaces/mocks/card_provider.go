@@ -112,3 +112,77 @@ func (_c *CardProvider_GetCardBillingInfo_Call) RunAndReturn(run func(ctx contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetCardLimit provides a mock function for the type CardProvider
+func (_mock *CardProvider) GetCardLimit(ctx context.Context, userID vos.UUID, cardID vos.UUID) (*interfaces.CardLimitInfo, error) {
+	ret := _mock.Called(ctx, userID, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCardLimit")
+	}
+
+	var r0 *interfaces.CardLimitInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, vos.UUID) (*interfaces.CardLimitInfo, error)); ok {
+		return returnFunc(ctx, userID, cardID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, vos.UUID) *interfaces.CardLimitInfo); ok {
+		r0 = returnFunc(ctx, userID, cardID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*interfaces.CardLimitInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, vos.UUID, vos.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CardProvider_GetCardLimit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCardLimit'
+type CardProvider_GetCardLimit_Call struct {
+	*mock.Call
+}
+
+// GetCardLimit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID vos.UUID
+//   - cardID vos.UUID
+func (_e *CardProvider_Expecter) GetCardLimit(ctx interface{}, userID interface{}, cardID interface{}) *CardProvider_GetCardLimit_Call {
+	return &CardProvider_GetCardLimit_Call{Call: _e.mock.On("GetCardLimit", ctx, userID, cardID)}
+}
+
+func (_c *CardProvider_GetCardLimit_Call) Run(run func(ctx context.Context, userID vos.UUID, cardID vos.UUID)) *CardProvider_GetCardLimit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 vos.UUID
+		if args[1] != nil {
+			arg1 = args[1].(vos.UUID)
+		}
+		var arg2 vos.UUID
+		if args[2] != nil {
+			arg2 = args[2].(vos.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *CardProvider_GetCardLimit_Call) Return(cardLimitInfo *interfaces.CardLimitInfo, err error) *CardProvider_GetCardLimit_Call {
+	_c.Call.Return(cardLimitInfo, err)
+	return _c
+}
+
+func (_c *CardProvider_GetCardLimit_Call) RunAndReturn(run func(ctx context.Context, userID vos.UUID, cardID vos.UUID) (*interfaces.CardLimitInfo, error)) *CardProvider_GetCardLimit_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	"context"
 
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
 	"github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
@@ -41,6 +42,156 @@ func (_m *InvoiceRepository) EXPECT() *InvoiceRepository_Expecter {
 	return &InvoiceRepository_Expecter{mock: &_m.Mock}
 }
 
+// ApplyPayment provides a mock function for the type InvoiceRepository
+func (_mock *InvoiceRepository) ApplyPayment(ctx context.Context, tx database.DBTX, invoice *entities.Invoice, amount vos.Money) (bool, error) {
+	ret := _mock.Called(ctx, tx, invoice, amount)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyPayment")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, *entities.Invoice, vos.Money) (bool, error)); ok {
+		return returnFunc(ctx, tx, invoice, amount)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, *entities.Invoice, vos.Money) bool); ok {
+		r0 = returnFunc(ctx, tx, invoice, amount)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, database.DBTX, *entities.Invoice, vos.Money) error); ok {
+		r1 = returnFunc(ctx, tx, invoice, amount)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InvoiceRepository_ApplyPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyPayment'
+type InvoiceRepository_ApplyPayment_Call struct {
+	*mock.Call
+}
+
+// ApplyPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - invoice *entities.Invoice
+//   - amount vos.Money
+func (_e *InvoiceRepository_Expecter) ApplyPayment(ctx interface{}, tx interface{}, invoice interface{}, amount interface{}) *InvoiceRepository_ApplyPayment_Call {
+	return &InvoiceRepository_ApplyPayment_Call{Call: _e.mock.On("ApplyPayment", ctx, tx, invoice, amount)}
+}
+
+func (_c *InvoiceRepository_ApplyPayment_Call) Run(run func(ctx context.Context, tx database.DBTX, invoice *entities.Invoice, amount vos.Money)) *InvoiceRepository_ApplyPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 *entities.Invoice
+		if args[2] != nil {
+			arg2 = args[2].(*entities.Invoice)
+		}
+		var arg3 vos.Money
+		if args[3] != nil {
+			arg3 = args[3].(vos.Money)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_ApplyPayment_Call) Return(b bool, err error) *InvoiceRepository_ApplyPayment_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *InvoiceRepository_ApplyPayment_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, invoice *entities.Invoice, amount vos.Money) (bool, error)) *InvoiceRepository_ApplyPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Close provides a mock function for the type InvoiceRepository
+func (_mock *InvoiceRepository) Close(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error) {
+	ret := _mock.Called(ctx, tx, invoice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Close")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, *entities.Invoice) (bool, error)); ok {
+		return returnFunc(ctx, tx, invoice)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, *entities.Invoice) bool); ok {
+		r0 = returnFunc(ctx, tx, invoice)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, database.DBTX, *entities.Invoice) error); ok {
+		r1 = returnFunc(ctx, tx, invoice)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InvoiceRepository_Close_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Close'
+type InvoiceRepository_Close_Call struct {
+	*mock.Call
+}
+
+// Close is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - invoice *entities.Invoice
+func (_e *InvoiceRepository_Expecter) Close(ctx interface{}, tx interface{}, invoice interface{}) *InvoiceRepository_Close_Call {
+	return &InvoiceRepository_Close_Call{Call: _e.mock.On("Close", ctx, tx, invoice)}
+}
+
+func (_c *InvoiceRepository_Close_Call) Run(run func(ctx context.Context, tx database.DBTX, invoice *entities.Invoice)) *InvoiceRepository_Close_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 *entities.Invoice
+		if args[2] != nil {
+			arg2 = args[2].(*entities.Invoice)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_Close_Call) Return(b bool, err error) *InvoiceRepository_Close_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *InvoiceRepository_Close_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error)) *InvoiceRepository_Close_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteItem provides a mock function for the type InvoiceRepository
 func (_mock *InvoiceRepository) DeleteItem(ctx context.Context, itemID vos.UUID) error {
 	ret := _mock.Called(ctx, itemID)
@@ -784,6 +935,302 @@ func (_c *InvoiceRepository_ListByUserAndMonthPaginated_Call) RunAndReturn(run f
 	return _c
 }
 
+// ListOpenUntil provides a mock function for the type InvoiceRepository
+func (_mock *InvoiceRepository) ListOpenUntil(ctx context.Context, referenceMonth vos0.ReferenceMonth, after *interfaces.OpenInvoiceCursor, limit int) ([]*entities.Invoice, error) {
+	ret := _mock.Called(ctx, referenceMonth, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOpenUntil")
+	}
+
+	var r0 []*entities.Invoice
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos0.ReferenceMonth, *interfaces.OpenInvoiceCursor, int) ([]*entities.Invoice, error)); ok {
+		return returnFunc(ctx, referenceMonth, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos0.ReferenceMonth, *interfaces.OpenInvoiceCursor, int) []*entities.Invoice); ok {
+		r0 = returnFunc(ctx, referenceMonth, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Invoice)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, vos0.ReferenceMonth, *interfaces.OpenInvoiceCursor, int) error); ok {
+		r1 = returnFunc(ctx, referenceMonth, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InvoiceRepository_ListOpenUntil_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOpenUntil'
+type InvoiceRepository_ListOpenUntil_Call struct {
+	*mock.Call
+}
+
+// ListOpenUntil is a helper method to define mock.On call
+//   - ctx context.Context
+//   - referenceMonth vos0.ReferenceMonth
+//   - after *interfaces.OpenInvoiceCursor
+//   - limit int
+func (_e *InvoiceRepository_Expecter) ListOpenUntil(ctx interface{}, referenceMonth interface{}, after interface{}, limit interface{}) *InvoiceRepository_ListOpenUntil_Call {
+	return &InvoiceRepository_ListOpenUntil_Call{Call: _e.mock.On("ListOpenUntil", ctx, referenceMonth, after, limit)}
+}
+
+func (_c *InvoiceRepository_ListOpenUntil_Call) Run(run func(ctx context.Context, referenceMonth vos0.ReferenceMonth, after *interfaces.OpenInvoiceCursor, limit int)) *InvoiceRepository_ListOpenUntil_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 vos0.ReferenceMonth
+		if args[1] != nil {
+			arg1 = args[1].(vos0.ReferenceMonth)
+		}
+		var arg2 *interfaces.OpenInvoiceCursor
+		if args[2] != nil {
+			arg2 = args[2].(*interfaces.OpenInvoiceCursor)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_ListOpenUntil_Call) Return(_a0 []*entities.Invoice, _a1 error) *InvoiceRepository_ListOpenUntil_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InvoiceRepository_ListOpenUntil_Call) RunAndReturn(run func(ctx context.Context, referenceMonth vos0.ReferenceMonth, after *interfaces.OpenInvoiceCursor, limit int) ([]*entities.Invoice, error)) *InvoiceRepository_ListOpenUntil_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkCarriedOver provides a mock function for the type InvoiceRepository
+func (_mock *InvoiceRepository) MarkCarriedOver(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error) {
+	ret := _mock.Called(ctx, tx, invoice)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkCarriedOver")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, *entities.Invoice) (bool, error)); ok {
+		return returnFunc(ctx, tx, invoice)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, *entities.Invoice) bool); ok {
+		r0 = returnFunc(ctx, tx, invoice)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, database.DBTX, *entities.Invoice) error); ok {
+		r1 = returnFunc(ctx, tx, invoice)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InvoiceRepository_MarkCarriedOver_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCarriedOver'
+type InvoiceRepository_MarkCarriedOver_Call struct {
+	*mock.Call
+}
+
+// MarkCarriedOver is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - invoice *entities.Invoice
+func (_e *InvoiceRepository_Expecter) MarkCarriedOver(ctx interface{}, tx interface{}, invoice interface{}) *InvoiceRepository_MarkCarriedOver_Call {
+	return &InvoiceRepository_MarkCarriedOver_Call{Call: _e.mock.On("MarkCarriedOver", ctx, tx, invoice)}
+}
+
+func (_c *InvoiceRepository_MarkCarriedOver_Call) Run(run func(ctx context.Context, tx database.DBTX, invoice *entities.Invoice)) *InvoiceRepository_MarkCarriedOver_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 *entities.Invoice
+		if args[2] != nil {
+			arg2 = args[2].(*entities.Invoice)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_MarkCarriedOver_Call) Return(b bool, err error) *InvoiceRepository_MarkCarriedOver_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *InvoiceRepository_MarkCarriedOver_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error)) *InvoiceRepository_MarkCarriedOver_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumCategoryByUserAndMonth provides a mock function for the type InvoiceRepository
+func (_mock *InvoiceRepository) SumCategoryByUserAndMonth(ctx context.Context, userID vos.UUID, referenceMonth vos0.ReferenceMonth, categoryID vos.UUID) (vos.Money, error) {
+	ret := _mock.Called(ctx, userID, referenceMonth, categoryID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumCategoryByUserAndMonth")
+	}
+
+	var r0 vos.Money
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, vos0.ReferenceMonth, vos.UUID) (vos.Money, error)); ok {
+		return returnFunc(ctx, userID, referenceMonth, categoryID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, vos0.ReferenceMonth, vos.UUID) vos.Money); ok {
+		r0 = returnFunc(ctx, userID, referenceMonth, categoryID)
+	} else {
+		r0 = ret.Get(0).(vos.Money)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, vos.UUID, vos0.ReferenceMonth, vos.UUID) error); ok {
+		r1 = returnFunc(ctx, userID, referenceMonth, categoryID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InvoiceRepository_SumCategoryByUserAndMonth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumCategoryByUserAndMonth'
+type InvoiceRepository_SumCategoryByUserAndMonth_Call struct {
+	*mock.Call
+}
+
+// SumCategoryByUserAndMonth is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID vos.UUID
+//   - referenceMonth vos0.ReferenceMonth
+//   - categoryID vos.UUID
+func (_e *InvoiceRepository_Expecter) SumCategoryByUserAndMonth(ctx interface{}, userID interface{}, referenceMonth interface{}, categoryID interface{}) *InvoiceRepository_SumCategoryByUserAndMonth_Call {
+	return &InvoiceRepository_SumCategoryByUserAndMonth_Call{Call: _e.mock.On("SumCategoryByUserAndMonth", ctx, userID, referenceMonth, categoryID)}
+}
+
+func (_c *InvoiceRepository_SumCategoryByUserAndMonth_Call) Run(run func(ctx context.Context, userID vos.UUID, referenceMonth vos0.ReferenceMonth, categoryID vos.UUID)) *InvoiceRepository_SumCategoryByUserAndMonth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 vos.UUID
+		if args[1] != nil {
+			arg1 = args[1].(vos.UUID)
+		}
+		var arg2 vos0.ReferenceMonth
+		if args[2] != nil {
+			arg2 = args[2].(vos0.ReferenceMonth)
+		}
+		var arg3 vos.UUID
+		if args[3] != nil {
+			arg3 = args[3].(vos.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_SumCategoryByUserAndMonth_Call) Return(money vos.Money, err error) *InvoiceRepository_SumCategoryByUserAndMonth_Call {
+	_c.Call.Return(money, err)
+	return _c
+}
+
+func (_c *InvoiceRepository_SumCategoryByUserAndMonth_Call) RunAndReturn(run func(ctx context.Context, userID vos.UUID, referenceMonth vos0.ReferenceMonth, categoryID vos.UUID) (vos.Money, error)) *InvoiceRepository_SumCategoryByUserAndMonth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SumOutstandingByCard provides a mock function for the type InvoiceRepository
+func (_mock *InvoiceRepository) SumOutstandingByCard(ctx context.Context, cardID vos.UUID) (vos.Money, error) {
+	ret := _mock.Called(ctx, cardID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SumOutstandingByCard")
+	}
+
+	var r0 vos.Money
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID) (vos.Money, error)); ok {
+		return returnFunc(ctx, cardID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID) vos.Money); ok {
+		r0 = returnFunc(ctx, cardID)
+	} else {
+		r0 = ret.Get(0).(vos.Money)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, vos.UUID) error); ok {
+		r1 = returnFunc(ctx, cardID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InvoiceRepository_SumOutstandingByCard_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SumOutstandingByCard'
+type InvoiceRepository_SumOutstandingByCard_Call struct {
+	*mock.Call
+}
+
+// SumOutstandingByCard is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cardID vos.UUID
+func (_e *InvoiceRepository_Expecter) SumOutstandingByCard(ctx interface{}, cardID interface{}) *InvoiceRepository_SumOutstandingByCard_Call {
+	return &InvoiceRepository_SumOutstandingByCard_Call{Call: _e.mock.On("SumOutstandingByCard", ctx, cardID)}
+}
+
+func (_c *InvoiceRepository_SumOutstandingByCard_Call) Run(run func(ctx context.Context, cardID vos.UUID)) *InvoiceRepository_SumOutstandingByCard_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 vos.UUID
+		if args[1] != nil {
+			arg1 = args[1].(vos.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceRepository_SumOutstandingByCard_Call) Return(money vos.Money, err error) *InvoiceRepository_SumOutstandingByCard_Call {
+	_c.Call.Return(money, err)
+	return _c
+}
+
+func (_c *InvoiceRepository_SumOutstandingByCard_Call) RunAndReturn(run func(ctx context.Context, cardID vos.UUID) (vos.Money, error)) *InvoiceRepository_SumOutstandingByCard_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type InvoiceRepository
 func (_mock *InvoiceRepository) Update(ctx context.Context, invoice *entities.Invoice) error {
 	ret := _mock.Called(ctx, invoice)
@@ -0,0 +1,29 @@
+package vos
+
+import "github.com/jailtonjunior94/financial/internal/invoice/domain"
+
+// Meios aceitos para pagamento de fatura.
+const (
+	InvoicePaymentMethodPix    = "pix"
+	InvoicePaymentMethodTed    = "ted"
+	InvoicePaymentMethodBoleto = "boleto"
+)
+
+// InvoicePaymentMethod representa o meio usado para pagar uma fatura.
+type InvoicePaymentMethod struct {
+	Value string
+}
+
+// NewInvoicePaymentMethod valida e cria o meio de pagamento.
+func NewInvoicePaymentMethod(v string) (InvoicePaymentMethod, error) {
+	switch v {
+	case InvoicePaymentMethodPix, InvoicePaymentMethodTed, InvoicePaymentMethodBoleto:
+		return InvoicePaymentMethod{Value: v}, nil
+	default:
+		return InvoicePaymentMethod{}, domain.ErrInvalidInvoicePaymentMethod
+	}
+}
+
+func (m InvoicePaymentMethod) String() string {
+	return m.Value
+}
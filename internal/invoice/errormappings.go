@@ -47,6 +47,14 @@ func ErrorMappings() map[error]httperrors.ErrorMapping {
 			Status:  http.StatusBadRequest,
 			Message: "Invoice total amount cannot be negative",
 		},
+		domain.ErrInvalidPaymentAmount: {
+			Status:  http.StatusBadRequest,
+			Message: "Payment amount must be greater than zero",
+		},
+		domain.ErrInvalidInvoicePaymentMethod: {
+			Status:  http.StatusBadRequest,
+			Message: "Payment method must be pix, ted or boleto",
+		},
 
 		// Ownership errors -> 403 Forbidden
 		domain.ErrInvoiceNotOwned: {
@@ -69,5 +77,27 @@ func ErrorMappings() map[error]httperrors.ErrorMapping {
 			Status:  http.StatusConflict,
 			Message: "Invoice already exists for this card and month",
 		},
+		domain.ErrInvoiceNotOpen: {
+			Status:  http.StatusConflict,
+			Message: "Invoice is not open",
+		},
+		domain.ErrInvoiceNotClosed: {
+			Status:  http.StatusConflict,
+			Message: "Invoice must be closed before it can be paid",
+		},
+		domain.ErrInvoiceAlreadyPaid: {
+			Status:  http.StatusConflict,
+			Message: "Invoice is already paid",
+		},
+		domain.ErrInvoiceCarriedOver: {
+			Status:  http.StatusConflict,
+			Message: "Invoice balance was carried over to the next invoice",
+		},
+
+		// Business rule errors -> 422 Unprocessable Entity
+		domain.ErrPaymentExceedsBalance: {
+			Status:  http.StatusUnprocessableEntity,
+			Message: "Payment amount exceeds the invoice remaining balance",
+		},
 	}
 }
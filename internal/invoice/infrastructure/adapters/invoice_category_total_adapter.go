@@ -20,32 +20,16 @@ func NewInvoiceCategoryTotalAdapter(invoiceRepository interfaces.InvoiceReposito
 }
 
 // GetCategoryTotal retorna a soma de InstallmentAmount das invoice_items de uma categoria no mês.
-// A soma é feita em memória sobre as entidades carregadas, sem query SQL adicional.
+// Em lançamentos rateados entre categorias, apenas a parte da categoria é somada.
 func (a *invoiceCategoryTotalAdapter) GetCategoryTotal(
 	ctx context.Context,
 	userID sharedVos.UUID,
 	referenceMonth pkgVos.ReferenceMonth,
 	categoryID sharedVos.UUID,
 ) (sharedVos.Money, error) {
-	invoices, err := a.invoiceRepository.FindByUserAndMonth(ctx, userID, referenceMonth)
+	total, err := a.invoiceRepository.SumCategoryByUserAndMonth(ctx, userID, referenceMonth, categoryID)
 	if err != nil {
-		return sharedVos.Money{}, fmt.Errorf("failed to find invoices: %w", err)
+		return sharedVos.Money{}, fmt.Errorf("failed to sum category installments: %w", err)
 	}
-
-	total, _ := sharedVos.NewMoney(0, sharedVos.CurrencyBRL)
-
-	for _, invoice := range invoices {
-		for _, item := range invoice.Items {
-			if item.CategoryID.String() != categoryID.String() {
-				continue
-			}
-			sum, err := total.Add(item.InstallmentAmount)
-			if err != nil {
-				return sharedVos.Money{}, fmt.Errorf("failed to sum installment amounts: %w", err)
-			}
-			total = sum
-		}
-	}
-
 	return total, nil
 }
@@ -2,26 +2,36 @@ package adapters
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 
+	invoiceDomain "github.com/jailtonjunior94/financial/internal/invoice/domain"
 	invoiceEntities "github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
 	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
 	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
 )
 
-// InvoiceProviderAdapter implements transactionInterfaces.InvoiceProvider using the invoice repository.
+// InvoiceProviderAdapter implements transactionInterfaces.InvoiceProvider using the invoice repositories.
 type InvoiceProviderAdapter struct {
-	repo invoiceInterfaces.InvoiceRepository
-	o11y observability.Observability
+	repo     invoiceInterfaces.InvoiceRepository
+	itemRepo invoiceInterfaces.InvoiceItemRepository
+	o11y     observability.Observability
 }
 
 // NewInvoiceProviderAdapter creates a new InvoiceProviderAdapter.
-func NewInvoiceProviderAdapter(repo invoiceInterfaces.InvoiceRepository, o11y observability.Observability) *InvoiceProviderAdapter {
-	return &InvoiceProviderAdapter{repo: repo, o11y: o11y}
+func NewInvoiceProviderAdapter(
+	repo invoiceInterfaces.InvoiceRepository,
+	itemRepo invoiceInterfaces.InvoiceItemRepository,
+	o11y observability.Observability,
+) *InvoiceProviderAdapter {
+	return &InvoiceProviderAdapter{repo: repo, itemRepo: itemRepo, o11y: o11y}
 }
 
 // FindOrCreate atomically finds or creates an invoice for the given card and month.
@@ -62,8 +72,10 @@ func (a *InvoiceProviderAdapter) FindOrCreate(
 	}
 
 	return &transactionInterfaces.InvoiceInfo{
-		ID:     invoice.ID,
-		Status: status,
+		ID:             invoice.ID,
+		UserID:         invoice.UserID,
+		Status:         status,
+		ReferenceMonth: invoice.ReferenceMonth,
 	}, nil
 }
 
@@ -79,3 +91,250 @@ func (a *InvoiceProviderAdapter) GetStatus(ctx context.Context, invoiceID vos.UU
 	}
 	return status, nil
 }
+
+// FindByID returns the owner, status and reference month of an invoice, or nil when it does not exist.
+func (a *InvoiceProviderAdapter) FindByID(ctx context.Context, invoiceID vos.UUID) (*transactionInterfaces.InvoiceInfo, error) {
+	ctx, span := a.o11y.Tracer().Start(ctx, "invoice_provider_adapter.find_by_id")
+	defer span.End()
+
+	invoice, err := a.repo.FindByID(ctx, invoiceID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if invoice == nil {
+		return nil, nil
+	}
+
+	status := invoice.Status
+	if status == "" {
+		status = "open"
+	}
+
+	return &transactionInterfaces.InvoiceInfo{
+		ID:             invoice.ID,
+		UserID:         invoice.UserID,
+		Status:         status,
+		ReferenceMonth: invoice.ReferenceMonth,
+	}, nil
+}
+
+// AddItems materializes invoice items for credit transactions and refreshes the affected invoice totals.
+func (a *InvoiceProviderAdapter) AddItems(ctx context.Context, tx database.DBTX, items []transactionInterfaces.InvoiceItemInfo) error {
+	ctx, span := a.o11y.Tracer().Start(ctx, "invoice_provider_adapter.add_items")
+	defer span.End()
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	invoiceItems := make([]*invoiceEntities.InvoiceItem, 0, len(items))
+	invoiceIDs := make([]vos.UUID, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, info := range items {
+		item, err := invoiceEntities.NewInvoiceItem(
+			info.InvoiceID,
+			info.CategoryID,
+			info.PurchaseDate,
+			info.Description,
+			info.TotalAmount,
+			info.InstallmentNumber,
+			info.InstallmentTotal,
+			info.InstallmentAmount,
+		)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		id, err := vos.NewUUID()
+		if err != nil {
+			return err
+		}
+		item.SetID(id)
+		transactionID := info.TransactionID
+		item.TransactionID = &transactionID
+		invoiceItems = append(invoiceItems, item)
+
+		if _, ok := seen[info.InvoiceID.String()]; !ok {
+			seen[info.InvoiceID.String()] = struct{}{}
+			invoiceIDs = append(invoiceIDs, info.InvoiceID)
+		}
+	}
+
+	if err := a.itemRepo.InsertItems(ctx, tx, invoiceItems); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+
+	if err := a.itemRepo.RecalculateTotals(ctx, tx, invoiceIDs); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+	return nil
+}
+
+// AddRefundItems posts refund credits as negative items and refreshes the affected invoice totals.
+func (a *InvoiceProviderAdapter) AddRefundItems(ctx context.Context, tx database.DBTX, items []transactionInterfaces.RefundItemInfo) error {
+	ctx, span := a.o11y.Tracer().Start(ctx, "invoice_provider_adapter.add_refund_items")
+	defer span.End()
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	invoiceItems := make([]*invoiceEntities.InvoiceItem, 0, len(items))
+	invoiceIDs := make([]vos.UUID, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, info := range items {
+		item, err := invoiceEntities.NewRefundItem(
+			info.InvoiceID,
+			info.RefundedTransactionID,
+			info.CategoryID,
+			info.RefundDate,
+			info.Description,
+			info.Amount,
+			info.BudgetMonth,
+		)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		id, err := vos.NewUUID()
+		if err != nil {
+			return err
+		}
+		item.SetID(id)
+		invoiceItems = append(invoiceItems, item)
+
+		if _, ok := seen[info.InvoiceID.String()]; !ok {
+			seen[info.InvoiceID.String()] = struct{}{}
+			invoiceIDs = append(invoiceIDs, info.InvoiceID)
+		}
+	}
+
+	if err := a.itemRepo.InsertItems(ctx, tx, invoiceItems); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+
+	if err := a.itemRepo.RecalculateTotals(ctx, tx, invoiceIDs); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+	return nil
+}
+
+// AddIOFItems bills the IOF of foreign currency purchases as items of their own and
+// refreshes the affected invoice totals.
+func (a *InvoiceProviderAdapter) AddIOFItems(ctx context.Context, tx database.DBTX, items []transactionInterfaces.IOFItemInfo) error {
+	ctx, span := a.o11y.Tracer().Start(ctx, "invoice_provider_adapter.add_iof_items")
+	defer span.End()
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	invoiceItems := make([]*invoiceEntities.InvoiceItem, 0, len(items))
+	invoiceIDs := make([]vos.UUID, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, info := range items {
+		item, err := invoiceEntities.NewPurchaseIOFItem(
+			info.InvoiceID,
+			info.TransactionID,
+			info.CategoryID,
+			info.PurchaseDate,
+			info.Description,
+			info.Amount,
+		)
+		if err != nil {
+			span.RecordError(err)
+			return err
+		}
+
+		id, err := vos.NewUUID()
+		if err != nil {
+			return err
+		}
+		item.SetID(id)
+		invoiceItems = append(invoiceItems, item)
+
+		if _, ok := seen[info.InvoiceID.String()]; !ok {
+			seen[info.InvoiceID.String()] = struct{}{}
+			invoiceIDs = append(invoiceIDs, info.InvoiceID)
+		}
+	}
+
+	if err := a.itemRepo.InsertItems(ctx, tx, invoiceItems); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+
+	if err := a.itemRepo.RecalculateTotals(ctx, tx, invoiceIDs); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+	return nil
+}
+
+// UpdateItem mirrors a transaction edit on its invoice item and refreshes the invoice total.
+func (a *InvoiceProviderAdapter) UpdateItem(ctx context.Context, tx database.DBTX, info transactionInterfaces.InvoiceItemInfo) error {
+	ctx, span := a.o11y.Tracer().Start(ctx, "invoice_provider_adapter.update_item")
+	defer span.End()
+
+	transactionID := info.TransactionID
+	item := &invoiceEntities.InvoiceItem{
+		InvoiceID:         info.InvoiceID,
+		TransactionID:     &transactionID,
+		CategoryID:        info.CategoryID,
+		PurchaseDate:      info.PurchaseDate,
+		Description:       info.Description,
+		TotalAmount:       info.TotalAmount,
+		InstallmentNumber: info.InstallmentNumber,
+		InstallmentTotal:  info.InstallmentTotal,
+		InstallmentAmount: info.InstallmentAmount,
+	}
+
+	if err := a.itemRepo.UpdateItemByTransaction(ctx, tx, item); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+
+	if err := a.itemRepo.RecalculateTotals(ctx, tx, []vos.UUID{info.InvoiceID}); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+	return nil
+}
+
+// RemoveItems removes the invoice items of cancelled transactions and refreshes the affected invoice totals.
+func (a *InvoiceProviderAdapter) RemoveItems(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID) error {
+	ctx, span := a.o11y.Tracer().Start(ctx, "invoice_provider_adapter.remove_items")
+	defer span.End()
+
+	if len(transactionIDs) == 0 {
+		return nil
+	}
+
+	invoiceIDs, err := a.itemRepo.DeleteItemsByTransactionIDs(ctx, tx, transactionIDs)
+	if err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+
+	if err := a.itemRepo.RecalculateTotals(ctx, tx, invoiceIDs); err != nil {
+		span.RecordError(err)
+		return closedInvoiceError(err)
+	}
+	return nil
+}
+
+// closedInvoiceError reports an item write refused because its invoice is no longer open
+// as transactionDomain.ErrInvoiceClosed, so the transaction module answers 422.
+func closedInvoiceError(err error) error {
+	if errors.Is(err, invoiceDomain.ErrInvoiceNotOpen) {
+		return fmt.Errorf("%w: %w", transactionDomain.ErrInvoiceClosed, err)
+	}
+	return err
+}
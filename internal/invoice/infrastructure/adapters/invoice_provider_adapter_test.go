@@ -11,18 +11,21 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	invoiceDomain "github.com/jailtonjunior94/financial/internal/invoice/domain"
 	invoiceEntities "github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
 	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
 	"github.com/jailtonjunior94/financial/internal/invoice/infrastructure/adapters"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
 	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
 )
 
 type InvoiceProviderAdapterSuite struct {
 	suite.Suite
-	ctx  context.Context
-	obs  *fake.Provider
-	repo *invoiceMocks.InvoiceRepository
+	ctx      context.Context
+	obs      *fake.Provider
+	repo     *invoiceMocks.InvoiceRepository
+	itemRepo *invoiceMocks.InvoiceItemRepository
 }
 
 func TestInvoiceProviderAdapterSuite(t *testing.T) {
@@ -33,6 +36,7 @@ func (s *InvoiceProviderAdapterSuite) SetupTest() {
 	s.obs = fake.NewProvider()
 	s.ctx = context.Background()
 	s.repo = invoiceMocks.NewInvoiceRepository(s.T())
+	s.itemRepo = invoiceMocks.NewInvoiceItemRepository(s.T())
 }
 
 func (s *InvoiceProviderAdapterSuite) TestFindOrCreate() {
@@ -85,7 +89,7 @@ func (s *InvoiceProviderAdapterSuite) TestFindOrCreate() {
 	for _, scenario := range scenarios {
 		s.Run(scenario.name, func() {
 			scenario.dependencies()
-			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.obs)
+			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.itemRepo, s.obs)
 			info, err := adapter.FindOrCreate(s.ctx, userID, cardID, refMonth, dueDate)
 			scenario.expect(info, err)
 		})
@@ -138,9 +142,383 @@ func (s *InvoiceProviderAdapterSuite) TestGetStatus() {
 	for _, scenario := range scenarios {
 		s.Run(scenario.name, func() {
 			scenario.dependencies()
-			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.obs)
+			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.itemRepo, s.obs)
 			status, err := adapter.GetStatus(s.ctx, invoiceID)
 			scenario.expect(status, err)
 		})
 	}
 }
+
+func (s *InvoiceProviderAdapterSuite) TestFindByID() {
+	invoiceID, _ := vos.NewUUID()
+	userID, _ := vos.NewUUID()
+
+	type dependencies func()
+	type expect func(info *transactionInterfaces.InvoiceInfo, err error)
+
+	scenarios := []struct {
+		name         string
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name: "should return the invoice owner and status",
+			dependencies: func() {
+				invoice := &invoiceEntities.Invoice{}
+				invoice.SetID(invoiceID)
+				invoice.UserID = userID
+				invoice.Status = "closed"
+				invoice.ReferenceMonth, _ = pkgVos.NewReferenceMonth("2026-02")
+				s.repo.EXPECT().FindByID(mock.Anything, invoiceID).Return(invoice, nil).Once()
+			},
+			expect: func(info *transactionInterfaces.InvoiceInfo, err error) {
+				s.NoError(err)
+				s.Equal(userID.String(), info.UserID.String())
+				s.Equal("closed", info.Status)
+				s.Equal("2026-02", info.ReferenceMonth.String())
+			},
+		},
+		{
+			name: "should return nil when invoice not found",
+			dependencies: func() {
+				s.repo.EXPECT().FindByID(mock.Anything, invoiceID).Return(nil, nil).Once()
+			},
+			expect: func(info *transactionInterfaces.InvoiceInfo, err error) {
+				s.NoError(err)
+				s.Nil(info)
+			},
+		},
+		{
+			name: "should propagate error from repository",
+			dependencies: func() {
+				s.repo.EXPECT().FindByID(mock.Anything, invoiceID).Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(info *transactionInterfaces.InvoiceInfo, err error) {
+				s.Error(err)
+				s.Nil(info)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.itemRepo, s.obs)
+			info, err := adapter.FindByID(s.ctx, invoiceID)
+			scenario.expect(info, err)
+		})
+	}
+}
+
+func (s *InvoiceProviderAdapterSuite) TestAddItems() {
+	invoiceA, _ := vos.NewUUID()
+	invoiceB, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	purchaseDate := time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC)
+	total, _ := vos.NewMoneyFromFloat(300.0, vos.CurrencyBRL)
+	installment, _ := vos.NewMoneyFromFloat(100.0, vos.CurrencyBRL)
+
+	makeItems := func(invoiceIDs ...vos.UUID) []transactionInterfaces.InvoiceItemInfo {
+		items := make([]transactionInterfaces.InvoiceItemInfo, 0, len(invoiceIDs))
+		for i, invoiceID := range invoiceIDs {
+			transactionID, _ := vos.NewUUID()
+			items = append(items, transactionInterfaces.InvoiceItemInfo{
+				TransactionID:     transactionID,
+				InvoiceID:         invoiceID,
+				CategoryID:        categoryID,
+				PurchaseDate:      purchaseDate,
+				Description:       "Notebook",
+				TotalAmount:       total,
+				InstallmentNumber: i + 1,
+				InstallmentTotal:  len(invoiceIDs),
+				InstallmentAmount: installment,
+			})
+		}
+		return items
+	}
+
+	type dependencies func()
+	type expect func(err error)
+
+	scenarios := []struct {
+		name         string
+		items        []transactionInterfaces.InvoiceItemInfo
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name:  "should insert one item per installment and recalculate each invoice once",
+			items: makeItems(invoiceA, invoiceB, invoiceB),
+			dependencies: func() {
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []*invoiceEntities.InvoiceItem) bool {
+					return len(items) == 3 && items[0].TransactionID != nil && items[0].ID.String() != ""
+				})).Return(nil).Once()
+				s.itemRepo.EXPECT().RecalculateTotals(mock.Anything, mock.Anything, []vos.UUID{invoiceA, invoiceB}).Return(nil).Once()
+			},
+			expect: func(err error) {
+				s.NoError(err)
+			},
+		},
+		{
+			name:         "should do nothing when there are no items",
+			items:        nil,
+			dependencies: func() {},
+			expect: func(err error) {
+				s.NoError(err)
+			},
+		},
+		{
+			name:  "should propagate error from item repository",
+			items: makeItems(invoiceA, invoiceA, invoiceA),
+			dependencies: func() {
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(err error) {
+				s.Error(err)
+			},
+		},
+		{
+			name:  "should report invoice closed when an invoice is no longer open",
+			items: makeItems(invoiceA, invoiceB, invoiceB),
+			dependencies: func() {
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.Anything).Return(invoiceDomain.ErrInvoiceNotOpen).Once()
+			},
+			expect: func(err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvoiceClosed)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.itemRepo, s.obs)
+			err := adapter.AddItems(s.ctx, nil, scenario.items)
+			scenario.expect(err)
+		})
+	}
+}
+
+func (s *InvoiceProviderAdapterSuite) TestAddRefundItems() {
+	invoiceID, _ := vos.NewUUID()
+	transactionID, _ := vos.NewUUID()
+	food, _ := vos.NewUUID()
+	home, _ := vos.NewUUID()
+	budgetMonth, _ := pkgVos.NewReferenceMonth("2026-02")
+	refundDate := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	amount, _ := vos.NewMoneyFromFloat(25.0, vos.CurrencyBRL)
+
+	makeItem := func(categoryID vos.UUID, amount vos.Money) transactionInterfaces.RefundItemInfo {
+		return transactionInterfaces.RefundItemInfo{
+			RefundedTransactionID: transactionID,
+			InvoiceID:             invoiceID,
+			CategoryID:            categoryID,
+			RefundDate:            refundDate,
+			Description:           "Estorno: Mercado",
+			Amount:                amount,
+			BudgetMonth:           budgetMonth,
+		}
+	}
+
+	type dependencies func()
+	type expect func(err error)
+
+	scenarios := []struct {
+		name         string
+		items        []transactionInterfaces.RefundItemInfo
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name:  "should insert negative refund items and recalculate the invoice",
+			items: []transactionInterfaces.RefundItemInfo{makeItem(food, amount), makeItem(home, amount)},
+			dependencies: func() {
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []*invoiceEntities.InvoiceItem) bool {
+					first := items[0]
+					return len(items) == 2 &&
+						first.IsRefund() &&
+						first.TransactionID == nil &&
+						first.RefundedTransactionID.String() == transactionID.String() &&
+						first.BudgetMonth.Equal(budgetMonth) &&
+						first.InstallmentAmount.Cents() == -2500 &&
+						items[1].CategoryID.String() == home.String()
+				})).Return(nil).Once()
+				s.itemRepo.EXPECT().RecalculateTotals(mock.Anything, mock.Anything, []vos.UUID{invoiceID}).Return(nil).Once()
+			},
+			expect: func(err error) {
+				s.NoError(err)
+			},
+		},
+		{
+			name:         "should reject a refund item without amount",
+			items:        []transactionInterfaces.RefundItemInfo{makeItem(food, vos.Money{})},
+			dependencies: func() {},
+			expect: func(err error) {
+				s.Error(err)
+			},
+		},
+		{
+			name:  "should propagate error from item repository",
+			items: []transactionInterfaces.RefundItemInfo{makeItem(food, amount)},
+			dependencies: func() {
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(err error) {
+				s.Error(err)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.itemRepo, s.obs)
+			err := adapter.AddRefundItems(s.ctx, nil, scenario.items)
+			scenario.expect(err)
+		})
+	}
+}
+
+func (s *InvoiceProviderAdapterSuite) TestAddIOFItems() {
+	invoiceID, _ := vos.NewUUID()
+	transactionID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	purchaseDate := time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC)
+	amount, _ := vos.NewMoneyFromFloat(3.80, vos.CurrencyBRL)
+
+	makeItem := func(amount vos.Money) transactionInterfaces.IOFItemInfo {
+		return transactionInterfaces.IOFItemInfo{
+			TransactionID: transactionID,
+			InvoiceID:     invoiceID,
+			CategoryID:    categoryID,
+			PurchaseDate:  purchaseDate,
+			Description:   "IOF: Hotel",
+			Amount:        amount,
+		}
+	}
+
+	type dependencies func()
+	type expect func(err error)
+
+	scenarios := []struct {
+		name         string
+		items        []transactionInterfaces.IOFItemInfo
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name:  "should insert the iof item linked to the purchase and recalculate the invoice",
+			items: []transactionInterfaces.IOFItemInfo{makeItem(amount)},
+			dependencies: func() {
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []*invoiceEntities.InvoiceItem) bool {
+					item := items[0]
+					return len(items) == 1 &&
+						item.Type == invoiceEntities.InvoiceItemTypeIOF &&
+						!item.IsRevolvingCharge() &&
+						item.TransactionID.String() == transactionID.String() &&
+						item.CategoryID.String() == categoryID.String() &&
+						item.InstallmentAmount.Cents() == 380
+				})).Return(nil).Once()
+				s.itemRepo.EXPECT().RecalculateTotals(mock.Anything, mock.Anything, []vos.UUID{invoiceID}).Return(nil).Once()
+			},
+			expect: func(err error) {
+				s.NoError(err)
+			},
+		},
+		{
+			name:         "should reject an iof item without amount",
+			items:        []transactionInterfaces.IOFItemInfo{makeItem(vos.Money{})},
+			dependencies: func() {},
+			expect: func(err error) {
+				s.Error(err)
+			},
+		},
+		{
+			name:  "should propagate error from item repository",
+			items: []transactionInterfaces.IOFItemInfo{makeItem(amount)},
+			dependencies: func() {
+				s.itemRepo.EXPECT().InsertItems(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(err error) {
+				s.Error(err)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.itemRepo, s.obs)
+			err := adapter.AddIOFItems(s.ctx, nil, scenario.items)
+			scenario.expect(err)
+		})
+	}
+}
+
+func (s *InvoiceProviderAdapterSuite) TestUpdateItem() {
+	transactionID, _ := vos.NewUUID()
+	invoiceID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	amount, _ := vos.NewMoneyFromFloat(150.0, vos.CurrencyBRL)
+	info := transactionInterfaces.InvoiceItemInfo{
+		TransactionID:     transactionID,
+		InvoiceID:         invoiceID,
+		CategoryID:        categoryID,
+		Description:       "Mercado",
+		TotalAmount:       amount,
+		InstallmentNumber: 1,
+		InstallmentTotal:  1,
+		InstallmentAmount: amount,
+	}
+
+	s.itemRepo.EXPECT().UpdateItemByTransaction(mock.Anything, mock.Anything, mock.MatchedBy(func(item *invoiceEntities.InvoiceItem) bool {
+		return item.TransactionID != nil && item.TransactionID.String() == transactionID.String() && item.Description == "Mercado"
+	})).Return(nil).Once()
+	s.itemRepo.EXPECT().RecalculateTotals(mock.Anything, mock.Anything, []vos.UUID{invoiceID}).Return(nil).Once()
+
+	adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.itemRepo, s.obs)
+	s.NoError(adapter.UpdateItem(s.ctx, nil, info))
+}
+
+func (s *InvoiceProviderAdapterSuite) TestRemoveItems() {
+	transactionID, _ := vos.NewUUID()
+	invoiceID, _ := vos.NewUUID()
+
+	type dependencies func()
+	type expect func(err error)
+
+	scenarios := []struct {
+		name         string
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name: "should remove items and recalculate affected invoices",
+			dependencies: func() {
+				s.itemRepo.EXPECT().DeleteItemsByTransactionIDs(mock.Anything, mock.Anything, []vos.UUID{transactionID}).Return([]vos.UUID{invoiceID}, nil).Once()
+				s.itemRepo.EXPECT().RecalculateTotals(mock.Anything, mock.Anything, []vos.UUID{invoiceID}).Return(nil).Once()
+			},
+			expect: func(err error) {
+				s.NoError(err)
+			},
+		},
+		{
+			name: "should propagate error when deleting items",
+			dependencies: func() {
+				s.itemRepo.EXPECT().DeleteItemsByTransactionIDs(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(err error) {
+				s.Error(err)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			adapter := adapters.NewInvoiceProviderAdapter(s.repo, s.itemRepo, s.obs)
+			err := adapter.RemoveItems(s.ctx, nil, []vos.UUID{transactionID})
+			scenario.expect(err)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"encoding/json"
 	"net/http"
 	"strconv"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/go-chi/chi/v5"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/jailtonjunior94/financial/internal/invoice/application/dtos"
 	"github.com/jailtonjunior94/financial/internal/invoice/application/usecase"
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
@@ -21,6 +23,7 @@ type InvoiceHandler struct {
 	errorHandler httperrors.ErrorHandler
 	listByCardUC usecase.ListInvoicesByCardPaginatedUseCase
 	getByCardUC  usecase.GetInvoiceUseCase
+	payUC        usecase.RegisterInvoicePaymentUseCase
 }
 
 // NewInvoiceHandler creates a new InvoiceHandler.
@@ -29,12 +32,14 @@ func NewInvoiceHandler(
 	errorHandler httperrors.ErrorHandler,
 	listByCardUC usecase.ListInvoicesByCardPaginatedUseCase,
 	getByCardUC usecase.GetInvoiceUseCase,
+	payUC usecase.RegisterInvoicePaymentUseCase,
 ) *InvoiceHandler {
 	return &InvoiceHandler{
 		o11y:         o11y,
 		errorHandler: errorHandler,
 		listByCardUC: listByCardUC,
 		getByCardUC:  getByCardUC,
+		payUC:        payUC,
 	}
 }
 
@@ -159,6 +164,97 @@ func (h *InvoiceHandler) GetByCard(w http.ResponseWriter, r *http.Request) {
 	responses.JSON(w, http.StatusOK, output)
 }
 
+// Pay godoc
+//
+//	@Summary		Register an invoice payment
+//	@Description	Registers a full or partial payment for a closed invoice.
+//	@Description	The invoice moves to `paid` when the remaining balance reaches zero.
+//	@Tags			invoices
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			cardId		path		string						true	"Card ID"		format(uuid)
+//	@Param			invoiceId	path		string						true	"Invoice ID"	format(uuid)
+//	@Param			request		body		dtos.InvoicePaymentInput	true	"Payment data"
+//	@Param			Idempotency-Key	header	string	false	"Key that makes retries of this request replay the first response"
+//	@Success		201	{object}	dtos.InvoicePaymentOutput
+//	@Failure		400	{object}	httperrors.ProblemDetail
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		409	{object}	httperrors.ProblemDetail
+//	@Failure		422	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/cards/{cardId}/invoices/{invoiceId}/payments [post]
+func (h *InvoiceHandler) Pay(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "invoice_handler.pay")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.o11y.Logger().Info(ctx, "request_received",
+		observability.String("operation", "pay"),
+		observability.String("layer", "handler"),
+		observability.String("entity", "invoice"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", user.ID),
+	)
+	var input *dtos.InvoicePaymentInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.o11y.Logger().Error(ctx, "validation_failed",
+			observability.String("operation", "pay"),
+			observability.String("layer", "handler"),
+			observability.String("entity", "invoice"),
+			observability.String("correlation_id", correlationID),
+			observability.String("user_id", user.ID),
+			observability.String("error_code", "DECODE_BODY_FAILED"),
+			observability.Error(err),
+		)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	if validationErrs := input.Validate(); validationErrs.HasErrors() {
+		h.o11y.Logger().Warn(ctx, "validation_failed",
+			observability.String("operation", "pay"),
+			observability.String("layer", "handler"),
+			observability.String("entity", "invoice"),
+			observability.String("correlation_id", correlationID),
+			observability.String("user_id", user.ID),
+			observability.String("error_code", "INPUT_VALIDATION_FAILED"),
+		)
+		h.errorHandler.HandleError(w, r, validationErrs)
+		return
+	}
+	cardID := chi.URLParam(r, "cardId")
+	invoiceID := chi.URLParam(r, "invoiceId")
+	output, err := h.payUC.Execute(ctx, user.ID, cardID, invoiceID, input)
+	if err != nil {
+		span.RecordError(err)
+		h.o11y.Logger().Error(ctx, "request_failed",
+			observability.String("operation", "pay"),
+			observability.String("layer", "handler"),
+			observability.String("entity", "invoice"),
+			observability.String("correlation_id", correlationID),
+			observability.String("user_id", user.ID),
+			observability.Error(err),
+		)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "pay"),
+		observability.String("layer", "handler"),
+		observability.String("entity", "invoice"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", user.ID),
+		observability.String("invoice_status", output.InvoiceStatus),
+	)
+	responses.JSON(w, http.StatusCreated, output)
+}
+
 const (
 	maxInvoiceHandlerLimit     = 100
 	defaultInvoiceHandlerLimit = 20
@@ -8,20 +8,22 @@ import (
 
 // InvoiceRouter registers invoice HTTP routes.
 type InvoiceRouter struct {
-	handlers       *InvoiceHandler
-	authMiddleware middlewares.Authorization
+	handlers              *InvoiceHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
 }
 
 // NewInvoiceRouter creates a new InvoiceRouter.
-func NewInvoiceRouter(handlers *InvoiceHandler, authMiddleware middlewares.Authorization) *InvoiceRouter {
-	return &InvoiceRouter{handlers: handlers, authMiddleware: authMiddleware}
+func NewInvoiceRouter(handlers *InvoiceHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *InvoiceRouter {
+	return &InvoiceRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
 }
 
 // Register registers routes on the provided chi.Router.
 func (r InvoiceRouter) Register(router chi.Router) {
 	router.Group(func(protected chi.Router) {
-		protected.Use(r.authMiddleware.Authorization)
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
 		protected.Get("/api/v1/cards/{cardId}/invoices", r.handlers.ListByCard)
 		protected.Get("/api/v1/cards/{cardId}/invoices/{invoiceId}", r.handlers.GetByCard)
+		protected.Post("/api/v1/cards/{cardId}/invoices/{invoiceId}/payments", r.handlers.Pay)
 	})
 }
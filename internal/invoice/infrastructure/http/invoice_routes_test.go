@@ -0,0 +1,104 @@
+package http_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/go-chi/chi/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/application/dtos"
+	invoiceHttp "github.com/jailtonjunior94/financial/internal/invoice/infrastructure/http"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+	"github.com/jailtonjunior94/financial/pkg/auth"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
+	idempotencyMocks "github.com/jailtonjunior94/financial/pkg/idempotency/mocks"
+)
+
+const (
+	testUserID    = "550e8400-e29b-41d4-a716-446655440000"
+	testCardID    = "660e8400-e29b-41d4-a716-446655440001"
+	testInvoiceID = "770e8400-e29b-41d4-a716-446655440002"
+)
+
+// stubAuthorization authenticates every request as testUserID.
+type stubAuthorization struct{}
+
+func (stubAuthorization) Authorization(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := auth.NewAuthenticatedUser(testUserID, "user@example.com", nil)
+		next.ServeHTTP(w, r.WithContext(middlewares.AddUserToContext(r.Context(), user)))
+	})
+}
+
+type stubRegisterInvoicePayment struct {
+	calls  int
+	output *dtos.InvoicePaymentOutput
+}
+
+func (s *stubRegisterInvoicePayment) Execute(_ context.Context, _, _, _ string, _ *dtos.InvoicePaymentInput) (*dtos.InvoicePaymentOutput, error) {
+	s.calls++
+	return s.output, nil
+}
+
+func newInvoiceTestRouter(payUC *stubRegisterInvoicePayment, repository idempotency.Repository) chi.Router {
+	obs := fake.NewProvider()
+	errorHandler := httperrors.NewErrorHandler(obs)
+	handler := invoiceHttp.NewInvoiceHandler(obs, errorHandler, nil, nil, payUC)
+	idempotencyMiddleware := middlewares.NewIdempotency(repository, idempotency.DefaultTTL, obs, errorHandler)
+
+	router := chi.NewRouter()
+	invoiceHttp.NewInvoiceRouter(handler, stubAuthorization{}, idempotencyMiddleware).Register(router)
+	return router
+}
+
+func newPaymentRequest(key string) *http.Request {
+	body := `{"amount":"1500.00","payment_date":"2025-01-10","method":"pix"}`
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/cards/"+testCardID+"/invoices/"+testInvoiceID+"/payments", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(middlewares.IdempotencyKeyHeader, key)
+	return req
+}
+
+func TestInvoiceRouter_ReplaysStoredPaymentResponse(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	var stored *idempotency.Record
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, record *idempotency.Record) { stored = record }).
+		Return(true, nil).Once()
+	repository.EXPECT().Complete(mock.Anything, testUserID, "pay-1", http.StatusCreated, mock.Anything, mock.Anything).
+		Run(func(_ context.Context, _, _ string, status int, headers map[string]string, body []byte) {
+			stored.StatusCode = &status
+			stored.ResponseHeaders = headers
+			stored.ResponseBody = body
+		}).
+		Return(nil).Once()
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).Return(false, nil).Once()
+	repository.EXPECT().Find(mock.Anything, testUserID, "pay-1").
+		RunAndReturn(func(_ context.Context, _, _ string) (*idempotency.Record, error) { return stored, nil }).Once()
+	payUC := &stubRegisterInvoicePayment{output: &dtos.InvoicePaymentOutput{
+		ID:            "990e8400-e29b-41d4-a716-446655440005",
+		InvoiceID:     testInvoiceID,
+		Amount:        "1500.00",
+		InvoiceStatus: "paid",
+	}}
+	router := newInvoiceTestRouter(payUC, repository)
+
+	first := httptest.NewRecorder()
+	router.ServeHTTP(first, newPaymentRequest("pay-1"))
+	retry := httptest.NewRecorder()
+	router.ServeHTTP(retry, newPaymentRequest("pay-1"))
+
+	assert.Equal(t, 1, payUC.calls)
+	assert.Equal(t, http.StatusCreated, first.Code)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, "true", retry.Header().Get(middlewares.IdempotentReplayedHeader))
+	assert.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+	assert.JSONEq(t, first.Body.String(), retry.Body.String())
+}
@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/application/usecase"
+	pkgjobs "github.com/jailtonjunior94/financial/pkg/jobs"
+)
+
+// CloseInvoicesJob implementa jobs.Job para o fechamento automático de faturas.
+type CloseInvoicesJob struct {
+	useCase  usecase.CloseInvoicesUseCase
+	schedule string
+	o11y     observability.Observability
+}
+
+// NewCloseInvoicesJob cria um novo job de fechamento de faturas.
+func NewCloseInvoicesJob(
+	useCase usecase.CloseInvoicesUseCase,
+	schedule string,
+	o11y observability.Observability,
+) pkgjobs.Job {
+	return &CloseInvoicesJob{
+		useCase:  useCase,
+		schedule: schedule,
+		o11y:     o11y,
+	}
+}
+
+// Name retorna o identificador do job.
+func (j *CloseInvoicesJob) Name() string {
+	return "invoice_closing"
+}
+
+// Schedule retorna a expressão cron para agendamento.
+// Padrão: "0 1 * * *" - executa diariamente à 1h, logo após a virada do dia de fechamento.
+func (j *CloseInvoicesJob) Schedule() string {
+	if j.schedule != "" {
+		return j.schedule
+	}
+	return "0 1 * * *"
+}
+
+// Run fecha as faturas cuja data de fechamento já passou.
+func (j *CloseInvoicesJob) Run(ctx context.Context) error {
+	ctx, span := j.o11y.Tracer().Start(ctx, "invoice.close_invoices_job.run")
+	defer span.End()
+
+	closed, err := j.useCase.Execute(ctx, time.Now().UTC())
+	if err != nil {
+		j.o11y.Logger().Error(ctx, "invoice closing job failed",
+			observability.Error(err),
+			observability.Int("closed", closed),
+		)
+		return fmt.Errorf("invoice closing job: %w", err)
+	}
+
+	if closed > 0 {
+		j.o11y.Logger().Info(ctx, "invoice closing job completed",
+			observability.Int("closed", closed),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,301 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+type invoiceItemRepository struct {
+	o11y observability.Observability
+	fm   *metrics.FinancialMetrics
+}
+
+func NewInvoiceItemRepository(o11y observability.Observability, fm *metrics.FinancialMetrics) interfaces.InvoiceItemRepository {
+	return &invoiceItemRepository{
+		o11y: o11y,
+		fm:   fm,
+	}
+}
+
+func (r *invoiceItemRepository) InsertItems(ctx context.Context, tx database.DBTX, items []*entities.InvoiceItem) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_item_repository.insert_items")
+	defer span.End()
+
+	if len(items) == 0 {
+		r.fm.RecordRepositoryQuery(ctx, "insert_items", "invoice_item", time.Since(start))
+		return nil
+	}
+
+	invoiceIDs := make([]vos.UUID, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		if _, ok := seen[item.InvoiceID.String()]; !ok {
+			seen[item.InvoiceID.String()] = struct{}{}
+			invoiceIDs = append(invoiceIDs, item.InvoiceID)
+		}
+	}
+	if err := lockOpenInvoices(ctx, tx, invoiceIDs); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "insert_items", "invoice_item", metrics.ClassifyError(err), time.Since(start))
+		return err
+	}
+
+	const numColumns = 15
+	valueStrings := make([]string, 0, len(items))
+	valueArgs := make([]any, 0, len(items)*numColumns)
+
+	for i, item := range items {
+		placeholderStart := i*numColumns + 1
+		placeholders := make([]string, numColumns)
+		for j := range numColumns {
+			placeholders[j] = fmt.Sprintf("$%d", placeholderStart+j)
+		}
+		valueStrings = append(valueStrings, fmt.Sprintf("(%s)", strings.Join(placeholders, ", ")))
+
+		valueArgs = append(valueArgs,
+			item.ID.Value,
+			item.InvoiceID.Value,
+			nullableUUIDValue(item.TransactionID),
+			categoryIDValue(item),
+			itemTypeValue(item),
+			nullableUUIDValue(item.CarriedFromInvoiceID),
+			item.PurchaseDate,
+			item.Description,
+			item.TotalAmount.Float(),
+			item.InstallmentNumber,
+			item.InstallmentTotal,
+			item.InstallmentAmount.Float(),
+			nullableUUIDValue(item.RefundedTransactionID),
+			budgetMonthValue(item.BudgetMonth),
+			item.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`insert into invoice_items (
+		id,
+		invoice_id,
+		transaction_id,
+		category_id,
+		item_type,
+		carried_from_invoice_id,
+		purchase_date,
+		description,
+		total_amount,
+		installment_number,
+		installment_total,
+		installment_amount,
+		refunded_transaction_id,
+		budget_month,
+		created_at
+	) values %s`, strings.Join(valueStrings, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, valueArgs...); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "insert_items", "invoice_item", "infra", time.Since(start))
+		return err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "insert_items", "invoice_item", time.Since(start))
+	return nil
+}
+
+func (r *invoiceItemRepository) UpdateItemByTransaction(ctx context.Context, tx database.DBTX, item *entities.InvoiceItem) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_item_repository.update_item_by_transaction")
+	defer span.End()
+
+	// O IOF de uma compra internacional acompanha a categoria da compra, mas mantém descrição e valor.
+	query := `update invoice_items set
+		category_id = $2,
+		description = case when item_type = 'purchase' then $3 else description end,
+		total_amount = case when item_type = 'purchase' and installment_total = 1 then $4 else total_amount end,
+		installment_amount = case when item_type = 'purchase' then $5 else installment_amount end,
+		updated_at = $6
+	where transaction_id = $1 and deleted_at is null and frozen_at is null`
+
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		nullableUUIDValue(item.TransactionID),
+		item.CategoryID.Value,
+		item.Description,
+		item.TotalAmount.Float(),
+		item.InstallmentAmount.Float(),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "update_item_by_transaction", "invoice_item", "infra", time.Since(start))
+		return err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "update_item_by_transaction", "invoice_item", time.Since(start))
+	return nil
+}
+
+func (r *invoiceItemRepository) DeleteItemsByTransactionIDs(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID) ([]vos.UUID, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_item_repository.delete_items_by_transaction_ids")
+	defer span.End()
+
+	if len(transactionIDs) == 0 {
+		r.fm.RecordRepositoryQuery(ctx, "delete_items_by_transaction_ids", "invoice_item", time.Since(start))
+		return nil, nil
+	}
+
+	placeholders := make([]string, len(transactionIDs))
+	args := make([]any, 0, len(transactionIDs)+1)
+	args = append(args, time.Now().UTC())
+	for i, id := range transactionIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args = append(args, id.Value)
+	}
+
+	query := fmt.Sprintf(`update invoice_items set
+		deleted_at = $1
+	where transaction_id IN (%s) and deleted_at is null and frozen_at is null
+	returning invoice_id`, strings.Join(placeholders, ", "))
+
+	rows, err := tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "delete_items_by_transaction_ids", "invoice_item", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "DeleteItemsByTransactionIDs: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	seen := make(map[uuid.UUID]struct{})
+	var invoiceIDs []vos.UUID
+	for rows.Next() {
+		var invoiceID uuid.UUID
+		if err := rows.Scan(&invoiceID); err != nil {
+			span.RecordError(err)
+			r.fm.RecordRepositoryFailure(ctx, "delete_items_by_transaction_ids", "invoice_item", "infra", time.Since(start))
+			return nil, err
+		}
+		if _, ok := seen[invoiceID]; ok {
+			continue
+		}
+		seen[invoiceID] = struct{}{}
+		invoiceIDs = append(invoiceIDs, vos.UUID{Value: invoiceID})
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "delete_items_by_transaction_ids", "invoice_item", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "delete_items_by_transaction_ids", "invoice_item", time.Since(start))
+	return invoiceIDs, nil
+}
+
+func (r *invoiceItemRepository) FreezeItems(ctx context.Context, tx database.DBTX, invoiceID vos.UUID, frozenAt time.Time) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_item_repository.freeze_items")
+	defer span.End()
+
+	query := `update invoice_items set
+		frozen_at = $2
+	where invoice_id = $1 and deleted_at is null and frozen_at is null`
+
+	if _, err := tx.ExecContext(ctx, query, invoiceID.Value, frozenAt); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "freeze_items", "invoice_item", "infra", time.Since(start))
+		return err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "freeze_items", "invoice_item", time.Since(start))
+	return nil
+}
+
+func (r *invoiceItemRepository) RecalculateTotals(ctx context.Context, tx database.DBTX, invoiceIDs []vos.UUID) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_item_repository.recalculate_totals")
+	defer span.End()
+
+	if len(invoiceIDs) == 0 {
+		r.fm.RecordRepositoryQuery(ctx, "recalculate_totals", "invoice_item", time.Since(start))
+		return nil
+	}
+
+	if err := lockOpenInvoices(ctx, tx, invoiceIDs); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "recalculate_totals", "invoice_item", metrics.ClassifyError(err), time.Since(start))
+		return err
+	}
+
+	placeholders := make([]string, len(invoiceIDs))
+	args := make([]any, 0, len(invoiceIDs)+1)
+	args = append(args, time.Now().UTC())
+	for i, id := range invoiceIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args = append(args, id.Value)
+	}
+
+	query := fmt.Sprintf(`update invoices i set
+		total_amount = coalesce((
+			select sum(ii.installment_amount)
+			from invoice_items ii
+			where ii.invoice_id = i.id and ii.deleted_at is null
+		), 0),
+		updated_at = $1
+	where i.id IN (%s)`, strings.Join(placeholders, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "recalculate_totals", "invoice_item", "infra", time.Since(start))
+		return err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "recalculate_totals", "invoice_item", time.Since(start))
+	return nil
+}
+
+// lockOpenInvoices bloqueia as faturas (for share) até o fim da transação e falha com
+// ErrInvoiceNotOpen quando alguma não está aberta: itens e total de uma fatura fechada ou paga
+// não mudam, e um fechamento concorrente espera a transação terminar.
+func lockOpenInvoices(ctx context.Context, tx database.DBTX, invoiceIDs []vos.UUID) error {
+	placeholders := make([]string, len(invoiceIDs))
+	args := make([]any, len(invoiceIDs))
+	for i, id := range invoiceIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = id.Value
+	}
+
+	query := fmt.Sprintf(`select count(*) from (
+		select id
+		from invoices
+		where id in (%s) and status = 'open' and deleted_at is null
+		for share
+	) locked`, strings.Join(placeholders, ", "))
+
+	var open int
+	if err := tx.QueryRowContext(ctx, query, args...).Scan(&open); err != nil {
+		return err
+	}
+	if open != len(invoiceIDs) {
+		return domain.ErrInvoiceNotOpen
+	}
+	return nil
+}
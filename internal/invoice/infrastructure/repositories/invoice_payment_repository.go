@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+type invoicePaymentRepository struct {
+	o11y observability.Observability
+	fm   *metrics.FinancialMetrics
+}
+
+func NewInvoicePaymentRepository(o11y observability.Observability, fm *metrics.FinancialMetrics) interfaces.InvoicePaymentRepository {
+	return &invoicePaymentRepository{
+		o11y: o11y,
+		fm:   fm,
+	}
+}
+
+func (r *invoicePaymentRepository) Insert(ctx context.Context, tx database.DBTX, payment *entities.InvoicePayment) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_payment_repository.insert")
+	defer span.End()
+
+	query := `insert into invoice_payments (
+		id,
+		invoice_id,
+		user_id,
+		amount,
+		payment_date,
+		method,
+		created_at
+	) values ($1, $2, $3, $4, $5, $6, $7)`
+
+	_, err := tx.ExecContext(
+		ctx,
+		query,
+		payment.ID.Value,
+		payment.InvoiceID.Value,
+		payment.UserID.Value,
+		payment.Amount.Float(),
+		payment.PaymentDate,
+		payment.Method.String(),
+		payment.CreatedAt,
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "insert", "invoice_payment", "infra", time.Since(start))
+		return err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "insert", "invoice_payment", time.Since(start))
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
 	"github.com/jailtonjunior94/financial/pkg/constants"
 	"github.com/jailtonjunior94/financial/pkg/helpers"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
@@ -96,7 +97,8 @@ func (r *invoiceRepository) UpsertInvoice(ctx context.Context, invoice *entities
 		DO UPDATE SET updated_at = invoices.updated_at
 		RETURNING id, user_id, card_id, reference_month, due_date,
 		          total_amount, created_at, updated_at, deleted_at,
-		          COALESCE(status, 'open')
+		          COALESCE(status, 'open'), paid_amount,
+		          minimum_payment, carried_over_at
 	`
 
 	row := r.db.QueryRowContext(ctx, query,
@@ -154,6 +156,297 @@ func (r *invoiceRepository) FindStatus(ctx context.Context, invoiceID vos.UUID)
 	return status, nil
 }
 
+// SumOutstandingByCard returns the unpaid balance of every invoice of the card that still
+// consumes credit limit: open invoices (including future installments) and closed invoices
+// not fully paid. Invoices whose balance was carried over are skipped, since the balance
+// now lives in the next invoice as a revolving item. A credit balance left by refunds counts
+// as a negative amount until it is carried into the next invoice as a credit item.
+func (r *invoiceRepository) SumOutstandingByCard(ctx context.Context, cardID vos.UUID) (vos.Money, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_repository.sum_outstanding_by_card")
+	defer span.End()
+
+	query := `SELECT COALESCE(SUM(total_amount - paid_amount), 0)::TEXT
+		FROM invoices
+		WHERE card_id = $1
+		  AND status <> 'paid'
+		  AND carried_over_at IS NULL
+		  AND deleted_at IS NULL`
+
+	var outstanding string
+	if err := r.db.QueryRowContext(ctx, query, cardID.Value).Scan(&outstanding); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "sum_outstanding_by_card", "invoice", "infra", time.Since(start))
+		return vos.Money{}, err
+	}
+
+	amount, err := vos.NewMoneyFromString(outstanding, constants.DefaultCurrency)
+	if err != nil {
+		span.RecordError(err)
+		return vos.Money{}, err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "sum_outstanding_by_card", "invoice", time.Since(start))
+	return amount, nil
+}
+
+// SumCategoryByUserAndMonth soma as parcelas de uma categoria nas faturas do usuário no mês.
+// Itens de lançamentos rateados entre categorias (transaction_splits) contam apenas a parte
+// da categoria; os demais, inclusive o IOF de compras internacionais, contam pela categoria
+// do item. Estornos são negativos e entram no mês da fatura da compra estornada
+// (budget_month), não no da fatura que os recebe.
+func (r *invoiceRepository) SumCategoryByUserAndMonth(
+	ctx context.Context,
+	userID vos.UUID,
+	referenceMonth pkgVos.ReferenceMonth,
+	categoryID vos.UUID,
+) (vos.Money, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_repository.sum_category_by_user_month")
+	defer span.End()
+
+	query := `SELECT COALESCE(SUM(COALESCE(ts.amount, ii.installment_amount)), 0)::TEXT
+		FROM invoices i
+		JOIN invoice_items ii ON ii.invoice_id = i.id AND ii.deleted_at IS NULL
+		LEFT JOIN transaction_splits ts ON ts.transaction_id = ii.transaction_id AND ii.item_type = 'purchase'
+		WHERE i.user_id = $1
+		  AND COALESCE(ii.budget_month, i.reference_month) >= $2
+		  AND COALESCE(ii.budget_month, i.reference_month) < $3
+		  AND i.deleted_at IS NULL
+		  AND (ts.category_id = $4 OR (ts.id IS NULL AND ii.category_id = $4))`
+
+	var total string
+	if err := r.db.QueryRowContext(ctx, query,
+		userID.Value,
+		referenceMonth.FirstDay(),
+		referenceMonth.AddMonths(1).FirstDay(),
+		categoryID.Value,
+	).Scan(&total); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "sum_category_by_user_month", "invoice", "infra", time.Since(start))
+		return vos.Money{}, err
+	}
+
+	amount, err := vos.NewMoneyFromString(total, constants.DefaultCurrency)
+	if err != nil {
+		span.RecordError(err)
+		return vos.Money{}, err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "sum_category_by_user_month", "invoice", time.Since(start))
+	return amount, nil
+}
+
+// ListOpenUntil returns open invoices whose reference month is on or before the given month,
+// oldest first, starting right after the cursor (keyset on reference_month, id). Items are not loaded.
+func (r *invoiceRepository) ListOpenUntil(
+	ctx context.Context,
+	referenceMonth pkgVos.ReferenceMonth,
+	after *interfaces.OpenInvoiceCursor,
+	limit int,
+) ([]*entities.Invoice, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_repository.list_open_until")
+	defer span.End()
+
+	query := `select
+		id,
+		user_id,
+		card_id,
+		reference_month,
+		due_date,
+		total_amount,
+		created_at,
+		updated_at,
+		deleted_at,
+		status,
+		paid_amount,
+		minimum_payment,
+		carried_over_at
+	from invoices
+	where status = 'open'
+	  and reference_month <= $1
+	  and deleted_at is null
+	  and ($3::date is null or (reference_month, id) > ($3::date, $4::uuid))
+	order by reference_month, id
+	limit $2`
+
+	var afterMonth, afterID any
+	if after != nil {
+		afterMonth = after.ReferenceMonth.ToTime()
+		afterID = after.ID.Value
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, referenceMonth.ToTime(), limit, afterMonth, afterID)
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "list_open_until", "invoice", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "ListOpenUntil: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	var invoices []*entities.Invoice
+	for rows.Next() {
+		invoice, err := r.scanInvoiceWithStatus(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.fm.RecordRepositoryFailure(ctx, "list_open_until", "invoice", "infra", time.Since(start))
+			return nil, err
+		}
+		invoices = append(invoices, invoice)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "list_open_until", "invoice", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "list_open_until", "invoice", time.Since(start))
+	return invoices, nil
+}
+
+// Close marks an open invoice as closed and stamps its closing date and minimum payment inside the given transaction.
+// Returns false when the invoice was no longer open (already closed by a concurrent run).
+func (r *invoiceRepository) Close(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_repository.close")
+	defer span.End()
+
+	query := `update invoices set
+		status = $2,
+		closing_date = $3,
+		minimum_payment = $4,
+		updated_at = $5
+	where id = $1 and status = 'open' and deleted_at is null`
+
+	result, err := tx.ExecContext(
+		ctx,
+		query,
+		invoice.ID.Value,
+		invoice.Status,
+		invoice.ClosingDate,
+		moneyValue(invoice.MinimumPayment),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "close", "invoice", "infra", time.Since(start))
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "close", "invoice", "infra", time.Since(start))
+		return false, err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "close", "invoice", time.Since(start))
+	return affected == 1, nil
+}
+
+// ApplyPayment adds the payment amount to paid_amount inside the given transaction and decides the
+// status in SQL, so concurrent partial payments that together settle the invoice still mark it paid.
+// The stored status and paid amount are copied back to invoice. The update is guarded so that
+// concurrent payments cannot exceed the total; returns false when the invoice is no longer closed,
+// was carried over or the payment would overpay it.
+func (r *invoiceRepository) ApplyPayment(ctx context.Context, tx database.DBTX, invoice *entities.Invoice, amount vos.Money) (bool, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_repository.apply_payment")
+	defer span.End()
+
+	query := `update invoices set
+		paid_amount = paid_amount + $2,
+		status = case when paid_amount + $2 >= total_amount then 'paid' else 'closed' end,
+		updated_at = $3
+	where id = $1
+	  and status = 'closed'
+	  and paid_amount + $2 <= total_amount
+	  and carried_over_at is null
+	  and deleted_at is null
+	returning status, paid_amount::text`
+
+	var status, paidAmount string
+	err := tx.QueryRowContext(
+		ctx,
+		query,
+		invoice.ID.Value,
+		amount.Float(),
+		time.Now().UTC(),
+	).Scan(&status, &paidAmount)
+	if errors.Is(err, sql.ErrNoRows) {
+		r.fm.RecordRepositoryQuery(ctx, "apply_payment", "invoice", time.Since(start))
+		return false, nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "apply_payment", "invoice", "infra", time.Since(start))
+		return false, err
+	}
+
+	paid, err := vos.NewMoneyFromString(paidAmount, invoice.PaidAmount.Currency())
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "apply_payment", "invoice", "infra", time.Since(start))
+		return false, fmt.Errorf("failed to create Money from paid_amount: %w", err)
+	}
+	invoice.Status = status
+	invoice.PaidAmount = paid
+
+	r.fm.RecordRepositoryQuery(ctx, "apply_payment", "invoice", time.Since(start))
+	return true, nil
+}
+
+// MarkCarriedOver stamps carried_over_at on a closed invoice inside the given transaction.
+// The update is guarded by the paid amount read when the carry-over was computed; returns false
+// when the invoice was paid, carried over or received a payment concurrently.
+func (r *invoiceRepository) MarkCarriedOver(ctx context.Context, tx database.DBTX, invoice *entities.Invoice) (bool, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_repository.mark_carried_over")
+	defer span.End()
+
+	query := `update invoices set
+		carried_over_at = $2,
+		updated_at = $3
+	where id = $1
+	  and status = 'closed'
+	  and paid_amount = $4
+	  and carried_over_at is null
+	  and deleted_at is null`
+
+	result, err := tx.ExecContext(
+		ctx,
+		query,
+		invoice.ID.Value,
+		invoice.CarriedOverAt,
+		time.Now().UTC(),
+		invoice.PaidAmount.Float(),
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "mark_carried_over", "invoice", "infra", time.Since(start))
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.fm.RecordRepositoryFailure(ctx, "mark_carried_over", "invoice", "infra", time.Since(start))
+		return false, err
+	}
+
+	r.fm.RecordRepositoryQuery(ctx, "mark_carried_over", "invoice", time.Since(start))
+	return affected == 1, nil
+}
+
 func (r *invoiceRepository) InsertItems(ctx context.Context, items []*entities.InvoiceItem) error {
 	start := time.Now()
 	ctx, span := r.o11y.Tracer().Start(ctx, "invoice_repository.insert_items")
@@ -164,7 +457,7 @@ func (r *invoiceRepository) InsertItems(ctx context.Context, items []*entities.I
 		return nil
 	}
 
-	const numColumns = 12
+	const numColumns = 17
 	valueStrings := make([]string, 0, len(items))
 	valueArgs := make([]any, 0, len(items)*numColumns)
 
@@ -179,13 +472,18 @@ func (r *invoiceRepository) InsertItems(ctx context.Context, items []*entities.I
 		valueArgs = append(valueArgs,
 			item.ID.Value,
 			item.InvoiceID.Value,
-			item.CategoryID.Value,
+			nullableUUIDValue(item.TransactionID),
+			categoryIDValue(item),
+			itemTypeValue(item),
+			nullableUUIDValue(item.CarriedFromInvoiceID),
 			item.PurchaseDate,
 			item.Description,
 			item.TotalAmount.Float(),
 			item.InstallmentNumber,
 			item.InstallmentTotal,
 			item.InstallmentAmount.Float(),
+			nullableUUIDValue(item.RefundedTransactionID),
+			budgetMonthValue(item.BudgetMonth),
 			item.CreatedAt,
 			item.UpdatedAt.Ptr(),
 			item.DeletedAt.Ptr(),
@@ -195,13 +493,18 @@ func (r *invoiceRepository) InsertItems(ctx context.Context, items []*entities.I
 	query := fmt.Sprintf(`insert into invoice_items (
 		id,
 		invoice_id,
+		transaction_id,
 		category_id,
+		item_type,
+		carried_from_invoice_id,
 		purchase_date,
 		description,
 		total_amount,
 		installment_number,
 		installment_total,
 		installment_amount,
+		refunded_transaction_id,
+		budget_month,
 		created_at,
 		updated_at,
 		deleted_at
@@ -232,13 +535,17 @@ func (r *invoiceRepository) FindByID(ctx context.Context, id vos.UUID) (*entitie
 		total_amount,
 		created_at,
 		updated_at,
-		deleted_at
+		deleted_at,
+		status,
+		paid_amount,
+		minimum_payment,
+		carried_over_at
 	from invoices
 	where id = $1 and deleted_at is null`
 
 	row := r.db.QueryRowContext(ctx, query, id.Value)
 
-	invoice, err := r.scanInvoice(row)
+	invoice, err := r.scanInvoiceWithStatus(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			r.fm.RecordRepositoryQuery(ctx, "find_by_id", "invoice", time.Since(start))
@@ -280,7 +587,11 @@ func (r *invoiceRepository) FindByUserAndCardAndMonth(
 		total_amount,
 		created_at,
 		updated_at,
-		deleted_at
+		deleted_at,
+		status,
+		paid_amount,
+		minimum_payment,
+		carried_over_at
 	from invoices
 	where user_id = $1
 	  and card_id = $2
@@ -290,7 +601,7 @@ func (r *invoiceRepository) FindByUserAndCardAndMonth(
 
 	row := r.db.QueryRowContext(ctx, query, userID.Value, cardID.Value, referenceMonth.FirstDay(), referenceMonth.AddMonths(1).FirstDay())
 
-	invoice, err := r.scanInvoice(row)
+	invoice, err := r.scanInvoiceWithStatus(row)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			r.fm.RecordRepositoryQuery(ctx, "find_by_user_card_month", "invoice", time.Since(start))
@@ -588,7 +899,11 @@ func (r *invoiceRepository) ListByCard(ctx context.Context, params interfaces.Li
 			total_amount,
 			created_at,
 			updated_at,
-			deleted_at
+			deleted_at,
+			status,
+			paid_amount,
+			minimum_payment,
+			carried_over_at
 		FROM invoices
 		WHERE
 			user_id = $1
@@ -627,7 +942,7 @@ func (r *invoiceRepository) ListByCard(ctx context.Context, params interfaces.Li
 	}()
 	invoices := make([]*entities.Invoice, 0)
 	for rows.Next() {
-		invoice, err := r.scanInvoice(rows)
+		invoice, err := r.scanInvoiceWithStatus(rows)
 		if err != nil {
 			span.RecordError(err)
 			r.fm.RecordRepositoryFailure(ctx, "list_by_card", "invoice", "infra", time.Since(start))
@@ -750,13 +1065,18 @@ func (r *invoiceRepository) FindItemsByPurchaseOrigin(
 	query := `select
 		id,
 		invoice_id,
+		transaction_id,
 		category_id,
+		item_type,
+		carried_from_invoice_id,
 		purchase_date,
 		description,
 		total_amount,
 		installment_number,
 		installment_total,
 		installment_amount,
+		refunded_transaction_id,
+		budget_month,
 		created_at,
 		updated_at,
 		deleted_at
@@ -818,13 +1138,18 @@ func (r *invoiceRepository) findItemsByInvoiceIDs(ctx context.Context, ids []vos
 	query := fmt.Sprintf(`select
 		id,
 		invoice_id,
+		transaction_id,
 		category_id,
+		item_type,
+		carried_from_invoice_id,
 		purchase_date,
 		description,
 		total_amount,
 		installment_number,
 		installment_total,
 		installment_amount,
+		refunded_transaction_id,
+		budget_month,
 		created_at,
 		updated_at,
 		deleted_at
@@ -861,13 +1186,18 @@ func (r *invoiceRepository) findItemsByInvoiceID(ctx context.Context, invoiceID
 	query := `select
 		id,
 		invoice_id,
+		transaction_id,
 		category_id,
+		item_type,
+		carried_from_invoice_id,
 		purchase_date,
 		description,
 		total_amount,
 		installment_number,
 		installment_total,
 		installment_amount,
+		refunded_transaction_id,
+		budget_month,
 		created_at,
 		updated_at,
 		deleted_at
@@ -926,6 +1256,8 @@ func (r *invoiceRepository) scanInvoice(s scanner) (*entities.Invoice, error) {
 		return nil, fmt.Errorf("failed to create Money from total_amount: %w", err)
 	}
 
+	// paid_amount não faz parte desta projeção; parte de zero para manter a moeda consistente
+	invoice.PaidAmount, _ = vos.NewMoney(0, constants.DefaultCurrency)
 	invoice.ReferenceMonth = pkgVos.NewReferenceMonthFromDate(referenceDate)
 	invoice.UpdatedAt = helpers.ParseNullableTime(updatedAt)
 	invoice.DeletedAt = helpers.ParseNullableTime(deletedAt)
@@ -933,11 +1265,12 @@ func (r *invoiceRepository) scanInvoice(s scanner) (*entities.Invoice, error) {
 	return &invoice, nil
 }
 
-// scanInvoiceWithStatus scans an invoice row that includes the status column.
+// scanInvoiceWithStatus scans an invoice row that includes the status, payment and revolving columns.
 func (r *invoiceRepository) scanInvoiceWithStatus(s scanner) (*entities.Invoice, error) {
 	var invoice entities.Invoice
 	var updatedAt, deletedAt *time.Time
-	var totalAmount string
+	var totalAmount, paidAmount string
+	var minimumPayment *string
 	var referenceDate time.Time
 
 	err := s.Scan(
@@ -951,6 +1284,9 @@ func (r *invoiceRepository) scanInvoiceWithStatus(s scanner) (*entities.Invoice,
 		&updatedAt,
 		&deletedAt,
 		&invoice.Status,
+		&paidAmount,
+		&minimumPayment,
+		&invoice.CarriedOverAt,
 	)
 	if err != nil {
 		return nil, err
@@ -961,6 +1297,19 @@ func (r *invoiceRepository) scanInvoiceWithStatus(s scanner) (*entities.Invoice,
 		return nil, fmt.Errorf("failed to create Money from total_amount: %w", err)
 	}
 
+	invoice.PaidAmount, err = vos.NewMoneyFromString(paidAmount, constants.DefaultCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Money from paid_amount: %w", err)
+	}
+
+	if minimumPayment != nil {
+		minimum, err := vos.NewMoneyFromString(*minimumPayment, constants.DefaultCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create Money from minimum_payment: %w", err)
+		}
+		invoice.MinimumPayment = &minimum
+	}
+
 	invoice.ReferenceMonth = pkgVos.NewReferenceMonthFromDate(referenceDate)
 	invoice.UpdatedAt = helpers.ParseNullableTime(updatedAt)
 	invoice.DeletedAt = helpers.ParseNullableTime(deletedAt)
@@ -972,17 +1321,24 @@ func (r *invoiceRepository) scanInvoiceItemFromRows(rows *sql.Rows) (*entities.I
 	var item entities.InvoiceItem
 	var updatedAt, deletedAt *time.Time
 	var totalAmount, installmentAmount string
+	var transactionID, categoryID, carriedFromInvoiceID, refundedTransactionID *uuid.UUID
+	var budgetMonth *time.Time
 
 	err := rows.Scan(
 		&item.ID.Value,
 		&item.InvoiceID.Value,
-		&item.CategoryID.Value,
+		&transactionID,
+		&categoryID,
+		&item.Type,
+		&carriedFromInvoiceID,
 		&item.PurchaseDate,
 		&item.Description,
 		&totalAmount,
 		&item.InstallmentNumber,
 		&item.InstallmentTotal,
 		&installmentAmount,
+		&refundedTransactionID,
+		&budgetMonth,
 		&item.CreatedAt,
 		&updatedAt,
 		&deletedAt,
@@ -1001,8 +1357,65 @@ func (r *invoiceRepository) scanInvoiceItemFromRows(rows *sql.Rows) (*entities.I
 		return nil, fmt.Errorf("failed to create Money from installment_amount: %w", err)
 	}
 
+	if transactionID != nil {
+		item.TransactionID = &vos.UUID{Value: *transactionID}
+	}
+	if categoryID != nil {
+		item.CategoryID = vos.UUID{Value: *categoryID}
+	}
+	if carriedFromInvoiceID != nil {
+		item.CarriedFromInvoiceID = &vos.UUID{Value: *carriedFromInvoiceID}
+	}
+	if refundedTransactionID != nil {
+		item.RefundedTransactionID = &vos.UUID{Value: *refundedTransactionID}
+	}
+	if budgetMonth != nil {
+		month := pkgVos.NewReferenceMonthFromDate(*budgetMonth)
+		item.BudgetMonth = &month
+	}
+
 	item.UpdatedAt = helpers.ParseNullableTime(updatedAt)
 	item.DeletedAt = helpers.ParseNullableTime(deletedAt)
 
 	return &item, nil
 }
+
+// nullableUUIDValue converte um vínculo opcional (lançamento, fatura de origem) para o valor do driver.
+func nullableUUIDValue(id *vos.UUID) any {
+	if id == nil {
+		return nil
+	}
+	return id.Value
+}
+
+// categoryIDValue grava NULL para encargos do rotativo e saldo credor, que não têm categoria.
+func categoryIDValue(item *entities.InvoiceItem) any {
+	if !item.HasCategory() {
+		return nil
+	}
+	return item.CategoryID.Value
+}
+
+// itemTypeValue trata itens sem tipo explícito como compras.
+func itemTypeValue(item *entities.InvoiceItem) string {
+	if item.Type == "" {
+		return entities.InvoiceItemTypePurchase
+	}
+	return item.Type
+}
+
+// budgetMonthValue grava o mês do orçamento dos estornos e NULL nos demais itens.
+func budgetMonthValue(month *pkgVos.ReferenceMonth) any {
+	if month == nil {
+		return nil
+	}
+	return month.ToTime()
+}
+
+// moneyValue converte um valor opcional para o driver.
+func moneyValue(m *vos.Money) any {
+	if m == nil {
+		return nil
+	}
+	return m.Float()
+}
@@ -1,7 +1,9 @@
 package invoice
 
 import (
-	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"database/sql"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 
 	"github.com/jailtonjunior94/financial/internal/invoice/application/usecase"
@@ -12,7 +14,9 @@ import (
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/auth"
 	pkginterfaces "github.com/jailtonjunior94/financial/pkg/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
 )
 
 // InvoiceModule wires the invoice bounded context.
@@ -25,36 +29,47 @@ type InvoiceModule struct {
 
 // NewInvoiceModule creates and wires all dependencies for the invoice module.
 func NewInvoiceModule(
-	db database.DBTX,
+	db *sql.DB,
 	o11y observability.Observability,
 	tokenValidator auth.TokenValidator,
-) InvoiceModule {
+	outboxService outbox.Service,
+) (InvoiceModule, error) {
 	errorHandler := httperrors.NewErrorHandler(o11y, ErrorMappings())
 	authMiddleware := middlewares.NewAuthorization(tokenValidator, o11y, errorHandler)
+	idempotencyMiddleware := middlewares.NewIdempotency(idempotency.NewRepository(db, o11y), idempotency.DefaultTTL, o11y, errorHandler)
 
 	financialMetrics := metrics.NewFinancialMetrics(o11y)
 	invoiceRepository := repositories.NewInvoiceRepository(db, o11y, financialMetrics)
+	invoiceItemRepository := repositories.NewInvoiceItemRepository(o11y, financialMetrics)
+	invoicePaymentRepository := repositories.NewInvoicePaymentRepository(o11y, financialMetrics)
+
+	unitOfWork, err := uow.NewUnitOfWork(db)
+	if err != nil {
+		return InvoiceModule{}, err
+	}
 
 	getInvoiceUseCase := usecase.NewGetInvoiceUseCase(invoiceRepository, o11y)
 	listInvoicesByCardPaginatedUseCase := usecase.NewListInvoicesByCardPaginatedUseCase(invoiceRepository, o11y)
+	registerInvoicePaymentUseCase := usecase.NewRegisterInvoicePaymentUseCase(unitOfWork, invoiceRepository, invoicePaymentRepository, outboxService, o11y)
 
 	invoiceHandler := http.NewInvoiceHandler(
 		o11y,
 		errorHandler,
 		listInvoicesByCardPaginatedUseCase,
 		getInvoiceUseCase,
+		registerInvoicePaymentUseCase,
 	)
 
-	invoiceRouter := http.NewInvoiceRouter(invoiceHandler, authMiddleware)
+	invoiceRouter := http.NewInvoiceRouter(invoiceHandler, authMiddleware, idempotencyMiddleware)
 
 	invoiceTotalProvider := adapters.NewInvoiceTotalProviderAdapter(invoiceRepository)
 	invoiceCategoryTotalProvider := adapters.NewInvoiceCategoryTotalAdapter(invoiceRepository)
-	invoiceProviderAdapter := adapters.NewInvoiceProviderAdapter(invoiceRepository, o11y)
+	invoiceProviderAdapter := adapters.NewInvoiceProviderAdapter(invoiceRepository, invoiceItemRepository, o11y)
 
 	return InvoiceModule{
 		InvoiceRouter:                invoiceRouter,
 		InvoiceTotalProvider:         invoiceTotalProvider,
 		InvoiceCategoryTotalProvider: invoiceCategoryTotalProvider,
 		InvoiceProviderAdapter:       invoiceProviderAdapter,
-	}
+	}, nil
 }
@@ -0,0 +1,31 @@
+package dtos
+
+import "io"
+
+// AttachmentUploadInput is an uploaded file for POST /api/v1/transactions/{id}/attachments
+// and POST /api/v1/invoices/{id}/attachments. The content type is detected from Content;
+// the one declared by the client is not trusted.
+type AttachmentUploadInput struct {
+	OwnerType string
+	OwnerID   string
+	FileName  string
+	Content   io.Reader
+}
+
+// AttachmentOutput is the response for attachment endpoints.
+type AttachmentOutput struct {
+	ID          string `json:"id"`
+	OwnerType   string `json:"owner_type"`
+	OwnerID     string `json:"owner_id"`
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Size        int64  `json:"size_bytes"`
+	SHA256      string `json:"sha256"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// AttachmentContent is a downloaded attachment. The caller must close Content.
+type AttachmentContent struct {
+	Attachment *AttachmentOutput
+	Content    io.ReadCloser
+}
@@ -0,0 +1,140 @@
+package dtos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// MaxRecategorizationDays limits the period re-categorized by a single request.
+const MaxRecategorizationDays = 366
+
+// CategorizationRuleInput is the request body for POST /api/v1/categorization-rules and
+// PUT /api/v1/categorization-rules/{id}. Every condition given must match; at least one
+// is required. Rules are evaluated by ascending priority.
+type CategorizationRuleInput struct {
+	Name                string   `json:"name" example:"Uber"`
+	Priority            int      `json:"priority" example:"10"`
+	Enabled             *bool    `json:"enabled,omitempty"`
+	DescriptionContains string   `json:"description_contains,omitempty" example:"uber"`
+	DescriptionRegex    string   `json:"description_regex,omitempty" example:"^(uber|99)\\b"`
+	MinAmount           *float64 `json:"min_amount,omitempty"`
+	MaxAmount           *float64 `json:"max_amount,omitempty"`
+	PaymentMethod       string   `json:"payment_method,omitempty" enums:"pix,boleto,ted,debit,credit"`
+	CardID              string   `json:"card_id,omitempty"`
+	CategoryID          string   `json:"category_id"`
+	SubcategoryID       string   `json:"subcategory_id,omitempty"`
+	TagIDs              []string `json:"tag_ids,omitempty"`
+}
+
+// Validate validates the CategorizationRuleInput fields; the conditions are checked by the rule itself.
+func (i *CategorizationRuleInput) Validate() error {
+	if strings.TrimSpace(i.CategoryID) == "" {
+		return fmt.Errorf("%w: category_id is required", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	if i.MinAmount != nil && *i.MinAmount <= 0 || i.MaxAmount != nil && *i.MaxAmount <= 0 {
+		return fmt.Errorf("%w: amount limits must be positive", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	return validateTagIDs(i.TagIDs)
+}
+
+// IsEnabled reports whether the rule is enabled; rules are enabled unless stated otherwise.
+func (i *CategorizationRuleInput) IsEnabled() bool {
+	return i.Enabled == nil || *i.Enabled
+}
+
+// CategorizationRuleOutput is the response for categorization rule endpoints.
+type CategorizationRuleOutput struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	Priority            int      `json:"priority"`
+	Enabled             bool     `json:"enabled"`
+	DescriptionContains string   `json:"description_contains,omitempty"`
+	DescriptionRegex    string   `json:"description_regex,omitempty"`
+	MinAmount           *float64 `json:"min_amount,omitempty"`
+	MaxAmount           *float64 `json:"max_amount,omitempty"`
+	PaymentMethod       string   `json:"payment_method,omitempty"`
+	CardID              *string  `json:"card_id,omitempty"`
+	CategoryID          string   `json:"category_id"`
+	SubcategoryID       *string  `json:"subcategory_id,omitempty"`
+	TagIDs              []string `json:"tag_ids"`
+	CreatedAt           string   `json:"created_at"`
+	UpdatedAt           *string  `json:"updated_at,omitempty"`
+}
+
+// CategorizationPreviewInput is the request body for POST /api/v1/categorization-rules/preview.
+// Amount is the purchase total; card_id only matters for card payments.
+type CategorizationPreviewInput struct {
+	Description   string  `json:"description" example:"UBER *TRIP"`
+	Amount        float64 `json:"amount" example:"23.90"`
+	PaymentMethod string  `json:"payment_method,omitempty"`
+	CardID        string  `json:"card_id,omitempty"`
+}
+
+// Validate validates the CategorizationPreviewInput fields.
+func (i *CategorizationPreviewInput) Validate() error {
+	if strings.TrimSpace(i.Description) == "" {
+		return transactionDomain.ErrDescriptionRequired
+	}
+	if i.Amount < 0 {
+		return transactionDomain.ErrAmountMustBePositive
+	}
+	return nil
+}
+
+// CategorizationPreviewOutput lists the enabled rules matching the preview in evaluation
+// order. AppliedRule is the first of them, the one a new transaction would get.
+type CategorizationPreviewOutput struct {
+	MatchedRules []*CategorizationRuleOutput `json:"matched_rules"`
+	AppliedRule  *CategorizationRuleOutput   `json:"applied_rule,omitempty"`
+}
+
+// ApplyCategorizationRulesInput is the request body for POST /api/v1/categorization-rules/apply.
+// DryRun reports what would change without changing it.
+type ApplyCategorizationRulesInput struct {
+	StartDate string `json:"start_date" example:"2026-01-01"`
+	EndDate   string `json:"end_date" example:"2026-03-31"`
+	DryRun    bool   `json:"dry_run,omitempty"`
+}
+
+// Validate validates the period, returning the parsed dates.
+func (i *ApplyCategorizationRulesInput) Validate() (time.Time, time.Time, error) {
+	from, err := time.Parse("2006-01-02", i.StartDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: start_date must be in YYYY-MM-DD format", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	to, err := time.Parse("2006-01-02", i.EndDate)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: end_date must be in YYYY-MM-DD format", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: start_date cannot be after end_date", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	if to.Sub(from) >= MaxRecategorizationDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: the period cannot exceed %d days", transactionDomain.ErrInvalidCategorizationRule, MaxRecategorizationDays)
+	}
+	return from, to, nil
+}
+
+// RecategorizedTransaction is a transaction whose category or tags a rule changed.
+type RecategorizedTransaction struct {
+	TransactionID      string   `json:"transaction_id"`
+	RuleID             string   `json:"rule_id"`
+	PreviousCategoryID string   `json:"previous_category_id"`
+	CategoryID         string   `json:"category_id"`
+	SubcategoryID      *string  `json:"subcategory_id,omitempty"`
+	AddedTagIDs        []string `json:"added_tag_ids,omitempty"`
+}
+
+// ApplyCategorizationRulesOutput is the response for POST /api/v1/categorization-rules/apply.
+// Locked counts the matched transactions left untouched because their invoice is closed.
+type ApplyCategorizationRulesOutput struct {
+	DryRun        bool                        `json:"dry_run"`
+	Evaluated     int                         `json:"evaluated"`
+	Matched       int                         `json:"matched"`
+	Recategorized int                         `json:"recategorized"`
+	Locked        int                         `json:"locked"`
+	Transactions  []*RecategorizedTransaction `json:"transactions"`
+}
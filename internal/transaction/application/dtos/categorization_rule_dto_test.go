@@ -0,0 +1,58 @@
+package dtos_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+func TestCategorizationRuleInput_Validate(t *testing.T) {
+	zero := 0.0
+
+	require.NoError(t, (&dtos.CategorizationRuleInput{Name: "Uber", CategoryID: "01965b87-b35a-7f18-a3b1-000000000001"}).Validate())
+	require.ErrorIs(t, (&dtos.CategorizationRuleInput{Name: "Uber"}).Validate(), transactionDomain.ErrInvalidCategorizationRule)
+	require.ErrorIs(t, (&dtos.CategorizationRuleInput{Name: "Uber", CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", MaxAmount: &zero}).Validate(),
+		transactionDomain.ErrInvalidCategorizationRule)
+	require.ErrorIs(t, (&dtos.CategorizationRuleInput{Name: "Uber", CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", TagIDs: []string{" "}}).Validate(),
+		transactionDomain.ErrInvalidTagging)
+}
+
+func TestCategorizationRuleInput_IsEnabled(t *testing.T) {
+	disabled := false
+	require.True(t, (&dtos.CategorizationRuleInput{}).IsEnabled())
+	require.False(t, (&dtos.CategorizationRuleInput{Enabled: &disabled}).IsEnabled())
+}
+
+func TestCategorizationPreviewInput_Validate(t *testing.T) {
+	require.NoError(t, (&dtos.CategorizationPreviewInput{Description: "UBER *TRIP", Amount: 23.90}).Validate())
+	require.ErrorIs(t, (&dtos.CategorizationPreviewInput{Description: " ", Amount: 23.90}).Validate(), transactionDomain.ErrDescriptionRequired)
+	require.ErrorIs(t, (&dtos.CategorizationPreviewInput{Description: "Uber", Amount: -1}).Validate(), transactionDomain.ErrAmountMustBePositive)
+}
+
+func TestApplyCategorizationRulesInput_Validate(t *testing.T) {
+	t.Run("should parse the period", func(t *testing.T) {
+		from, to, err := (&dtos.ApplyCategorizationRulesInput{StartDate: "2026-01-01", EndDate: "2026-12-31"}).Validate()
+		require.NoError(t, err)
+		require.Equal(t, "2026-01-01", from.Format("2006-01-02"))
+		require.Equal(t, "2026-12-31", to.Format("2006-01-02"))
+	})
+
+	scenarios := []struct {
+		name  string
+		input dtos.ApplyCategorizationRulesInput
+	}{
+		{name: "missing start date", input: dtos.ApplyCategorizationRulesInput{EndDate: "2026-01-31"}},
+		{name: "invalid end date", input: dtos.ApplyCategorizationRulesInput{StartDate: "2026-01-01", EndDate: "31/01/2026"}},
+		{name: "inverted period", input: dtos.ApplyCategorizationRulesInput{StartDate: "2026-02-01", EndDate: "2026-01-31"}},
+		{name: "period over the limit", input: dtos.ApplyCategorizationRulesInput{StartDate: "2025-01-01", EndDate: "2026-01-02"}},
+	}
+	for _, scenario := range scenarios {
+		t.Run("should return error for "+scenario.name, func(t *testing.T) {
+			_, _, err := scenario.input.Validate()
+			require.ErrorIs(t, err, transactionDomain.ErrInvalidCategorizationRule)
+		})
+	}
+}
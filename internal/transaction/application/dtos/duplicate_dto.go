@@ -0,0 +1,81 @@
+package dtos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+const (
+	// DefaultDuplicateReviewDays is the period reviewed when the request has no dates.
+	DefaultDuplicateReviewDays = 90
+	// MaxDuplicateReviewDays limits the period reviewed by a single request.
+	MaxDuplicateReviewDays = 366
+)
+
+// DuplicateReviewParams holds the period of GET /api/v1/transactions/duplicates, as read
+// from the query string. Both dates are optional.
+type DuplicateReviewParams struct {
+	StartDate string
+	EndDate   string
+}
+
+// Validate validates the period, returning the parsed dates. The end date defaults to
+// today and the start date to DefaultDuplicateReviewDays before the end date.
+func (p *DuplicateReviewParams) Validate(today time.Time) (time.Time, time.Time, error) {
+	to := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if p.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", p.EndDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: end_date must be in YYYY-MM-DD format", transactionDomain.ErrInvalidListFilter)
+		}
+		to = parsed
+	}
+	from := to.AddDate(0, 0, -DefaultDuplicateReviewDays)
+	if p.StartDate != "" {
+		parsed, err := time.Parse("2006-01-02", p.StartDate)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("%w: start_date must be in YYYY-MM-DD format", transactionDomain.ErrInvalidListFilter)
+		}
+		from = parsed
+	}
+	if from.After(to) {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: start_date cannot be after end_date", transactionDomain.ErrInvalidListFilter)
+	}
+	if to.Sub(from) >= MaxDuplicateReviewDays*24*time.Hour {
+		return time.Time{}, time.Time{}, fmt.Errorf("%w: the period cannot exceed %d days", transactionDomain.ErrInvalidListFilter, MaxDuplicateReviewDays)
+	}
+	return from, to, nil
+}
+
+// DuplicatePairOutput is a likely duplicate and the transaction it repeats. Original is
+// the transaction entered first.
+type DuplicatePairOutput struct {
+	Original  *TransactionOutput `json:"original"`
+	Duplicate *TransactionOutput `json:"duplicate"`
+}
+
+// MergeInput is the request body for POST /api/v1/transactions/{id}/merge. The
+// transaction in the path is kept and DuplicateID is cancelled.
+type MergeInput struct {
+	DuplicateID string `json:"duplicate_id" example:"01965b87-b35a-7f18-a3b1-000000000002"`
+}
+
+// Validate validates the MergeInput fields.
+func (i *MergeInput) Validate() error {
+	if strings.TrimSpace(i.DuplicateID) == "" {
+		return fmt.Errorf("%w: duplicate_id is required", transactionDomain.ErrInvalidMerge)
+	}
+	return nil
+}
+
+// MergeOutput is the response for POST /api/v1/transactions/{id}/merge. Transaction is
+// the kept transaction, with the tags it received from the cancelled one.
+type MergeOutput struct {
+	Transaction      *TransactionOutput `json:"transaction"`
+	Cancelled        *TransactionOutput `json:"cancelled"`
+	MovedAttachments int                `json:"moved_attachments"`
+	AddedTagIDs      []string           `json:"added_tag_ids,omitempty"`
+}
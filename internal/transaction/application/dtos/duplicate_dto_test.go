@@ -0,0 +1,50 @@
+package dtos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+func TestDuplicateReviewParams_Validate(t *testing.T) {
+	today := time.Date(2026, 3, 31, 15, 4, 5, 0, time.UTC)
+
+	t.Run("should default to the last days up to today", func(t *testing.T) {
+		from, to, err := (&dtos.DuplicateReviewParams{}).Validate(today)
+		require.NoError(t, err)
+		require.Equal(t, "2025-12-31", from.Format("2006-01-02"))
+		require.Equal(t, "2026-03-31", to.Format("2006-01-02"))
+	})
+
+	t.Run("should parse the period", func(t *testing.T) {
+		from, to, err := (&dtos.DuplicateReviewParams{StartDate: "2026-01-01", EndDate: "2026-01-31"}).Validate(today)
+		require.NoError(t, err)
+		require.Equal(t, "2026-01-01", from.Format("2006-01-02"))
+		require.Equal(t, "2026-01-31", to.Format("2006-01-02"))
+	})
+
+	scenarios := []struct {
+		name   string
+		params dtos.DuplicateReviewParams
+	}{
+		{name: "invalid start date", params: dtos.DuplicateReviewParams{StartDate: "01/01/2026"}},
+		{name: "invalid end date", params: dtos.DuplicateReviewParams{EndDate: "2026-13-01"}},
+		{name: "inverted period", params: dtos.DuplicateReviewParams{StartDate: "2026-02-01", EndDate: "2026-01-31"}},
+		{name: "period over the limit", params: dtos.DuplicateReviewParams{StartDate: "2025-01-01", EndDate: "2026-01-02"}},
+	}
+	for _, scenario := range scenarios {
+		t.Run("should return error for "+scenario.name, func(t *testing.T) {
+			_, _, err := scenario.params.Validate(today)
+			require.ErrorIs(t, err, transactionDomain.ErrInvalidListFilter)
+		})
+	}
+}
+
+func TestMergeInput_Validate(t *testing.T) {
+	require.NoError(t, (&dtos.MergeInput{DuplicateID: "01965b87-b35a-7f18-a3b1-000000000002"}).Validate())
+	require.ErrorIs(t, (&dtos.MergeInput{DuplicateID: " "}).Validate(), transactionDomain.ErrInvalidMerge)
+}
@@ -0,0 +1,55 @@
+package dtos
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+)
+
+// MaxExchangeRateImportRows limits how many rates a single CSV file can carry.
+const MaxExchangeRateImportRows = 5000
+
+// ExchangeRateInput is the request body for POST /api/v1/exchange-rates and a line of an
+// exchange rate file: the value of one unit of currency in BRL on rate_date.
+type ExchangeRateInput struct {
+	Currency string  `json:"currency" example:"USD"`
+	Rate     float64 `json:"rate" example:"5.4321"`
+	RateDate string  `json:"rate_date" example:"2026-03-10"` // YYYY-MM-DD
+}
+
+// Validate validates the ExchangeRateInput fields.
+func (i *ExchangeRateInput) Validate() error {
+	if _, err := vos.NewCurrency(strings.TrimSpace(i.Currency)); err != nil {
+		return fmt.Errorf("%w: unsupported currency %q", transactionDomain.ErrInvalidExchangeRate, i.Currency)
+	}
+	if !factories.IsForeignCurrency(i.Currency) {
+		return fmt.Errorf("%w: %s is the base currency", transactionDomain.ErrInvalidExchangeRate, factories.BaseCurrency)
+	}
+	if math.IsNaN(i.Rate) || i.Rate <= 0 {
+		return fmt.Errorf("%w: rate must be positive", transactionDomain.ErrInvalidExchangeRate)
+	}
+	if _, err := time.Parse("2006-01-02", i.RateDate); err != nil {
+		return fmt.Errorf("%w: rate_date must be in YYYY-MM-DD format", transactionDomain.ErrInvalidExchangeRate)
+	}
+	return nil
+}
+
+// ExchangeRateOutput is an exchange rate of the user. Source is manual or csv.
+type ExchangeRateOutput struct {
+	Currency     string  `json:"currency"`
+	BaseCurrency string  `json:"base_currency"`
+	Rate         float64 `json:"rate"`
+	RateDate     string  `json:"rate_date"`
+	Source       string  `json:"source"`
+}
+
+// ExchangeRateImportOutput is the response for POST /api/v1/exchange-rates/import.
+type ExchangeRateImportOutput struct {
+	Imported int `json:"imported"`
+}
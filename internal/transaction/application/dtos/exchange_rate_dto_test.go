@@ -0,0 +1,32 @@
+package dtos_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+func TestExchangeRateInput_Validate(t *testing.T) {
+	t.Run("should pass with a foreign currency", func(t *testing.T) {
+		input := dtos.ExchangeRateInput{Currency: "usd", Rate: 5.4321, RateDate: "2026-03-10"}
+		require.NoError(t, input.Validate())
+	})
+
+	scenarios := []struct {
+		name  string
+		input dtos.ExchangeRateInput
+	}{
+		{name: "should reject an unsupported currency", input: dtos.ExchangeRateInput{Currency: "XYZ", Rate: 1, RateDate: "2026-03-10"}},
+		{name: "should reject the base currency", input: dtos.ExchangeRateInput{Currency: "BRL", Rate: 1, RateDate: "2026-03-10"}},
+		{name: "should reject a non positive rate", input: dtos.ExchangeRateInput{Currency: "EUR", Rate: 0, RateDate: "2026-03-10"}},
+		{name: "should reject an invalid date", input: dtos.ExchangeRateInput{Currency: "EUR", Rate: 6.1, RateDate: "10/03/2026"}},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			require.ErrorIs(t, scenario.input.Validate(), transactionDomain.ErrInvalidExchangeRate)
+		})
+	}
+}
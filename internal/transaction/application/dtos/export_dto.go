@@ -0,0 +1,17 @@
+package dtos
+
+// Formats accepted by GET /api/v1/transactions/export.
+const (
+	ExportFormatCSV   = "csv"
+	ExportFormatOFX   = "ofx"
+	ExportFormatJSONL = "jsonl"
+)
+
+// TransactionExportOutput is one exported transaction: the same fields as the API
+// output plus the names of its category, subcategory and card.
+type TransactionExportOutput struct {
+	*TransactionOutput
+	CategoryName    string  `json:"category_name"`
+	SubcategoryName *string `json:"subcategory_name,omitempty"`
+	CardName        *string `json:"card_name,omitempty"`
+}
@@ -0,0 +1,111 @@
+package dtos
+
+import (
+	"fmt"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// MaxImportRows limits how many rows a single import can carry.
+const MaxImportRows = 5000
+
+// Row statuses reported by an import.
+const (
+	ImportRowValid     = "valid"
+	ImportRowInvalid   = "invalid"
+	ImportRowDuplicate = "duplicate"
+	ImportRowImported  = "imported"
+)
+
+// CSVImportMapping maps each transaction field to the header of the CSV column holding it.
+// An empty entry falls back to a column named after the field itself.
+type CSVImportMapping struct {
+	TransactionDate string `json:"transaction_date,omitempty"`
+	Description     string `json:"description,omitempty"`
+	Amount          string `json:"amount,omitempty"`
+	Direction       string `json:"direction,omitempty"`
+	PaymentMethod   string `json:"payment_method,omitempty"`
+	CategoryID      string `json:"category_id,omitempty"`
+	SubcategoryID   string `json:"subcategory_id,omitempty"`
+	CardID          string `json:"card_id,omitempty"`
+	Installments    string `json:"installments,omitempty"`
+}
+
+// CSVImportOptions describes how to read a CSV file. Defaults fills the fields of
+// every row whose column is missing or empty (e.g. one payment method for a bank export).
+type CSVImportOptions struct {
+	Mapping          CSVImportMapping
+	Delimiter        string // ",", ";" or "tab"
+	DateFormat       string // YYYY-MM-DD, DD/MM/YYYY, MM/DD/YYYY, DD-MM-YYYY, DD.MM.YYYY or YYYY/MM/DD
+	DecimalSeparator string // "." or ","
+	Defaults         TransactionInput
+}
+
+// CategoryRule assigns a category to the statement entries whose description contains
+// the given text. Matching ignores case and accents.
+type CategoryRule struct {
+	Contains      string `json:"contains"`
+	CategoryID    string `json:"category_id"`
+	SubcategoryID string `json:"subcategory_id,omitempty"`
+}
+
+// OFXImportOptions describes how to turn the entries of an OFX statement into
+// transactions. CardID is only used for the card purchases of a debit statement.
+type OFXImportOptions struct {
+	PaymentMethod string
+	CardID        string
+	CategoryID    string
+	SubcategoryID string
+	CategoryRules []CategoryRule
+}
+
+// ImportRow is one transaction read from an import file. Errors holds the problems
+// found while reading the row itself (unreadable date or amount). RuleID is the stored
+// categorization rule that set the category of the row, if any.
+type ImportRow struct {
+	Line   int
+	Input  TransactionInput
+	Errors []string
+	RuleID string
+}
+
+// ImportInput is the parsed content of an import request. When UncategorizedFallback
+// is set, rows without a category go to the user's "Uncategorized" category.
+type ImportInput struct {
+	Rows                  []*ImportRow
+	DryRun                bool
+	AllowDuplicates       bool
+	UncategorizedFallback bool
+}
+
+// Validate validates the ImportInput size.
+func (i *ImportInput) Validate() error {
+	if len(i.Rows) == 0 {
+		return transactionDomain.ErrImportEmpty
+	}
+	if len(i.Rows) > MaxImportRows {
+		return fmt.Errorf("%w: %d rows, maximum is %d", transactionDomain.ErrImportTooManyRows, len(i.Rows), MaxImportRows)
+	}
+	return nil
+}
+
+// ImportRowResult reports the outcome of one row.
+type ImportRowResult struct {
+	Line           int      `json:"line"`
+	Status         string   `json:"status" enums:"valid,invalid,duplicate,imported"`
+	Errors         []string `json:"errors,omitempty"`
+	TransactionIDs []string `json:"transaction_ids,omitempty"`
+	RuleID         string   `json:"rule_id,omitempty"`
+}
+
+// ImportOutput is the response for POST /api/v1/transactions/import.
+type ImportOutput struct {
+	DryRun     bool               `json:"dry_run"`
+	Committed  bool               `json:"committed"`
+	Total      int                `json:"total"`
+	Valid      int                `json:"valid"`
+	Invalid    int                `json:"invalid"`
+	Duplicates int                `json:"duplicates"`
+	Imported   int                `json:"imported"`
+	Rows       []*ImportRowResult `json:"rows"`
+}
@@ -0,0 +1,27 @@
+package dtos_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+func TestImportInput_Validate(t *testing.T) {
+	t.Run("should accept rows within the limit", func(t *testing.T) {
+		input := &dtos.ImportInput{Rows: []*dtos.ImportRow{{Line: 2}}}
+		require.NoError(t, input.Validate())
+	})
+
+	t.Run("should return error for empty import", func(t *testing.T) {
+		input := &dtos.ImportInput{}
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrImportEmpty)
+	})
+
+	t.Run("should return error when exceeding the row limit", func(t *testing.T) {
+		input := &dtos.ImportInput{Rows: make([]*dtos.ImportRow, dtos.MaxImportRows+1)}
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrImportTooManyRows)
+	})
+}
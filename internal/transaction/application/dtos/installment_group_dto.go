@@ -0,0 +1,77 @@
+package dtos
+
+import (
+	"fmt"
+	"time"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// PayoffInput is the request body for POST /api/v1/installment-groups/{id}/payoff.
+type PayoffInput struct {
+	Discount   *float64 `json:"discount,omitempty" example:"35.00"`
+	PayoffDate string   `json:"payoff_date,omitempty" example:"2026-03-20"` // YYYY-MM-DD, defaults to today
+}
+
+// Validate validates the PayoffInput fields, returning the payoff date.
+func (i *PayoffInput) Validate(today time.Time) (time.Time, error) {
+	if i.Discount != nil && *i.Discount < 0 {
+		return time.Time{}, fmt.Errorf("%w: discount must not be negative", transactionDomain.ErrInvalidPayoff)
+	}
+	if i.PayoffDate == "" {
+		return time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC), nil
+	}
+	parsed, err := time.Parse("2006-01-02", i.PayoffDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: payoff_date must be in YYYY-MM-DD format", transactionDomain.ErrInvalidPayoff)
+	}
+	return parsed, nil
+}
+
+// PayoffOutput is the early payoff of an installment group. Transaction is the
+// consolidated item billed on the open invoice and Cancelled the installments it replaced.
+type PayoffOutput struct {
+	ID                 string               `json:"id"`
+	InstallmentGroupID string               `json:"installment_group_id"`
+	InvoiceID          string               `json:"invoice_id"`
+	Installments       int                  `json:"installments"`
+	OriginalAmount     float64              `json:"original_amount"`
+	Discount           float64              `json:"discount"`
+	Amount             float64              `json:"amount"`
+	PayoffDate         string               `json:"payoff_date"`
+	Transaction        *TransactionOutput   `json:"transaction"`
+	Cancelled          []*TransactionOutput `json:"cancelled"`
+}
+
+// InstallmentGroupUpdateInput is the request body for PUT /api/v1/installment-groups/{id}.
+// Amount is the new total of the purchase.
+type InstallmentGroupUpdateInput struct {
+	Amount       float64 `json:"amount" example:"1200.00"`
+	Installments int     `json:"installments" example:"12"`
+}
+
+// Validate validates the InstallmentGroupUpdateInput fields.
+func (i *InstallmentGroupUpdateInput) Validate() error {
+	if i.Amount <= 0 {
+		return transactionDomain.ErrAmountMustBePositive
+	}
+	if i.Installments < 1 {
+		return fmt.Errorf("%w: installments must be at least 1", transactionDomain.ErrInvalidInstallmentEdit)
+	}
+	if i.Installments > 48 {
+		return transactionDomain.ErrInstallmentsTooMany
+	}
+	return nil
+}
+
+// InstallmentGroupOutput is the outcome of editing an installment purchase. Locked lists
+// the installments left unchanged because their invoices are closed or paid.
+type InstallmentGroupOutput struct {
+	InstallmentGroupID string               `json:"installment_group_id"`
+	Amount             float64              `json:"amount"`
+	Installments       int                  `json:"installments"`
+	Updated            []*TransactionOutput `json:"updated"`
+	Created            []*TransactionOutput `json:"created"`
+	Cancelled          []*TransactionOutput `json:"cancelled"`
+	Locked             []*TransactionOutput `json:"locked"`
+}
@@ -0,0 +1,64 @@
+package dtos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+func TestPayoffInput_Validate(t *testing.T) {
+	today := time.Date(2026, 3, 31, 15, 4, 5, 0, time.UTC)
+
+	t.Run("should default the payoff date to today", func(t *testing.T) {
+		date, err := (&dtos.PayoffInput{}).Validate(today)
+		require.NoError(t, err)
+		require.Equal(t, "2026-03-31", date.Format("2006-01-02"))
+	})
+
+	t.Run("should accept a zero discount and parse the payoff date", func(t *testing.T) {
+		zero := 0.0
+		date, err := (&dtos.PayoffInput{Discount: &zero, PayoffDate: "2026-03-20"}).Validate(today)
+		require.NoError(t, err)
+		require.Equal(t, "2026-03-20", date.Format("2006-01-02"))
+	})
+
+	negative := -1.0
+	scenarios := []struct {
+		name  string
+		input dtos.PayoffInput
+	}{
+		{name: "negative discount", input: dtos.PayoffInput{Discount: &negative}},
+		{name: "invalid payoff date", input: dtos.PayoffInput{PayoffDate: "20/03/2026"}},
+	}
+	for _, scenario := range scenarios {
+		t.Run("should return error for "+scenario.name, func(t *testing.T) {
+			_, err := scenario.input.Validate(today)
+			require.ErrorIs(t, err, transactionDomain.ErrInvalidPayoff)
+		})
+	}
+}
+
+func TestInstallmentGroupUpdateInput_Validate(t *testing.T) {
+	t.Run("should accept valid terms", func(t *testing.T) {
+		require.NoError(t, (&dtos.InstallmentGroupUpdateInput{Amount: 1200, Installments: 12}).Validate())
+	})
+
+	scenarios := []struct {
+		name     string
+		input    dtos.InstallmentGroupUpdateInput
+		expected error
+	}{
+		{name: "zero amount", input: dtos.InstallmentGroupUpdateInput{Installments: 3}, expected: transactionDomain.ErrAmountMustBePositive},
+		{name: "zero installments", input: dtos.InstallmentGroupUpdateInput{Amount: 100}, expected: transactionDomain.ErrInvalidInstallmentEdit},
+		{name: "too many installments", input: dtos.InstallmentGroupUpdateInput{Amount: 100, Installments: 49}, expected: transactionDomain.ErrInstallmentsTooMany},
+	}
+	for _, scenario := range scenarios {
+		t.Run("should return error for "+scenario.name, func(t *testing.T) {
+			require.ErrorIs(t, scenario.input.Validate(), scenario.expected)
+		})
+	}
+}
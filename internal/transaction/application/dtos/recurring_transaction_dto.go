@@ -0,0 +1,98 @@
+package dtos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+// RecurringTransactionInput is the request body for POST /api/v1/recurring-transactions.
+type RecurringTransactionInput struct {
+	Description   string  `json:"description"`
+	Amount        float64 `json:"amount"`
+	Direction     string  `json:"direction,omitempty" enums:"INCOME,EXPENSE"`
+	PaymentMethod string  `json:"payment_method"`
+	CategoryID    string  `json:"category_id"`
+	SubcategoryID string  `json:"subcategory_id,omitempty"`
+	CardID        string  `json:"card_id,omitempty"`
+	RRule         string  `json:"rrule" example:"FREQ=MONTHLY;BYMONTHDAY=5"`
+	StartDate     string  `json:"start_date"`
+}
+
+// Validate validates the template with the same rules as a single transaction dated today,
+// so every occurrence can be materialized, and then the schedule fields.
+func (i *RecurringTransactionInput) Validate() error {
+	template := &TransactionInput{
+		Description:     i.Description,
+		Amount:          i.Amount,
+		Direction:       i.Direction,
+		PaymentMethod:   i.PaymentMethod,
+		TransactionDate: time.Now().UTC().Format("2006-01-02"),
+		CategoryID:      i.CategoryID,
+		SubcategoryID:   i.SubcategoryID,
+		CardID:          i.CardID,
+	}
+	if err := template.Validate(); err != nil {
+		return err
+	}
+	if strings.TrimSpace(i.RRule) == "" {
+		return fmt.Errorf("%w: rrule is required", transactionDomain.ErrInvalidRecurrenceRule)
+	}
+	if _, err := transactionVos.NewRecurrenceRule(i.RRule); err != nil {
+		return err
+	}
+	if i.StartDate == "" {
+		return fmt.Errorf("start_date is required")
+	}
+	if _, err := time.Parse("2006-01-02", i.StartDate); err != nil {
+		return fmt.Errorf("start_date must be in YYYY-MM-DD format")
+	}
+	return nil
+}
+
+// RecurringTransactionUpdateInput is the request body for PUT /api/v1/recurring-transactions/{id}.
+// The schedule cannot be changed; delete the recurrence and create a new one instead.
+type RecurringTransactionUpdateInput struct {
+	Description   string  `json:"description"`
+	Amount        float64 `json:"amount"`
+	CategoryID    string  `json:"category_id"`
+	SubcategoryID string  `json:"subcategory_id,omitempty"`
+	Active        *bool   `json:"active,omitempty"`
+}
+
+// Validate validates the RecurringTransactionUpdateInput fields.
+func (i *RecurringTransactionUpdateInput) Validate() error {
+	if strings.TrimSpace(i.Description) == "" {
+		return transactionDomain.ErrDescriptionRequired
+	}
+	if i.Amount <= 0 {
+		return transactionDomain.ErrAmountMustBePositive
+	}
+	if strings.TrimSpace(i.CategoryID) == "" {
+		return fmt.Errorf("category_id is required")
+	}
+	return nil
+}
+
+// RecurringTransactionOutput is the response for recurring transaction endpoints.
+type RecurringTransactionOutput struct {
+	ID              string  `json:"id"`
+	UserID          string  `json:"user_id"`
+	CategoryID      string  `json:"category_id"`
+	SubcategoryID   *string `json:"subcategory_id,omitempty"`
+	CardID          *string `json:"card_id,omitempty"`
+	Description     string  `json:"description"`
+	Amount          float64 `json:"amount"`
+	Direction       string  `json:"direction"`
+	PaymentMethod   string  `json:"payment_method"`
+	RRule           string  `json:"rrule"`
+	StartDate       string  `json:"start_date"`
+	NextOccurrence  *string `json:"next_occurrence,omitempty"`
+	OccurrenceCount int     `json:"occurrence_count"`
+	Active          bool    `json:"active"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       *string `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,69 @@
+package dtos_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+func validRecurringInput() *dtos.RecurringTransactionInput {
+	return &dtos.RecurringTransactionInput{
+		Description:   "Aluguel",
+		Amount:        1500.00,
+		PaymentMethod: "pix",
+		CategoryID:    "01965b87-b35a-7f18-a3b1-000000000001",
+		RRule:         "FREQ=MONTHLY;BYMONTHDAY=5",
+		StartDate:     "2099-01-01",
+	}
+}
+
+func TestRecurringTransactionInput_Validate(t *testing.T) {
+	t.Run("should accept a future start date", func(t *testing.T) {
+		require.NoError(t, validRecurringInput().Validate())
+	})
+
+	t.Run("should apply single transaction rules to the template", func(t *testing.T) {
+		input := validRecurringInput()
+		input.PaymentMethod = "credit"
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrCardRequiredForCredit)
+	})
+
+	t.Run("should return error for missing rrule", func(t *testing.T) {
+		input := validRecurringInput()
+		input.RRule = ""
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrInvalidRecurrenceRule)
+	})
+
+	t.Run("should return error for invalid rrule", func(t *testing.T) {
+		input := validRecurringInput()
+		input.RRule = "FREQ=MONTHLY;BYDAY=MO"
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrInvalidRecurrenceRule)
+	})
+
+	t.Run("should return error for missing start_date", func(t *testing.T) {
+		input := validRecurringInput()
+		input.StartDate = ""
+		require.Error(t, input.Validate())
+	})
+
+	t.Run("should return error for malformed start_date", func(t *testing.T) {
+		input := validRecurringInput()
+		input.StartDate = "05/01/2026"
+		require.Error(t, input.Validate())
+	})
+}
+
+func TestRecurringTransactionUpdateInput_Validate(t *testing.T) {
+	t.Run("should pass with valid fields", func(t *testing.T) {
+		input := &dtos.RecurringTransactionUpdateInput{Description: "Aluguel", Amount: 1600, CategoryID: "cat"}
+		require.NoError(t, input.Validate())
+	})
+
+	t.Run("should return error for zero amount", func(t *testing.T) {
+		input := &dtos.RecurringTransactionUpdateInput{Description: "Aluguel", CategoryID: "cat"}
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrAmountMustBePositive)
+	})
+}
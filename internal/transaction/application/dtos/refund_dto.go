@@ -0,0 +1,46 @@
+package dtos
+
+import (
+	"fmt"
+	"time"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// RefundInput is the request body for POST /api/v1/transactions/{id}/refunds. Without an
+// amount the whole amount still refundable is refunded.
+type RefundInput struct {
+	Amount     *float64 `json:"amount,omitempty" example:"49.90"`
+	Reason     string   `json:"reason,omitempty" example:"Produto devolvido"`
+	RefundDate string   `json:"refund_date,omitempty" example:"2026-03-20"` // YYYY-MM-DD, defaults to today
+}
+
+// Validate validates the RefundInput fields, returning the refund date.
+func (i *RefundInput) Validate(today time.Time) (time.Time, error) {
+	if i.Amount != nil && *i.Amount <= 0 {
+		return time.Time{}, fmt.Errorf("%w: amount must be positive", transactionDomain.ErrInvalidRefund)
+	}
+	day := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	if i.RefundDate == "" {
+		return day, nil
+	}
+	parsed, err := time.Parse("2006-01-02", i.RefundDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: refund_date must be in YYYY-MM-DD format", transactionDomain.ErrInvalidRefund)
+	}
+	if parsed.After(day) {
+		return time.Time{}, fmt.Errorf("%w: refund_date cannot be in the future", transactionDomain.ErrInvalidRefund)
+	}
+	return parsed, nil
+}
+
+// RefundOutput is a refund of a transaction. InvoiceID is the invoice that received the credit.
+type RefundOutput struct {
+	ID            string  `json:"id"`
+	TransactionID string  `json:"transaction_id"`
+	InvoiceID     string  `json:"invoice_id"`
+	Amount        float64 `json:"amount"`
+	Reason        string  `json:"reason,omitempty"`
+	RefundDate    string  `json:"refund_date"`
+	CreatedAt     string  `json:"created_at"`
+}
@@ -0,0 +1,44 @@
+package dtos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+func TestRefundInput_Validate(t *testing.T) {
+	today := time.Date(2026, 3, 31, 15, 4, 5, 0, time.UTC)
+	amount := 49.9
+
+	t.Run("should default the refund date to today", func(t *testing.T) {
+		date, err := (&dtos.RefundInput{Amount: &amount}).Validate(today)
+		require.NoError(t, err)
+		require.Equal(t, "2026-03-31", date.Format("2006-01-02"))
+	})
+
+	t.Run("should parse the refund date", func(t *testing.T) {
+		date, err := (&dtos.RefundInput{RefundDate: "2026-03-20"}).Validate(today)
+		require.NoError(t, err)
+		require.Equal(t, "2026-03-20", date.Format("2006-01-02"))
+	})
+
+	zero := 0.0
+	scenarios := []struct {
+		name  string
+		input dtos.RefundInput
+	}{
+		{name: "zero amount", input: dtos.RefundInput{Amount: &zero}},
+		{name: "invalid refund date", input: dtos.RefundInput{RefundDate: "20/03/2026"}},
+		{name: "future refund date", input: dtos.RefundInput{RefundDate: "2026-04-01"}},
+	}
+	for _, scenario := range scenarios {
+		t.Run("should return error for "+scenario.name, func(t *testing.T) {
+			_, err := scenario.input.Validate(today)
+			require.ErrorIs(t, err, transactionDomain.ErrInvalidRefund)
+		})
+	}
+}
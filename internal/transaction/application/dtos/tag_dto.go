@@ -0,0 +1,128 @@
+package dtos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// MaxTaggedTransactions is the maximum number of transactions tagged in one bulk request.
+const MaxTaggedTransactions = 100
+
+// TagInput is the request body for POST /api/v1/tags and PUT /api/v1/tags/{id}.
+type TagInput struct {
+	Name string `json:"name" example:"vacation-2026"`
+}
+
+// Validate validates the TagInput fields.
+func (i *TagInput) Validate() error {
+	_, err := entities.NormalizeTagName(i.Name)
+	return err
+}
+
+// TagOutput is the response for tag endpoints.
+type TagOutput struct {
+	ID        string  `json:"id"`
+	Name      string  `json:"name"`
+	CreatedAt string  `json:"created_at"`
+	UpdatedAt *string `json:"updated_at,omitempty"`
+}
+
+// TransactionTagOutput is a tag as shown in a transaction.
+type TransactionTagOutput struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// TagGroupSuggestion is returned when the tags of one installment changed and the other
+// installments of the purchase kept theirs. Repeating the request with
+// apply_tags_to_group tags the whole purchase.
+type TagGroupSuggestion struct {
+	InstallmentGroupID string `json:"installment_group_id"`
+	OtherInstallments  int    `json:"other_installments"`
+}
+
+// TagSummaryParams holds the optional period of GET /api/v1/tags/{id}/summary.
+type TagSummaryParams struct {
+	StartDate string
+	EndDate   string
+}
+
+// Validate validates the period, returning the parsed dates.
+func (p *TagSummaryParams) Validate() (*time.Time, *time.Time, error) {
+	var from, to *time.Time
+	if p.StartDate != "" {
+		parsed, err := time.Parse("2006-01-02", p.StartDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("start_date must be in YYYY-MM-DD format")
+		}
+		from = &parsed
+	}
+	if p.EndDate != "" {
+		parsed, err := time.Parse("2006-01-02", p.EndDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("end_date must be in YYYY-MM-DD format")
+		}
+		to = &parsed
+	}
+	if from != nil && to != nil && from.After(*to) {
+		return nil, nil, fmt.Errorf("start_date cannot be after end_date")
+	}
+	return from, to, nil
+}
+
+// TagSummaryOutput is the response for GET /api/v1/tags/{id}/summary.
+type TagSummaryOutput struct {
+	TagID            string  `json:"tag_id"`
+	Name             string  `json:"name"`
+	StartDate        *string `json:"start_date,omitempty"`
+	EndDate          *string `json:"end_date,omitempty"`
+	ExpenseTotal     float64 `json:"expense_total"`
+	IncomeTotal      float64 `json:"income_total"`
+	TransactionCount int     `json:"transaction_count"`
+}
+
+// ApplyTagsInput is the request body for POST /api/v1/transactions/tags. The tags are
+// added to the ones each transaction already has. ApplyToGroups extends the request to
+// every installment of the purchases the transactions belong to.
+type ApplyTagsInput struct {
+	TransactionIDs []string `json:"transaction_ids"`
+	TagIDs         []string `json:"tag_ids"`
+	ApplyToGroups  bool     `json:"apply_to_groups,omitempty"`
+}
+
+// Validate validates the ApplyTagsInput fields.
+func (i *ApplyTagsInput) Validate() error {
+	if len(i.TransactionIDs) == 0 {
+		return fmt.Errorf("%w: transaction_ids is required", transactionDomain.ErrInvalidTagging)
+	}
+	if len(i.TransactionIDs) > MaxTaggedTransactions {
+		return fmt.Errorf("%w: at most %d transactions can be tagged at once", transactionDomain.ErrInvalidTagging, MaxTaggedTransactions)
+	}
+	if len(i.TagIDs) == 0 {
+		return fmt.Errorf("%w: tag_ids is required", transactionDomain.ErrInvalidTagging)
+	}
+	return validateTagIDs(i.TagIDs)
+}
+
+// ApplyTagsOutput is the response for POST /api/v1/transactions/tags.
+type ApplyTagsOutput struct {
+	TaggedTransactions int `json:"tagged_transactions"`
+}
+
+// validateTagIDs checks the number of tags set at once; the IDs themselves are checked
+// against the catalog of the user.
+func validateTagIDs(tagIDs []string) error {
+	if len(tagIDs) > entities.MaxTagsPerRequest {
+		return fmt.Errorf("%w: at most %d tags can be set at once", transactionDomain.ErrInvalidTagging, entities.MaxTagsPerRequest)
+	}
+	for i, id := range tagIDs {
+		if strings.TrimSpace(id) == "" {
+			return fmt.Errorf("%w: tag_ids[%d] is empty", transactionDomain.ErrInvalidTagging, i)
+		}
+	}
+	return nil
+}
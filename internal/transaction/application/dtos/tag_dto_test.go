@@ -0,0 +1,74 @@
+package dtos_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+func TestTagInput_Validate(t *testing.T) {
+	require.NoError(t, (&dtos.TagInput{Name: "vacation-2026"}).Validate())
+	require.ErrorIs(t, (&dtos.TagInput{Name: " "}).Validate(), transactionDomain.ErrInvalidTagName)
+}
+
+func TestTagSummaryParams_Validate(t *testing.T) {
+	t.Run("should accept an open period", func(t *testing.T) {
+		from, to, err := (&dtos.TagSummaryParams{}).Validate()
+		require.NoError(t, err)
+		require.Nil(t, from)
+		require.Nil(t, to)
+	})
+
+	t.Run("should parse both dates", func(t *testing.T) {
+		from, to, err := (&dtos.TagSummaryParams{StartDate: "2026-01-01", EndDate: "2026-01-31"}).Validate()
+		require.NoError(t, err)
+		require.Equal(t, "2026-01-01", from.Format("2006-01-02"))
+		require.Equal(t, "2026-01-31", to.Format("2006-01-02"))
+	})
+
+	t.Run("should return error for an inverted period", func(t *testing.T) {
+		_, _, err := (&dtos.TagSummaryParams{StartDate: "2026-02-01", EndDate: "2026-01-31"}).Validate()
+		require.Error(t, err)
+	})
+
+	t.Run("should return error for an invalid date", func(t *testing.T) {
+		_, _, err := (&dtos.TagSummaryParams{EndDate: "31/01/2026"}).Validate()
+		require.Error(t, err)
+	})
+}
+
+func TestApplyTagsInput_Validate(t *testing.T) {
+	valid := func() *dtos.ApplyTagsInput {
+		return &dtos.ApplyTagsInput{
+			TransactionIDs: []string{"01965b87-b35a-7f18-a3b1-000000000001"},
+			TagIDs:         []string{"01965b87-b35a-7f18-a3b1-0000000000a1"},
+		}
+	}
+
+	t.Run("should pass with transactions and tags", func(t *testing.T) {
+		require.NoError(t, valid().Validate())
+	})
+
+	t.Run("should return error without transactions", func(t *testing.T) {
+		input := valid()
+		input.TransactionIDs = nil
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrInvalidTagging)
+	})
+
+	t.Run("should return error for too many transactions", func(t *testing.T) {
+		input := valid()
+		for range dtos.MaxTaggedTransactions {
+			input.TransactionIDs = append(input.TransactionIDs, "01965b87-b35a-7f18-a3b1-000000000001")
+		}
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrInvalidTagging)
+	})
+
+	t.Run("should return error without tags", func(t *testing.T) {
+		input := valid()
+		input.TagIDs = nil
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrInvalidTagging)
+	})
+}
@@ -2,10 +2,15 @@ package dtos
 
 import (
 	"fmt"
+	"math"
 	"strings"
 	"time"
 
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
 	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
 	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
 )
 
@@ -13,12 +18,31 @@ import (
 type TransactionInput struct {
 	Description     string  `json:"description"`
 	Amount          float64 `json:"amount"`
+	Direction       string  `json:"direction,omitempty" enums:"INCOME,EXPENSE"`
 	PaymentMethod   string  `json:"payment_method"`
 	TransactionDate string  `json:"transaction_date"`
 	CategoryID      string  `json:"category_id"`
 	SubcategoryID   string  `json:"subcategory_id,omitempty"`
 	CardID          string  `json:"card_id,omitempty"`
 	Installments    int     `json:"installments,omitempty"`
+	// Splits divides the amount across categories; category_id then defaults to the first split.
+	Splits []SplitInput `json:"splits,omitempty"`
+	// TagIDs are tags of the user's catalog; every installment of the purchase carries them.
+	TagIDs []string `json:"tag_ids,omitempty"`
+	// Currency is the currency of amount and splits when the purchase was made in a foreign
+	// currency. They are converted with the latest exchange rate up to transaction_date.
+	Currency string `json:"currency,omitempty" example:"USD"`
+	// IOFPercentage charges IOF on a foreign currency card purchase, billed as a separate invoice item.
+	IOFPercentage *float64 `json:"iof_percentage,omitempty" example:"3.5"`
+	// ExternalID identifies the entry in an imported statement; it is never read from the API body.
+	ExternalID string `json:"-"`
+}
+
+// SplitInput is the share of a transaction amount assigned to one category.
+type SplitInput struct {
+	CategoryID    string  `json:"category_id"`
+	SubcategoryID string  `json:"subcategory_id,omitempty"`
+	Amount        float64 `json:"amount"`
 }
 
 // Validate validates the TransactionInput fields.
@@ -33,6 +57,13 @@ func (i *TransactionInput) Validate() error {
 	if err != nil {
 		return transactionDomain.ErrInvalidPaymentMethod
 	}
+	direction := transactionVos.DirectionExpense
+	if i.Direction != "" {
+		direction, err = transactionVos.NewTransactionDirection(i.Direction)
+		if err != nil {
+			return transactionDomain.ErrInvalidDirection
+		}
+	}
 	if i.TransactionDate == "" {
 		return fmt.Errorf("transaction_date is required")
 	}
@@ -40,12 +71,20 @@ func (i *TransactionInput) Validate() error {
 	if err != nil {
 		return fmt.Errorf("transaction_date must be in YYYY-MM-DD format")
 	}
-	if parsed.After(time.Now().UTC().Truncate(24 * time.Hour)) {
+	// A future date schedules the transaction; card purchases are billed on their
+	// invoice and cannot be scheduled.
+	if pm.RequiresCard() && parsed.After(time.Now().UTC().Truncate(24*time.Hour)) {
 		return transactionDomain.ErrTransactionDateFuture
 	}
-	if i.CategoryID == "" {
+	if i.CategoryID == "" && len(i.Splits) == 0 {
 		return fmt.Errorf("category_id is required")
 	}
+	if err := validateSplits(i.Amount, i.Splits); err != nil {
+		return err
+	}
+	if err := validateTagIDs(i.TagIDs); err != nil {
+		return err
+	}
 	if pm.RequiresCard() && strings.TrimSpace(i.CardID) == "" {
 		return transactionDomain.ErrCardRequiredForCredit
 	}
@@ -56,12 +95,42 @@ func (i *TransactionInput) Validate() error {
 	if installments == 0 {
 		installments = 1
 	}
+	if direction.IsIncome() && pm.IsCredit() {
+		return transactionDomain.ErrIncomeNotAllowedForCredit
+	}
+	if direction.IsIncome() && installments > 1 {
+		return transactionDomain.ErrIncomeInstallments
+	}
 	if !pm.IsCredit() && installments > 1 {
 		return transactionDomain.ErrInstallmentsOnlyForCredit
 	}
 	if pm.IsCredit() && installments > 48 {
 		return transactionDomain.ErrInstallmentsTooMany
 	}
+	return i.validateForeignAmount(pm, installments)
+}
+
+// validateForeignAmount checks the currency and the IOF of a foreign currency purchase.
+// Foreign purchases are paid at once, and IOF only applies to them when paid by credit card.
+func (i *TransactionInput) validateForeignAmount(pm transactionVos.PaymentMethod, installments int) error {
+	if i.Currency != "" {
+		if _, err := vos.NewCurrency(i.Currency); err != nil {
+			return fmt.Errorf("%w: unsupported currency %q", transactionDomain.ErrInvalidForeignAmount, i.Currency)
+		}
+	}
+	foreign := factories.IsForeignCurrency(i.Currency)
+	if foreign && installments > 1 {
+		return fmt.Errorf("%w: foreign currency purchases cannot be paid in installments", transactionDomain.ErrInvalidForeignAmount)
+	}
+	if i.IOFPercentage == nil {
+		return nil
+	}
+	if !foreign || !pm.IsCredit() {
+		return fmt.Errorf("%w: iof_percentage only applies to foreign currency credit card purchases", transactionDomain.ErrInvalidForeignAmount)
+	}
+	if *i.IOFPercentage <= 0 || *i.IOFPercentage > factories.MaxIOFPercentage {
+		return fmt.Errorf("%w: iof_percentage must be greater than 0 and at most %.0f", transactionDomain.ErrInvalidForeignAmount, factories.MaxIOFPercentage)
+	}
 	return nil
 }
 
@@ -71,6 +140,12 @@ type TransactionUpdateInput struct {
 	Amount        float64 `json:"amount"`
 	CategoryID    string  `json:"category_id"`
 	SubcategoryID string  `json:"subcategory_id,omitempty"`
+	// Splits replaces the current splits; omitting it leaves the whole amount in category_id.
+	Splits []SplitInput `json:"splits,omitempty"`
+	// TagIDs replaces the current tags; omitting it keeps them and an empty list removes them.
+	TagIDs *[]string `json:"tag_ids,omitempty"`
+	// ApplyTagsToGroup sets the same tags on the other installments of the purchase.
+	ApplyTagsToGroup bool `json:"apply_tags_to_group,omitempty"`
 }
 
 // Validate validates the TransactionUpdateInput fields.
@@ -81,9 +156,52 @@ func (i *TransactionUpdateInput) Validate() error {
 	if i.Amount <= 0 {
 		return transactionDomain.ErrAmountMustBePositive
 	}
-	if strings.TrimSpace(i.CategoryID) == "" {
+	if strings.TrimSpace(i.CategoryID) == "" && len(i.Splits) == 0 {
 		return fmt.Errorf("category_id is required")
 	}
+	if err := validateSplits(i.Amount, i.Splits); err != nil {
+		return err
+	}
+	if i.TagIDs == nil {
+		if i.ApplyTagsToGroup {
+			return fmt.Errorf("%w: apply_tags_to_group requires tag_ids", transactionDomain.ErrInvalidTagging)
+		}
+		return nil
+	}
+	return validateTagIDs(*i.TagIDs)
+}
+
+// validateSplits checks that a split purchase has at least two shares in distinct
+// categories, all positive and summing to the amount.
+func validateSplits(amount float64, splits []SplitInput) error {
+	if len(splits) == 0 {
+		return nil
+	}
+	if len(splits) < 2 {
+		return fmt.Errorf("%w: at least two splits are required", transactionDomain.ErrInvalidSplits)
+	}
+	if len(splits) > entities.MaxSplits {
+		return fmt.Errorf("%w: a transaction can have at most %d splits", transactionDomain.ErrInvalidSplits, entities.MaxSplits)
+	}
+	seen := make(map[string]bool, len(splits))
+	var sum int64
+	for i, split := range splits {
+		if strings.TrimSpace(split.CategoryID) == "" {
+			return fmt.Errorf("%w: splits[%d].category_id is required", transactionDomain.ErrInvalidSplits, i)
+		}
+		if split.Amount <= 0 {
+			return fmt.Errorf("%w: splits[%d].amount must be positive", transactionDomain.ErrInvalidSplits, i)
+		}
+		key := split.CategoryID + "|" + split.SubcategoryID
+		if seen[key] {
+			return fmt.Errorf("%w: splits[%d] repeats a category", transactionDomain.ErrInvalidSplits, i)
+		}
+		seen[key] = true
+		sum += int64(math.Round(split.Amount * 100))
+	}
+	if sum != int64(math.Round(amount*100)) {
+		return transactionDomain.ErrSplitsTotalMismatch
+	}
 	return nil
 }
 
@@ -98,12 +216,42 @@ type TransactionOutput struct {
 	InstallmentGroupID *string `json:"installment_group_id,omitempty"`
 	Description        string  `json:"description"`
 	Amount             float64 `json:"amount"`
+	Direction          string  `json:"direction"`
 	PaymentMethod      string  `json:"payment_method"`
 	TransactionDate    string  `json:"transaction_date"`
 	InstallmentNumber  *int    `json:"installment_number,omitempty"`
 	InstallmentTotal   *int    `json:"installment_total,omitempty"`
 	Status             string  `json:"status"`
-	CreatedAt          string  `json:"created_at"`
+	OverLimit          bool    `json:"over_limit,omitempty"`
+	// OriginalCurrency and OriginalAmount are what a foreign currency purchase cost, converted
+	// to amount with ExchangeRate. IOFAmount is billed on the invoice as an item of its own.
+	OriginalCurrency string   `json:"original_currency,omitempty"`
+	OriginalAmount   *float64 `json:"original_amount,omitempty"`
+	ExchangeRate     *float64 `json:"exchange_rate,omitempty"`
+	IOFAmount        *float64 `json:"iof_amount,omitempty"`
+	// CategorizationRuleID is the rule that set the category of a transaction created without one.
+	CategorizationRuleID string `json:"categorization_rule_id,omitempty"`
+	// PossibleDuplicateOf warns, on creation, of existing transactions the new one probably repeats.
+	PossibleDuplicateOf []string `json:"possible_duplicate_of,omitempty"`
+	CreatedAt           string   `json:"created_at"`
+	// Version is also sent as the ETag header and is what If-Match must carry on updates.
+	Version int `json:"version"`
+
+	Splits []*SplitOutput          `json:"splits,omitempty"`
+	Tags   []*TransactionTagOutput `json:"tags,omitempty"`
+
+	// RefundedAmount and Refunds show the refund history of a billed card purchase.
+	RefundedAmount float64         `json:"refunded_amount,omitempty"`
+	Refunds        []*RefundOutput `json:"refunds,omitempty"`
+
+	TagGroupSuggestion *TagGroupSuggestion `json:"tag_group_suggestion,omitempty"`
+}
+
+// SplitOutput is the share of a transaction in one category.
+type SplitOutput struct {
+	CategoryID    string  `json:"category_id"`
+	SubcategoryID *string `json:"subcategory_id,omitempty"`
+	Amount        float64 `json:"amount"`
 }
 
 // TransactionListOutput is the paginated response for GET /api/v1/transactions.
@@ -118,12 +266,25 @@ type ReverseOutput struct {
 	Kept      []*TransactionOutput `json:"kept"`
 }
 
-// ListParams holds the filtering and pagination parameters for listing transactions.
+// ListParams holds the filtering, sorting and pagination parameters for listing
+// transactions, as read from the query string.
 type ListParams struct {
-	PaymentMethod string
-	CategoryID    string
-	StartDate     string
-	EndDate       string
-	Limit         int
-	Cursor        string
+	PaymentMethod      string
+	CategoryID         string
+	SubcategoryID      string
+	CardID             string
+	InvoiceID          string
+	InstallmentGroupID string
+	TagIDs             string
+	Direction          string
+	Status             string
+	MinAmount          string
+	MaxAmount          string
+	Search             string
+	StartDate          string
+	EndDate            string
+	SortBy             string
+	SortOrder          string
+	Limit              int
+	Cursor             string
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 )
 
 func validPixInput() *dtos.TransactionInput {
@@ -55,11 +57,20 @@ func TestTransactionInput_Validate(t *testing.T) {
 		require.Error(t, err)
 	})
 
-	t.Run("should return error for transaction_date in future", func(t *testing.T) {
+	t.Run("should accept a future transaction_date for pix", func(t *testing.T) {
 		input := validPixInput()
 		input.TransactionDate = time.Now().Add(48 * time.Hour).Format("2006-01-02")
 		err := input.Validate()
-		require.Error(t, err)
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error for credit with transaction_date in future", func(t *testing.T) {
+		input := validPixInput()
+		input.PaymentMethod = "credit"
+		input.CardID = "01965b87-b35a-7f18-a3b1-000000000003"
+		input.TransactionDate = time.Now().Add(48 * time.Hour).Format("2006-01-02")
+		err := input.Validate()
+		require.ErrorIs(t, err, transactionDomain.ErrTransactionDateFuture)
 	})
 
 	t.Run("should return error for amount = 0", func(t *testing.T) {
@@ -91,4 +102,222 @@ func TestTransactionInput_Validate(t *testing.T) {
 		err := input.Validate()
 		require.NoError(t, err)
 	})
+
+	t.Run("should pass for income via pix", func(t *testing.T) {
+		input := validPixInput()
+		input.Direction = "INCOME"
+		err := input.Validate()
+		require.NoError(t, err)
+	})
+
+	t.Run("should return error for invalid direction", func(t *testing.T) {
+		input := validPixInput()
+		input.Direction = "SIDEWAYS"
+		err := input.Validate()
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidDirection)
+	})
+
+	t.Run("should return error for income with credit", func(t *testing.T) {
+		input := validPixInput()
+		input.Direction = "INCOME"
+		input.PaymentMethod = "credit"
+		input.CardID = "01965b87-b35a-7f18-a3b1-000000000003"
+		err := input.Validate()
+		require.ErrorIs(t, err, transactionDomain.ErrIncomeNotAllowedForCredit)
+	})
+	t.Run("should accept splits in place of category_id", func(t *testing.T) {
+		input := validPixInput()
+		input.CategoryID = ""
+		input.Splits = []dtos.SplitInput{
+			{CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", Amount: 70.10},
+			{CategoryID: "01965b87-b35a-7f18-a3b1-000000000002", Amount: 29.90},
+		}
+		require.NoError(t, input.Validate())
+	})
+
+	t.Run("should return error for invalid splits", func(t *testing.T) {
+		scenarios := []struct {
+			name   string
+			splits []dtos.SplitInput
+			err    error
+		}{
+			{
+				name:   "single split",
+				splits: []dtos.SplitInput{{CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", Amount: 100}},
+				err:    transactionDomain.ErrInvalidSplits,
+			},
+			{
+				name: "missing category",
+				splits: []dtos.SplitInput{
+					{CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", Amount: 50},
+					{Amount: 50},
+				},
+				err: transactionDomain.ErrInvalidSplits,
+			},
+			{
+				name: "repeated category",
+				splits: []dtos.SplitInput{
+					{CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", Amount: 50},
+					{CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", Amount: 50},
+				},
+				err: transactionDomain.ErrInvalidSplits,
+			},
+			{
+				name: "negative amount",
+				splits: []dtos.SplitInput{
+					{CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", Amount: 110},
+					{CategoryID: "01965b87-b35a-7f18-a3b1-000000000002", Amount: -10},
+				},
+				err: transactionDomain.ErrInvalidSplits,
+			},
+			{
+				name: "sum differs from the amount",
+				splits: []dtos.SplitInput{
+					{CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", Amount: 50},
+					{CategoryID: "01965b87-b35a-7f18-a3b1-000000000002", Amount: 49.99},
+				},
+				err: transactionDomain.ErrSplitsTotalMismatch,
+			},
+		}
+		for _, scenario := range scenarios {
+			t.Run(scenario.name, func(t *testing.T) {
+				input := validPixInput()
+				input.Splits = scenario.splits
+				require.ErrorIs(t, input.Validate(), scenario.err)
+			})
+		}
+	})
+}
+
+func TestTransactionInput_ValidateForeignAmount(t *testing.T) {
+	creditInput := func() *dtos.TransactionInput {
+		input := validPixInput()
+		input.PaymentMethod = "credit"
+		input.CardID = "01965b87-b35a-7f18-a3b1-000000000003"
+		input.Currency = "usd"
+		return input
+	}
+	iof := func(value float64) *float64 { return &value }
+
+	t.Run("should accept a foreign currency card purchase with iof", func(t *testing.T) {
+		input := creditInput()
+		input.IOFPercentage = iof(3.5)
+		require.NoError(t, input.Validate())
+	})
+
+	t.Run("should accept a foreign currency pix without iof", func(t *testing.T) {
+		input := validPixInput()
+		input.Currency = "EUR"
+		require.NoError(t, input.Validate())
+	})
+
+	scenarios := []struct {
+		name  string
+		input func() *dtos.TransactionInput
+	}{
+		{
+			name: "should reject an unsupported currency",
+			input: func() *dtos.TransactionInput {
+				input := creditInput()
+				input.Currency = "XYZ"
+				return input
+			},
+		},
+		{
+			name: "should reject installments",
+			input: func() *dtos.TransactionInput {
+				input := creditInput()
+				input.Installments = 3
+				return input
+			},
+		},
+		{
+			name: "should reject iof without a foreign currency",
+			input: func() *dtos.TransactionInput {
+				input := creditInput()
+				input.Currency = "BRL"
+				input.IOFPercentage = iof(3.5)
+				return input
+			},
+		},
+		{
+			name: "should reject iof outside credit card purchases",
+			input: func() *dtos.TransactionInput {
+				input := validPixInput()
+				input.Currency = "USD"
+				input.IOFPercentage = iof(3.5)
+				return input
+			},
+		},
+		{
+			name: "should reject an iof percentage out of range",
+			input: func() *dtos.TransactionInput {
+				input := creditInput()
+				input.IOFPercentage = iof(30)
+				return input
+			},
+		},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			require.ErrorIs(t, scenario.input().Validate(), transactionDomain.ErrInvalidForeignAmount)
+		})
+	}
+}
+
+func TestTransactionUpdateInput_Validate(t *testing.T) {
+	t.Run("should require category_id when there are no splits", func(t *testing.T) {
+		input := &dtos.TransactionUpdateInput{Description: "Supermercado", Amount: 100}
+		require.Error(t, input.Validate())
+	})
+
+	t.Run("should check the splits against the amount", func(t *testing.T) {
+		input := &dtos.TransactionUpdateInput{
+			Description: "Supermercado",
+			Amount:      100,
+			Splits: []dtos.SplitInput{
+				{CategoryID: "01965b87-b35a-7f18-a3b1-000000000001", Amount: 60},
+				{CategoryID: "01965b87-b35a-7f18-a3b1-000000000002", Amount: 30},
+			},
+		}
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrSplitsTotalMismatch)
+
+		input.Splits[1].Amount = 40
+		require.NoError(t, input.Validate())
+	})
+
+	t.Run("should only apply tags to the group when tag_ids is sent", func(t *testing.T) {
+		input := &dtos.TransactionUpdateInput{
+			Description:      "Passagem",
+			Amount:           100,
+			CategoryID:       "01965b87-b35a-7f18-a3b1-000000000001",
+			ApplyTagsToGroup: true,
+		}
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrInvalidTagging)
+
+		input.TagIDs = &[]string{}
+		require.NoError(t, input.Validate())
+	})
+}
+
+func TestTransactionInput_ValidateTags(t *testing.T) {
+	t.Run("should accept tags", func(t *testing.T) {
+		input := validPixInput()
+		input.TagIDs = []string{"01965b87-b35a-7f18-a3b1-0000000000a1"}
+		require.NoError(t, input.Validate())
+	})
+
+	t.Run("should return error for too many tags", func(t *testing.T) {
+		input := validPixInput()
+		for range entities.MaxTagsPerRequest + 1 {
+			input.TagIDs = append(input.TagIDs, "01965b87-b35a-7f18-a3b1-0000000000a1")
+		}
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrInvalidTagging)
+	})
+
+	t.Run("should return error for a blank tag", func(t *testing.T) {
+		input := validPixInput()
+		input.TagIDs = []string{" "}
+		require.ErrorIs(t, input.Validate(), transactionDomain.ErrInvalidTagging)
+	})
 }
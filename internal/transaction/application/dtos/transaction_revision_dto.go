@@ -0,0 +1,19 @@
+package dtos
+
+// TransactionRevisionOutput is one entry of the history of a transaction. ChangedBy is
+// the user who made the change, omitted when it was made by the worker.
+type TransactionRevisionOutput struct {
+	ID        string              `json:"id"`
+	Action    string              `json:"action"`
+	ChangedBy *string             `json:"changed_by,omitempty"`
+	Changes   []FieldChangeOutput `json:"changes"`
+	CreatedAt string              `json:"created_at"`
+}
+
+// FieldChangeOutput is the change of one field. From is null on creation and whenever
+// the field was unset.
+type FieldChangeOutput struct {
+	Field string  `json:"field"`
+	From  *string `json:"from"`
+	To    *string `json:"to"`
+}
@@ -0,0 +1,321 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+type (
+	ApplyCategorizationRulesUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.ApplyCategorizationRulesInput) (*dtos.ApplyCategorizationRulesOutput, error)
+	}
+
+	applyCategorizationRulesUseCase struct {
+		o11y               observability.Observability
+		uow                uow.UnitOfWork
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+		ruleRepository     transactionInterfaces.CategorizationRuleRepository
+		invoiceProvider    transactionInterfaces.InvoiceProvider
+		outboxService      outbox.Service
+	}
+
+	// recategorization is a transaction changed by a rule, with what is needed to persist it.
+	recategorization struct {
+		transaction *entities.Transaction
+		rule        *entities.CategorizationRule
+		totalAmount vos.Money
+		previous    events.TransactionSnapshot
+		before      map[vos.UUID]entities.TransactionState
+		moved       bool
+		addedTags   []vos.UUID
+	}
+)
+
+// NewApplyCategorizationRulesUseCase creates a new ApplyCategorizationRulesUseCase.
+func NewApplyCategorizationRulesUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	ruleRepository transactionInterfaces.CategorizationRuleRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	outboxService outbox.Service,
+) ApplyCategorizationRulesUseCase {
+	return &applyCategorizationRulesUseCase{
+		o11y:               o11y,
+		uow:                unitOfWork,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		ruleRepository:     ruleRepository,
+		invoiceProvider:    invoiceProvider,
+		outboxService:      outboxService,
+	}
+}
+
+// Execute re-applies the rules of the user to the active transactions of the period.
+// The first matching rule sets the category and subcategory and adds its tags; split
+// transactions keep their shares and are not evaluated. Installments are matched by
+// their purchase total. Transactions on closed or paid invoices are counted as locked
+// and left untouched. Every change is persisted in a single unit of work, emitting
+// transaction.updated for the transactions that moved to another category.
+func (u *applyCategorizationRulesUseCase) Execute(ctx context.Context, userID string, input *dtos.ApplyCategorizationRulesInput) (*dtos.ApplyCategorizationRulesOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "apply_categorization_rules_usecase.execute")
+	defer span.End()
+
+	from, to, err := input.Validate()
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	output := &dtos.ApplyCategorizationRulesOutput{
+		DryRun:       input.DryRun,
+		Transactions: make([]*dtos.RecategorizedTransaction, 0),
+	}
+
+	rules, err := u.ruleRepository.ListByUser(ctx, userUUID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return output, nil
+	}
+
+	transactions, err := u.repository.ListByDateRange(ctx, userUUID, from, to)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	changes, err := u.evaluate(ctx, rules, transactions, output)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if !input.DryRun && len(changes) > 0 {
+		if err := u.persist(ctx, changes); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ApplyCategorizationRules"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+		observability.Int("evaluated", output.Evaluated),
+		observability.Int("recategorized", output.Recategorized),
+	)
+
+	return output, nil
+}
+
+// evaluate matches the transactions against the rules, applying the outcomes in memory
+// and filling the counters of the output.
+func (u *applyCategorizationRulesUseCase) evaluate(
+	ctx context.Context,
+	rules []*entities.CategorizationRule,
+	transactions []*entities.Transaction,
+	output *dtos.ApplyCategorizationRulesOutput,
+) ([]*recategorization, error) {
+	totals, err := purchaseTotals(transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]string)
+	changes := make([]*recategorization, 0)
+	for _, t := range transactions {
+		if t.IsSplit() {
+			continue
+		}
+		output.Evaluated++
+
+		total := totals[purchaseKey(t)]
+		matched := entities.MatchingCategorizationRules(rules, newCategorizationSubject(t.Description, total.Float(), t.PaymentMethod.String(), optionalUUIDString(t.CardID)))
+		if len(matched) == 0 {
+			continue
+		}
+		output.Matched++
+
+		rule := matched[0]
+		moved := t.CategoryID.String() != rule.Outcome.CategoryID.String() ||
+			optionalUUIDString(t.SubcategoryID) != optionalUUIDString(rule.Outcome.SubcategoryID)
+		addedTags := missingTags(t, rule.Outcome.TagIDs)
+		if !moved && len(addedTags) == 0 {
+			continue
+		}
+
+		if t.InvoiceID != nil {
+			status, ok := statuses[t.InvoiceID.String()]
+			if !ok {
+				status, err = u.invoiceProvider.GetStatus(ctx, *t.InvoiceID)
+				if err != nil {
+					return nil, err
+				}
+				statuses[t.InvoiceID.String()] = status
+			}
+			if !t.IsEditable(status) {
+				output.Locked++
+				continue
+			}
+		}
+
+		change := &recategorization{
+			transaction: t,
+			rule:        rule,
+			totalAmount: total,
+			previous:    toTransactionSnapshot(t),
+			before:      statesOf(t),
+			moved:       moved,
+			addedTags:   addedTags,
+		}
+		previousCategoryID := t.CategoryID.String()
+		if moved {
+			t.Recategorize(rule.Outcome.CategoryID, rule.Outcome.SubcategoryID)
+		}
+		changes = append(changes, change)
+
+		recategorized := &dtos.RecategorizedTransaction{
+			TransactionID:      t.ID.String(),
+			RuleID:             rule.ID.String(),
+			PreviousCategoryID: previousCategoryID,
+			CategoryID:         rule.Outcome.CategoryID.String(),
+		}
+		if rule.Outcome.SubcategoryID != nil {
+			subcategoryID := rule.Outcome.SubcategoryID.String()
+			recategorized.SubcategoryID = &subcategoryID
+		}
+		for _, tagID := range addedTags {
+			recategorized.AddedTagIDs = append(recategorized.AddedTagIDs, tagID.String())
+		}
+		output.Transactions = append(output.Transactions, recategorized)
+		output.Recategorized++
+	}
+	return changes, nil
+}
+
+// persist saves the changes, their invoice items, tags and events in one unit of work.
+func (u *applyCategorizationRulesUseCase) persist(ctx context.Context, changes []*recategorization) error {
+	return u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		for _, change := range changes {
+			t := change.transaction
+			if len(change.addedTags) > 0 {
+				if err := u.repository.AddTags(ctx, tx, []vos.UUID{t.ID}, change.addedTags); err != nil {
+					return err
+				}
+			}
+			if !change.moved {
+				continue
+			}
+
+			if err := u.repository.Update(ctx, tx, t); err != nil {
+				return err
+			}
+			if err := saveRevisions(ctx, tx, u.revisionRepository, &t.UserID, change.before, t); err != nil {
+				return err
+			}
+			if t.InvoiceID != nil {
+				if err := u.invoiceProvider.UpdateItem(ctx, tx, toInvoiceItem(t, change.totalAmount)); err != nil {
+					return err
+				}
+			}
+			event := events.NewTransactionUpdatedEvent(
+				t.ID,
+				t.UserID,
+				change.previous,
+				toTransactionSnapshot(t),
+				*t.UpdatedAt,
+			)
+			aggregateID, _ := uuid.Parse(t.ID.String())
+			if err := u.outboxService.SaveDomainEvent(
+				ctx,
+				tx,
+				aggregateID,
+				"transaction",
+				event.EventType(),
+				outbox.JSONBPayload(event.Payload()),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// purchaseTotals sums the listed installments of each purchase. Installments share the
+// purchase date, so a period holds either all the active installments or none of them.
+func purchaseTotals(transactions []*entities.Transaction) (map[string]vos.Money, error) {
+	totals := make(map[string]vos.Money, len(transactions))
+	for _, t := range transactions {
+		key := purchaseKey(t)
+		total, ok := totals[key]
+		if !ok {
+			totals[key] = t.Amount
+			continue
+		}
+		sum, err := total.Add(t.Amount)
+		if err != nil {
+			return nil, err
+		}
+		totals[key] = sum
+	}
+	return totals, nil
+}
+
+func purchaseKey(t *entities.Transaction) string {
+	if t.InstallmentGroupID != nil {
+		return t.InstallmentGroupID.String()
+	}
+	return t.ID.String()
+}
+
+// missingTags returns the tags the transaction does not carry yet.
+func missingTags(t *entities.Transaction, tagIDs []vos.UUID) []vos.UUID {
+	missing := make([]vos.UUID, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		if !slices.ContainsFunc(t.Tags, func(tag *entities.Tag) bool { return tag.ID.String() == tagID.String() }) {
+			missing = append(missing, tagID)
+		}
+	}
+	return missing
+}
+
+func toTransactionSnapshot(t *entities.Transaction) events.TransactionSnapshot {
+	return events.TransactionSnapshot{
+		CategoryID:     t.CategoryID,
+		Amount:         t.Amount,
+		ReferenceMonth: resolveReferenceMonth(t, t.TransactionDate),
+		Splits:         toSplitSnapshots(t),
+	}
+}
+
+func optionalUUIDString(id *vos.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
@@ -0,0 +1,183 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type ApplyCategorizationRulesUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.TransactionRepository
+	revisionRepo    *transactionMocks.TransactionRevisionRepository
+	ruleRepo        *transactionMocks.CategorizationRuleRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+	outboxService   *outboxMocks.Service
+}
+
+func TestApplyCategorizationRulesUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ApplyCategorizationRulesUseCaseSuite))
+}
+
+func (s *ApplyCategorizationRulesUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.ruleRepo = transactionMocks.NewCategorizationRuleRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func (s *ApplyCategorizationRulesUseCaseSuite) useCase() ApplyCategorizationRulesUseCase {
+	return NewApplyCategorizationRulesUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.ruleRepo, s.invoiceProvider, s.outboxService)
+}
+
+func (s *ApplyCategorizationRulesUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	otherCategoryID := "550e8400-e29b-41d4-a716-446655440001"
+	transportCategoryID := "550e8400-e29b-41d4-a716-446655440002"
+	transportTag := buildTag(userID, "transport")
+	uberRule := buildCategorizationRule(userID, transportCategoryID, 0, entities.CategorizationRuleConditions{DescriptionContains: "uber"}, transportTag)
+	input := &dtos.ApplyCategorizationRulesInput{StartDate: "2026-03-01", EndDate: "2026-03-31"}
+
+	buildUberTransaction := func(categoryID string, invoiceID *vos.UUID) *entities.Transaction {
+		t := buildTransaction(userID, categoryID, invoiceID)
+		t.Description = "UBER *TRIP"
+		return t
+	}
+
+	s.Run("should move matching transactions to the rule category and add its tags", func() {
+		uber := buildUberTransaction(otherCategoryID, nil)
+		other := buildTransaction(userID, otherCategoryID, nil)
+		s.ruleRepo.EXPECT().ListByUser(mock.Anything, userUUID).Return([]*entities.CategorizationRule{uberRule}, nil).Once()
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID, mock.Anything, mock.Anything).Return([]*entities.Transaction{uber, other}, nil).Once()
+		s.repo.EXPECT().AddTags(mock.Anything, mock.Anything, []vos.UUID{uber.ID}, []vos.UUID{transportTag.ID}).Return(nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.MatchedBy(func(t *entities.Transaction) bool {
+			return t.ID == uber.ID && t.CategoryID.String() == transportCategoryID
+		})).Return(nil).Once()
+		s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.updated", mock.Anything).Return(nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, input)
+
+		s.NoError(err)
+		s.Equal(2, output.Evaluated)
+		s.Equal(1, output.Matched)
+		s.Equal(1, output.Recategorized)
+		s.Equal(otherCategoryID, output.Transactions[0].PreviousCategoryID)
+		s.Equal([]string{transportTag.ID.String()}, output.Transactions[0].AddedTagIDs)
+	})
+
+	s.Run("should match installments by the purchase total and update their invoice items", func() {
+		invoiceID, _ := vos.NewUUID()
+		groupID, _ := vos.NewUUID()
+		first := buildTransaction(userID, otherCategoryID, &invoiceID)
+		second := buildTransaction(userID, otherCategoryID, &invoiceID)
+		first.InstallmentGroupID, second.InstallmentGroupID = &groupID, &groupID
+		bigPurchases := buildCategorizationRule(userID, transportCategoryID, 0, entities.CategorizationRuleConditions{MinAmount: mustMoney(150)})
+		s.ruleRepo.EXPECT().ListByUser(mock.Anything, userUUID).Return([]*entities.CategorizationRule{bigPurchases}, nil).Once()
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID, mock.Anything, mock.Anything).Return([]*entities.Transaction{first, second}, nil).Once()
+		s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invoiceID).Return("open", nil).Once()
+		s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+		s.invoiceProvider.EXPECT().UpdateItem(mock.Anything, mock.Anything, mock.MatchedBy(func(item transactionInterfaces.InvoiceItemInfo) bool {
+			return item.CategoryID.String() == transportCategoryID && item.TotalAmount.Float() == 200
+		})).Return(nil).Times(2)
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+
+		output, err := s.useCase().Execute(s.ctx, userID, input)
+
+		s.NoError(err)
+		s.Equal(2, output.Recategorized)
+	})
+
+	s.Run("should leave transactions on closed invoices untouched", func() {
+		invoiceID, _ := vos.NewUUID()
+		uber := buildUberTransaction(otherCategoryID, &invoiceID)
+		s.ruleRepo.EXPECT().ListByUser(mock.Anything, userUUID).Return([]*entities.CategorizationRule{uberRule}, nil).Once()
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID, mock.Anything, mock.Anything).Return([]*entities.Transaction{uber}, nil).Once()
+		s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invoiceID).Return("closed", nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, input)
+
+		s.NoError(err)
+		s.Equal(1, output.Matched)
+		s.Equal(1, output.Locked)
+		s.Zero(output.Recategorized)
+		s.Equal(otherCategoryID, uber.CategoryID.String())
+	})
+
+	s.Run("should skip split transactions and transactions already matching the rule", func() {
+		split := buildUberTransaction(otherCategoryID, nil)
+		split.Splits = []*entities.TransactionSplit{{}}
+		done := buildUberTransaction(transportCategoryID, nil)
+		done.SetTags([]*entities.Tag{transportTag})
+		s.ruleRepo.EXPECT().ListByUser(mock.Anything, userUUID).Return([]*entities.CategorizationRule{uberRule}, nil).Once()
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID, mock.Anything, mock.Anything).Return([]*entities.Transaction{split, done}, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, input)
+
+		s.NoError(err)
+		s.Equal(1, output.Evaluated)
+		s.Equal(1, output.Matched)
+		s.Zero(output.Recategorized)
+	})
+
+	s.Run("should report the changes without persisting them on a dry run", func() {
+		uber := buildUberTransaction(otherCategoryID, nil)
+		s.ruleRepo.EXPECT().ListByUser(mock.Anything, userUUID).Return([]*entities.CategorizationRule{uberRule}, nil).Once()
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID, mock.Anything, mock.Anything).Return([]*entities.Transaction{uber}, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.ApplyCategorizationRulesInput{StartDate: "2026-03-01", EndDate: "2026-03-31", DryRun: true})
+
+		s.NoError(err)
+		s.True(output.DryRun)
+		s.Equal(1, output.Recategorized)
+	})
+
+	s.Run("should not list transactions when the user has no rules", func() {
+		s.ruleRepo.EXPECT().ListByUser(mock.Anything, userUUID).Return([]*entities.CategorizationRule{}, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, input)
+
+		s.NoError(err)
+		s.Zero(output.Evaluated)
+		s.Empty(output.Transactions)
+	})
+
+	s.Run("should reject a period longer than the limit", func() {
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.ApplyCategorizationRulesInput{StartDate: "2025-01-01", EndDate: "2026-03-31"})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidCategorizationRule)
+		s.Nil(output)
+	})
+
+	s.Run("should return repository error", func() {
+		uber := buildUberTransaction(otherCategoryID, nil)
+		s.ruleRepo.EXPECT().ListByUser(mock.Anything, userUUID).Return([]*entities.CategorizationRule{uberRule}, nil).Once()
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID, mock.Anything, mock.Anything).Return([]*entities.Transaction{uber}, nil).Once()
+		s.repo.EXPECT().AddTags(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, input)
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
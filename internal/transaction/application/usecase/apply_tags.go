@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ApplyTagsUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.ApplyTagsInput) (*dtos.ApplyTagsOutput, error)
+	}
+
+	applyTagsUseCase struct {
+		o11y          observability.Observability
+		uow           uow.UnitOfWork
+		repository    transactionInterfaces.TransactionRepository
+		tagRepository transactionInterfaces.TagRepository
+	}
+)
+
+// NewApplyTagsUseCase creates a new ApplyTagsUseCase.
+func NewApplyTagsUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	tagRepository transactionInterfaces.TagRepository,
+) ApplyTagsUseCase {
+	return &applyTagsUseCase{o11y: o11y, uow: unitOfWork, repository: repository, tagRepository: tagRepository}
+}
+
+// Execute adds the tags to every transaction of the request, all or nothing. Tags only
+// label transactions, so installments on closed or paid invoices can be tagged as well.
+func (u *applyTagsUseCase) Execute(ctx context.Context, userID string, input *dtos.ApplyTagsInput) (*dtos.ApplyTagsOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "apply_tags_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	tags, err := findOwnedTags(ctx, u.tagRepository, userUUID, input.TagIDs)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	transactionIDs, err := u.resolveTransactions(ctx, userID, input)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tagIDs := make([]vos.UUID, 0, len(tags))
+	for _, tag := range tags {
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.AddTags(ctx, tx, transactionIDs, tagIDs)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ApplyTags"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return &dtos.ApplyTagsOutput{TaggedTransactions: len(transactionIDs)}, nil
+}
+
+// resolveTransactions checks that every transaction belongs to the user and, when
+// requested, adds the other installments of their purchases. Repeated IDs are ignored.
+func (u *applyTagsUseCase) resolveTransactions(ctx context.Context, userID string, input *dtos.ApplyTagsInput) ([]vos.UUID, error) {
+	seen := make(map[string]bool, len(input.TransactionIDs))
+	groups := make(map[string]bool)
+	ids := make([]vos.UUID, 0, len(input.TransactionIDs))
+	add := func(id vos.UUID) {
+		if !seen[id.String()] {
+			seen[id.String()] = true
+			ids = append(ids, id)
+		}
+	}
+
+	for _, raw := range input.TransactionIDs {
+		id, err := vos.NewUUIDFromString(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: transaction_ids must be UUIDs", transactionDomain.ErrInvalidTagging)
+		}
+		if seen[id.String()] {
+			continue
+		}
+		transaction, err := u.repository.FindByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if transaction == nil {
+			return nil, transactionDomain.ErrTransactionNotFound
+		}
+		if transaction.UserID.String() != userID {
+			return nil, transactionDomain.ErrTransactionNotOwned
+		}
+		add(transaction.ID)
+
+		if !input.ApplyToGroups || transaction.InstallmentGroupID == nil || groups[transaction.InstallmentGroupID.String()] {
+			continue
+		}
+		groups[transaction.InstallmentGroupID.String()] = true
+		installments, err := u.repository.FindByInstallmentGroup(ctx, *transaction.InstallmentGroupID)
+		if err != nil {
+			return nil, err
+		}
+		for _, installment := range installments {
+			add(installment.ID)
+		}
+	}
+	return ids, nil
+}
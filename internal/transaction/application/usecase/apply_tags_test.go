@@ -0,0 +1,135 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type ApplyTagsUseCaseSuite struct {
+	suite.Suite
+	ctx     context.Context
+	obs     *fake.Provider
+	repo    *transactionMocks.TransactionRepository
+	tagRepo *transactionMocks.TagRepository
+}
+
+func TestApplyTagsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ApplyTagsUseCaseSuite))
+}
+
+func (s *ApplyTagsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.tagRepo = transactionMocks.NewTagRepository(s.T())
+}
+
+func (s *ApplyTagsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	invoiceID, _ := vos.NewUUID()
+	tag := buildTag(userID, "vacation-2026")
+
+	s.Run("should tag the transactions of the request", func() {
+		first := buildTransaction(userID, categoryID, nil)
+		second := buildTransaction(userID, categoryID, nil)
+		s.tagRepo.EXPECT().FindByIDs(mock.Anything, userUUID, []vos.UUID{tag.ID}).Return([]*entities.Tag{tag}, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, first.ID).Return(first, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, second.ID).Return(second, nil).Once()
+		s.repo.EXPECT().AddTags(mock.Anything, mock.Anything, []vos.UUID{first.ID, second.ID}, []vos.UUID{tag.ID}).Return(nil).Once()
+
+		output, err := NewApplyTagsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, &dtos.ApplyTagsInput{
+			TransactionIDs: []string{first.ID.String(), second.ID.String(), first.ID.String()},
+			TagIDs:         []string{tag.ID.String()},
+		})
+
+		s.NoError(err)
+		s.Equal(2, output.TaggedTransactions)
+	})
+
+	s.Run("should tag every installment of the purchase", func() {
+		groupID, _ := vos.NewUUID()
+		installments := make([]*entities.Transaction, 3)
+		for i := range installments {
+			installments[i] = buildTransaction(userID, categoryID, &invoiceID)
+			installments[i].InstallmentGroupID = &groupID
+		}
+		s.tagRepo.EXPECT().FindByIDs(mock.Anything, userUUID, mock.Anything).Return([]*entities.Tag{tag}, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, installments[1].ID).Return(installments[1], nil).Once()
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(installments, nil).Once()
+		s.repo.EXPECT().AddTags(mock.Anything, mock.Anything, mock.MatchedBy(func(ids []vos.UUID) bool {
+			return len(ids) == 3 && ids[0].String() == installments[1].ID.String()
+		}), []vos.UUID{tag.ID}).Return(nil).Once()
+
+		output, err := NewApplyTagsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, &dtos.ApplyTagsInput{
+			TransactionIDs: []string{installments[1].ID.String()},
+			TagIDs:         []string{tag.ID.String()},
+			ApplyToGroups:  true,
+		})
+
+		s.NoError(err)
+		s.Equal(3, output.TaggedTransactions)
+	})
+
+	s.Run("should reject a transaction of another user", func() {
+		other := buildTransaction("550e8400-e29b-41d4-a716-446655440099", categoryID, nil)
+		s.tagRepo.EXPECT().FindByIDs(mock.Anything, userUUID, mock.Anything).Return([]*entities.Tag{tag}, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, other.ID).Return(other, nil).Once()
+
+		output, err := NewApplyTagsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, &dtos.ApplyTagsInput{
+			TransactionIDs: []string{other.ID.String()},
+			TagIDs:         []string{tag.ID.String()},
+		})
+
+		s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+		s.Nil(output)
+	})
+
+	s.Run("should reject a tag outside the catalog", func() {
+		s.tagRepo.EXPECT().FindByIDs(mock.Anything, userUUID, mock.Anything).Return([]*entities.Tag{}, nil).Once()
+
+		output, err := NewApplyTagsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, &dtos.ApplyTagsInput{
+			TransactionIDs: []string{"550e8400-e29b-41d4-a716-446655440010"},
+			TagIDs:         []string{tag.ID.String()},
+		})
+
+		s.ErrorIs(err, transactionDomain.ErrTagNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should reject a request without tags", func() {
+		output, err := NewApplyTagsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, &dtos.ApplyTagsInput{
+			TransactionIDs: []string{"550e8400-e29b-41d4-a716-446655440010"},
+		})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidTagging)
+		s.Nil(output)
+	})
+
+	s.Run("should return repository error", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		s.tagRepo.EXPECT().FindByIDs(mock.Anything, userUUID, mock.Anything).Return([]*entities.Tag{tag}, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+		s.repo.EXPECT().AddTags(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+		output, err := NewApplyTagsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, &dtos.ApplyTagsInput{
+			TransactionIDs: []string{transaction.ID.String()},
+			TagIDs:         []string{tag.ID.String()},
+		})
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
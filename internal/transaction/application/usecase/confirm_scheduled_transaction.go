@@ -0,0 +1,116 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+type (
+	ConfirmScheduledTransactionUseCase interface {
+		Execute(ctx context.Context, userID, transactionID string) (*dtos.TransactionOutput, error)
+	}
+
+	confirmScheduledTransactionUseCase struct {
+		o11y               observability.Observability
+		uow                uow.UnitOfWork
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+		outboxService      outbox.Service
+	}
+)
+
+// NewConfirmScheduledTransactionUseCase creates a new ConfirmScheduledTransactionUseCase.
+func NewConfirmScheduledTransactionUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	outboxService outbox.Service,
+) ConfirmScheduledTransactionUseCase {
+	return &confirmScheduledTransactionUseCase{
+		o11y:               o11y,
+		uow:                unitOfWork,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		outboxService:      outboxService,
+	}
+}
+
+// Execute activates a scheduled transaction without waiting for its date, as when a
+// boleto is paid ahead of time; the transaction is then dated today.
+func (u *confirmScheduledTransactionUseCase) Execute(ctx context.Context, userID, transactionID string) (*dtos.TransactionOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "confirm_scheduled_transaction_usecase.execute")
+	defer span.End()
+
+	transaction, err := findOwnedTransaction(ctx, u.repository, userID, transactionID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	before := statesOf(transaction)
+	if err := transaction.Confirm(time.Now().UTC().Truncate(24 * time.Hour)); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		resolved, err := resolveScheduled(ctx, tx, u.repository, u.revisionRepository, u.outboxService, transaction, before, &transaction.UserID)
+		if err != nil {
+			return err
+		}
+		if !resolved {
+			return transactionDomain.ErrTransactionNotScheduled
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ConfirmScheduledTransaction"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return toOutput(transaction), nil
+}
+
+// findOwnedTransaction loads a transaction and checks that it belongs to the user.
+func findOwnedTransaction(
+	ctx context.Context,
+	repository transactionInterfaces.TransactionRepository,
+	userID, transactionID string,
+) (*entities.Transaction, error) {
+	id, err := vos.NewUUIDFromString(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction_id: %w", err)
+	}
+
+	transaction, err := repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, transactionDomain.ErrTransactionNotFound
+	}
+	if transaction.UserID.String() != userID {
+		return nil, transactionDomain.ErrTransactionNotOwned
+	}
+	return transaction, nil
+}
@@ -0,0 +1,138 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type ConfirmScheduledTransactionUseCaseSuite struct {
+	suite.Suite
+	ctx           context.Context
+	obs           *fake.Provider
+	repo          *transactionMocks.TransactionRepository
+	revisionRepo  *transactionMocks.TransactionRevisionRepository
+	outboxService *outboxMocks.Service
+}
+
+func TestConfirmScheduledTransactionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ConfirmScheduledTransactionUseCaseSuite))
+}
+
+func (s *ConfirmScheduledTransactionUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func (s *ConfirmScheduledTransactionUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	otherUserID := "550e8400-e29b-41d4-a716-446655440099"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+
+	scenarios := []struct {
+		name         string
+		userID       string
+		dependencies func() *entities.Transaction
+		expect       func(output *dtos.TransactionOutput, err error)
+	}{
+		{
+			name:   "should activate a transaction ahead of its date dating it today",
+			userID: userID,
+			dependencies: func() *entities.Transaction {
+				t := buildScheduledTransaction(userID, categoryID, today.AddDate(0, 0, 5))
+				s.repo.EXPECT().FindByID(mock.Anything, t.ID).Return(t, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, t).Return(true, nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.created", mock.Anything).
+					Return(nil).Once()
+				return t
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Equal("active", output.Status)
+				s.Equal(today.Format("2006-01-02"), output.TransactionDate)
+			},
+		},
+		{
+			name:   "should reject a transaction that is not scheduled",
+			userID: userID,
+			dependencies: func() *entities.Transaction {
+				t := buildTransaction(userID, categoryID, nil)
+				s.repo.EXPECT().FindByID(mock.Anything, t.ID).Return(t, nil).Once()
+				return t
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrTransactionNotScheduled)
+				s.Nil(output)
+			},
+		},
+		{
+			name:   "should reject a transaction resolved concurrently",
+			userID: userID,
+			dependencies: func() *entities.Transaction {
+				t := buildScheduledTransaction(userID, categoryID, today.AddDate(0, 0, 5))
+				s.repo.EXPECT().FindByID(mock.Anything, t.ID).Return(t, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, t).Return(false, nil).Once()
+				return t
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrTransactionNotScheduled)
+				s.Nil(output)
+			},
+		},
+		{
+			name:   "should reject a transaction of another user",
+			userID: otherUserID,
+			dependencies: func() *entities.Transaction {
+				t := buildScheduledTransaction(userID, categoryID, today.AddDate(0, 0, 5))
+				s.repo.EXPECT().FindByID(mock.Anything, t.ID).Return(t, nil).Once()
+				return t
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+				s.Nil(output)
+			},
+		},
+		{
+			name:   "should propagate error when the event cannot be saved",
+			userID: userID,
+			dependencies: func() *entities.Transaction {
+				t := buildScheduledTransaction(userID, categoryID, today)
+				s.repo.EXPECT().FindByID(mock.Anything, t.ID).Return(t, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, t).Return(true, nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.created", mock.Anything).
+					Return(errors.New("outbox error")).Once()
+				return t
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			t := scenario.dependencies()
+			uc := NewConfirmScheduledTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.outboxService)
+			output, err := uc.Execute(s.ctx, scenario.userID, t.ID.String())
+			scenario.expect(output, err)
+		})
+	}
+}
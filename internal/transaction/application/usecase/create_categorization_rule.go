@@ -0,0 +1,141 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	CreateCategorizationRuleUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.CategorizationRuleInput) (*dtos.CategorizationRuleOutput, error)
+	}
+
+	createCategorizationRuleUseCase struct {
+		o11y          observability.Observability
+		uow           uow.UnitOfWork
+		repository    transactionInterfaces.CategorizationRuleRepository
+		tagRepository transactionInterfaces.TagRepository
+	}
+)
+
+// NewCreateCategorizationRuleUseCase creates a new CreateCategorizationRuleUseCase.
+func NewCreateCategorizationRuleUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.CategorizationRuleRepository,
+	tagRepository transactionInterfaces.TagRepository,
+) CreateCategorizationRuleUseCase {
+	return &createCategorizationRuleUseCase{o11y: o11y, uow: unitOfWork, repository: repository, tagRepository: tagRepository}
+}
+
+func (u *createCategorizationRuleUseCase) Execute(ctx context.Context, userID string, input *dtos.CategorizationRuleInput) (*dtos.CategorizationRuleOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "create_categorization_rule_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	conditions, outcome, err := toCategorizationRuleDefinition(ctx, u.tagRepository, userUUID, input)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	rule, err := entities.NewCategorizationRule(userUUID, input.Name, input.Priority, input.IsEnabled(), conditions, outcome)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Save(ctx, tx, rule)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "CreateCategorizationRule"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "categorization_rule"),
+		observability.String("user_id", userID),
+	)
+
+	return toCategorizationRuleOutput(rule), nil
+}
+
+// toCategorizationRuleDefinition parses the conditions and outcome of a rule request.
+// The tags must belong to the user's catalog.
+func toCategorizationRuleDefinition(
+	ctx context.Context,
+	tagRepository transactionInterfaces.TagRepository,
+	userID vos.UUID,
+	input *dtos.CategorizationRuleInput,
+) (entities.CategorizationRuleConditions, entities.CategorizationRuleOutcome, error) {
+	var conditions entities.CategorizationRuleConditions
+	var outcome entities.CategorizationRuleOutcome
+
+	categoryID, err := vos.NewUUIDFromString(input.CategoryID)
+	if err != nil {
+		return conditions, outcome, fmt.Errorf("%w: invalid category_id", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	outcome.CategoryID = categoryID
+	if input.SubcategoryID != "" {
+		subcategoryID, err := vos.NewUUIDFromString(input.SubcategoryID)
+		if err != nil {
+			return conditions, outcome, fmt.Errorf("%w: invalid subcategory_id", transactionDomain.ErrInvalidCategorizationRule)
+		}
+		outcome.SubcategoryID = &subcategoryID
+	}
+	tags, err := findOwnedTags(ctx, tagRepository, userID, input.TagIDs)
+	if err != nil {
+		return conditions, outcome, err
+	}
+	for _, tag := range tags {
+		outcome.TagIDs = append(outcome.TagIDs, tag.ID)
+	}
+
+	conditions.DescriptionContains = input.DescriptionContains
+	conditions.DescriptionRegex = input.DescriptionRegex
+	conditions.PaymentMethod = input.PaymentMethod
+	for _, limit := range []struct {
+		value  *float64
+		target **vos.Money
+	}{{input.MinAmount, &conditions.MinAmount}, {input.MaxAmount, &conditions.MaxAmount}} {
+		if limit.value == nil {
+			continue
+		}
+		amount, err := vos.NewMoneyFromFloat(*limit.value, vos.CurrencyBRL)
+		if err != nil {
+			return conditions, outcome, fmt.Errorf("%w: invalid amount limit", transactionDomain.ErrInvalidCategorizationRule)
+		}
+		*limit.target = &amount
+	}
+	if input.CardID != "" {
+		cardID, err := vos.NewUUIDFromString(input.CardID)
+		if err != nil {
+			return conditions, outcome, fmt.Errorf("%w: invalid card_id", transactionDomain.ErrInvalidCategorizationRule)
+		}
+		conditions.CardID = &cardID
+	}
+	return conditions, outcome, nil
+}
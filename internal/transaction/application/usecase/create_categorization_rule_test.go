@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type CreateCategorizationRuleUseCaseSuite struct {
+	suite.Suite
+	ctx     context.Context
+	obs     *fake.Provider
+	repo    *transactionMocks.CategorizationRuleRepository
+	tagRepo *transactionMocks.TagRepository
+}
+
+func TestCreateCategorizationRuleUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CreateCategorizationRuleUseCaseSuite))
+}
+
+func (s *CreateCategorizationRuleUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewCategorizationRuleRepository(s.T())
+	s.tagRepo = transactionMocks.NewTagRepository(s.T())
+}
+
+func buildCategorizationRule(userID, categoryID string, priority int, conditions entities.CategorizationRuleConditions, tags ...*entities.Tag) *entities.CategorizationRule {
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	categoryUUID, _ := vos.NewUUIDFromString(categoryID)
+	outcome := entities.CategorizationRuleOutcome{CategoryID: categoryUUID}
+	for _, tag := range tags {
+		outcome.TagIDs = append(outcome.TagIDs, tag.ID)
+	}
+	rule, _ := entities.NewCategorizationRule(userUUID, "rule", priority, true, conditions, outcome)
+	return rule
+}
+
+func mustMoney(value float64) *vos.Money {
+	money, _ := vos.NewMoneyFromFloat(value, vos.CurrencyBRL)
+	return &money
+}
+
+func (s *CreateCategorizationRuleUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	transportTag := buildTag(userID, "transport")
+	minAmount := 10.0
+
+	scenarios := []struct {
+		name         string
+		input        *dtos.CategorizationRuleInput
+		dependencies func()
+		expect       func(output *dtos.CategorizationRuleOutput, err error)
+	}{
+		{
+			name: "should create an enabled rule with its tags",
+			input: &dtos.CategorizationRuleInput{
+				Name:                "Uber",
+				Priority:            10,
+				DescriptionContains: "uber",
+				MinAmount:           &minAmount,
+				PaymentMethod:       "pix",
+				CategoryID:          "550e8400-e29b-41d4-a716-446655440002",
+				TagIDs:              []string{transportTag.ID.String()},
+			},
+			dependencies: func() {
+				s.tagRepo.EXPECT().FindByIDs(mock.Anything, userUUID, []vos.UUID{transportTag.ID}).Return([]*entities.Tag{transportTag}, nil).Once()
+				s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.MatchedBy(func(rule *entities.CategorizationRule) bool {
+					return rule.Enabled && rule.UserID.String() == userID && len(rule.Outcome.TagIDs) == 1 &&
+						rule.Conditions.MinAmount != nil && rule.Conditions.MinAmount.Float() == 10
+				})).Return(nil).Once()
+			},
+			expect: func(output *dtos.CategorizationRuleOutput, err error) {
+				s.NoError(err)
+				s.Equal("Uber", output.Name)
+				s.True(output.Enabled)
+				s.Equal([]string{transportTag.ID.String()}, output.TagIDs)
+			},
+		},
+		{
+			name:         "should require a category",
+			input:        &dtos.CategorizationRuleInput{Name: "Uber", DescriptionContains: "uber"},
+			dependencies: func() {},
+			expect: func(output *dtos.CategorizationRuleOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidCategorizationRule)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject a rule without conditions",
+			input: &dtos.CategorizationRuleInput{
+				Name:       "Everything",
+				CategoryID: "550e8400-e29b-41d4-a716-446655440002",
+			},
+			dependencies: func() {},
+			expect: func(output *dtos.CategorizationRuleOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidCategorizationRule)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject a tag outside the user catalog",
+			input: &dtos.CategorizationRuleInput{
+				Name:                "Uber",
+				DescriptionContains: "uber",
+				CategoryID:          "550e8400-e29b-41d4-a716-446655440002",
+				TagIDs:              []string{transportTag.ID.String()},
+			},
+			dependencies: func() {
+				s.tagRepo.EXPECT().FindByIDs(mock.Anything, userUUID, mock.Anything).Return([]*entities.Tag{}, nil).Once()
+			},
+			expect: func(output *dtos.CategorizationRuleOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrTagNotFound)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should return repository error",
+			input: &dtos.CategorizationRuleInput{
+				Name:             "Streaming",
+				DescriptionRegex: "netflix|spotify",
+				CategoryID:       "550e8400-e29b-41d4-a716-446655440002",
+			},
+			dependencies: func() {
+				s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(output *dtos.CategorizationRuleOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			output, err := NewCreateCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, scenario.input)
+			scenario.expect(output, err)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	CreateRecurringTransactionUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.RecurringTransactionInput) (*dtos.RecurringTransactionOutput, error)
+	}
+
+	createRecurringTransactionUseCase struct {
+		o11y         observability.Observability
+		uow          uow.UnitOfWork
+		repository   transactionInterfaces.RecurringTransactionRepository
+		cardProvider invoiceInterfaces.CardProvider
+		factory      *factories.RecurringTransactionFactory
+	}
+)
+
+// NewCreateRecurringTransactionUseCase creates a new CreateRecurringTransactionUseCase.
+func NewCreateRecurringTransactionUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.RecurringTransactionRepository,
+	cardProvider invoiceInterfaces.CardProvider,
+) CreateRecurringTransactionUseCase {
+	return &createRecurringTransactionUseCase{
+		o11y:         o11y,
+		uow:          unitOfWork,
+		repository:   repository,
+		cardProvider: cardProvider,
+		factory:      factories.NewRecurringTransactionFactory(),
+	}
+}
+
+func (u *createRecurringTransactionUseCase) Execute(ctx context.Context, userID string, input *dtos.RecurringTransactionInput) (*dtos.RecurringTransactionOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "create_recurring_transaction_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	startDate, err := time.Parse("2006-01-02", input.StartDate)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid start_date: %w", err)
+	}
+
+	recurring, err := u.factory.Create(factories.RecurringCreateParams{
+		UserID:        userID,
+		CategoryID:    input.CategoryID,
+		SubcategoryID: input.SubcategoryID,
+		CardID:        input.CardID,
+		Description:   input.Description,
+		Amount:        input.Amount,
+		Direction:     input.Direction,
+		PaymentMethod: input.PaymentMethod,
+		Rule:          input.RRule,
+		StartDate:     startDate,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// Fail now rather than on every materialization when the card is unknown or not owned.
+	if recurring.PaymentMethod.IsCredit() {
+		if _, err := u.cardProvider.GetCardBillingInfo(ctx, recurring.UserID, *recurring.CardID); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Save(ctx, tx, recurring)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "CreateRecurringTransaction"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "recurring_transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return toRecurringOutput(recurring), nil
+}
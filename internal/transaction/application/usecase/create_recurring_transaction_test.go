@@ -0,0 +1,163 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type CreateRecurringTransactionUseCaseSuite struct {
+	suite.Suite
+	ctx          context.Context
+	obs          *fake.Provider
+	repo         *transactionMocks.RecurringTransactionRepository
+	cardProvider *invoiceMocks.CardProvider
+}
+
+func TestCreateRecurringTransactionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CreateRecurringTransactionUseCaseSuite))
+}
+
+func (s *CreateRecurringTransactionUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewRecurringTransactionRepository(s.T())
+	s.cardProvider = invoiceMocks.NewCardProvider(s.T())
+}
+
+func (s *CreateRecurringTransactionUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	cardID := "550e8400-e29b-41d4-a716-446655440010"
+	cardUUID, _ := vos.NewUUIDFromString(cardID)
+
+	rentInput := func() *dtos.RecurringTransactionInput {
+		return &dtos.RecurringTransactionInput{
+			Description:   "Aluguel",
+			Amount:        1500.00,
+			PaymentMethod: "pix",
+			CategoryID:    categoryID,
+			RRule:         "FREQ=MONTHLY;BYMONTHDAY=5",
+			StartDate:     "2026-01-01",
+		}
+	}
+	subscriptionInput := func() *dtos.RecurringTransactionInput {
+		return &dtos.RecurringTransactionInput{
+			Description:   "Streaming",
+			Amount:        39.90,
+			PaymentMethod: "credit",
+			CardID:        cardID,
+			CategoryID:    categoryID,
+			RRule:         "FREQ=MONTHLY",
+			StartDate:     "2026-01-31",
+		}
+	}
+
+	type expect func(output *dtos.RecurringTransactionOutput, err error)
+
+	scenarios := []struct {
+		name         string
+		input        *dtos.RecurringTransactionInput
+		dependencies func()
+		expect       expect
+	}{
+		{
+			name:  "should create pix recurrence scheduled for its first occurrence",
+			input: rentInput(),
+			dependencies: func() {
+				s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.NoError(err)
+				s.Equal("FREQ=MONTHLY;BYMONTHDAY=5", output.RRule)
+				s.Equal("2026-01-05", *output.NextOccurrence)
+				s.Equal("EXPENSE", output.Direction)
+				s.True(output.Active)
+			},
+		},
+		{
+			name:  "should check card ownership for credit recurrences",
+			input: subscriptionInput(),
+			dependencies: func() {
+				userUUID, _ := vos.NewUUIDFromString(userID)
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, userUUID, cardUUID).
+					Return(&invoiceInterfaces.CardBillingInfo{CardID: cardUUID, DueDay: 10, ClosingOffsetDays: 7}, nil).Once()
+				s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.NoError(err)
+				s.Equal(cardID, *output.CardID)
+				s.Equal("2026-01-31", *output.NextOccurrence)
+			},
+		},
+		{
+			name:  "should not save when the card cannot be used",
+			input: subscriptionInput(),
+			dependencies: func() {
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, cardUUID).
+					Return(nil, errors.New("card not found")).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should return validation error for invalid rule",
+			input: func() *dtos.RecurringTransactionInput {
+				input := rentInput()
+				input.RRule = "FREQ=MINUTELY"
+				return input
+			}(),
+			dependencies: func() {},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidRecurrenceRule)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should return error when the rule ends before start date",
+			input: func() *dtos.RecurringTransactionInput {
+				input := rentInput()
+				input.RRule = "FREQ=MONTHLY;UNTIL=20251231"
+				return input
+			}(),
+			dependencies: func() {},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidRecurrenceRule)
+				s.Nil(output)
+			},
+		},
+		{
+			name:  "should return repository error",
+			input: rentInput(),
+			dependencies: func() {
+				s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			uc := NewCreateRecurringTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.cardProvider)
+			output, err := uc.Execute(s.ctx, userID, scenario.input)
+			scenario.expect(output, err)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	CreateTagUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.TagInput) (*dtos.TagOutput, error)
+	}
+
+	createTagUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.TagRepository
+	}
+)
+
+// NewCreateTagUseCase creates a new CreateTagUseCase.
+func NewCreateTagUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TagRepository,
+) CreateTagUseCase {
+	return &createTagUseCase{o11y: o11y, uow: unitOfWork, repository: repository}
+}
+
+func (u *createTagUseCase) Execute(ctx context.Context, userID string, input *dtos.TagInput) (*dtos.TagOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "create_tag_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	tag, err := entities.NewTag(userUUID, input.Name)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	existing, err := u.repository.FindByName(ctx, userUUID, tag.Name)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if existing != nil {
+		return nil, transactionDomain.ErrTagAlreadyExists
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Save(ctx, tx, tag)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "CreateTag"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "tag"),
+		observability.String("user_id", userID),
+	)
+
+	return toTagOutput(tag), nil
+}
@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type CreateTagUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.TagRepository
+}
+
+func TestCreateTagUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(CreateTagUseCaseSuite))
+}
+
+func (s *CreateTagUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTagRepository(s.T())
+}
+
+func buildTag(userID, name string) *entities.Tag {
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	tag, _ := entities.NewTag(userUUID, name)
+	return tag
+}
+
+func (s *CreateTagUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+
+	scenarios := []struct {
+		name         string
+		input        *dtos.TagInput
+		dependencies func()
+		expect       func(output *dtos.TagOutput, err error)
+	}{
+		{
+			name:  "should create a tag with a normalized name",
+			input: &dtos.TagInput{Name: "  Vacation   2026 "},
+			dependencies: func() {
+				s.repo.EXPECT().FindByName(mock.Anything, userUUID, "vacation 2026").Return(nil, nil).Once()
+				s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.MatchedBy(func(tag *entities.Tag) bool {
+					return tag.Name == "vacation 2026" && tag.UserID.String() == userID
+				})).Return(nil).Once()
+			},
+			expect: func(output *dtos.TagOutput, err error) {
+				s.NoError(err)
+				s.Equal("vacation 2026", output.Name)
+				s.NotEmpty(output.ID)
+			},
+		},
+		{
+			name:         "should reject an empty name",
+			input:        &dtos.TagInput{Name: "   "},
+			dependencies: func() {},
+			expect: func(output *dtos.TagOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidTagName)
+				s.Nil(output)
+			},
+		},
+		{
+			name:  "should reject a name already in the catalog",
+			input: &dtos.TagInput{Name: "Reimbursable"},
+			dependencies: func() {
+				s.repo.EXPECT().FindByName(mock.Anything, userUUID, "reimbursable").
+					Return(buildTag(userID, "reimbursable"), nil).Once()
+			},
+			expect: func(output *dtos.TagOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrTagAlreadyExists)
+				s.Nil(output)
+			},
+		},
+		{
+			name:  "should return repository error",
+			input: &dtos.TagInput{Name: "work"},
+			dependencies: func() {
+				s.repo.EXPECT().FindByName(mock.Anything, userUUID, "work").Return(nil, nil).Once()
+				s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(output *dtos.TagOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			output, err := NewCreateTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, scenario.input)
+			scenario.expect(output, err)
+		})
+	}
+}
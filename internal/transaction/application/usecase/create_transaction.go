@@ -3,6 +3,8 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
@@ -14,6 +16,7 @@ import (
 	invoiceFactories "github.com/jailtonjunior94/financial/internal/invoice/domain/factories"
 	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
@@ -27,14 +30,29 @@ type (
 		Execute(ctx context.Context, userID string, input *dtos.TransactionInput) ([]*dtos.TransactionOutput, error)
 	}
 
+	// preparedTransaction holds the transactions built for one input, ready to be persisted.
+	preparedTransaction struct {
+		userID          vos.UUID
+		transactions    []*entities.Transaction
+		invoiceItems    []transactionInterfaces.InvoiceItemInfo
+		iofItems        []transactionInterfaces.IOFItemInfo
+		transactionDate time.Time
+		overLimit       bool
+		rule            *entities.CategorizationRule
+	}
+
 	createTransactionUseCase struct {
-		o11y            observability.Observability
-		uow             uow.UnitOfWork
-		repository      transactionInterfaces.TransactionRepository
-		invoiceProvider transactionInterfaces.InvoiceProvider
-		cardProvider    invoiceInterfaces.CardProvider
-		factory         *factories.TransactionFactory
-		outboxService   outbox.Service
+		o11y                   observability.Observability
+		uow                    uow.UnitOfWork
+		repository             transactionInterfaces.TransactionRepository
+		tagRepository          transactionInterfaces.TagRepository
+		ruleRepository         transactionInterfaces.CategorizationRuleRepository
+		exchangeRateRepository transactionInterfaces.ExchangeRateRepository
+		revisionRepository     transactionInterfaces.TransactionRevisionRepository
+		invoiceProvider        transactionInterfaces.InvoiceProvider
+		cardProvider           invoiceInterfaces.CardProvider
+		factory                *factories.TransactionFactory
+		outboxService          outbox.Service
 	}
 )
 
@@ -43,18 +61,26 @@ func NewCreateTransactionUseCase(
 	o11y observability.Observability,
 	unitOfWork uow.UnitOfWork,
 	repository transactionInterfaces.TransactionRepository,
+	tagRepository transactionInterfaces.TagRepository,
+	ruleRepository transactionInterfaces.CategorizationRuleRepository,
+	exchangeRateRepository transactionInterfaces.ExchangeRateRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
 	invoiceProvider transactionInterfaces.InvoiceProvider,
 	cardProvider invoiceInterfaces.CardProvider,
 	outboxService outbox.Service,
 ) CreateTransactionUseCase {
 	return &createTransactionUseCase{
-		o11y:            o11y,
-		uow:             unitOfWork,
-		repository:      repository,
-		invoiceProvider: invoiceProvider,
-		cardProvider:    cardProvider,
-		factory:         factories.NewTransactionFactory(),
-		outboxService:   outboxService,
+		o11y:                   o11y,
+		uow:                    unitOfWork,
+		repository:             repository,
+		tagRepository:          tagRepository,
+		ruleRepository:         ruleRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		revisionRepository:     revisionRepository,
+		invoiceProvider:        invoiceProvider,
+		cardProvider:           cardProvider,
+		factory:                factories.NewTransactionFactory(),
+		outboxService:          outboxService,
 	}
 }
 
@@ -62,26 +88,100 @@ func (u *createTransactionUseCase) Execute(ctx context.Context, userID string, i
 	ctx, span := u.o11y.Tracer().Start(ctx, "create_transaction_usecase.execute")
 	defer span.End()
 
-	if err := input.Validate(); err != nil {
+	prepared, err := u.prepare(ctx, userID, input)
+	if err != nil {
 		span.RecordError(err)
 		return nil, err
 	}
 
-	userUUID, err := vos.NewUUIDFromString(userID)
+	duplicates, err := u.findPossibleDuplicates(ctx, prepared.transactions[0])
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.persist(ctx, tx, prepared)
+	})
 	if err != nil {
 		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "CreateTransaction"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	outputs := toOutputList(prepared.transactions)
+	for _, output := range outputs {
+		output.OverLimit = prepared.overLimit
+		if prepared.rule != nil {
+			output.CategorizationRuleID = prepared.rule.ID.String()
+		}
+		output.PossibleDuplicateOf = duplicates
+	}
+	return outputs, nil
+}
+
+// findPossibleDuplicates returns the IDs of the active transactions the new one is a
+// likely duplicate of. Installment purchases are checked by their first installment, the
+// one a statement entered before the purchase would repeat. Duplicates do not block the
+// creation; they are only reported.
+func (u *createTransactionUseCase) findPossibleDuplicates(ctx context.Context, t *entities.Transaction) ([]string, error) {
+	existing, err := u.repository.ListByDateRange(ctx, t.UserID,
+		t.TransactionDate.Add(-entities.DuplicateDateWindow), t.TransactionDate.Add(entities.DuplicateDateWindow))
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0)
+	for _, other := range existing {
+		if t.IsLikelyDuplicateOf(other) {
+			ids = append(ids, other.ID.String())
+		}
+	}
+	return ids, nil
+}
+
+// prepare validates the input and builds the transactions of a purchase, resolving
+// (and creating when missing) the invoices of credit installments. An input without a
+// category is categorized by the user's rules first, and one in a foreign currency is
+// converted with the user's latest rate up to its date. Nothing is persisted.
+func (u *createTransactionUseCase) prepare(ctx context.Context, userID string, input *dtos.TransactionInput) (*preparedTransaction, error) {
+	rule, err := u.categorize(ctx, userID, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
 		return nil, fmt.Errorf("invalid user_id: %w", err)
 	}
 
 	transactionDate, err := time.Parse("2006-01-02", input.TransactionDate)
 	if err != nil {
-		span.RecordError(err)
 		return nil, fmt.Errorf("invalid transaction_date: %w", err)
 	}
 
 	pm, err := transactionVos.NewPaymentMethod(input.PaymentMethod)
 	if err != nil {
-		span.RecordError(err)
+		return nil, err
+	}
+
+	tags, err := findOwnedTags(ctx, u.tagRepository, userUUID, input.TagIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	foreign, err := u.convert(ctx, userUUID, input, transactionDate)
+	if err != nil {
 		return nil, err
 	}
 
@@ -91,35 +191,44 @@ func (u *createTransactionUseCase) Execute(ctx context.Context, userID string, i
 	}
 
 	var transactions []*entities.Transaction
+	overLimit := false
 
 	if pm.IsCredit() {
 		cardUUID, err := vos.NewUUIDFromString(input.CardID)
 		if err != nil {
-			span.RecordError(err)
 			return nil, fmt.Errorf("invalid card_id: %w", err)
 		}
 
 		billingInfo, err := u.cardProvider.GetCardBillingInfo(ctx, userUUID, cardUUID)
 		if err != nil {
-			span.RecordError(err)
 			return nil, err
 		}
 
 		calculator, err := invoiceFactories.NewInvoiceCalculator(billingInfo.DueDay, billingInfo.ClosingOffsetDays)
 		if err != nil {
-			span.RecordError(err)
 			return nil, fmt.Errorf("invalid card billing configuration: %w", err)
 		}
 
+		purchaseAmount := input.Amount
+		if foreign != nil {
+			purchaseAmount = foreign.Total().Float()
+		}
+		overLimit, err = u.checkCreditLimit(ctx, userUUID, cardUUID, purchaseAmount)
+		if err != nil {
+			return nil, err
+		}
+
 		months := calculator.CalculateInstallmentMonths(transactionDate, installments)
 		invoiceIDs := make([]string, 0, installments)
 		for _, month := range months {
 			dueDate := calculator.CalculateDueDate(month)
 			info, err := u.invoiceProvider.FindOrCreate(ctx, userUUID, cardUUID, month, dueDate)
 			if err != nil {
-				span.RecordError(err)
 				return nil, err
 			}
+			if info.Status != "open" {
+				return nil, fmt.Errorf("%w: the invoice of %s is %s", transactionDomain.ErrInvoiceClosed, month.String(), info.Status)
+			}
 			invoiceIDs = append(invoiceIDs, info.ID.String())
 		}
 
@@ -132,13 +241,15 @@ func (u *createTransactionUseCase) Execute(ctx context.Context, userID string, i
 				InvoiceID:       invoiceIDs[0],
 				Description:     input.Description,
 				Amount:          input.Amount,
+				Direction:       input.Direction,
 				PaymentMethod:   input.PaymentMethod,
 				TransactionDate: transactionDate,
 				Installments:    1,
+				Splits:          toSplitParams(input.Splits),
+				Foreign:         foreign,
 			}
 			tx, err := u.factory.Create(createParams)
 			if err != nil {
-				span.RecordError(err)
 				return nil, err
 			}
 			transactions = []*entities.Transaction{tx}
@@ -151,15 +262,16 @@ func (u *createTransactionUseCase) Execute(ctx context.Context, userID string, i
 					CardID:          input.CardID,
 					Description:     input.Description,
 					Amount:          input.Amount,
+					Direction:       input.Direction,
 					PaymentMethod:   input.PaymentMethod,
 					TransactionDate: transactionDate,
 					Installments:    installments,
+					Splits:          toSplitParams(input.Splits),
 				},
 				InvoiceIDs: invoiceIDs,
 			}
 			transactions, err = u.factory.CreateInstallments(installParams)
 			if err != nil {
-				span.RecordError(err)
 				return nil, err
 			}
 		}
@@ -170,62 +282,211 @@ func (u *createTransactionUseCase) Execute(ctx context.Context, userID string, i
 			SubcategoryID:   input.SubcategoryID,
 			Description:     input.Description,
 			Amount:          input.Amount,
+			Direction:       input.Direction,
 			PaymentMethod:   input.PaymentMethod,
 			TransactionDate: transactionDate,
 			Installments:    1,
+			ExternalID:      input.ExternalID,
+			Splits:          toSplitParams(input.Splits),
+			Scheduled:       transactionDate.After(time.Now().UTC().Truncate(24 * time.Hour)),
+			Foreign:         foreign,
 		}
 		tx, err := u.factory.Create(createParams)
 		if err != nil {
-			span.RecordError(err)
 			return nil, err
 		}
 		transactions = []*entities.Transaction{tx}
 	}
 
-	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
-		if err := u.repository.SaveAll(ctx, tx, transactions); err != nil {
+	for _, t := range transactions {
+		t.SetTags(tags)
+	}
+
+	invoiceItems, err := toInvoiceItems(transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &preparedTransaction{
+		userID:          userUUID,
+		transactions:    transactions,
+		invoiceItems:    invoiceItems,
+		iofItems:        toIOFItems(transactions),
+		transactionDate: transactionDate,
+		overLimit:       overLimit,
+		rule:            rule,
+	}, nil
+}
+
+// convert converts an input in a foreign currency to the base currency with the latest
+// rate the user has for it up to the transaction date. Inputs in the base currency
+// return nil.
+func (u *createTransactionUseCase) convert(ctx context.Context, userID vos.UUID, input *dtos.TransactionInput, transactionDate time.Time) (*factories.ForeignAmount, error) {
+	if !factories.IsForeignCurrency(input.Currency) {
+		return nil, nil
+	}
+	currency, err := vos.NewCurrency(strings.TrimSpace(input.Currency))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unsupported currency %q", transactionDomain.ErrInvalidForeignAmount, input.Currency)
+	}
+	rate, err := u.exchangeRateRepository.FindLatest(ctx, userID, currency, transactionDate)
+	if err != nil {
+		return nil, err
+	}
+	if rate == nil {
+		return nil, fmt.Errorf("%w: no %s rate on or before %s", transactionDomain.ErrExchangeRateNotFound, currency, transactionDate.Format("2006-01-02"))
+	}
+	return factories.NewForeignAmount(input.Amount, input.Currency, rate.Rate, input.IOFPercentage)
+}
+
+// categorize applies the first matching categorization rule of the user to an input
+// without a category, returning the rule applied, if any.
+func (u *createTransactionUseCase) categorize(ctx context.Context, userID string, input *dtos.TransactionInput) (*entities.CategorizationRule, error) {
+	if !needsCategorization(input) {
+		return nil, nil
+	}
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+	rules, err := u.ruleRepository.ListByUser(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+	return categorizeInput(rules, input), nil
+}
+
+// persist saves a prepared purchase, its invoice items (IOF included), the creation of
+// each transaction in its history and one transaction.created event per transaction in
+// the given database transaction. Scheduled transactions emit
+// theirs only when they are promoted to active.
+func (u *createTransactionUseCase) persist(ctx context.Context, tx database.DBTX, prepared *preparedTransaction) error {
+	if err := u.repository.SaveAll(ctx, tx, prepared.transactions); err != nil {
+		return err
+	}
+	if err := saveRevisions(ctx, tx, u.revisionRepository, &prepared.userID, nil, prepared.transactions...); err != nil {
+		return err
+	}
+	if len(prepared.invoiceItems) > 0 {
+		if err := u.invoiceProvider.AddItems(ctx, tx, prepared.invoiceItems); err != nil {
 			return err
 		}
-		for _, t := range transactions {
-			referenceMonth := resolveReferenceMonth(t, transactionDate)
-			event := events.NewTransactionCreatedEvent(
-				t.ID,
-				t.UserID,
-				t.CategoryID,
-				t.Amount,
-				t.PaymentMethod,
-				t.TransactionDate,
-				referenceMonth,
-				t.InvoiceID,
-				t.InstallmentNumber,
-				t.InstallmentTotal,
-				t.InstallmentGroupID,
-			)
-			aggregateID, _ := uuid.Parse(t.ID.String())
-			if err := u.outboxService.SaveDomainEvent(
-				ctx,
-				tx,
-				aggregateID,
-				"transaction",
-				event.EventType(),
-				outbox.JSONBPayload(event.Payload()),
-			); err != nil {
-				return err
-			}
+	}
+	if len(prepared.iofItems) > 0 {
+		if err := u.invoiceProvider.AddIOFItems(ctx, tx, prepared.iofItems); err != nil {
+			return err
 		}
-		return nil
-	})
+	}
+	for _, t := range prepared.transactions {
+		if t.Status.IsScheduled() {
+			continue
+		}
+		referenceMonth := resolveReferenceMonth(t, prepared.transactionDate)
+		event := events.NewTransactionCreatedEvent(
+			t.ID,
+			t.UserID,
+			t.CategoryID,
+			t.Amount,
+			t.Direction,
+			t.PaymentMethod,
+			t.TransactionDate,
+			referenceMonth,
+			t.InvoiceID,
+			t.InstallmentNumber,
+			t.InstallmentTotal,
+			t.InstallmentGroupID,
+			toSplitSnapshots(t),
+		)
+		aggregateID, _ := uuid.Parse(t.ID.String())
+		if err := u.outboxService.SaveDomainEvent(
+			ctx,
+			tx,
+			aggregateID,
+			"transaction",
+			event.EventType(),
+			outbox.JSONBPayload(event.Payload()),
+		); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCreditLimit compares the full purchase amount (all installments) with the card available limit.
+// Depending on the card policy, an over-limit purchase is rejected or accepted and flagged (returns true).
+// Cards without a configured limit are not checked.
+func (u *createTransactionUseCase) checkCreditLimit(ctx context.Context, userID, cardID vos.UUID, amount float64) (bool, error) {
+	limit, err := u.cardProvider.GetCardLimit(ctx, userID, cardID)
 	if err != nil {
-		span.RecordError(err)
-		return nil, err
+		return false, err
+	}
+	if !limit.HasLimit {
+		return false, nil
 	}
 
-	u.o11y.Logger().Info(ctx, "request_completed",
+	purchase, err := vos.NewMoneyFromFloat(amount, vos.CurrencyBRL)
+	if err != nil {
+		return false, err
+	}
+	if !purchase.GreaterThan(limit.AvailableLimit) {
+		return false, nil
+	}
+
+	if limit.OverLimitPolicy != "flag" {
+		return false, transactionDomain.ErrCreditLimitExceeded
+	}
+
+	u.o11y.Logger().Warn(ctx, "credit_limit_exceeded",
 		observability.String("operation", "CreateTransaction"),
 		observability.String("layer", "usecase"),
 		observability.String("entity", "transaction"),
-		observability.String("user_id", userID),
+		observability.String("user_id", userID.String()),
+		observability.String("card_id", cardID.String()),
+		observability.Float64("amount", amount),
+		observability.Float64("available_limit", limit.AvailableLimit.Float()),
 	)
+	return true, nil
+}
+
+// needsCategorization reports whether the input leaves the category to the rules.
+func needsCategorization(input *dtos.TransactionInput) bool {
+	return strings.TrimSpace(input.CategoryID) == "" && len(input.Splits) == 0
+}
 
-	return toOutputList(transactions), nil
+// categorizeInput sets the category and subcategory of the first rule matching the input
+// and adds the rule's tags to the ones given, returning the rule. The purchase amount is
+// matched as a whole, before it is divided into installments.
+func categorizeInput(rules []*entities.CategorizationRule, input *dtos.TransactionInput) *entities.CategorizationRule {
+	if len(rules) == 0 {
+		return nil
+	}
+	matched := entities.MatchingCategorizationRules(rules, newCategorizationSubject(input.Description, input.Amount, input.PaymentMethod, input.CardID))
+	if len(matched) == 0 {
+		return nil
+	}
+
+	rule := matched[0]
+	input.CategoryID = rule.Outcome.CategoryID.String()
+	input.SubcategoryID = ""
+	if rule.Outcome.SubcategoryID != nil {
+		input.SubcategoryID = rule.Outcome.SubcategoryID.String()
+	}
+	for _, tagID := range rule.Outcome.TagIDs {
+		if !slices.Contains(input.TagIDs, tagID.String()) {
+			input.TagIDs = append(input.TagIDs, tagID.String())
+		}
+	}
+	return rule
+}
+
+// newCategorizationSubject builds the subject matched by the rules. An amount that is not
+// valid money matches no amount condition.
+func newCategorizationSubject(description string, amount float64, paymentMethod, cardID string) entities.CategorizationSubject {
+	money, _ := vos.NewMoneyFromFloat(amount, vos.CurrencyBRL)
+	return entities.CategorizationSubject{
+		Description:   description,
+		Amount:        money,
+		PaymentMethod: paymentMethod,
+		CardID:        cardID,
+	}
 }
@@ -16,8 +16,10 @@ import (
 	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
 	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
 	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
 	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
 )
 
@@ -29,12 +31,16 @@ func (m *mockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context, tx
 
 type CreateTransactionUseCaseSuite struct {
 	suite.Suite
-	ctx             context.Context
-	obs             *fake.Provider
-	repo            *transactionMocks.TransactionRepository
-	invoiceProvider *transactionMocks.InvoiceProvider
-	cardProvider    *invoiceMocks.CardProvider
-	outboxService   *outboxMocks.Service
+	ctx              context.Context
+	obs              *fake.Provider
+	repo             *transactionMocks.TransactionRepository
+	revisionRepo     *transactionMocks.TransactionRevisionRepository
+	tagRepo          *transactionMocks.TagRepository
+	ruleRepo         *transactionMocks.CategorizationRuleRepository
+	exchangeRateRepo *transactionMocks.ExchangeRateRepository
+	invoiceProvider  *transactionMocks.InvoiceProvider
+	cardProvider     *invoiceMocks.CardProvider
+	outboxService    *outboxMocks.Service
 }
 
 func TestCreateTransactionUseCaseSuite(t *testing.T) {
@@ -45,6 +51,10 @@ func (s *CreateTransactionUseCaseSuite) SetupTest() {
 	s.obs = fake.NewProvider()
 	s.ctx = context.Background()
 	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.tagRepo = transactionMocks.NewTagRepository(s.T())
+	s.ruleRepo = transactionMocks.NewCategorizationRuleRepository(s.T())
+	s.exchangeRateRepo = transactionMocks.NewExchangeRateRepository(s.T())
 	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
 	s.cardProvider = invoiceMocks.NewCardProvider(s.T())
 	s.outboxService = outboxMocks.NewService(s.T())
@@ -58,10 +68,40 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 		DueDay:            10,
 		ClosingOffsetDays: 3,
 	}
+	noLimit := &invoiceInterfaces.CardLimitInfo{CardID: validCardID}
+	availableLimit, _ := vos.NewMoney(50000, vos.CurrencyBRL)
+	creditLimit, _ := vos.NewMoney(100000, vos.CurrencyBRL)
+	blockingLimit := &invoiceInterfaces.CardLimitInfo{
+		CardID:          validCardID,
+		HasLimit:        true,
+		CreditLimit:     creditLimit,
+		AvailableLimit:  availableLimit,
+		OverLimitPolicy: "block",
+	}
+	flaggingLimit := &invoiceInterfaces.CardLimitInfo{
+		CardID:          validCardID,
+		HasLimit:        true,
+		CreditLimit:     creditLimit,
+		AvailableLimit:  availableLimit,
+		OverLimitPolicy: "flag",
+	}
 	invoiceInfo := &transactionInterfaces.InvoiceInfo{
 		ID:     validInvoiceID,
 		Status: "open",
 	}
+	vacationTag := buildTag("550e8400-e29b-41d4-a716-446655440000", "vacation-2026")
+	transportTag := buildTag("550e8400-e29b-41d4-a716-446655440000", "transport")
+	uberRule := buildCategorizationRule("550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440002", 10,
+		entities.CategorizationRuleConditions{DescriptionContains: "uber"}, transportTag)
+	creditRule := buildCategorizationRule("550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440003", 20,
+		entities.CategorizationRuleConditions{PaymentMethod: "credit"})
+	importedLunch := buildTransaction("550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440001", nil)
+	importedLunch.Description = "PIX ENVIADO LUNCH HOUSE"
+	importedLunch.Amount = *mustMoney(50)
+	otherLunch := buildTransaction("550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440001", nil)
+	otherLunch.Description = "Lunch"
+	usdRate := buildExchangeRate("550e8400-e29b-41d4-a716-446655440000", vos.CurrencyUSD, 5.4321, time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC))
+	iofPercentage := 4.38
 
 	type args struct {
 		userID string
@@ -89,7 +129,9 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 				},
 			},
 			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
 				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 			},
 			expect: func(outputs []*dtos.TransactionOutput, err error) {
@@ -100,6 +142,51 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 				s.Nil(outputs[0].InvoiceID)
 			},
 		},
+		{
+			name: "should create income transaction via pix",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Salary",
+					Amount:          5000.00,
+					Direction:       "INCOME",
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 1)
+				s.Equal("INCOME", outputs[0].Direction)
+			},
+		},
+		{
+			name: "should return error when income uses credit",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Cashback",
+					Amount:          10.00,
+					Direction:       "INCOME",
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+				},
+			},
+			dependencies: func() {},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Nil(outputs)
+				s.ErrorIs(err, transactionDomain.ErrIncomeNotAllowedForCredit)
+			},
+		},
 		{
 			name: "should create credit transaction with 1 installment",
 			args: args{
@@ -116,8 +203,14 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 			},
 			dependencies: func() {
 				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(noLimit, nil).Once()
 				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(invoiceInfo, nil).Once()
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
 				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.InvoiceItemInfo) bool {
+					return len(items) == 1 && items[0].TotalAmount.Equals(items[0].InstallmentAmount)
+				})).Return(nil).Once()
 				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
 			},
 			expect: func(outputs []*dtos.TransactionOutput, err error) {
@@ -128,6 +221,98 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 				s.NotNil(outputs[0].InvoiceID)
 			},
 		},
+		{
+			name: "should convert a foreign credit purchase and bill its iof as a separate item",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Hotel",
+					Amount:          100.00,
+					Currency:        "usd",
+					IOFPercentage:   &iofPercentage,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+				},
+			},
+			dependencies: func() {
+				s.exchangeRateRepo.EXPECT().FindLatest(mock.Anything, mock.Anything, vos.CurrencyUSD, mock.Anything).Return(usdRate, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(noLimit, nil).Once()
+				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(invoiceInfo, nil).Once()
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+					return len(ts) == 1 && ts[0].Amount.Cents() == 54321 && ts[0].OriginalAmount.Cents() == 10000
+				})).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.InvoiceItemInfo) bool {
+					return len(items) == 1 && items[0].InstallmentAmount.Cents() == 54321
+				})).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddIOFItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.IOFItemInfo) bool {
+					return len(items) == 1 &&
+						items[0].Amount.Cents() == 2379 &&
+						items[0].InvoiceID.String() == validInvoiceID.String() &&
+						items[0].Description == "IOF: Hotel"
+				})).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 1)
+				s.Equal(543.21, outputs[0].Amount)
+				s.Equal("USD", outputs[0].OriginalCurrency)
+				s.Equal(100.0, *outputs[0].OriginalAmount)
+				s.Equal(5.4321, *outputs[0].ExchangeRate)
+				s.Equal(23.79, *outputs[0].IOFAmount)
+			},
+		},
+		{
+			name: "should check the converted amount plus iof against the card limit",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Hotel",
+					Amount:          95.00,
+					Currency:        "USD",
+					IOFPercentage:   &iofPercentage,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+				},
+			},
+			dependencies: func() {
+				s.exchangeRateRepo.EXPECT().FindLatest(mock.Anything, mock.Anything, vos.CurrencyUSD, mock.Anything).Return(usdRate, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(blockingLimit, nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Nil(outputs)
+				s.ErrorIs(err, transactionDomain.ErrCreditLimitExceeded)
+			},
+		},
+		{
+			name: "should return error when there is no rate for the foreign currency",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Dinner",
+					Amount:          40.00,
+					Currency:        "EUR",
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+				},
+			},
+			dependencies: func() {
+				s.exchangeRateRepo.EXPECT().FindLatest(mock.Anything, mock.Anything, vos.CurrencyEUR, mock.Anything).Return(nil, nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Nil(outputs)
+				s.ErrorIs(err, transactionDomain.ErrExchangeRateNotFound)
+			},
+		},
 		{
 			name: "should create credit transaction with 3 installments",
 			args: args{
@@ -144,8 +329,17 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 			},
 			dependencies: func() {
 				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(noLimit, nil).Once()
 				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(invoiceInfo, nil).Times(3)
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
 				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.InvoiceItemInfo) bool {
+					return len(items) == 3 &&
+						items[0].TotalAmount.Float() == 900.00 &&
+						items[2].InstallmentNumber == 3 &&
+						items[2].InstallmentTotal == 3
+				})).Return(nil).Once()
 				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
 			},
 			expect: func(outputs []*dtos.TransactionOutput, err error) {
@@ -155,6 +349,255 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 				s.Equal(outputs[1].InstallmentGroupID, outputs[2].InstallmentGroupID)
 			},
 		},
+		{
+			name: "should split a pix purchase across categories and publish the splits",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Supermercado",
+					Amount:          100.00,
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+					Splits: []dtos.SplitInput{
+						{CategoryID: "550e8400-e29b-41d4-a716-446655440001", Amount: 60.00},
+						{CategoryID: "550e8400-e29b-41d4-a716-446655440002", Amount: 25.00},
+						{CategoryID: "550e8400-e29b-41d4-a716-446655440003", Amount: 15.00},
+					},
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+					return len(ts) == 1 && len(ts[0].Splits) == 3
+				})).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, "transaction.created", mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+					splits, ok := payload["splits"].([]map[string]any)
+					return ok && len(splits) == 3 && splits[1]["amount"] == int64(2500)
+				})).Return(nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 1)
+				s.Equal("550e8400-e29b-41d4-a716-446655440001", outputs[0].CategoryID)
+				s.Len(outputs[0].Splits, 3)
+				s.Equal(15.00, outputs[0].Splits[2].Amount)
+			},
+		},
+		{
+			name: "should split each installment of a credit purchase",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Supermercado",
+					Amount:          100.00,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+					Installments:    2,
+					Splits: []dtos.SplitInput{
+						{CategoryID: "550e8400-e29b-41d4-a716-446655440001", Amount: 70.01},
+						{CategoryID: "550e8400-e29b-41d4-a716-446655440002", Amount: 29.99},
+					},
+				},
+			},
+			dependencies: func() {
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(noLimit, nil).Once()
+				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(invoiceInfo, nil).Times(2)
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 2)
+				s.Equal(35.01, outputs[0].Splits[0].Amount)
+				s.Equal(14.99, outputs[0].Splits[1].Amount)
+				s.Equal(35.00, outputs[1].Splits[0].Amount)
+				s.Equal(15.00, outputs[1].Splits[1].Amount)
+			},
+		},
+		{
+			name: "should return error when splits do not sum to the amount",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Supermercado",
+					Amount:          100.00,
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+					Splits: []dtos.SplitInput{
+						{CategoryID: "550e8400-e29b-41d4-a716-446655440001", Amount: 60.00},
+						{CategoryID: "550e8400-e29b-41d4-a716-446655440002", Amount: 30.00},
+					},
+				},
+			},
+			dependencies: func() {},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Nil(outputs)
+				s.ErrorIs(err, transactionDomain.ErrSplitsTotalMismatch)
+			},
+		},
+		{
+			name: "should tag every installment of a credit purchase",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Hotel",
+					Amount:          600.00,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+					Installments:    2,
+					TagIDs:          []string{vacationTag.ID.String()},
+				},
+			},
+			dependencies: func() {
+				s.tagRepo.EXPECT().FindByIDs(mock.Anything, vacationTag.UserID, []vos.UUID{vacationTag.ID}).Return([]*entities.Tag{vacationTag}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(noLimit, nil).Once()
+				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(invoiceInfo, nil).Times(2)
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+					return len(ts) == 2 && len(ts[0].Tags) == 1 && len(ts[1].Tags) == 1
+				})).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 2)
+				s.Equal("vacation-2026", outputs[1].Tags[0].Name)
+			},
+		},
+		{
+			name: "should categorize a transaction without category by the first matching rule",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "UBER *TRIP",
+					Amount:          23.90,
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+					TagIDs:          []string{vacationTag.ID.String()},
+				},
+			},
+			dependencies: func() {
+				s.ruleRepo.EXPECT().ListByUser(mock.Anything, uberRule.UserID).Return([]*entities.CategorizationRule{creditRule, uberRule}, nil).Once()
+				s.tagRepo.EXPECT().FindByIDs(mock.Anything, vacationTag.UserID, []vos.UUID{vacationTag.ID, transportTag.ID}).
+					Return([]*entities.Tag{vacationTag, transportTag}, nil).Once()
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+					return len(ts) == 1 && ts[0].CategoryID.String() == "550e8400-e29b-41d4-a716-446655440002" && len(ts[0].Tags) == 2
+				})).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 1)
+				s.Equal("550e8400-e29b-41d4-a716-446655440002", outputs[0].CategoryID)
+				s.Equal(uberRule.ID.String(), outputs[0].CategorizationRuleID)
+			},
+		},
+		{
+			name: "should match installments by the purchase total",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "TV",
+					Amount:          3000.00,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+					Installments:    3,
+				},
+			},
+			dependencies: func() {
+				bigPurchases := buildCategorizationRule("550e8400-e29b-41d4-a716-446655440000", "550e8400-e29b-41d4-a716-446655440004", 0,
+					entities.CategorizationRuleConditions{MinAmount: mustMoney(2000)})
+				s.ruleRepo.EXPECT().ListByUser(mock.Anything, mock.Anything).Return([]*entities.CategorizationRule{creditRule, bigPurchases}, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(noLimit, nil).Once()
+				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(invoiceInfo, nil).Times(3)
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+					return len(ts) == 3 && ts[2].CategoryID.String() == "550e8400-e29b-41d4-a716-446655440004"
+				})).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(3)
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 3)
+				s.Equal("550e8400-e29b-41d4-a716-446655440004", outputs[0].CategoryID)
+			},
+		},
+		{
+			name: "should require a category when no rule matches",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Bakery",
+					Amount:          12.00,
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+				},
+			},
+			dependencies: func() {
+				s.ruleRepo.EXPECT().ListByUser(mock.Anything, mock.Anything).Return([]*entities.CategorizationRule{uberRule, creditRule}, nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Nil(outputs)
+				s.ErrorContains(err, "category_id is required")
+			},
+		},
+		{
+			name: "should return error when the rules cannot be loaded",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "UBER *TRIP",
+					Amount:          23.90,
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+				},
+			},
+			dependencies: func() {
+				s.ruleRepo.EXPECT().ListByUser(mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Nil(outputs)
+				s.ErrorContains(err, "db error")
+			},
+		},
+		{
+			name: "should return error when a tag is not in the user catalog",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Lunch",
+					Amount:          50.00,
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					TagIDs:          []string{vacationTag.ID.String()},
+				},
+			},
+			dependencies: func() {
+				s.tagRepo.EXPECT().FindByIDs(mock.Anything, vacationTag.UserID, mock.Anything).Return([]*entities.Tag{}, nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Nil(outputs)
+				s.ErrorIs(err, transactionDomain.ErrTagNotFound)
+			},
+		},
 		{
 			name: "should return error when credit payment without card_id",
 			args: args{
@@ -215,15 +658,41 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 			},
 		},
 		{
-			name: "should return error when transaction_date is in the future",
+			name: "should schedule a future boleto without publishing transaction.created",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Condo fee",
+					Amount:          850.00,
+					PaymentMethod:   "boleto",
+					TransactionDate: time.Now().UTC().AddDate(0, 0, 7).Format("2006-01-02"),
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(transactions []*entities.Transaction) bool {
+					return len(transactions) == 1 && transactions[0].Status.IsScheduled()
+				})).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 1)
+				s.Equal("scheduled", outputs[0].Status)
+			},
+		},
+		{
+			name: "should return error when a card transaction_date is in the future",
 			args: args{
 				userID: "550e8400-e29b-41d4-a716-446655440000",
 				input: &dtos.TransactionInput{
 					Description:     "Future",
 					Amount:          50.00,
-					PaymentMethod:   "pix",
+					PaymentMethod:   "credit",
 					TransactionDate: time.Now().UTC().AddDate(0, 0, 1).Format("2006-01-02"),
 					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
 				},
 			},
 			dependencies: func() {},
@@ -277,6 +746,135 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 				s.Contains(err.Error(), "card not found")
 			},
 		},
+		{
+			name: "should warn about likely duplicates without blocking the creation",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Lunch",
+					Amount:          50.00,
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-02",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything,
+					time.Date(2026, 2, 28, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)).
+					Return([]*entities.Transaction{importedLunch, otherLunch}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 1)
+				s.Equal([]string{importedLunch.ID.String()}, outputs[0].PossibleDuplicateOf)
+			},
+		},
+		{
+			name: "should return error when looking for duplicates fails",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Lunch",
+					Amount:          50.00,
+					PaymentMethod:   "pix",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Error(err)
+				s.Nil(outputs)
+			},
+		},
+		{
+			name: "should create credit transaction within the available limit",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Groceries",
+					Amount:          500.00,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+				},
+			},
+			dependencies: func() {
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(blockingLimit, nil).Once()
+				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(invoiceInfo, nil).Once()
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 1)
+				s.False(outputs[0].OverLimit)
+			},
+		},
+		{
+			name: "should reject purchase above the available limit when card blocks",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Notebook",
+					Amount:          600.00,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+					Installments:    6,
+				},
+			},
+			dependencies: func() {
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(blockingLimit, nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.Nil(outputs)
+				s.ErrorIs(err, transactionDomain.ErrCreditLimitExceeded)
+			},
+		},
+		{
+			name: "should accept and flag purchase above the available limit when card flags",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Notebook",
+					Amount:          600.00,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-03-01",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+					Installments:    2,
+				},
+			},
+			dependencies: func() {
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(flaggingLimit, nil).Once()
+				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(invoiceInfo, nil).Times(2)
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Times(2)
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Len(outputs, 2)
+				s.True(outputs[0].OverLimit)
+				s.True(outputs[1].OverLimit)
+			},
+		},
 		{
 			name: "should propagate error from invoice provider",
 			args: args{
@@ -293,6 +891,7 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 			},
 			dependencies: func() {
 				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(noLimit, nil).Once()
 				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
 			},
 			expect: func(outputs []*dtos.TransactionOutput, err error) {
@@ -301,6 +900,31 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 				s.Contains(err.Error(), "db error")
 			},
 		},
+		{
+			name: "should reject backdated credit purchase into a closed invoice",
+			args: args{
+				userID: "550e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionInput{
+					Description:     "Purchase",
+					Amount:          100.00,
+					PaymentMethod:   "credit",
+					TransactionDate: "2026-01-10",
+					CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+					CardID:          "550e8400-e29b-41d4-a716-446655440010",
+					Installments:    1,
+				},
+			},
+			dependencies: func() {
+				closedInvoice := &transactionInterfaces.InvoiceInfo{ID: validInvoiceID, Status: "closed"}
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(billingInfo, nil).Once()
+				s.cardProvider.EXPECT().GetCardLimit(mock.Anything, mock.Anything, mock.Anything).Return(noLimit, nil).Once()
+				s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(closedInvoice, nil).Once()
+			},
+			expect: func(outputs []*dtos.TransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvoiceClosed)
+				s.Nil(outputs)
+			},
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -310,6 +934,10 @@ func (s *CreateTransactionUseCaseSuite) TestExecute() {
 				s.obs,
 				&mockUnitOfWork{},
 				s.repo,
+				s.tagRepo,
+				s.ruleRepo,
+				s.exchangeRateRepo,
+				s.revisionRepo,
 				s.invoiceProvider,
 				s.cardProvider,
 				s.outboxService,
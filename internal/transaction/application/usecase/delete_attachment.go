@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/storage"
+)
+
+type (
+	DeleteAttachmentUseCase interface {
+		Execute(ctx context.Context, userID, attachmentID string) error
+	}
+
+	deleteAttachmentUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.AttachmentRepository
+		owners     attachmentOwners
+		blobStore  storage.BlobStore
+	}
+)
+
+func NewDeleteAttachmentUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.AttachmentRepository,
+	transactionRepository transactionInterfaces.TransactionRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	blobStore storage.BlobStore,
+) DeleteAttachmentUseCase {
+	return &deleteAttachmentUseCase{
+		o11y:       o11y,
+		uow:        unitOfWork,
+		repository: repository,
+		owners:     attachmentOwners{transactions: transactionRepository, invoices: invoiceProvider},
+		blobStore:  blobStore,
+	}
+}
+
+// Execute removes the attachment and, once no other attachment shares its content, the
+// blob. A blob that fails to be removed is only logged: the attachment is already gone.
+func (u *deleteAttachmentUseCase) Execute(ctx context.Context, userID, attachmentID string) error {
+	ctx, span := u.o11y.Tracer().Start(ctx, "delete_attachment_usecase.execute")
+	defer span.End()
+
+	attachment, err := u.owners.findAttachment(ctx, u.repository, userID, attachmentID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	attachment.Delete()
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Delete(ctx, tx, attachment)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	references, err := u.repository.CountByHash(ctx, attachment.SHA256)
+	if err == nil && references == 0 {
+		err = u.blobStore.Delete(ctx, attachment.StorageKey())
+	}
+	if err != nil {
+		span.RecordError(err)
+		u.o11y.Logger().Warn(ctx, "attachment_blob_not_removed",
+			observability.String("attachment_id", attachment.ID.String()),
+			observability.Error(err),
+		)
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "DeleteAttachment"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "attachment"),
+		observability.String("user_id", userID),
+	)
+	return nil
+}
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	storageMocks "github.com/jailtonjunior94/financial/pkg/storage/mocks"
+)
+
+type DeleteAttachmentUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.AttachmentRepository
+	transactionRepo *transactionMocks.TransactionRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+	blobStore       *storageMocks.BlobStore
+}
+
+func TestDeleteAttachmentUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DeleteAttachmentUseCaseSuite))
+}
+
+func (s *DeleteAttachmentUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewAttachmentRepository(s.T())
+	s.transactionRepo = transactionMocks.NewTransactionRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.blobStore = storageMocks.NewBlobStore(s.T())
+}
+
+func (s *DeleteAttachmentUseCaseSuite) useCase() DeleteAttachmentUseCase {
+	return NewDeleteAttachmentUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.transactionRepo, s.invoiceProvider, s.blobStore)
+}
+
+func (s *DeleteAttachmentUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+
+	s.Run("should remove the blob of the last reference", func() {
+		invoiceID, _ := vos.NewUUID()
+		attachment := buildAttachment(userID, entities.AttachmentOwnerInvoice, invoiceID, receiptPDF)
+		s.repo.EXPECT().FindByID(mock.Anything, attachment.ID).Return(attachment, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, invoiceID).
+			Return(&transactionInterfaces.InvoiceInfo{ID: invoiceID, UserID: userUUID, Status: "open"}, nil).Once()
+		s.repo.EXPECT().Delete(mock.Anything, mock.Anything, mock.MatchedBy(func(a *entities.Attachment) bool {
+			return a.DeletedAt != nil
+		})).Return(nil).Once()
+		s.repo.EXPECT().CountByHash(mock.Anything, attachment.SHA256).Return(0, nil).Once()
+		s.blobStore.EXPECT().Delete(mock.Anything, attachment.StorageKey()).Return(nil).Once()
+
+		err := s.useCase().Execute(s.ctx, userID, attachment.ID.String())
+
+		s.NoError(err)
+	})
+
+	s.Run("should keep a blob shared with another attachment", func() {
+		invoiceID, _ := vos.NewUUID()
+		attachment := buildAttachment(userID, entities.AttachmentOwnerInvoice, invoiceID, receiptPDF)
+		s.repo.EXPECT().FindByID(mock.Anything, attachment.ID).Return(attachment, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, invoiceID).
+			Return(&transactionInterfaces.InvoiceInfo{ID: invoiceID, UserID: userUUID, Status: "open"}, nil).Once()
+		s.repo.EXPECT().Delete(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.repo.EXPECT().CountByHash(mock.Anything, attachment.SHA256).Return(1, nil).Once()
+
+		err := s.useCase().Execute(s.ctx, userID, attachment.ID.String())
+
+		s.NoError(err)
+	})
+
+	s.Run("should succeed when the blob cannot be removed", func() {
+		invoiceID, _ := vos.NewUUID()
+		attachment := buildAttachment(userID, entities.AttachmentOwnerInvoice, invoiceID, receiptPDF)
+		s.repo.EXPECT().FindByID(mock.Anything, attachment.ID).Return(attachment, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, invoiceID).
+			Return(&transactionInterfaces.InvoiceInfo{ID: invoiceID, UserID: userUUID, Status: "open"}, nil).Once()
+		s.repo.EXPECT().Delete(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.repo.EXPECT().CountByHash(mock.Anything, attachment.SHA256).Return(0, nil).Once()
+		s.blobStore.EXPECT().Delete(mock.Anything, attachment.StorageKey()).Return(errors.New("permission denied")).Once()
+
+		err := s.useCase().Execute(s.ctx, userID, attachment.ID.String())
+
+		s.NoError(err)
+	})
+
+	s.Run("should reject an attachment of an invoice of another user", func() {
+		invoiceID, _ := vos.NewUUID()
+		otherUser, _ := vos.NewUUID()
+		attachment := buildAttachment(otherUser.String(), entities.AttachmentOwnerInvoice, invoiceID, receiptPDF)
+		s.repo.EXPECT().FindByID(mock.Anything, attachment.ID).Return(attachment, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, invoiceID).
+			Return(&transactionInterfaces.InvoiceInfo{ID: invoiceID, UserID: otherUser, Status: "open"}, nil).Once()
+
+		err := s.useCase().Execute(s.ctx, userID, attachment.ID.String())
+
+		s.ErrorIs(err, transactionDomain.ErrInvoiceNotOwned)
+	})
+
+	s.Run("should return repository error", func() {
+		invoiceID, _ := vos.NewUUID()
+		attachment := buildAttachment(userID, entities.AttachmentOwnerInvoice, invoiceID, receiptPDF)
+		s.repo.EXPECT().FindByID(mock.Anything, attachment.ID).Return(attachment, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, invoiceID).
+			Return(&transactionInterfaces.InvoiceInfo{ID: invoiceID, UserID: userUUID, Status: "open"}, nil).Once()
+		s.repo.EXPECT().Delete(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+		err := s.useCase().Execute(s.ctx, userID, attachment.ID.String())
+
+		s.Error(err)
+	})
+}
@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	DeleteCategorizationRuleUseCase interface {
+		Execute(ctx context.Context, userID, ruleID string) error
+	}
+
+	deleteCategorizationRuleUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.CategorizationRuleRepository
+	}
+)
+
+// NewDeleteCategorizationRuleUseCase creates a new DeleteCategorizationRuleUseCase.
+func NewDeleteCategorizationRuleUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.CategorizationRuleRepository,
+) DeleteCategorizationRuleUseCase {
+	return &deleteCategorizationRuleUseCase{o11y: o11y, uow: unitOfWork, repository: repository}
+}
+
+// Execute removes the rule; the transactions it categorized are not changed.
+func (u *deleteCategorizationRuleUseCase) Execute(ctx context.Context, userID, ruleID string) error {
+	ctx, span := u.o11y.Tracer().Start(ctx, "delete_categorization_rule_usecase.execute")
+	defer span.End()
+
+	rule, err := findOwnedCategorizationRule(ctx, u.repository, userID, ruleID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	rule.Delete()
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Update(ctx, tx, rule)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "DeleteCategorizationRule"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "categorization_rule"),
+		observability.String("user_id", userID),
+	)
+	return nil
+}
@@ -0,0 +1,74 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type DeleteCategorizationRuleUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.CategorizationRuleRepository
+}
+
+func TestDeleteCategorizationRuleUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DeleteCategorizationRuleUseCaseSuite))
+}
+
+func (s *DeleteCategorizationRuleUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewCategorizationRuleRepository(s.T())
+}
+
+func (s *DeleteCategorizationRuleUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	conditions := entities.CategorizationRuleConditions{DescriptionContains: "uber"}
+
+	s.Run("should soft delete the rule", func() {
+		rule := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 0, conditions)
+		s.repo.EXPECT().FindByID(mock.Anything, rule.ID).Return(rule, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.MatchedBy(func(r *entities.CategorizationRule) bool {
+			return r.DeletedAt != nil
+		})).Return(nil).Once()
+
+		err := NewDeleteCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, rule.ID.String())
+
+		s.NoError(err)
+	})
+
+	s.Run("should reject a rule of another user", func() {
+		rule := buildCategorizationRule("550e8400-e29b-41d4-a716-446655440099", "550e8400-e29b-41d4-a716-446655440002", 0, conditions)
+		s.repo.EXPECT().FindByID(mock.Anything, rule.ID).Return(rule, nil).Once()
+
+		err := NewDeleteCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, rule.ID.String())
+
+		s.ErrorIs(err, transactionDomain.ErrCategorizationRuleNotOwned)
+	})
+
+	s.Run("should reject an invalid ID", func() {
+		err := NewDeleteCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, "invalid")
+
+		s.Error(err)
+	})
+
+	s.Run("should return repository error", func() {
+		rule := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 0, conditions)
+		s.repo.EXPECT().FindByID(mock.Anything, rule.ID).Return(rule, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, rule).Return(errors.New("db error")).Once()
+
+		err := NewDeleteCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, rule.ID.String())
+
+		s.Error(err)
+	})
+}
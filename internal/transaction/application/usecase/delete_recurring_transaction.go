@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	DeleteRecurringTransactionUseCase interface {
+		Execute(ctx context.Context, userID, recurringID string) error
+	}
+
+	deleteRecurringTransactionUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.RecurringTransactionRepository
+	}
+)
+
+// NewDeleteRecurringTransactionUseCase creates a new DeleteRecurringTransactionUseCase.
+func NewDeleteRecurringTransactionUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.RecurringTransactionRepository,
+) DeleteRecurringTransactionUseCase {
+	return &deleteRecurringTransactionUseCase{o11y: o11y, uow: unitOfWork, repository: repository}
+}
+
+// Execute stops the recurrence. Transactions already materialized are kept.
+func (u *deleteRecurringTransactionUseCase) Execute(ctx context.Context, userID, recurringID string) error {
+	ctx, span := u.o11y.Tracer().Start(ctx, "delete_recurring_transaction_usecase.execute")
+	defer span.End()
+
+	recurring, err := findOwnedRecurring(ctx, u.repository, userID, recurringID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	expectedNext := recurring.NextOccurrence
+	recurring.Delete()
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		deleted, err := u.repository.Update(ctx, tx, recurring, expectedNext)
+		if err != nil {
+			return err
+		}
+		if !deleted {
+			return transactionDomain.ErrRecurringTransactionConflict
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "DeleteRecurringTransaction"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "recurring_transaction"),
+		observability.String("user_id", userID),
+	)
+	return nil
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type DeleteRecurringTransactionUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.RecurringTransactionRepository
+}
+
+func TestDeleteRecurringTransactionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DeleteRecurringTransactionUseCaseSuite))
+}
+
+func (s *DeleteRecurringTransactionUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewRecurringTransactionRepository(s.T())
+}
+
+func (s *DeleteRecurringTransactionUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	recurringID := "660e8400-e29b-41d4-a716-446655440000"
+	startDate := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	scenarios := []struct {
+		name         string
+		dependencies func()
+		expect       func(err error)
+	}{
+		{
+			name: "should soft delete and deactivate the recurrence",
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", startDate)
+				expectedNext := recurring.NextOccurrence
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.MatchedBy(func(r *entities.RecurringTransaction) bool {
+					return r.DeletedAt != nil && !r.Active
+				}), expectedNext).Return(true, nil).Once()
+			},
+			expect: func(err error) {
+				s.NoError(err)
+			},
+		},
+		{
+			name: "should return conflict when the recurrence changed concurrently",
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", startDate)
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+			},
+			expect: func(err error) {
+				s.ErrorIs(err, transactionDomain.ErrRecurringTransactionConflict)
+			},
+		},
+		{
+			name: "should return error when recurrence not found",
+			dependencies: func() {
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			expect: func(err error) {
+				s.ErrorIs(err, transactionDomain.ErrRecurringTransactionNotFound)
+			},
+		},
+		{
+			name: "should return repository error",
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", startDate)
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, errors.New("db error")).Once()
+			},
+			expect: func(err error) {
+				s.Error(err)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			uc := NewDeleteRecurringTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo)
+			scenario.expect(uc.Execute(s.ctx, userID, recurringID))
+		})
+	}
+}
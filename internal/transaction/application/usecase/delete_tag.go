@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	DeleteTagUseCase interface {
+		Execute(ctx context.Context, userID, tagID string) error
+	}
+
+	deleteTagUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.TagRepository
+	}
+)
+
+// NewDeleteTagUseCase creates a new DeleteTagUseCase.
+func NewDeleteTagUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TagRepository,
+) DeleteTagUseCase {
+	return &deleteTagUseCase{o11y: o11y, uow: unitOfWork, repository: repository}
+}
+
+// Execute removes the tag from the catalog and from every transaction carrying it.
+func (u *deleteTagUseCase) Execute(ctx context.Context, userID, tagID string) error {
+	ctx, span := u.o11y.Tracer().Start(ctx, "delete_tag_usecase.execute")
+	defer span.End()
+
+	tag, err := findOwnedTag(ctx, u.repository, userID, tagID)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+	tag.Delete()
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Update(ctx, tx, tag)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "DeleteTag"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "tag"),
+		observability.String("user_id", userID),
+	)
+	return nil
+}
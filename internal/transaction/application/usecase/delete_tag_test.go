@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type DeleteTagUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.TagRepository
+}
+
+func TestDeleteTagUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DeleteTagUseCaseSuite))
+}
+
+func (s *DeleteTagUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTagRepository(s.T())
+}
+
+func (s *DeleteTagUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+
+	s.Run("should soft delete the tag", func() {
+		tag := buildTag(userID, "vacation")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.MatchedBy(func(t *entities.Tag) bool {
+			return t.DeletedAt != nil
+		})).Return(nil).Once()
+
+		err := NewDeleteTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, tag.ID.String())
+
+		s.NoError(err)
+	})
+
+	s.Run("should reject a tag of another user", func() {
+		tag := buildTag("550e8400-e29b-41d4-a716-446655440099", "vacation")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+
+		err := NewDeleteTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, tag.ID.String())
+
+		s.ErrorIs(err, transactionDomain.ErrTagNotOwned)
+	})
+
+	s.Run("should reject an invalid ID", func() {
+		err := NewDeleteTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, "invalid")
+
+		s.Error(err)
+	})
+
+	s.Run("should return repository error", func() {
+		tag := buildTag(userID, "vacation")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, tag).Return(errors.New("db error")).Once()
+
+		err := NewDeleteTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, tag.ID.String())
+
+		s.Error(err)
+	})
+}
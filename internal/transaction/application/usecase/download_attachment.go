@@ -0,0 +1,70 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/storage"
+)
+
+type (
+	DownloadAttachmentUseCase interface {
+		Execute(ctx context.Context, userID, attachmentID string) (*dtos.AttachmentContent, error)
+	}
+
+	downloadAttachmentUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.AttachmentRepository
+		owners     attachmentOwners
+		blobStore  storage.BlobStore
+	}
+)
+
+func NewDownloadAttachmentUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.AttachmentRepository,
+	transactionRepository transactionInterfaces.TransactionRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	blobStore storage.BlobStore,
+) DownloadAttachmentUseCase {
+	return &downloadAttachmentUseCase{
+		o11y:       o11y,
+		repository: repository,
+		owners:     attachmentOwners{transactions: transactionRepository, invoices: invoiceProvider},
+		blobStore:  blobStore,
+	}
+}
+
+func (u *downloadAttachmentUseCase) Execute(ctx context.Context, userID, attachmentID string) (*dtos.AttachmentContent, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "download_attachment_usecase.execute")
+	defer span.End()
+
+	attachment, err := u.owners.findAttachment(ctx, u.repository, userID, attachmentID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	content, err := u.blobStore.Get(ctx, attachment.StorageKey())
+	if err != nil {
+		span.RecordError(err)
+		if errors.Is(err, storage.ErrBlobNotFound) {
+			return nil, fmt.Errorf("attachment %s has no content in the blob store: %w", attachment.ID.String(), err)
+		}
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "DownloadAttachment"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "attachment"),
+		observability.String("user_id", userID),
+	)
+
+	return &dtos.AttachmentContent{Attachment: toAttachmentOutput(attachment), Content: content}, nil
+}
@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	"github.com/jailtonjunior94/financial/pkg/storage"
+	storageMocks "github.com/jailtonjunior94/financial/pkg/storage/mocks"
+)
+
+type DownloadAttachmentUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.AttachmentRepository
+	transactionRepo *transactionMocks.TransactionRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+	blobStore       *storageMocks.BlobStore
+}
+
+func TestDownloadAttachmentUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(DownloadAttachmentUseCaseSuite))
+}
+
+func (s *DownloadAttachmentUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewAttachmentRepository(s.T())
+	s.transactionRepo = transactionMocks.NewTransactionRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.blobStore = storageMocks.NewBlobStore(s.T())
+}
+
+func (s *DownloadAttachmentUseCaseSuite) useCase() DownloadAttachmentUseCase {
+	return NewDownloadAttachmentUseCase(s.obs, s.repo, s.transactionRepo, s.invoiceProvider, s.blobStore)
+}
+
+func (s *DownloadAttachmentUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+
+	s.Run("should stream the content of the attachment", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		attachment := buildAttachment(userID, entities.AttachmentOwnerTransaction, transaction.ID, receiptPDF)
+		s.repo.EXPECT().FindByID(mock.Anything, attachment.ID).Return(attachment, nil).Once()
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+		s.blobStore.EXPECT().Get(mock.Anything, attachment.StorageKey()).
+			Return(io.NopCloser(strings.NewReader(receiptPDF)), nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, attachment.ID.String())
+
+		s.NoError(err)
+		s.Equal("application/pdf", output.Attachment.ContentType)
+		content, _ := io.ReadAll(output.Content)
+		s.Equal(receiptPDF, string(content))
+	})
+
+	s.Run("should return not found for an unknown attachment", func() {
+		attachmentID, _ := vos.NewUUID()
+		s.repo.EXPECT().FindByID(mock.Anything, attachmentID).Return(nil, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, attachmentID.String())
+
+		s.ErrorIs(err, transactionDomain.ErrAttachmentNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should reject an attachment of a transaction of another user", func() {
+		otherUser := "550e8400-e29b-41d4-a716-446655440099"
+		transaction := buildTransaction(otherUser, categoryID, nil)
+		attachment := buildAttachment(otherUser, entities.AttachmentOwnerTransaction, transaction.ID, receiptPDF)
+		s.repo.EXPECT().FindByID(mock.Anything, attachment.ID).Return(attachment, nil).Once()
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, attachment.ID.String())
+
+		s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+		s.Nil(output)
+	})
+
+	s.Run("should report missing content in the blob store", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		attachment := buildAttachment(userID, entities.AttachmentOwnerTransaction, transaction.ID, receiptPDF)
+		s.repo.EXPECT().FindByID(mock.Anything, attachment.ID).Return(attachment, nil).Once()
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+		s.blobStore.EXPECT().Get(mock.Anything, attachment.StorageKey()).Return(nil, storage.ErrBlobNotFound).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, attachment.ID.String())
+
+		s.ErrorIs(err, storage.ErrBlobNotFound)
+		s.Nil(output)
+	})
+}
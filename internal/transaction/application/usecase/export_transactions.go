@@ -0,0 +1,76 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ExportTransactionsUseCase interface {
+		Execute(ctx context.Context, userID string, params *dtos.ListParams, emit func(row *dtos.TransactionExportOutput) error) error
+	}
+
+	exportTransactionsUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.TransactionRepository
+	}
+)
+
+// NewExportTransactionsUseCase creates a new ExportTransactionsUseCase.
+func NewExportTransactionsUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.TransactionRepository,
+) ExportTransactionsUseCase {
+	return &exportTransactionsUseCase{o11y: o11y, repository: repository}
+}
+
+// Execute validates the filters before reading anything and then calls emit for every
+// matching transaction, oldest first, as they are read from the database. Limit, cursor
+// and sorting are ignored: the export always covers every matching transaction.
+func (u *exportTransactionsUseCase) Execute(ctx context.Context, userID string, params *dtos.ListParams, emit func(row *dtos.TransactionExportOutput) error) error {
+	ctx, span := u.o11y.Tracer().Start(ctx, "export_transactions_usecase.execute")
+	defer span.End()
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	repoParams, err := toRepositoryListParams(userUUID, params)
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	exported := 0
+	err = u.repository.StreamForExport(ctx, repoParams, func(row *transactionInterfaces.ExportRow) error {
+		exported++
+		return emit(&dtos.TransactionExportOutput{
+			TransactionOutput: toOutput(row.Transaction),
+			CategoryName:      row.CategoryName,
+			SubcategoryName:   row.SubcategoryName,
+			CardName:          row.CardName,
+		})
+	})
+	if err != nil {
+		span.RecordError(err)
+		return err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ExportTransactions"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+		observability.Int("exported", exported),
+	)
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type ExportTransactionsUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.TransactionRepository
+}
+
+func TestExportTransactionsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ExportTransactionsUseCaseSuite))
+}
+
+func (s *ExportTransactionsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+}
+
+func (s *ExportTransactionsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	transactions := makeTransactions(userID, 2)
+	subcategoryName := "Supermercado"
+	emitErr := errors.New("client disconnected")
+
+	streamRows := func(_ context.Context, _ transactionInterfaces.ListParams, fn func(row *transactionInterfaces.ExportRow) error) error {
+		for _, t := range transactions {
+			if err := fn(&transactionInterfaces.ExportRow{Transaction: t, CategoryName: "Alimentação", SubcategoryName: &subcategoryName}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	type dependencies func()
+	type expect func(rows []*dtos.TransactionExportOutput, err error)
+
+	scenarios := []struct {
+		name         string
+		params       *dtos.ListParams
+		emitErr      error
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name:   "should emit every matching transaction with its names",
+			params: &dtos.ListParams{Direction: "EXPENSE", StartDate: "2026-03-01", EndDate: "2026-03-31", Limit: 10, Cursor: "ignored"},
+			dependencies: func() {
+				s.repo.EXPECT().
+					StreamForExport(mock.Anything, mock.MatchedBy(func(p transactionInterfaces.ListParams) bool {
+						return p.Direction == "EXPENSE" &&
+							p.StartDate.Equal(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)) &&
+							p.EndDate.Equal(time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)) &&
+							p.Limit == 0 && p.Cursor == ""
+					}), mock.Anything).
+					RunAndReturn(streamRows).Once()
+			},
+			expect: func(rows []*dtos.TransactionExportOutput, err error) {
+				s.NoError(err)
+				s.Len(rows, 2)
+				s.Equal(transactions[0].ID.String(), rows[0].ID)
+				s.Equal("Alimentação", rows[0].CategoryName)
+				s.Equal(&subcategoryName, rows[0].SubcategoryName)
+				s.Nil(rows[0].CardName)
+			},
+		},
+		{
+			name:    "should stop when emit fails",
+			params:  &dtos.ListParams{},
+			emitErr: emitErr,
+			dependencies: func() {
+				s.repo.EXPECT().StreamForExport(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(streamRows).Once()
+			},
+			expect: func(rows []*dtos.TransactionExportOutput, err error) {
+				s.ErrorIs(err, emitErr)
+				s.Len(rows, 1)
+			},
+		},
+		{
+			name:         "should validate the filters before reading",
+			params:       &dtos.ListParams{Direction: "SIDEWAYS"},
+			dependencies: func() {},
+			expect: func(rows []*dtos.TransactionExportOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidDirection)
+				s.Empty(rows)
+			},
+		},
+		{
+			name:   "should return error when the query fails",
+			params: &dtos.ListParams{},
+			dependencies: func() {
+				s.repo.EXPECT().StreamForExport(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(rows []*dtos.TransactionExportOutput, err error) {
+				s.Error(err)
+				s.Empty(rows)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			var rows []*dtos.TransactionExportOutput
+			uc := NewExportTransactionsUseCase(s.obs, s.repo)
+			err := uc.Execute(s.ctx, userID, scenario.params, func(row *dtos.TransactionExportOutput) error {
+				rows = append(rows, row)
+				return scenario.emitErr
+			})
+			scenario.expect(rows, err)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	GetRecurringTransactionUseCase interface {
+		Execute(ctx context.Context, userID, recurringID string) (*dtos.RecurringTransactionOutput, error)
+	}
+
+	getRecurringTransactionUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.RecurringTransactionRepository
+	}
+)
+
+// NewGetRecurringTransactionUseCase creates a new GetRecurringTransactionUseCase.
+func NewGetRecurringTransactionUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.RecurringTransactionRepository,
+) GetRecurringTransactionUseCase {
+	return &getRecurringTransactionUseCase{o11y: o11y, repository: repository}
+}
+
+func (u *getRecurringTransactionUseCase) Execute(ctx context.Context, userID, recurringID string) (*dtos.RecurringTransactionOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "get_recurring_transaction_usecase.execute")
+	defer span.End()
+
+	recurring, err := findOwnedRecurring(ctx, u.repository, userID, recurringID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "GetRecurringTransaction"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "recurring_transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return toRecurringOutput(recurring), nil
+}
+
+// findOwnedRecurring loads a recurring transaction and checks that it belongs to the user.
+func findOwnedRecurring(
+	ctx context.Context,
+	repository transactionInterfaces.RecurringTransactionRepository,
+	userID, recurringID string,
+) (*entities.RecurringTransaction, error) {
+	id, err := vos.NewUUIDFromString(recurringID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurring_transaction_id: %w", err)
+	}
+
+	recurring, err := repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if recurring == nil {
+		return nil, transactionDomain.ErrRecurringTransactionNotFound
+	}
+	if recurring.UserID.String() != userID {
+		return nil, transactionDomain.ErrRecurringTransactionNotOwned
+	}
+	return recurring, nil
+}
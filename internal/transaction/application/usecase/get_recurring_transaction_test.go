@@ -0,0 +1,129 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+func buildRecurringTransaction(userID, categoryID, rule string, startDate time.Time) *entities.RecurringTransaction {
+	id, _ := vos.NewUUID()
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	catUUID, _ := vos.NewUUIDFromString(categoryID)
+	amount, _ := vos.NewMoneyFromFloat(1500.0, vos.CurrencyBRL)
+	pm, _ := transactionVos.NewPaymentMethod("pix")
+	rrule, _ := transactionVos.NewRecurrenceRule(rule)
+	recurring, _ := entities.NewRecurringTransaction(entities.RecurringTransactionParams{
+		ID:            id,
+		UserID:        userUUID,
+		CategoryID:    catUUID,
+		Description:   "Aluguel",
+		Amount:        amount,
+		PaymentMethod: pm,
+		Rule:          rrule,
+		StartDate:     startDate,
+		CreatedAt:     time.Now().UTC(),
+	})
+	return recurring
+}
+
+type GetRecurringTransactionUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.RecurringTransactionRepository
+}
+
+func TestGetRecurringTransactionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(GetRecurringTransactionUseCaseSuite))
+}
+
+func (s *GetRecurringTransactionUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewRecurringTransactionRepository(s.T())
+}
+
+func (s *GetRecurringTransactionUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	recurringID := "660e8400-e29b-41d4-a716-446655440000"
+
+	scenarios := []struct {
+		name         string
+		userID       string
+		recurringID  string
+		dependencies func()
+		expect       func(output *dtos.RecurringTransactionOutput, err error)
+	}{
+		{
+			name:        "should return recurrence owned by user",
+			userID:      userID,
+			recurringID: recurringID,
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.NoError(err)
+				s.Equal("FREQ=MONTHLY", output.RRule)
+				s.Equal("2026-01-05", output.StartDate)
+			},
+		},
+		{
+			name:        "should return error when recurrence belongs to another user",
+			userID:      "550e8400-e29b-41d4-a716-446655440099",
+			recurringID: recurringID,
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC))
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrRecurringTransactionNotOwned)
+				s.Nil(output)
+			},
+		},
+		{
+			name:        "should return error when recurrence not found",
+			userID:      userID,
+			recurringID: recurringID,
+			dependencies: func() {
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrRecurringTransactionNotFound)
+				s.Nil(output)
+			},
+		},
+		{
+			name:         "should return error for invalid id",
+			userID:       userID,
+			recurringID:  "invalid",
+			dependencies: func() {},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			uc := NewGetRecurringTransactionUseCase(s.obs, s.repo)
+			output, err := uc.Execute(s.ctx, scenario.userID, scenario.recurringID)
+			scenario.expect(output, err)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	GetTagSummaryUseCase interface {
+		Execute(ctx context.Context, userID, tagID string, params *dtos.TagSummaryParams) (*dtos.TagSummaryOutput, error)
+	}
+
+	getTagSummaryUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.TagRepository
+	}
+)
+
+// NewGetTagSummaryUseCase creates a new GetTagSummaryUseCase.
+func NewGetTagSummaryUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.TagRepository,
+) GetTagSummaryUseCase {
+	return &getTagSummaryUseCase{o11y: o11y, repository: repository}
+}
+
+// Execute totals the active transactions carrying the tag, optionally within a period.
+func (u *getTagSummaryUseCase) Execute(ctx context.Context, userID, tagID string, params *dtos.TagSummaryParams) (*dtos.TagSummaryOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "get_tag_summary_usecase.execute")
+	defer span.End()
+
+	from, to, err := params.Validate()
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tag, err := findOwnedTag(ctx, u.repository, userID, tagID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	totals, err := u.repository.SumTransactions(ctx, tag.UserID, tag.ID, from, to)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "GetTagSummary"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "tag"),
+		observability.String("user_id", userID),
+	)
+
+	output := &dtos.TagSummaryOutput{
+		TagID:            tag.ID.String(),
+		Name:             tag.Name,
+		ExpenseTotal:     totals.Expense.Float(),
+		IncomeTotal:      totals.Income.Float(),
+		TransactionCount: totals.TransactionCount,
+	}
+	if from != nil {
+		start := from.Format("2006-01-02")
+		output.StartDate = &start
+	}
+	if to != nil {
+		end := to.Format("2006-01-02")
+		output.EndDate = &end
+	}
+	return output, nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type GetTagSummaryUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.TagRepository
+}
+
+func TestGetTagSummaryUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(GetTagSummaryUseCaseSuite))
+}
+
+func (s *GetTagSummaryUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTagRepository(s.T())
+}
+
+func (s *GetTagSummaryUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	expense, _ := vos.NewMoneyFromFloat(1250.50, vos.CurrencyBRL)
+	income, _ := vos.NewMoneyFromFloat(300, vos.CurrencyBRL)
+
+	s.Run("should sum the tagged transactions in the period", func() {
+		tag := buildTag(userID, "vacation-2026")
+		from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+		s.repo.EXPECT().SumTransactions(mock.Anything, tag.UserID, tag.ID, &from, &to).
+			Return(interfaces.TagTotals{Expense: expense, Income: income, TransactionCount: 4}, nil).Once()
+
+		output, err := NewGetTagSummaryUseCase(s.obs, s.repo).Execute(s.ctx, userID, tag.ID.String(),
+			&dtos.TagSummaryParams{StartDate: "2026-01-01", EndDate: "2026-01-31"})
+
+		s.NoError(err)
+		s.Equal("vacation-2026", output.Name)
+		s.Equal(1250.50, output.ExpenseTotal)
+		s.Equal(300.0, output.IncomeTotal)
+		s.Equal(4, output.TransactionCount)
+		s.Equal("2026-01-01", *output.StartDate)
+		s.Equal("2026-01-31", *output.EndDate)
+	})
+
+	s.Run("should sum every tagged transaction without a period", func() {
+		tag := buildTag(userID, "reimbursable")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+		s.repo.EXPECT().SumTransactions(mock.Anything, tag.UserID, tag.ID, (*time.Time)(nil), (*time.Time)(nil)).
+			Return(interfaces.TagTotals{Expense: expense, Income: income, TransactionCount: 2}, nil).Once()
+
+		output, err := NewGetTagSummaryUseCase(s.obs, s.repo).Execute(s.ctx, userID, tag.ID.String(), &dtos.TagSummaryParams{})
+
+		s.NoError(err)
+		s.Nil(output.StartDate)
+		s.Nil(output.EndDate)
+	})
+
+	s.Run("should reject an inverted period", func() {
+		tag := buildTag(userID, "vacation-2026")
+
+		output, err := NewGetTagSummaryUseCase(s.obs, s.repo).Execute(s.ctx, userID, tag.ID.String(),
+			&dtos.TagSummaryParams{StartDate: "2026-02-01", EndDate: "2026-01-01"})
+
+		s.Error(err)
+		s.Nil(output)
+	})
+
+	s.Run("should return not found", func() {
+		tag := buildTag(userID, "vacation-2026")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(nil, nil).Once()
+
+		output, err := NewGetTagSummaryUseCase(s.obs, s.repo).Execute(s.ctx, userID, tag.ID.String(), &dtos.TagSummaryParams{})
+
+		s.ErrorIs(err, transactionDomain.ErrTagNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should return repository error", func() {
+		tag := buildTag(userID, "vacation-2026")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+		s.repo.EXPECT().SumTransactions(mock.Anything, tag.UserID, tag.ID, mock.Anything, mock.Anything).
+			Return(interfaces.TagTotals{}, errors.New("db error")).Once()
+
+		output, err := NewGetTagSummaryUseCase(s.obs, s.repo).Execute(s.ctx, userID, tag.ID.String(), &dtos.TagSummaryParams{})
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
@@ -18,8 +18,9 @@ type (
 	}
 
 	getTransactionUseCase struct {
-		o11y       observability.Observability
-		repository transactionInterfaces.TransactionRepository
+		o11y             observability.Observability
+		repository       transactionInterfaces.TransactionRepository
+		refundRepository transactionInterfaces.RefundRepository
 	}
 )
 
@@ -27,8 +28,9 @@ type (
 func NewGetTransactionUseCase(
 	o11y observability.Observability,
 	repository transactionInterfaces.TransactionRepository,
+	refundRepository transactionInterfaces.RefundRepository,
 ) GetTransactionUseCase {
-	return &getTransactionUseCase{o11y: o11y, repository: repository}
+	return &getTransactionUseCase{o11y: o11y, repository: repository, refundRepository: refundRepository}
 }
 
 func (u *getTransactionUseCase) Execute(ctx context.Context, userID, transactionID string) (*dtos.TransactionOutput, error) {
@@ -54,6 +56,25 @@ func (u *getTransactionUseCase) Execute(ctx context.Context, userID, transaction
 		return nil, transactionDomain.ErrTransactionNotOwned
 	}
 
+	output := toOutput(transaction)
+	// Only card purchases can be refunded, so other transactions have no history to load.
+	if transaction.CardID != nil {
+		refunds, err := u.refundRepository.ListByTransaction(ctx, transaction.ID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		refunded, err := transaction.RefundedAmount(refunds)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		output.RefundedAmount = refunded.Float()
+		for _, refund := range refunds {
+			output.Refunds = append(output.Refunds, toRefundOutput(refund))
+		}
+	}
+
 	u.o11y.Logger().Info(ctx, "request_completed",
 		observability.String("operation", "GetTransaction"),
 		observability.String("layer", "usecase"),
@@ -61,5 +82,5 @@ func (u *getTransactionUseCase) Execute(ctx context.Context, userID, transaction
 		observability.String("user_id", userID),
 	)
 
-	return toOutput(transaction), nil
+	return output, nil
 }
@@ -0,0 +1,122 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	GetTransactionHistoryUseCase interface {
+		Execute(ctx context.Context, userID, transactionID string) ([]*dtos.TransactionRevisionOutput, error)
+	}
+
+	getTransactionHistoryUseCase struct {
+		o11y               observability.Observability
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+	}
+)
+
+// NewGetTransactionHistoryUseCase creates a new GetTransactionHistoryUseCase.
+func NewGetTransactionHistoryUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+) GetTransactionHistoryUseCase {
+	return &getTransactionHistoryUseCase{o11y: o11y, repository: repository, revisionRepository: revisionRepository}
+}
+
+// Execute returns the revisions of a transaction of the user, oldest first.
+func (u *getTransactionHistoryUseCase) Execute(ctx context.Context, userID, transactionID string) ([]*dtos.TransactionRevisionOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "get_transaction_history_usecase.execute")
+	defer span.End()
+
+	txID, err := vos.NewUUIDFromString(transactionID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid transaction_id: %w", err)
+	}
+
+	transaction, err := u.repository.FindByID(ctx, txID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, transactionDomain.ErrTransactionNotFound
+	}
+
+	if transaction.UserID.String() != userID {
+		return nil, transactionDomain.ErrTransactionNotOwned
+	}
+
+	revisions, err := u.revisionRepository.ListByTransaction(ctx, transaction.ID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "GetTransactionHistory"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	output := make([]*dtos.TransactionRevisionOutput, 0, len(revisions))
+	for _, revision := range revisions {
+		output = append(output, toRevisionOutput(revision))
+	}
+	return output, nil
+}
+
+// statesOf returns the current state of each transaction, to be diffed against once
+// they are changed.
+func statesOf(ts ...*entities.Transaction) map[vos.UUID]entities.TransactionState {
+	states := make(map[vos.UUID]entities.TransactionState, len(ts))
+	for _, t := range ts {
+		states[t.ID] = t.State()
+	}
+	return states
+}
+
+// saveRevisions records the history of transactions changed in a unit of work. Each
+// transaction with a state in before is diffed against it; any other is recorded as
+// created. Transactions with no tracked change are skipped. A nil changedBy marks the
+// changes made by the worker.
+func saveRevisions(
+	ctx context.Context,
+	tx database.DBTX,
+	repository transactionInterfaces.TransactionRevisionRepository,
+	changedBy *vos.UUID,
+	before map[vos.UUID]entities.TransactionState,
+	ts ...*entities.Transaction,
+) error {
+	revisions := make([]*entities.TransactionRevision, 0, len(ts))
+	for _, t := range ts {
+		var previous *entities.TransactionState
+		if state, ok := before[t.ID]; ok {
+			previous = &state
+		}
+		revision, err := entities.NewTransactionRevision(t, previous, changedBy)
+		if err != nil {
+			return err
+		}
+		if revision != nil {
+			revisions = append(revisions, revision)
+		}
+	}
+	if len(revisions) == 0 {
+		return nil
+	}
+	return repository.SaveAll(ctx, tx, revisions)
+}
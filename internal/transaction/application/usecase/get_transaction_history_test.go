@@ -0,0 +1,126 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type GetTransactionHistoryUseCaseSuite struct {
+	suite.Suite
+	ctx          context.Context
+	obs          *fake.Provider
+	repo         *transactionMocks.TransactionRepository
+	revisionRepo *transactionMocks.TransactionRevisionRepository
+}
+
+func TestGetTransactionHistoryUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(GetTransactionHistoryUseCaseSuite))
+}
+
+func (s *GetTransactionHistoryUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+}
+
+func (s *GetTransactionHistoryUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	transactionID := "660e8400-e29b-41d4-a716-446655440000"
+	txID, _ := vos.NewUUIDFromString(transactionID)
+
+	type args struct {
+		userID        string
+		transactionID string
+	}
+	type dependencies func()
+	type expect func(output []*dtos.TransactionRevisionOutput, err error)
+
+	scenarios := []struct {
+		name         string
+		args         args
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name: "should return the revisions made by the user and by the worker",
+			args: args{userID: userID, transactionID: transactionID},
+			dependencies: func() {
+				tx := buildTransaction(userID, categoryID, nil)
+				created, _ := entities.NewTransactionRevision(tx, nil, &tx.UserID)
+				before := tx.State()
+				_ = tx.Cancel()
+				cancelled, _ := entities.NewTransactionRevision(tx, &before, nil)
+				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+				s.revisionRepo.EXPECT().ListByTransaction(mock.Anything, tx.ID).Return([]*entities.TransactionRevision{created, cancelled}, nil).Once()
+			},
+			expect: func(output []*dtos.TransactionRevisionOutput, err error) {
+				s.NoError(err)
+				s.Require().Len(output, 2)
+				s.Equal(entities.RevisionActionCreated, output[0].Action)
+				s.Equal(userID, *output[0].ChangedBy)
+				s.Equal(entities.RevisionActionCancelled, output[1].Action)
+				s.Nil(output[1].ChangedBy)
+				s.Require().Len(output[1].Changes, 1)
+				s.Equal("status", output[1].Changes[0].Field)
+			},
+		},
+		{
+			name: "should return error when transaction belongs to another user",
+			args: args{userID: "different-0000-0000-0000-000000000001", transactionID: transactionID},
+			dependencies: func() {
+				tx := buildTransaction(userID, categoryID, nil)
+				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+			},
+			expect: func(output []*dtos.TransactionRevisionOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+			},
+		},
+		{
+			name: "should return error when transaction not found",
+			args: args{userID: userID, transactionID: transactionID},
+			dependencies: func() {
+				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(nil, nil).Once()
+			},
+			expect: func(output []*dtos.TransactionRevisionOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, transactionDomain.ErrTransactionNotFound)
+			},
+		},
+		{
+			name: "should return error when listing the revisions fails",
+			args: args{userID: userID, transactionID: transactionID},
+			dependencies: func() {
+				tx := buildTransaction(userID, categoryID, nil)
+				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+				s.revisionRepo.EXPECT().ListByTransaction(mock.Anything, tx.ID).Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(output []*dtos.TransactionRevisionOutput, err error) {
+				s.Nil(output)
+				s.EqualError(err, "db error")
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			uc := NewGetTransactionHistoryUseCase(s.obs, s.repo, s.revisionRepo)
+			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.transactionID)
+			scenario.expect(output, err)
+		})
+	}
+}
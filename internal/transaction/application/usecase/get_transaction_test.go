@@ -11,14 +11,16 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
 	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
 )
 
 type GetTransactionUseCaseSuite struct {
 	suite.Suite
-	ctx  context.Context
-	obs  *fake.Provider
-	repo *transactionMocks.TransactionRepository
+	ctx        context.Context
+	obs        *fake.Provider
+	repo       *transactionMocks.TransactionRepository
+	refundRepo *transactionMocks.RefundRepository
 }
 
 func TestGetTransactionUseCaseSuite(t *testing.T) {
@@ -29,6 +31,7 @@ func (s *GetTransactionUseCaseSuite) SetupTest() {
 	s.obs = fake.NewProvider()
 	s.ctx = context.Background()
 	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.refundRepo = transactionMocks.NewRefundRepository(s.T())
 }
 
 func (s *GetTransactionUseCaseSuite) TestExecute() {
@@ -62,6 +65,26 @@ func (s *GetTransactionUseCaseSuite) TestExecute() {
 				s.Equal(userID, output.UserID)
 			},
 		},
+		{
+			name: "should return the refund history of a card purchase",
+			args: args{userID: userID, transactionID: "660e8400-e29b-41d4-a716-446655440000"},
+			dependencies: func(txIDStr string) {
+				txID, _ := vos.NewUUIDFromString(txIDStr)
+				invoiceID, _ := vos.NewUUID()
+				cardID, _ := vos.NewUUID()
+				tx := buildTransaction(userID, categoryID, &invoiceID)
+				tx.CardID = &cardID
+				refundID, _ := vos.NewUUID()
+				refund := &entities.Refund{ID: refundID, TransactionID: tx.ID, InvoiceID: invoiceID, Amount: *mustMoney(30)}
+				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+				s.refundRepo.EXPECT().ListByTransaction(mock.Anything, tx.ID).Return([]*entities.Refund{refund, refund}, nil).Once()
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Equal(60.0, output.RefundedAmount)
+				s.Len(output.Refunds, 2)
+			},
+		},
 		{
 			name: "should return error when transaction belongs to another user",
 			args: args{userID: "different-0000-0000-0000-000000000001", transactionID: "660e8400-e29b-41d4-a716-446655440000"},
@@ -94,7 +117,7 @@ func (s *GetTransactionUseCaseSuite) TestExecute() {
 	for _, scenario := range scenarios {
 		s.Run(scenario.name, func() {
 			scenario.dependencies(scenario.args.transactionID)
-			uc := NewGetTransactionUseCase(s.obs, s.repo)
+			uc := NewGetTransactionUseCase(s.obs, s.repo, s.refundRepo)
 			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.transactionID)
 			scenario.expect(output, err)
 		})
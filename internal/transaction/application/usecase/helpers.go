@@ -2,9 +2,15 @@ package usecase
 
 import (
 	"time"
+	"unicode/utf8"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
 	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
 )
 
@@ -15,10 +21,12 @@ func toOutput(t *entities.Transaction) *dtos.TransactionOutput {
 		CategoryID:      t.CategoryID.String(),
 		Description:     t.Description,
 		Amount:          t.Amount.Float(),
+		Direction:       t.Direction.String(),
 		PaymentMethod:   t.PaymentMethod.String(),
 		TransactionDate: t.TransactionDate.Format("2006-01-02"),
 		Status:          t.Status.String(),
 		CreatedAt:       t.CreatedAt.Format(time.RFC3339),
+		Version:         t.Version,
 	}
 	if t.SubcategoryID != nil {
 		s := t.SubcategoryID.String()
@@ -38,6 +46,33 @@ func toOutput(t *entities.Transaction) *dtos.TransactionOutput {
 	}
 	out.InstallmentNumber = t.InstallmentNumber
 	out.InstallmentTotal = t.InstallmentTotal
+	if t.IsForeign() {
+		originalAmount := t.OriginalAmount.Float()
+		out.OriginalCurrency = t.OriginalAmount.Currency().String()
+		out.OriginalAmount = &originalAmount
+		if t.ExchangeRate != nil {
+			rate := t.ExchangeRate.Float()
+			out.ExchangeRate = &rate
+		}
+		if t.IOFAmount != nil {
+			iof := t.IOFAmount.Float()
+			out.IOFAmount = &iof
+		}
+	}
+	for _, split := range t.Splits {
+		splitOut := &dtos.SplitOutput{
+			CategoryID: split.CategoryID.String(),
+			Amount:     split.Amount.Float(),
+		}
+		if split.SubcategoryID != nil {
+			s := split.SubcategoryID.String()
+			splitOut.SubcategoryID = &s
+		}
+		out.Splits = append(out.Splits, splitOut)
+	}
+	for _, tag := range t.Tags {
+		out.Tags = append(out.Tags, &dtos.TransactionTagOutput{ID: tag.ID.String(), Name: tag.Name})
+	}
 	return out
 }
 
@@ -49,6 +84,272 @@ func toOutputList(ts []*entities.Transaction) []*dtos.TransactionOutput {
 	return out
 }
 
+// toSplitParams maps the splits of a request to the factory input.
+func toSplitParams(splits []dtos.SplitInput) []factories.SplitParams {
+	if len(splits) == 0 {
+		return nil
+	}
+	params := make([]factories.SplitParams, 0, len(splits))
+	for _, split := range splits {
+		params = append(params, factories.SplitParams{
+			CategoryID:    split.CategoryID,
+			SubcategoryID: split.SubcategoryID,
+			Amount:        split.Amount,
+		})
+	}
+	return params
+}
+
+// toSplitSnapshots copies the splits of a transaction into its events.
+func toSplitSnapshots(t *entities.Transaction) []events.SplitSnapshot {
+	if !t.IsSplit() {
+		return nil
+	}
+	snapshots := make([]events.SplitSnapshot, 0, len(t.Splits))
+	for _, split := range t.Splits {
+		snapshots = append(snapshots, events.SplitSnapshot{
+			CategoryID:    split.CategoryID,
+			SubcategoryID: split.SubcategoryID,
+			Amount:        split.Amount,
+		})
+	}
+	return snapshots
+}
+
 func resolveReferenceMonth(_ *entities.Transaction, transactionDate time.Time) pkgVos.ReferenceMonth {
 	return pkgVos.NewReferenceMonthFromDate(transactionDate)
 }
+
+// toInvoiceItem maps a billed transaction to its invoice item. totalAmount is the
+// original purchase amount, which differs from t.Amount for installments.
+func toInvoiceItem(t *entities.Transaction, totalAmount vos.Money) transactionInterfaces.InvoiceItemInfo {
+	installmentNumber, installmentTotal := 1, 1
+	if t.InstallmentNumber != nil {
+		installmentNumber = *t.InstallmentNumber
+	}
+	if t.InstallmentTotal != nil {
+		installmentTotal = *t.InstallmentTotal
+	}
+	return transactionInterfaces.InvoiceItemInfo{
+		TransactionID:     t.ID,
+		InvoiceID:         *t.InvoiceID,
+		CategoryID:        t.CategoryID,
+		PurchaseDate:      t.TransactionDate,
+		Description:       t.Description,
+		TotalAmount:       totalAmount,
+		InstallmentNumber: installmentNumber,
+		InstallmentTotal:  installmentTotal,
+		InstallmentAmount: t.Amount,
+	}
+}
+
+// toInvoiceItems maps the transactions of a single purchase to invoice items,
+// using the sum of all installments as the purchase total.
+func toInvoiceItems(ts []*entities.Transaction) ([]transactionInterfaces.InvoiceItemInfo, error) {
+	billed := make([]*entities.Transaction, 0, len(ts))
+	for _, t := range ts {
+		if t.InvoiceID != nil {
+			billed = append(billed, t)
+		}
+	}
+	if len(billed) == 0 {
+		return nil, nil
+	}
+
+	totalAmount := billed[0].Amount
+	for _, t := range billed[1:] {
+		sum, err := totalAmount.Add(t.Amount)
+		if err != nil {
+			return nil, err
+		}
+		totalAmount = sum
+	}
+
+	items := make([]transactionInterfaces.InvoiceItemInfo, 0, len(billed))
+	for _, t := range billed {
+		items = append(items, toInvoiceItem(t, totalAmount))
+	}
+	return items, nil
+}
+
+// toIOFItems maps the IOF of each billed foreign currency purchase to an invoice item of
+// its own, on the invoice of the purchase.
+func toIOFItems(ts []*entities.Transaction) []transactionInterfaces.IOFItemInfo {
+	var items []transactionInterfaces.IOFItemInfo
+	for _, t := range ts {
+		if t.IOFAmount == nil || t.InvoiceID == nil {
+			continue
+		}
+		description := "IOF: " + t.Description
+		if utf8.RuneCountInString(description) > maxInvoiceItemDescriptionLength {
+			description = string([]rune(description)[:maxInvoiceItemDescriptionLength])
+		}
+		items = append(items, transactionInterfaces.IOFItemInfo{
+			TransactionID: t.ID,
+			InvoiceID:     *t.InvoiceID,
+			CategoryID:    t.CategoryID,
+			PurchaseDate:  t.TransactionDate,
+			Description:   description,
+			Amount:        *t.IOFAmount,
+		})
+	}
+	return items
+}
+
+func toRevisionOutput(revision *entities.TransactionRevision) *dtos.TransactionRevisionOutput {
+	out := &dtos.TransactionRevisionOutput{
+		ID:        revision.ID.String(),
+		Action:    revision.Action,
+		Changes:   make([]dtos.FieldChangeOutput, 0, len(revision.Changes)),
+		CreatedAt: revision.CreatedAt.Format(time.RFC3339),
+	}
+	if revision.ChangedBy != nil {
+		changedBy := revision.ChangedBy.String()
+		out.ChangedBy = &changedBy
+	}
+	for _, change := range revision.Changes {
+		out.Changes = append(out.Changes, dtos.FieldChangeOutput{Field: change.Field, From: change.From, To: change.To})
+	}
+	return out
+}
+
+func toTagOutput(tag *entities.Tag) *dtos.TagOutput {
+	out := &dtos.TagOutput{
+		ID:        tag.ID.String(),
+		Name:      tag.Name,
+		CreatedAt: tag.CreatedAt.Format(time.RFC3339),
+	}
+	if tag.UpdatedAt != nil {
+		updated := tag.UpdatedAt.Format(time.RFC3339)
+		out.UpdatedAt = &updated
+	}
+	return out
+}
+
+func toCategorizationRuleOutput(rule *entities.CategorizationRule) *dtos.CategorizationRuleOutput {
+	c := rule.Conditions
+	out := &dtos.CategorizationRuleOutput{
+		ID:                  rule.ID.String(),
+		Name:                rule.Name,
+		Priority:            rule.Priority,
+		Enabled:             rule.Enabled,
+		DescriptionContains: c.DescriptionContains,
+		DescriptionRegex:    c.DescriptionRegex,
+		PaymentMethod:       c.PaymentMethod,
+		CategoryID:          rule.Outcome.CategoryID.String(),
+		TagIDs:              make([]string, 0, len(rule.Outcome.TagIDs)),
+		CreatedAt:           rule.CreatedAt.Format(time.RFC3339),
+	}
+	if c.MinAmount != nil {
+		minAmount := c.MinAmount.Float()
+		out.MinAmount = &minAmount
+	}
+	if c.MaxAmount != nil {
+		maxAmount := c.MaxAmount.Float()
+		out.MaxAmount = &maxAmount
+	}
+	if c.CardID != nil {
+		cardID := c.CardID.String()
+		out.CardID = &cardID
+	}
+	if rule.Outcome.SubcategoryID != nil {
+		subcategoryID := rule.Outcome.SubcategoryID.String()
+		out.SubcategoryID = &subcategoryID
+	}
+	for _, tagID := range rule.Outcome.TagIDs {
+		out.TagIDs = append(out.TagIDs, tagID.String())
+	}
+	if rule.UpdatedAt != nil {
+		updated := rule.UpdatedAt.Format(time.RFC3339)
+		out.UpdatedAt = &updated
+	}
+	return out
+}
+
+func toRecurringOutput(r *entities.RecurringTransaction) *dtos.RecurringTransactionOutput {
+	out := &dtos.RecurringTransactionOutput{
+		ID:              r.ID.String(),
+		UserID:          r.UserID.String(),
+		CategoryID:      r.CategoryID.String(),
+		Description:     r.Description,
+		Amount:          r.Amount.Float(),
+		Direction:       r.Direction.String(),
+		PaymentMethod:   r.PaymentMethod.String(),
+		RRule:           r.Rule.String(),
+		StartDate:       r.StartDate.Format("2006-01-02"),
+		OccurrenceCount: r.OccurrenceCount,
+		Active:          r.Active,
+		CreatedAt:       r.CreatedAt.Format(time.RFC3339),
+	}
+	if r.SubcategoryID != nil {
+		s := r.SubcategoryID.String()
+		out.SubcategoryID = &s
+	}
+	if r.CardID != nil {
+		c := r.CardID.String()
+		out.CardID = &c
+	}
+	if r.NextOccurrence != nil {
+		next := r.NextOccurrence.Format("2006-01-02")
+		out.NextOccurrence = &next
+	}
+	if r.UpdatedAt != nil {
+		updated := r.UpdatedAt.Format(time.RFC3339)
+		out.UpdatedAt = &updated
+	}
+	return out
+}
+
+// occurrenceInput builds the transaction materialized for an occurrence of the recurrence.
+func occurrenceInput(r *entities.RecurringTransaction, date time.Time) *dtos.TransactionInput {
+	input := &dtos.TransactionInput{
+		Description:     r.Description,
+		Amount:          r.Amount.Float(),
+		Direction:       r.Direction.String(),
+		PaymentMethod:   r.PaymentMethod.String(),
+		TransactionDate: date.Format("2006-01-02"),
+		CategoryID:      r.CategoryID.String(),
+	}
+	if r.SubcategoryID != nil {
+		input.SubcategoryID = r.SubcategoryID.String()
+	}
+	if r.CardID != nil {
+		input.CardID = r.CardID.String()
+	}
+	return input
+}
+
+func toRefundOutput(refund *entities.Refund) *dtos.RefundOutput {
+	return &dtos.RefundOutput{
+		ID:            refund.ID.String(),
+		TransactionID: refund.TransactionID.String(),
+		InvoiceID:     refund.InvoiceID.String(),
+		Amount:        refund.Amount.Float(),
+		Reason:        refund.Reason,
+		RefundDate:    refund.RefundDate.Format("2006-01-02"),
+		CreatedAt:     refund.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toExchangeRateOutput(rate *entities.ExchangeRate) *dtos.ExchangeRateOutput {
+	return &dtos.ExchangeRateOutput{
+		Currency:     rate.Currency.String(),
+		BaseCurrency: rate.BaseCurrency.String(),
+		Rate:         rate.Rate.Float(),
+		RateDate:     rate.RateDate.Format("2006-01-02"),
+		Source:       rate.Source,
+	}
+}
+
+func toAttachmentOutput(attachment *entities.Attachment) *dtos.AttachmentOutput {
+	return &dtos.AttachmentOutput{
+		ID:          attachment.ID.String(),
+		OwnerType:   string(attachment.OwnerType),
+		OwnerID:     attachment.OwnerID.String(),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		SHA256:      attachment.SHA256,
+		CreatedAt:   attachment.CreatedAt.Format(time.RFC3339),
+	}
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ImportExchangeRatesUseCase interface {
+		Execute(ctx context.Context, userID string, inputs []dtos.ExchangeRateInput) (*dtos.ExchangeRateImportOutput, error)
+	}
+
+	importExchangeRatesUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.ExchangeRateRepository
+	}
+)
+
+// NewImportExchangeRatesUseCase creates a new ImportExchangeRatesUseCase.
+func NewImportExchangeRatesUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.ExchangeRateRepository,
+) ImportExchangeRatesUseCase {
+	return &importExchangeRatesUseCase{o11y: o11y, uow: unitOfWork, repository: repository}
+}
+
+// Execute saves the rates read from a file in a single unit of work: an invalid rate
+// imports none. Rates of a currency already set for the same date are replaced.
+func (u *importExchangeRatesUseCase) Execute(ctx context.Context, userID string, inputs []dtos.ExchangeRateInput) (*dtos.ExchangeRateImportOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "import_exchange_rates_usecase.execute")
+	defer span.End()
+
+	if len(inputs) == 0 {
+		return nil, transactionDomain.ErrImportEmpty
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	rates := make([]*entities.ExchangeRate, 0, len(inputs))
+	for i, input := range inputs {
+		rate, err := newExchangeRate(userUUID, input, entities.ExchangeRateSourceCSV)
+		if err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("rates[%d]: %w", i, err)
+		}
+		rates = append(rates, rate)
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Upsert(ctx, tx, rates)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ImportExchangeRates"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "exchange_rate"),
+		observability.String("user_id", userID),
+		observability.Int("imported", len(rates)),
+	)
+
+	return &dtos.ExchangeRateImportOutput{Imported: len(rates)}, nil
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type ImportExchangeRatesUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.ExchangeRateRepository
+}
+
+func TestImportExchangeRatesUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ImportExchangeRatesUseCaseSuite))
+}
+
+func (s *ImportExchangeRatesUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewExchangeRateRepository(s.T())
+}
+
+func (s *ImportExchangeRatesUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+
+	scenarios := []struct {
+		name         string
+		inputs       []dtos.ExchangeRateInput
+		dependencies func()
+		expect       func(output *dtos.ExchangeRateImportOutput, err error)
+	}{
+		{
+			name: "should save every rate of the file in one upsert",
+			inputs: []dtos.ExchangeRateInput{
+				{Currency: "USD", Rate: 5.4321, RateDate: "2026-03-10"},
+				{Currency: "EUR", Rate: 6.0123, RateDate: "2026-03-10"},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().Upsert(mock.Anything, mock.Anything, mock.MatchedBy(func(rates []*entities.ExchangeRate) bool {
+					return len(rates) == 2 &&
+						rates[0].Source == entities.ExchangeRateSourceCSV &&
+						rates[1].Currency.String() == "EUR"
+				})).Return(nil).Once()
+			},
+			expect: func(output *dtos.ExchangeRateImportOutput, err error) {
+				s.NoError(err)
+				s.Equal(2, output.Imported)
+			},
+		},
+		{
+			name: "should import nothing when one rate is invalid",
+			inputs: []dtos.ExchangeRateInput{
+				{Currency: "USD", Rate: 5.4321, RateDate: "2026-03-10"},
+				{Currency: "USD", Rate: -1, RateDate: "2026-03-11"},
+			},
+			dependencies: func() {},
+			expect: func(output *dtos.ExchangeRateImportOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidExchangeRate)
+				s.ErrorContains(err, "rates[1]")
+				s.Nil(output)
+			},
+		},
+		{
+			name:         "should reject an empty file",
+			inputs:       nil,
+			dependencies: func() {},
+			expect: func(output *dtos.ExchangeRateImportOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrImportEmpty)
+				s.Nil(output)
+			},
+		},
+		{
+			name:   "should return repository error",
+			inputs: []dtos.ExchangeRateInput{{Currency: "USD", Rate: 5.4321, RateDate: "2026-03-10"}},
+			dependencies: func() {
+				s.repo.EXPECT().Upsert(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(output *dtos.ExchangeRateImportOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			output, err := NewImportExchangeRatesUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, scenario.inputs)
+			scenario.expect(output, err)
+		})
+	}
+}
@@ -0,0 +1,396 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+type (
+	ImportTransactionsUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.ImportInput) (*dtos.ImportOutput, error)
+	}
+
+	importTransactionsUseCase struct {
+		o11y             observability.Observability
+		uow              uow.UnitOfWork
+		repository       transactionInterfaces.TransactionRepository
+		ruleRepository   transactionInterfaces.CategorizationRuleRepository
+		categoryProvider transactionInterfaces.CategoryProvider
+		creator          *createTransactionUseCase
+	}
+
+	// importCandidate is a row that passed validation, with its duplicate key.
+	// alreadyImported marks a statement entry whose external id is known, which is
+	// never imported again, even when duplicates are allowed.
+	importCandidate struct {
+		result          *dtos.ImportRowResult
+		input           *dtos.TransactionInput
+		duplicateKey    string
+		date            time.Time
+		alreadyImported bool
+	}
+)
+
+// NewImportTransactionsUseCase creates a new ImportTransactionsUseCase. Rows are built
+// and persisted exactly like POST /api/v1/transactions, including invoice items and events.
+func NewImportTransactionsUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	tagRepository transactionInterfaces.TagRepository,
+	ruleRepository transactionInterfaces.CategorizationRuleRepository,
+	exchangeRateRepository transactionInterfaces.ExchangeRateRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	cardProvider invoiceInterfaces.CardProvider,
+	categoryProvider transactionInterfaces.CategoryProvider,
+	outboxService outbox.Service,
+) ImportTransactionsUseCase {
+	return &importTransactionsUseCase{
+		o11y:             o11y,
+		uow:              unitOfWork,
+		repository:       repository,
+		ruleRepository:   ruleRepository,
+		categoryProvider: categoryProvider,
+		creator: &createTransactionUseCase{
+			o11y:                   o11y,
+			uow:                    unitOfWork,
+			repository:             repository,
+			tagRepository:          tagRepository,
+			ruleRepository:         ruleRepository,
+			exchangeRateRepository: exchangeRateRepository,
+			revisionRepository:     revisionRepository,
+			invoiceProvider:        invoiceProvider,
+			cardProvider:           cardProvider,
+			factory:                factories.NewTransactionFactory(),
+			outboxService:          outboxService,
+		},
+	}
+}
+
+// Execute validates every row, flags duplicates of existing transactions (and of earlier
+// rows of the same file) and, unless it is a dry run, imports the remaining rows in a
+// single unit of work. The import is all-or-nothing: when any row is invalid nothing is
+// persisted and the output reports the errors of each row. Duplicates are skipped unless
+// AllowDuplicates is set; statement entries already imported (same external id) are
+// always skipped, so the same statement can be imported again safely.
+func (u *importTransactionsUseCase) Execute(ctx context.Context, userID string, input *dtos.ImportInput) (*dtos.ImportOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "import_transactions_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if err := u.categorize(ctx, userUUID, input.Rows); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if input.UncategorizedFallback {
+		if err := u.fillUncategorized(ctx, userUUID, input.Rows); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+	}
+
+	output := &dtos.ImportOutput{
+		DryRun: input.DryRun,
+		Total:  len(input.Rows),
+		Rows:   make([]*dtos.ImportRowResult, 0, len(input.Rows)),
+	}
+	candidates := make([]*importCandidate, 0, len(input.Rows))
+	for _, row := range input.Rows {
+		result := &dtos.ImportRowResult{Line: row.Line, Errors: row.Errors, RuleID: row.RuleID}
+		output.Rows = append(output.Rows, result)
+		if len(result.Errors) > 0 {
+			continue
+		}
+		candidate, err := newImportCandidate(row, result)
+		if err != nil {
+			result.Errors = append(result.Errors, err.Error())
+			continue
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	if err := u.flagDuplicates(ctx, userUUID, candidates); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	toImport := make([]*importCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.result.Status == dtos.ImportRowDuplicate && (candidate.alreadyImported || !input.AllowDuplicates) {
+			continue
+		}
+		toImport = append(toImport, candidate)
+	}
+
+	if !input.DryRun && countInvalid(output.Rows) == 0 {
+		if err := u.commit(ctx, userID, toImport); err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		output.Committed = countInvalid(output.Rows) == 0
+	}
+
+	summarize(output)
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ImportTransactions"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+		observability.Int("total", output.Total),
+		observability.Int("invalid", output.Invalid),
+		observability.Int("imported", output.Imported),
+	)
+
+	return output, nil
+}
+
+func newImportCandidate(row *dtos.ImportRow, result *dtos.ImportRowResult) (*importCandidate, error) {
+	rowInput := row.Input
+	if err := rowInput.Validate(); err != nil {
+		return nil, err
+	}
+	date, err := time.Parse("2006-01-02", rowInput.TransactionDate)
+	if err != nil {
+		return nil, err
+	}
+	amount, err := vos.NewMoneyFromFloat(rowInput.Amount, vos.CurrencyBRL)
+	if err != nil {
+		return nil, err
+	}
+	result.Status = dtos.ImportRowValid
+	return &importCandidate{
+		result:       result,
+		input:        &rowInput,
+		duplicateKey: entities.DuplicateKey(date, amount.Cents(), rowInput.Description),
+		date:         date,
+	}, nil
+}
+
+// categorize applies the user's categorization rules to the rows still without a
+// category, after the categories given by the request itself.
+func (u *importTransactionsUseCase) categorize(ctx context.Context, userID vos.UUID, rows []*dtos.ImportRow) error {
+	pending := slices.DeleteFunc(slices.Clone(rows), func(row *dtos.ImportRow) bool {
+		return len(row.Errors) > 0 || !needsCategorization(&row.Input)
+	})
+	if len(pending) == 0 {
+		return nil
+	}
+	rules, err := u.ruleRepository.ListByUser(ctx, userID)
+	if err != nil || len(rules) == 0 {
+		return err
+	}
+	for _, row := range pending {
+		if rule := categorizeInput(rules, &row.Input); rule != nil {
+			row.RuleID = rule.ID.String()
+		}
+	}
+	return nil
+}
+
+// fillUncategorized sets the user's "Uncategorized" category on the rows without one.
+// The category is created on first use, also on dry runs, so the rows can be validated.
+func (u *importTransactionsUseCase) fillUncategorized(ctx context.Context, userID vos.UUID, rows []*dtos.ImportRow) error {
+	var categoryID string
+	for _, row := range rows {
+		if row.Input.CategoryID != "" {
+			continue
+		}
+		if categoryID == "" {
+			id, err := u.categoryProvider.FindOrCreateUncategorized(ctx, userID)
+			if err != nil {
+				return err
+			}
+			categoryID = id.String()
+		}
+		row.Input.CategoryID = categoryID
+		row.Input.SubcategoryID = ""
+	}
+	return nil
+}
+
+// flagDuplicates marks candidates matching an existing transaction of the user, or an
+// earlier candidate of the same file, as duplicates. Candidates with an external id are
+// matched by it; by content they are only compared with transactions entered without
+// one, since a statement may list two equal entries on the same day.
+func (u *importTransactionsUseCase) flagDuplicates(ctx context.Context, userID vos.UUID, candidates []*importCandidate) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	if err := u.flagAlreadyImported(ctx, userID, candidates); err != nil {
+		return err
+	}
+
+	from, to := candidates[0].date, candidates[0].date
+	for _, candidate := range candidates[1:] {
+		if candidate.date.Before(from) {
+			from = candidate.date
+		}
+		if candidate.date.After(to) {
+			to = candidate.date
+		}
+	}
+
+	existing, err := u.repository.ListByDateRange(ctx, userID, from, to)
+	if err != nil {
+		return err
+	}
+
+	// seen holds every known key; seenWithoutExternalID only those of transactions
+	// entered without an external id.
+	seen := make(map[string]bool, len(existing)+len(candidates))
+	seenWithoutExternalID := make(map[string]bool, len(existing)+len(candidates))
+	for _, t := range existing {
+		seen[t.DuplicateKey()] = true
+		if t.ExternalID == nil {
+			seenWithoutExternalID[t.DuplicateKey()] = true
+		}
+	}
+	for _, candidate := range candidates {
+		if candidate.alreadyImported {
+			continue
+		}
+		if candidate.input.ExternalID != "" {
+			if seenWithoutExternalID[candidate.duplicateKey] {
+				candidate.result.Status = dtos.ImportRowDuplicate
+			}
+			continue
+		}
+		if seen[candidate.duplicateKey] {
+			candidate.result.Status = dtos.ImportRowDuplicate
+			continue
+		}
+		seen[candidate.duplicateKey] = true
+		seenWithoutExternalID[candidate.duplicateKey] = true
+	}
+	return nil
+}
+
+// flagAlreadyImported marks the candidates whose external id was already imported, or
+// repeats an earlier candidate of the same file.
+func (u *importTransactionsUseCase) flagAlreadyImported(ctx context.Context, userID vos.UUID, candidates []*importCandidate) error {
+	externalIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.input.ExternalID != "" {
+			externalIDs = append(externalIDs, candidate.input.ExternalID)
+		}
+	}
+	if len(externalIDs) == 0 {
+		return nil
+	}
+
+	seen, err := u.repository.FindExistingExternalIDs(ctx, userID, externalIDs)
+	if err != nil {
+		return err
+	}
+	if seen == nil {
+		seen = make(map[string]bool, len(externalIDs))
+	}
+	for _, candidate := range candidates {
+		externalID := candidate.input.ExternalID
+		if externalID == "" {
+			continue
+		}
+		if seen[externalID] {
+			candidate.result.Status = dtos.ImportRowDuplicate
+			candidate.alreadyImported = true
+			continue
+		}
+		seen[externalID] = true
+	}
+	return nil
+}
+
+// commit prepares every row first, so card and limit errors are reported per row, and
+// then persists all of them in one unit of work.
+func (u *importTransactionsUseCase) commit(ctx context.Context, userID string, candidates []*importCandidate) error {
+	prepared := make([]*preparedTransaction, 0, len(candidates))
+	failed := false
+	for _, candidate := range candidates {
+		p, err := u.creator.prepare(ctx, userID, candidate.input)
+		if err != nil {
+			candidate.result.Errors = append(candidate.result.Errors, err.Error())
+			failed = true
+			continue
+		}
+		prepared = append(prepared, p)
+	}
+	if failed {
+		return nil
+	}
+
+	err := u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		for _, p := range prepared {
+			if err := u.creator.persist(ctx, tx, p); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, candidate := range candidates {
+		candidate.result.Status = dtos.ImportRowImported
+		for _, t := range prepared[i].transactions {
+			candidate.result.TransactionIDs = append(candidate.result.TransactionIDs, t.ID.String())
+		}
+	}
+	return nil
+}
+
+func countInvalid(rows []*dtos.ImportRowResult) int {
+	invalid := 0
+	for _, row := range rows {
+		if len(row.Errors) > 0 {
+			invalid++
+		}
+	}
+	return invalid
+}
+
+// summarize sets the status of invalid rows and the counters of the output.
+func summarize(output *dtos.ImportOutput) {
+	for _, row := range output.Rows {
+		if len(row.Errors) > 0 {
+			row.Status = dtos.ImportRowInvalid
+		}
+		switch row.Status {
+		case dtos.ImportRowValid:
+			output.Valid++
+		case dtos.ImportRowInvalid:
+			output.Invalid++
+		case dtos.ImportRowDuplicate:
+			output.Duplicates++
+		case dtos.ImportRowImported:
+			output.Imported++
+		}
+	}
+}
@@ -0,0 +1,382 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type ImportTransactionsUseCaseSuite struct {
+	suite.Suite
+	ctx              context.Context
+	obs              *fake.Provider
+	repo             *transactionMocks.TransactionRepository
+	revisionRepo     *transactionMocks.TransactionRevisionRepository
+	tagRepo          *transactionMocks.TagRepository
+	ruleRepo         *transactionMocks.CategorizationRuleRepository
+	exchangeRateRepo *transactionMocks.ExchangeRateRepository
+	invoiceProvider  *transactionMocks.InvoiceProvider
+	cardProvider     *invoiceMocks.CardProvider
+	categoryProvider *transactionMocks.CategoryProvider
+	outboxService    *outboxMocks.Service
+}
+
+func TestImportTransactionsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ImportTransactionsUseCaseSuite))
+}
+
+func (s *ImportTransactionsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.tagRepo = transactionMocks.NewTagRepository(s.T())
+	s.ruleRepo = transactionMocks.NewCategorizationRuleRepository(s.T())
+	s.exchangeRateRepo = transactionMocks.NewExchangeRateRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.cardProvider = invoiceMocks.NewCardProvider(s.T())
+	s.categoryProvider = transactionMocks.NewCategoryProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func importRow(line int, description string, amount float64, date string) *dtos.ImportRow {
+	return &dtos.ImportRow{
+		Line: line,
+		Input: dtos.TransactionInput{
+			Description:     description,
+			Amount:          amount,
+			PaymentMethod:   "pix",
+			TransactionDate: date,
+			CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+		},
+	}
+}
+
+func statementRow(line int, description string, amount float64, date, externalID string) *dtos.ImportRow {
+	row := importRow(line, description, amount, date)
+	row.Input.ExternalID = externalID
+	return row
+}
+
+func existingTransaction(description string, amount float64, date time.Time) *entities.Transaction {
+	id, _ := vos.NewUUID()
+	money, _ := vos.NewMoneyFromFloat(amount, vos.CurrencyBRL)
+	pm, _ := transactionVos.NewPaymentMethod("pix")
+	t, _ := entities.NewTransaction(entities.TransactionParams{
+		ID:              id,
+		Description:     description,
+		Amount:          money,
+		PaymentMethod:   pm,
+		TransactionDate: date,
+	})
+	return t
+}
+
+func (s *ImportTransactionsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	existing := existingTransaction("Padaria", 12.50, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC))
+	groceries := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 0,
+		entities.CategorizationRuleConditions{DescriptionContains: "mercado"})
+
+	type dependencies func()
+	type expect func(output *dtos.ImportOutput, err error)
+
+	scenarios := []struct {
+		name         string
+		input        *dtos.ImportInput
+		dependencies dependencies
+		expect       expect
+	}{
+		{
+			name: "should report row errors and duplicates on dry run without persisting",
+			input: &dtos.ImportInput{
+				DryRun: true,
+				Rows: []*dtos.ImportRow{
+					importRow(2, "Mercado", 150.00, "2026-03-01"),
+					importRow(3, "", 10.00, "2026-03-01"),
+					importRow(4, "PADARIA", 12.50, "2026-03-02"),
+					{Line: 5, Errors: []string{"amount: invalid number \"abc\""}},
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().
+					ListByDateRange(mock.Anything, mock.Anything, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)).
+					Return([]*entities.Transaction{existing}, nil).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.False(output.Committed)
+				s.Equal(4, output.Total)
+				s.Equal(1, output.Valid)
+				s.Equal(2, output.Invalid)
+				s.Equal(1, output.Duplicates)
+				s.Equal(dtos.ImportRowValid, output.Rows[0].Status)
+				s.Equal(dtos.ImportRowInvalid, output.Rows[1].Status)
+				s.Equal([]string{transactionDomain.ErrDescriptionRequired.Error()}, output.Rows[1].Errors)
+				s.Equal(dtos.ImportRowDuplicate, output.Rows[2].Status)
+				s.Equal(dtos.ImportRowInvalid, output.Rows[3].Status)
+			},
+		},
+		{
+			name: "should not persist anything when a row is invalid",
+			input: &dtos.ImportInput{
+				Rows: []*dtos.ImportRow{
+					importRow(2, "Mercado", 150.00, "2026-03-01"),
+					importRow(3, "Farmácia", 0, "2026-03-01"),
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.False(output.Committed)
+				s.Equal(1, output.Invalid)
+				s.Equal(0, output.Imported)
+			},
+		},
+		{
+			name: "should import valid rows and skip duplicates within the file",
+			input: &dtos.ImportInput{
+				Rows: []*dtos.ImportRow{
+					importRow(2, "Mercado", 150.00, "2026-03-01"),
+					importRow(3, "Mercado", 150.00, "2026-03-01"),
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.True(output.Committed)
+				s.Equal(1, output.Imported)
+				s.Equal(1, output.Duplicates)
+				s.Len(output.Rows[0].TransactionIDs, 1)
+				s.Equal(dtos.ImportRowDuplicate, output.Rows[1].Status)
+			},
+		},
+		{
+			name: "should import duplicates when allowed",
+			input: &dtos.ImportInput{
+				AllowDuplicates: true,
+				Rows: []*dtos.ImportRow{
+					importRow(2, "Padaria", 12.50, "2026-03-02"),
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*entities.Transaction{existing}, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.True(output.Committed)
+				s.Equal(1, output.Imported)
+				s.Equal(0, output.Duplicates)
+			},
+		},
+		{
+			name: "should report card errors per row without persisting",
+			input: &dtos.ImportInput{
+				Rows: []*dtos.ImportRow{
+					importRow(2, "Mercado", 150.00, "2026-03-01"),
+					{
+						Line: 3,
+						Input: dtos.TransactionInput{
+							Description:     "Notebook",
+							Amount:          3000.00,
+							PaymentMethod:   "credit",
+							CardID:          "550e8400-e29b-41d4-a716-446655440010",
+							TransactionDate: "2026-03-01",
+							CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+						},
+					},
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("card not found")).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.False(output.Committed)
+				s.Equal(1, output.Invalid)
+				s.Equal([]string{"card not found"}, output.Rows[1].Errors)
+			},
+		},
+		{
+			name: "should always skip statement entries already imported",
+			input: &dtos.ImportInput{
+				AllowDuplicates: true,
+				Rows: []*dtos.ImportRow{
+					statementRow(2, "Café", 8.00, "2026-03-01", "ofx:123:1"),
+					statementRow(3, "Café", 8.00, "2026-03-01", "ofx:123:2"),
+					statementRow(4, "Café", 8.00, "2026-03-01", "ofx:123:2"),
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().
+					FindExistingExternalIDs(mock.Anything, mock.Anything, []string{"ofx:123:1", "ofx:123:2", "ofx:123:2"}).
+					Return(map[string]bool{"ofx:123:1": true}, nil).Once()
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.True(output.Committed)
+				s.Equal(2, output.Duplicates)
+				s.Equal(1, output.Imported)
+				s.Equal(dtos.ImportRowDuplicate, output.Rows[0].Status)
+				s.Equal(dtos.ImportRowImported, output.Rows[1].Status)
+				s.Equal(dtos.ImportRowDuplicate, output.Rows[2].Status)
+			},
+		},
+		{
+			name: "should not flag equal statement entries with distinct external ids",
+			input: &dtos.ImportInput{
+				DryRun: true,
+				Rows: []*dtos.ImportRow{
+					statementRow(2, "Café", 8.00, "2026-03-01", "ofx:123:1"),
+					statementRow(3, "Café", 8.00, "2026-03-01", "ofx:123:2"),
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().FindExistingExternalIDs(mock.Anything, mock.Anything, mock.Anything).Return(map[string]bool{}, nil).Once()
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.Equal(2, output.Valid)
+				s.Equal(0, output.Duplicates)
+			},
+		},
+		{
+			name: "should send rows without category to the uncategorized category",
+			input: &dtos.ImportInput{
+				DryRun:                true,
+				UncategorizedFallback: true,
+				Rows: []*dtos.ImportRow{
+					{Line: 2, Input: dtos.TransactionInput{Description: "Mercado", Amount: 10, PaymentMethod: "pix", TransactionDate: "2026-03-01"}},
+					{Line: 3, Input: dtos.TransactionInput{Description: "Farmácia", Amount: 20, PaymentMethod: "pix", TransactionDate: "2026-03-01"}},
+				},
+			},
+			dependencies: func() {
+				categoryID, _ := vos.NewUUIDFromString("550e8400-e29b-41d4-a716-446655440099")
+				s.ruleRepo.EXPECT().ListByUser(mock.Anything, mock.Anything).Return([]*entities.CategorizationRule{}, nil).Once()
+				s.categoryProvider.EXPECT().FindOrCreateUncategorized(mock.Anything, mock.Anything).Return(categoryID, nil).Once()
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.Equal(2, output.Valid)
+				s.Equal(0, output.Invalid)
+			},
+		},
+		{
+			name: "should categorize rows without category by the user rules before the fallback",
+			input: &dtos.ImportInput{
+				DryRun:                true,
+				UncategorizedFallback: true,
+				Rows: []*dtos.ImportRow{
+					{Line: 2, Input: dtos.TransactionInput{Description: "Mercado Extra", Amount: 10, PaymentMethod: "pix", TransactionDate: "2026-03-01"}},
+					{Line: 3, Input: dtos.TransactionInput{Description: "Farmácia", Amount: 20, PaymentMethod: "pix", TransactionDate: "2026-03-01"}},
+				},
+			},
+			dependencies: func() {
+				categoryID, _ := vos.NewUUIDFromString("550e8400-e29b-41d4-a716-446655440099")
+				s.ruleRepo.EXPECT().ListByUser(mock.Anything, mock.Anything).Return([]*entities.CategorizationRule{groceries}, nil).Once()
+				s.categoryProvider.EXPECT().FindOrCreateUncategorized(mock.Anything, mock.Anything).Return(categoryID, nil).Once()
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.NoError(err)
+				s.Equal(2, output.Valid)
+				s.Equal(groceries.ID.String(), output.Rows[0].RuleID)
+				s.Empty(output.Rows[1].RuleID)
+			},
+		},
+		{
+			name: "should return error when the rules cannot be loaded",
+			input: &dtos.ImportInput{
+				Rows: []*dtos.ImportRow{
+					{Line: 2, Input: dtos.TransactionInput{Description: "Mercado", Amount: 10, PaymentMethod: "pix", TransactionDate: "2026-03-01"}},
+				},
+			},
+			dependencies: func() {
+				s.ruleRepo.EXPECT().ListByUser(mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should return error when the uncategorized category cannot be resolved",
+			input: &dtos.ImportInput{
+				UncategorizedFallback: true,
+				Rows: []*dtos.ImportRow{
+					{Line: 2, Input: dtos.TransactionInput{Description: "Mercado", Amount: 10, PaymentMethod: "pix", TransactionDate: "2026-03-01"}},
+				},
+			},
+			dependencies: func() {
+				s.ruleRepo.EXPECT().ListByUser(mock.Anything, mock.Anything).Return(nil, nil).Once()
+				s.categoryProvider.EXPECT().FindOrCreateUncategorized(mock.Anything, mock.Anything).Return(vos.UUID{}, errors.New("db error")).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+		{
+			name:         "should return error for empty import",
+			input:        &dtos.ImportInput{},
+			dependencies: func() {},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrImportEmpty)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should return error when the duplicate lookup fails",
+			input: &dtos.ImportInput{
+				Rows: []*dtos.ImportRow{importRow(2, "Mercado", 150.00, "2026-03-01")},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListByDateRange(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(output *dtos.ImportOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			uc := NewImportTransactionsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo, s.ruleRepo, s.exchangeRateRepo, s.revisionRepo, s.invoiceProvider, s.cardProvider, s.categoryProvider, s.outboxService)
+			output, err := uc.Execute(s.ctx, userID, scenario.input)
+			scenario.expect(output, err)
+		})
+	}
+}
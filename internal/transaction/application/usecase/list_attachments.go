@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ListAttachmentsUseCase interface {
+		Execute(ctx context.Context, userID, ownerType, ownerID string) ([]*dtos.AttachmentOutput, error)
+	}
+
+	listAttachmentsUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.AttachmentRepository
+		owners     attachmentOwners
+	}
+)
+
+func NewListAttachmentsUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.AttachmentRepository,
+	transactionRepository transactionInterfaces.TransactionRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+) ListAttachmentsUseCase {
+	return &listAttachmentsUseCase{
+		o11y:       o11y,
+		repository: repository,
+		owners:     attachmentOwners{transactions: transactionRepository, invoices: invoiceProvider},
+	}
+}
+
+func (u *listAttachmentsUseCase) Execute(ctx context.Context, userID, ownerType, ownerID string) ([]*dtos.AttachmentOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "list_attachments_usecase.execute")
+	defer span.End()
+
+	owner, id, err := u.owners.authorize(ctx, userID, ownerType, ownerID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	attachments, err := u.repository.ListByOwner(ctx, owner, id)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ListAttachments"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "attachment"),
+		observability.String("user_id", userID),
+	)
+
+	output := make([]*dtos.AttachmentOutput, 0, len(attachments))
+	for _, attachment := range attachments {
+		output = append(output, toAttachmentOutput(attachment))
+	}
+	return output, nil
+}
@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type ListAttachmentsUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.AttachmentRepository
+	transactionRepo *transactionMocks.TransactionRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+}
+
+func TestListAttachmentsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ListAttachmentsUseCaseSuite))
+}
+
+func (s *ListAttachmentsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewAttachmentRepository(s.T())
+	s.transactionRepo = transactionMocks.NewTransactionRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+}
+
+func (s *ListAttachmentsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+
+	s.Run("should list the attachments of a transaction", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		attachment := buildAttachment(userID, entities.AttachmentOwnerTransaction, transaction.ID, receiptPDF)
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+		s.repo.EXPECT().ListByOwner(mock.Anything, entities.AttachmentOwnerTransaction, transaction.ID).
+			Return([]*entities.Attachment{attachment}, nil).Once()
+
+		uc := NewListAttachmentsUseCase(s.obs, s.repo, s.transactionRepo, s.invoiceProvider)
+		output, err := uc.Execute(s.ctx, userID, "transaction", transaction.ID.String())
+
+		s.NoError(err)
+		s.Len(output, 1)
+		s.Equal(attachment.ID.String(), output[0].ID)
+		s.Equal("nota.pdf", output[0].FileName)
+	})
+
+	s.Run("should return not found for an unknown invoice", func() {
+		invoiceID, _ := vos.NewUUID()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, invoiceID).Return(nil, nil).Once()
+
+		uc := NewListAttachmentsUseCase(s.obs, s.repo, s.transactionRepo, s.invoiceProvider)
+		output, err := uc.Execute(s.ctx, userID, "invoice", invoiceID.String())
+
+		s.ErrorIs(err, transactionDomain.ErrInvoiceNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should return repository error", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+		s.repo.EXPECT().ListByOwner(mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		uc := NewListAttachmentsUseCase(s.obs, s.repo, s.transactionRepo, s.invoiceProvider)
+		output, err := uc.Execute(s.ctx, userID, "transaction", transaction.ID.String())
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ListCategorizationRulesUseCase interface {
+		Execute(ctx context.Context, userID string) ([]*dtos.CategorizationRuleOutput, error)
+	}
+
+	listCategorizationRulesUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.CategorizationRuleRepository
+	}
+)
+
+// NewListCategorizationRulesUseCase creates a new ListCategorizationRulesUseCase.
+func NewListCategorizationRulesUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.CategorizationRuleRepository,
+) ListCategorizationRulesUseCase {
+	return &listCategorizationRulesUseCase{o11y: o11y, repository: repository}
+}
+
+// Execute lists the rules of the user in evaluation order.
+func (u *listCategorizationRulesUseCase) Execute(ctx context.Context, userID string) ([]*dtos.CategorizationRuleOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "list_categorization_rules_usecase.execute")
+	defer span.End()
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	rules, err := u.repository.ListByUser(ctx, userUUID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ListCategorizationRules"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "categorization_rule"),
+		observability.String("user_id", userID),
+	)
+
+	output := make([]*dtos.CategorizationRuleOutput, 0, len(rules))
+	for _, rule := range rules {
+		output = append(output, toCategorizationRuleOutput(rule))
+	}
+	return output, nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type ListCategorizationRulesUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.CategorizationRuleRepository
+}
+
+func TestListCategorizationRulesUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ListCategorizationRulesUseCaseSuite))
+}
+
+func (s *ListCategorizationRulesUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewCategorizationRuleRepository(s.T())
+}
+
+func (s *ListCategorizationRulesUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+
+	s.Run("should list the rules in evaluation order", func() {
+		rules := []*entities.CategorizationRule{
+			buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 1, entities.CategorizationRuleConditions{DescriptionContains: "uber"}),
+			buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440003", 5, entities.CategorizationRuleConditions{MaxAmount: mustMoney(30)}),
+		}
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(rules, nil).Once()
+
+		output, err := NewListCategorizationRulesUseCase(s.obs, s.repo).Execute(s.ctx, userID)
+
+		s.NoError(err)
+		s.Len(output, 2)
+		s.Equal("uber", output[0].DescriptionContains)
+		s.Equal(30.0, *output[1].MaxAmount)
+		s.Empty(output[1].TagIDs)
+	})
+
+	s.Run("should return repository error", func() {
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(nil, errors.New("db error")).Once()
+
+		output, err := NewListCategorizationRulesUseCase(s.obs, s.repo).Execute(s.ctx, userID)
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ListDuplicatesUseCase interface {
+		Execute(ctx context.Context, userID string, params *dtos.DuplicateReviewParams) ([]*dtos.DuplicatePairOutput, error)
+	}
+
+	listDuplicatesUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.TransactionRepository
+	}
+)
+
+// NewListDuplicatesUseCase creates a new ListDuplicatesUseCase.
+func NewListDuplicatesUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.TransactionRepository,
+) ListDuplicatesUseCase {
+	return &listDuplicatesUseCase{o11y: o11y, repository: repository}
+}
+
+// Execute lists the likely duplicates among the active transactions of the user whose
+// date falls in the period. The transactions they repeat may be up to
+// entities.DuplicateDateWindow outside of it.
+func (u *listDuplicatesUseCase) Execute(ctx context.Context, userID string, params *dtos.DuplicateReviewParams) ([]*dtos.DuplicatePairOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "list_duplicates_usecase.execute")
+	defer span.End()
+
+	from, to, err := params.Validate(time.Now().UTC())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	transactions, err := u.repository.ListByDateRange(ctx, userUUID,
+		from.Add(-entities.DuplicateDateWindow), to.Add(entities.DuplicateDateWindow))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	output := make([]*dtos.DuplicatePairOutput, 0)
+	for _, pair := range entities.FindLikelyDuplicates(transactions) {
+		if pair.Duplicate.TransactionDate.Before(from) || pair.Duplicate.TransactionDate.After(to) {
+			continue
+		}
+		output = append(output, &dtos.DuplicatePairOutput{
+			Original:  toOutput(pair.Original),
+			Duplicate: toOutput(pair.Duplicate),
+		})
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ListDuplicates"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return output, nil
+}
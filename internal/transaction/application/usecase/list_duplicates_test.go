@@ -0,0 +1,98 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type ListDuplicatesUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.TransactionRepository
+}
+
+func TestListDuplicatesUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ListDuplicatesUseCaseSuite))
+}
+
+func (s *ListDuplicatesUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+}
+
+func (s *ListDuplicatesUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	params := &dtos.DuplicateReviewParams{StartDate: "2026-03-01", EndDate: "2026-03-31"}
+
+	buildPurchase := func(description string, day int, createdAt time.Time) *entities.Transaction {
+		t := buildTransaction(userID, categoryID, nil)
+		t.Description = description
+		t.TransactionDate = time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC)
+		t.CreatedAt = createdAt
+		return t
+	}
+	now := time.Now().UTC()
+
+	s.Run("should pair the imported transaction with the one entered by hand", func() {
+		manual := buildPurchase("Padaria", 10, now.Add(-time.Hour))
+		imported := buildPurchase("PIX ENVIADO PADARIA SAO JOSE", 11, now)
+		other := buildPurchase("Farmacia", 11, now)
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID,
+			time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC), time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)).
+			Return([]*entities.Transaction{manual, imported, other}, nil).Once()
+
+		output, err := NewListDuplicatesUseCase(s.obs, s.repo).Execute(s.ctx, userID, params)
+
+		s.NoError(err)
+		s.Len(output, 1)
+		s.Equal(manual.ID.String(), output[0].Original.ID)
+		s.Equal(imported.ID.String(), output[0].Duplicate.ID)
+	})
+
+	s.Run("should leave out duplicates dated outside the period", func() {
+		manual := buildPurchase("Padaria", 10, now.Add(-time.Hour))
+		manual.TransactionDate = time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+		imported := buildPurchase("PADARIA SAO JOSE", 10, now)
+		imported.TransactionDate = time.Date(2026, 4, 2, 0, 0, 0, 0, time.UTC)
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID, mock.Anything, mock.Anything).
+			Return([]*entities.Transaction{manual, imported}, nil).Once()
+
+		output, err := NewListDuplicatesUseCase(s.obs, s.repo).Execute(s.ctx, userID, params)
+
+		s.NoError(err)
+		s.Empty(output)
+	})
+
+	s.Run("should reject an invalid period", func() {
+		output, err := NewListDuplicatesUseCase(s.obs, s.repo).Execute(s.ctx, userID,
+			&dtos.DuplicateReviewParams{StartDate: "2026-04-01", EndDate: "2026-03-01"})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidListFilter)
+		s.Nil(output)
+	})
+
+	s.Run("should return repository error", func() {
+		s.repo.EXPECT().ListByDateRange(mock.Anything, userUUID, mock.Anything, mock.Anything).Return(nil, errors.New("db error")).Once()
+
+		output, err := NewListDuplicatesUseCase(s.obs, s.repo).Execute(s.ctx, userID, params)
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
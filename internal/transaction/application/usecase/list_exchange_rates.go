@@ -0,0 +1,71 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ListExchangeRatesUseCase interface {
+		Execute(ctx context.Context, userID, currency string) ([]*dtos.ExchangeRateOutput, error)
+	}
+
+	listExchangeRatesUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.ExchangeRateRepository
+	}
+)
+
+// NewListExchangeRatesUseCase creates a new ListExchangeRatesUseCase.
+func NewListExchangeRatesUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.ExchangeRateRepository,
+) ListExchangeRatesUseCase {
+	return &listExchangeRatesUseCase{o11y: o11y, repository: repository}
+}
+
+// Execute lists the rates of the user, latest first, only of currency when given.
+func (u *listExchangeRatesUseCase) Execute(ctx context.Context, userID, currency string) ([]*dtos.ExchangeRateOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "list_exchange_rates_usecase.execute")
+	defer span.End()
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency != "" {
+		if _, err := vos.NewCurrency(currency); err != nil {
+			return nil, fmt.Errorf("%w: unsupported currency %q", transactionDomain.ErrInvalidListFilter, currency)
+		}
+	}
+
+	rates, err := u.repository.ListByUser(ctx, userUUID, currency)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ListExchangeRates"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "exchange_rate"),
+		observability.String("user_id", userID),
+	)
+
+	output := make([]*dtos.ExchangeRateOutput, 0, len(rates))
+	for _, rate := range rates {
+		output = append(output, toExchangeRateOutput(rate))
+	}
+	return output, nil
+}
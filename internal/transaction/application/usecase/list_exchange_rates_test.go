@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+type ListExchangeRatesUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.ExchangeRateRepository
+}
+
+func TestListExchangeRatesUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ListExchangeRatesUseCaseSuite))
+}
+
+func (s *ListExchangeRatesUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewExchangeRateRepository(s.T())
+}
+
+func buildExchangeRate(userID string, currency vos.Currency, value float64, rateDate time.Time) *entities.ExchangeRate {
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	rate, _ := transactionVos.NewExchangeRate(value)
+	exchangeRate, _ := entities.NewExchangeRate(userUUID, currency, vos.CurrencyBRL, rate, rateDate, entities.ExchangeRateSourceManual)
+	return exchangeRate
+}
+
+func (s *ListExchangeRatesUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+
+	scenarios := []struct {
+		name         string
+		currency     string
+		dependencies func()
+		expect       func(output []*dtos.ExchangeRateOutput, err error)
+	}{
+		{
+			name:     "should list the rates of a currency",
+			currency: " usd ",
+			dependencies: func() {
+				s.repo.EXPECT().ListByUser(s.ctx, userUUID, "USD").Return([]*entities.ExchangeRate{
+					buildExchangeRate(userID, vos.CurrencyUSD, 5.5, time.Date(2026, 3, 11, 0, 0, 0, 0, time.UTC)),
+					buildExchangeRate(userID, vos.CurrencyUSD, 5.4321, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)),
+				}, nil).Once()
+			},
+			expect: func(output []*dtos.ExchangeRateOutput, err error) {
+				s.NoError(err)
+				s.Len(output, 2)
+				s.Equal("2026-03-11", output[0].RateDate)
+				s.Equal(5.4321, output[1].Rate)
+			},
+		},
+		{
+			name:     "should list every currency when none is given",
+			currency: "",
+			dependencies: func() {
+				s.repo.EXPECT().ListByUser(s.ctx, userUUID, "").Return([]*entities.ExchangeRate{}, nil).Once()
+			},
+			expect: func(output []*dtos.ExchangeRateOutput, err error) {
+				s.NoError(err)
+				s.Empty(output)
+			},
+		},
+		{
+			name:         "should reject an unsupported currency",
+			currency:     "XYZ",
+			dependencies: func() {},
+			expect: func(output []*dtos.ExchangeRateOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidListFilter)
+				s.Nil(output)
+			},
+		},
+		{
+			name:     "should return repository error",
+			currency: "EUR",
+			dependencies: func() {
+				s.repo.EXPECT().ListByUser(s.ctx, userUUID, "EUR").Return(nil, errors.New("db error")).Once()
+			},
+			expect: func(output []*dtos.ExchangeRateOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			output, err := NewListExchangeRatesUseCase(s.obs, s.repo).Execute(s.ctx, userID, scenario.currency)
+			scenario.expect(output, err)
+		})
+	}
+}
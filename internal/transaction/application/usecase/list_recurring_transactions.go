@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ListRecurringTransactionsUseCase interface {
+		Execute(ctx context.Context, userID string) ([]*dtos.RecurringTransactionOutput, error)
+	}
+
+	listRecurringTransactionsUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.RecurringTransactionRepository
+	}
+)
+
+// NewListRecurringTransactionsUseCase creates a new ListRecurringTransactionsUseCase.
+func NewListRecurringTransactionsUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.RecurringTransactionRepository,
+) ListRecurringTransactionsUseCase {
+	return &listRecurringTransactionsUseCase{o11y: o11y, repository: repository}
+}
+
+func (u *listRecurringTransactionsUseCase) Execute(ctx context.Context, userID string) ([]*dtos.RecurringTransactionOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "list_recurring_transactions_usecase.execute")
+	defer span.End()
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	recurring, err := u.repository.ListByUser(ctx, userUUID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ListRecurringTransactions"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "recurring_transaction"),
+		observability.String("user_id", userID),
+	)
+
+	outputs := make([]*dtos.RecurringTransactionOutput, 0, len(recurring))
+	for _, r := range recurring {
+		outputs = append(outputs, toRecurringOutput(r))
+	}
+	return outputs, nil
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type ListRecurringTransactionsUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.RecurringTransactionRepository
+}
+
+func TestListRecurringTransactionsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ListRecurringTransactionsUseCaseSuite))
+}
+
+func (s *ListRecurringTransactionsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewRecurringTransactionRepository(s.T())
+}
+
+func (s *ListRecurringTransactionsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+
+	s.Run("should list user recurrences", func() {
+		recurring := []*entities.RecurringTransaction{
+			buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)),
+			buildRecurringTransaction(userID, categoryID, "FREQ=WEEKLY;BYDAY=FR", time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)),
+		}
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(recurring, nil).Once()
+
+		output, err := NewListRecurringTransactionsUseCase(s.obs, s.repo).Execute(s.ctx, userID)
+
+		s.NoError(err)
+		s.Len(output, 2)
+		s.Equal("2026-01-09", *output[1].NextOccurrence)
+	})
+
+	s.Run("should return repository error", func() {
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(nil, errors.New("db error")).Once()
+
+		output, err := NewListRecurringTransactionsUseCase(s.obs, s.repo).Execute(s.ctx, userID)
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
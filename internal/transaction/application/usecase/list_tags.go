@@ -0,0 +1,61 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	ListTagsUseCase interface {
+		Execute(ctx context.Context, userID string) ([]*dtos.TagOutput, error)
+	}
+
+	listTagsUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.TagRepository
+	}
+)
+
+// NewListTagsUseCase creates a new ListTagsUseCase.
+func NewListTagsUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.TagRepository,
+) ListTagsUseCase {
+	return &listTagsUseCase{o11y: o11y, repository: repository}
+}
+
+func (u *listTagsUseCase) Execute(ctx context.Context, userID string) ([]*dtos.TagOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "list_tags_usecase.execute")
+	defer span.End()
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	tags, err := u.repository.ListByUser(ctx, userUUID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "ListTags"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "tag"),
+		observability.String("user_id", userID),
+	)
+
+	output := make([]*dtos.TagOutput, 0, len(tags))
+	for _, tag := range tags {
+		output = append(output, toTagOutput(tag))
+	}
+	return output, nil
+}
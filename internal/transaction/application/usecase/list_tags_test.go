@@ -0,0 +1,57 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type ListTagsUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.TagRepository
+}
+
+func TestListTagsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(ListTagsUseCaseSuite))
+}
+
+func (s *ListTagsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTagRepository(s.T())
+}
+
+func (s *ListTagsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+
+	s.Run("should list the user catalog", func() {
+		tags := []*entities.Tag{buildTag(userID, "reimbursable"), buildTag(userID, "vacation")}
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(tags, nil).Once()
+
+		output, err := NewListTagsUseCase(s.obs, s.repo).Execute(s.ctx, userID)
+
+		s.NoError(err)
+		s.Len(output, 2)
+		s.Equal("reimbursable", output[0].Name)
+	})
+
+	s.Run("should return repository error", func() {
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(nil, errors.New("db error")).Once()
+
+		output, err := NewListTagsUseCase(s.obs, s.repo).Execute(s.ctx, userID)
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
@@ -3,13 +3,18 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
 )
 
 const (
@@ -54,30 +59,13 @@ func (u *listTransactionsUseCase) Execute(ctx context.Context, userID string, pa
 		limit = maxLimit
 	}
 
-	repoParams := transactionInterfaces.ListParams{
-		UserID:        userUUID,
-		PaymentMethod: params.PaymentMethod,
-		CategoryID:    params.CategoryID,
-		Limit:         limit,
-		Cursor:        params.Cursor,
-	}
-
-	if params.StartDate != "" {
-		t, err := time.Parse("2006-01-02", params.StartDate)
-		if err != nil {
-			span.RecordError(err)
-			return nil, fmt.Errorf("invalid start_date: %w", err)
-		}
-		repoParams.StartDate = &t
-	}
-	if params.EndDate != "" {
-		t, err := time.Parse("2006-01-02", params.EndDate)
-		if err != nil {
-			span.RecordError(err)
-			return nil, fmt.Errorf("invalid end_date: %w", err)
-		}
-		repoParams.EndDate = &t
+	repoParams, err := toRepositoryListParams(userUUID, params)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
 	}
+	repoParams.Limit = limit
+	repoParams.Cursor = params.Cursor
 
 	transactions, nextCursor, err := u.repository.ListPaginated(ctx, repoParams)
 	if err != nil {
@@ -97,3 +85,107 @@ func (u *listTransactionsUseCase) Execute(ctx context.Context, userID string, pa
 		NextCursor: nextCursor,
 	}, nil
 }
+
+// toRepositoryListParams validates the listing filters shared by the listing and the export.
+func toRepositoryListParams(userID vos.UUID, params *dtos.ListParams) (transactionInterfaces.ListParams, error) {
+	repoParams := transactionInterfaces.ListParams{
+		UserID:        userID,
+		PaymentMethod: params.PaymentMethod,
+		CategoryID:    params.CategoryID,
+		Direction:     params.Direction,
+		Status:        strings.ToLower(params.Status),
+		Search:        strings.TrimSpace(params.Search),
+		SortBy:        strings.ToLower(params.SortBy),
+		SortOrder:     strings.ToLower(params.SortOrder),
+	}
+
+	if params.Direction != "" {
+		if _, err := transactionVos.NewTransactionDirection(params.Direction); err != nil {
+			return repoParams, transactionDomain.ErrInvalidDirection
+		}
+	}
+	if repoParams.Status != "" && repoParams.Status != transactionInterfaces.StatusFilterAll {
+		if _, err := transactionVos.NewTransactionStatus(repoParams.Status); err != nil {
+			return repoParams, fmt.Errorf("%w: status must be active, scheduled, cancelled or all", transactionDomain.ErrInvalidListFilter)
+		}
+	}
+
+	ids := []struct {
+		field  string
+		value  string
+		target *string
+	}{
+		{"subcategory_id", params.SubcategoryID, &repoParams.SubcategoryID},
+		{"card_id", params.CardID, &repoParams.CardID},
+		{"invoice_id", params.InvoiceID, &repoParams.InvoiceID},
+		{"installment_group_id", params.InstallmentGroupID, &repoParams.InstallmentGroupID},
+	}
+	for _, id := range ids {
+		if id.value == "" {
+			continue
+		}
+		if _, err := vos.NewUUIDFromString(id.value); err != nil {
+			return repoParams, fmt.Errorf("%w: %s must be a UUID", transactionDomain.ErrInvalidListFilter, id.field)
+		}
+		*id.target = id.value
+	}
+
+	if params.TagIDs != "" {
+		for _, id := range strings.Split(params.TagIDs, ",") {
+			id = strings.TrimSpace(id)
+			if _, err := vos.NewUUIDFromString(id); err != nil {
+				return repoParams, fmt.Errorf("%w: tag_id must be a comma-separated list of UUIDs", transactionDomain.ErrInvalidListFilter)
+			}
+			repoParams.TagIDs = append(repoParams.TagIDs, id)
+		}
+	}
+
+	var err error
+	if repoParams.MinAmount, err = parseAmountFilter("min_amount", params.MinAmount); err != nil {
+		return repoParams, err
+	}
+	if repoParams.MaxAmount, err = parseAmountFilter("max_amount", params.MaxAmount); err != nil {
+		return repoParams, err
+	}
+	if repoParams.MinAmount != nil && repoParams.MaxAmount != nil && *repoParams.MinAmount > *repoParams.MaxAmount {
+		return repoParams, fmt.Errorf("%w: min_amount cannot be greater than max_amount", transactionDomain.ErrInvalidListFilter)
+	}
+
+	switch repoParams.SortBy {
+	case "", transactionInterfaces.SortByTransactionDate, transactionInterfaces.SortByAmount:
+	default:
+		return repoParams, fmt.Errorf("%w: sort_by must be transaction_date or amount", transactionDomain.ErrInvalidListFilter)
+	}
+	switch repoParams.SortOrder {
+	case "", transactionInterfaces.SortOrderAsc, transactionInterfaces.SortOrderDesc:
+	default:
+		return repoParams, fmt.Errorf("%w: sort_order must be asc or desc", transactionDomain.ErrInvalidListFilter)
+	}
+
+	if params.StartDate != "" {
+		t, err := time.Parse("2006-01-02", params.StartDate)
+		if err != nil {
+			return repoParams, fmt.Errorf("invalid start_date: %w", err)
+		}
+		repoParams.StartDate = &t
+	}
+	if params.EndDate != "" {
+		t, err := time.Parse("2006-01-02", params.EndDate)
+		if err != nil {
+			return repoParams, fmt.Errorf("invalid end_date: %w", err)
+		}
+		repoParams.EndDate = &t
+	}
+	return repoParams, nil
+}
+
+func parseAmountFilter(field, value string) (*float64, error) {
+	if value == "" {
+		return nil, nil
+	}
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil || amount < 0 || math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return nil, fmt.Errorf("%w: %s must be a non-negative number", transactionDomain.ErrInvalidListFilter, field)
+	}
+	return &amount, nil
+}
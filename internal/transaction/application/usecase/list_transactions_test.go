@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
 	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
@@ -129,6 +130,74 @@ func (s *ListTransactionsUseCaseSuite) TestExecute() {
 				s.Len(output.Data, 1)
 			},
 		},
+		{
+			name: "should pass the search filters and sort to the repository",
+			args: args{
+				userID: userID,
+				params: &dtos.ListParams{
+					SubcategoryID:      "550e8400-e29b-41d4-a716-446655440003",
+					CardID:             "550e8400-e29b-41d4-a716-446655440010",
+					InvoiceID:          "550e8400-e29b-41d4-a716-446655440020",
+					InstallmentGroupID: "550e8400-e29b-41d4-a716-446655440030",
+					Status:             "Cancelled",
+					MinAmount:          "10",
+					MaxAmount:          "99.90",
+					Search:             "  mercado ",
+					SortBy:             "amount",
+					SortOrder:          "ASC",
+					Limit:              10,
+				},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListPaginated(mock.Anything, mock.MatchedBy(func(p transactionInterfaces.ListParams) bool {
+					return p.SubcategoryID == "550e8400-e29b-41d4-a716-446655440003" &&
+						p.CardID == "550e8400-e29b-41d4-a716-446655440010" &&
+						p.InvoiceID == "550e8400-e29b-41d4-a716-446655440020" &&
+						p.InstallmentGroupID == "550e8400-e29b-41d4-a716-446655440030" &&
+						p.Status == transactionVos.TransactionStatusCancelled &&
+						*p.MinAmount == 10 && *p.MaxAmount == 99.90 &&
+						p.Search == "mercado" &&
+						p.SortBy == transactionInterfaces.SortByAmount &&
+						p.SortOrder == transactionInterfaces.SortOrderAsc
+				})).Return(nil, "", nil).Once()
+			},
+			expect: func(output *dtos.TransactionListOutput, err error) {
+				s.NoError(err)
+				s.Empty(output.Data)
+			},
+		},
+		{
+			name: "should filter by every tag of the list",
+			args: args{
+				userID: userID,
+				params: &dtos.ListParams{TagIDs: "550e8400-e29b-41d4-a716-446655440040, 550e8400-e29b-41d4-a716-446655440041"},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListPaginated(mock.Anything, mock.MatchedBy(func(p transactionInterfaces.ListParams) bool {
+					return len(p.TagIDs) == 2 &&
+						p.TagIDs[0] == "550e8400-e29b-41d4-a716-446655440040" &&
+						p.TagIDs[1] == "550e8400-e29b-41d4-a716-446655440041"
+				})).Return(nil, "", nil).Once()
+			},
+			expect: func(output *dtos.TransactionListOutput, err error) {
+				s.NoError(err)
+			},
+		},
+		{
+			name: "should accept all statuses",
+			args: args{
+				userID: userID,
+				params: &dtos.ListParams{Status: "all"},
+			},
+			dependencies: func() {
+				s.repo.EXPECT().ListPaginated(mock.Anything, mock.MatchedBy(func(p transactionInterfaces.ListParams) bool {
+					return p.Status == transactionInterfaces.StatusFilterAll
+				})).Return(nil, "", nil).Once()
+			},
+			expect: func(output *dtos.TransactionListOutput, err error) {
+				s.NoError(err)
+			},
+		},
 	}
 
 	for _, scenario := range scenarios {
@@ -140,3 +209,30 @@ func (s *ListTransactionsUseCaseSuite) TestExecute() {
 		})
 	}
 }
+
+func (s *ListTransactionsUseCaseSuite) TestExecuteInvalidFilters() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+
+	scenarios := []struct {
+		name   string
+		params *dtos.ListParams
+	}{
+		{name: "unknown status", params: &dtos.ListParams{Status: "deleted"}},
+		{name: "card_id that is not a UUID", params: &dtos.ListParams{CardID: "nubank"}},
+		{name: "negative min_amount", params: &dtos.ListParams{MinAmount: "-1"}},
+		{name: "max_amount that is not a number", params: &dtos.ListParams{MaxAmount: "abc"}},
+		{name: "min_amount greater than max_amount", params: &dtos.ListParams{MinAmount: "50", MaxAmount: "10"}},
+		{name: "tag_id that is not a UUID", params: &dtos.ListParams{TagIDs: "vacation"}},
+		{name: "unknown sort_by", params: &dtos.ListParams{SortBy: "description"}},
+		{name: "unknown sort_order", params: &dtos.ListParams{SortOrder: "up"}},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run("should reject "+scenario.name, func() {
+			uc := NewListTransactionsUseCase(s.obs, s.repo)
+			output, err := uc.Execute(s.ctx, userID, scenario.params)
+			s.ErrorIs(err, transactionDomain.ErrInvalidListFilter)
+			s.Nil(output)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+const (
+	// materializeRecurringBatchSize limits how many due recurrences are loaded per run.
+	materializeRecurringBatchSize = 200
+	// maxOccurrencesPerRun caps the catch-up of a single recurrence per run; the next run continues it.
+	maxOccurrencesPerRun = 31
+)
+
+type (
+	MaterializeRecurringTransactionsUseCase interface {
+		Execute(ctx context.Context, now time.Time) (int, error)
+	}
+
+	materializeRecurringTransactionsUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.RecurringTransactionRepository
+		createUC   CreateTransactionUseCase
+	}
+)
+
+// NewMaterializeRecurringTransactionsUseCase creates the use case that turns due
+// occurrences into transactions through CreateTransactionUseCase, so invoices, events
+// and budgets behave exactly as for manual entries.
+func NewMaterializeRecurringTransactionsUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.RecurringTransactionRepository,
+	createUC CreateTransactionUseCase,
+) MaterializeRecurringTransactionsUseCase {
+	return &materializeRecurringTransactionsUseCase{
+		o11y:       o11y,
+		uow:        unitOfWork,
+		repository: repository,
+		createUC:   createUC,
+	}
+}
+
+// Execute materializes every occurrence due up to the date of now, catching up on
+// occurrences missed while the worker was down. Returns how many occurrences were
+// materialized. A failing recurrence does not stop the others; errors are joined.
+func (u *materializeRecurringTransactionsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "materialize_recurring_transactions_usecase.execute")
+	defer span.End()
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	due, err := u.repository.ListDue(ctx, today, materializeRecurringBatchSize)
+	if err != nil {
+		span.RecordError(err)
+		return 0, err
+	}
+
+	materialized := 0
+	var errs []error
+
+	for _, recurring := range due {
+		for range maxOccurrencesPerRun {
+			if !recurring.IsDue(today) {
+				break
+			}
+			ok, err := u.materialize(ctx, recurring)
+			if err != nil {
+				span.RecordError(err)
+				u.o11y.Logger().Error(ctx, "query_failed",
+					observability.String("operation", "MaterializeRecurringTransactions"),
+					observability.String("layer", "usecase"),
+					observability.String("entity", "recurring_transaction"),
+					observability.String("recurring_transaction_id", recurring.ID.String()),
+					observability.Error(err),
+				)
+				errs = append(errs, err)
+				break
+			}
+			if !ok {
+				break
+			}
+			materialized++
+		}
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "MaterializeRecurringTransactions"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "recurring_transaction"),
+		observability.Int("due", len(due)),
+		observability.Int("materialized", materialized),
+	)
+
+	return materialized, errors.Join(errs...)
+}
+
+// materialize claims the next occurrence by advancing the schedule with a guarded update,
+// then creates its transaction. Claiming first guarantees that concurrent runs never create
+// the same occurrence twice. When the transaction cannot be created the claim is released,
+// so the occurrence is retried on the next run. Returns false when another run or a user
+// edit changed the recurrence first.
+func (u *materializeRecurringTransactionsUseCase) materialize(ctx context.Context, recurring *entities.RecurringTransaction) (bool, error) {
+	occurrence := *recurring.NextOccurrence
+	previousCount, previousActive := recurring.OccurrenceCount, recurring.Active
+
+	recurring.Advance()
+	claimed, err := u.update(ctx, recurring, &occurrence)
+	if err != nil || !claimed {
+		return false, err
+	}
+
+	if _, err := u.createUC.Execute(ctx, recurring.UserID.String(), occurrenceInput(recurring, occurrence)); err != nil {
+		claimedNext := recurring.NextOccurrence
+		recurring.NextOccurrence = &occurrence
+		recurring.OccurrenceCount = previousCount
+		recurring.Active = previousActive
+		if released, releaseErr := u.update(ctx, recurring, claimedNext); releaseErr != nil || !released {
+			u.o11y.Logger().Warn(ctx, "recurring_occurrence_release_failed",
+				observability.String("operation", "MaterializeRecurringTransactions"),
+				observability.String("layer", "usecase"),
+				observability.String("entity", "recurring_transaction"),
+				observability.String("recurring_transaction_id", recurring.ID.String()),
+				observability.String("occurrence", occurrence.Format("2006-01-02")),
+			)
+		}
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (u *materializeRecurringTransactionsUseCase) update(ctx context.Context, recurring *entities.RecurringTransaction, expectedNext *time.Time) (bool, error) {
+	updated := false
+	err := u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		ok, err := u.repository.Update(ctx, tx, recurring, expectedNext)
+		updated = ok
+		return err
+	})
+	return updated, err
+}
@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+// createTransactionStub records the inputs it receives and fails on the configured dates.
+type createTransactionStub struct {
+	inputs  []*dtos.TransactionInput
+	failOn  map[string]error
+	userIDs []string
+}
+
+func (c *createTransactionStub) Execute(_ context.Context, userID string, input *dtos.TransactionInput) ([]*dtos.TransactionOutput, error) {
+	if err, ok := c.failOn[input.TransactionDate]; ok {
+		return nil, err
+	}
+	c.inputs = append(c.inputs, input)
+	c.userIDs = append(c.userIDs, userID)
+	return []*dtos.TransactionOutput{{TransactionDate: input.TransactionDate}}, nil
+}
+
+type MaterializeRecurringTransactionsUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.RecurringTransactionRepository
+}
+
+func TestMaterializeRecurringTransactionsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(MaterializeRecurringTransactionsUseCaseSuite))
+}
+
+func (s *MaterializeRecurringTransactionsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewRecurringTransactionRepository(s.T())
+}
+
+func (s *MaterializeRecurringTransactionsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	now := time.Date(2026, 3, 10, 1, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	dateOf := func(value string) *time.Time {
+		parsed, _ := time.Parse("2006-01-02", value)
+		return &parsed
+	}
+
+	scenarios := []struct {
+		name         string
+		failOn       map[string]error
+		dependencies func() *entities.RecurringTransaction
+		expect       func(recurring *entities.RecurringTransaction, stub *createTransactionStub, count int, err error)
+	}{
+		{
+			name: "should catch up every missed occurrence claiming each before creating it",
+			dependencies: func() *entities.RecurringTransaction {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY;BYMONTHDAY=5", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+				s.repo.EXPECT().ListDue(mock.Anything, today, materializeRecurringBatchSize).
+					Return([]*entities.RecurringTransaction{recurring}, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, dateOf("2026-01-05")).Return(true, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, dateOf("2026-02-05")).Return(true, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, dateOf("2026-03-05")).Return(true, nil).Once()
+				return recurring
+			},
+			expect: func(recurring *entities.RecurringTransaction, stub *createTransactionStub, count int, err error) {
+				s.NoError(err)
+				s.Equal(3, count)
+				s.Require().Len(stub.inputs, 3)
+				s.Equal("2026-01-05", stub.inputs[0].TransactionDate)
+				s.Equal("2026-03-05", stub.inputs[2].TransactionDate)
+				s.Equal("Aluguel", stub.inputs[0].Description)
+				s.Equal(1500.00, stub.inputs[0].Amount)
+				s.Equal("pix", stub.inputs[0].PaymentMethod)
+				s.Equal(userID, stub.userIDs[0])
+				s.Equal(3, recurring.OccurrenceCount)
+				s.Equal("2026-04-05", recurring.NextOccurrence.Format("2006-01-02"))
+			},
+		},
+		{
+			name: "should deactivate the recurrence after its last occurrence",
+			dependencies: func() *entities.RecurringTransaction {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY;COUNT=1", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+				s.repo.EXPECT().ListDue(mock.Anything, today, materializeRecurringBatchSize).
+					Return([]*entities.RecurringTransaction{recurring}, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, dateOf("2026-03-01")).Return(true, nil).Once()
+				return recurring
+			},
+			expect: func(recurring *entities.RecurringTransaction, stub *createTransactionStub, count int, err error) {
+				s.NoError(err)
+				s.Equal(1, count)
+				s.False(recurring.Active)
+				s.Nil(recurring.NextOccurrence)
+			},
+		},
+		{
+			name: "should skip the recurrence when another run claimed it first",
+			dependencies: func() *entities.RecurringTransaction {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+				s.repo.EXPECT().ListDue(mock.Anything, today, materializeRecurringBatchSize).
+					Return([]*entities.RecurringTransaction{recurring}, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, dateOf("2026-03-01")).Return(false, nil).Once()
+				return recurring
+			},
+			expect: func(recurring *entities.RecurringTransaction, stub *createTransactionStub, count int, err error) {
+				s.NoError(err)
+				s.Equal(0, count)
+				s.Empty(stub.inputs)
+			},
+		},
+		{
+			name:   "should release the claim when the transaction cannot be created",
+			failOn: map[string]error{"2026-02-05": errors.New("category not found")},
+			dependencies: func() *entities.RecurringTransaction {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY;BYMONTHDAY=5", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+				s.repo.EXPECT().ListDue(mock.Anything, today, materializeRecurringBatchSize).
+					Return([]*entities.RecurringTransaction{recurring}, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, dateOf("2026-01-05")).Return(true, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, dateOf("2026-02-05")).Return(true, nil).Once()
+				// release: restores 2026-02-05 guarded by the claimed 2026-03-05
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, dateOf("2026-03-05")).Return(true, nil).Once()
+				return recurring
+			},
+			expect: func(recurring *entities.RecurringTransaction, stub *createTransactionStub, count int, err error) {
+				s.Error(err)
+				s.Equal(1, count)
+				s.Equal(1, recurring.OccurrenceCount)
+				s.Equal("2026-02-05", recurring.NextOccurrence.Format("2006-01-02"))
+				s.True(recurring.Active)
+			},
+		},
+		{
+			name: "should return error when due recurrences cannot be listed",
+			dependencies: func() *entities.RecurringTransaction {
+				s.repo.EXPECT().ListDue(mock.Anything, today, materializeRecurringBatchSize).
+					Return(nil, errors.New("db error")).Once()
+				return nil
+			},
+			expect: func(recurring *entities.RecurringTransaction, stub *createTransactionStub, count int, err error) {
+				s.Error(err)
+				s.Equal(0, count)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			recurring := scenario.dependencies()
+			stub := &createTransactionStub{failOn: scenario.failOn}
+			uc := NewMaterializeRecurringTransactionsUseCase(s.obs, &mockUnitOfWork{}, s.repo, stub)
+			count, err := uc.Execute(s.ctx, now)
+			scenario.expect(recurring, stub, count, err)
+		})
+	}
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+type (
+	MergeTransactionsUseCase interface {
+		Execute(ctx context.Context, userID, transactionID string, input *dtos.MergeInput) (*dtos.MergeOutput, error)
+	}
+
+	mergeTransactionsUseCase struct {
+		o11y                 observability.Observability
+		uow                  uow.UnitOfWork
+		repository           transactionInterfaces.TransactionRepository
+		revisionRepository   transactionInterfaces.TransactionRevisionRepository
+		attachmentRepository transactionInterfaces.AttachmentRepository
+		invoiceProvider      transactionInterfaces.InvoiceProvider
+		outboxService        outbox.Service
+	}
+)
+
+// NewMergeTransactionsUseCase creates a new MergeTransactionsUseCase.
+func NewMergeTransactionsUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	attachmentRepository transactionInterfaces.AttachmentRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	outboxService outbox.Service,
+) MergeTransactionsUseCase {
+	return &mergeTransactionsUseCase{
+		o11y:                 o11y,
+		uow:                  unitOfWork,
+		repository:           repository,
+		revisionRepository:   revisionRepository,
+		attachmentRepository: attachmentRepository,
+		invoiceProvider:      invoiceProvider,
+		outboxService:        outboxService,
+	}
+}
+
+// Execute merges a duplicate into the transaction it repeats. The duplicate is cancelled
+// the way a reversal cancels it: its invoice item is removed and transaction.reversed is
+// emitted so the budget no longer counts it. Its tags are added to the kept transaction
+// and its attachments are moved there; an attachment whose content the kept transaction
+// already has is deleted instead. Installments of a multi-installment purchase cannot be
+// merged, and a duplicate on a closed invoice is rejected.
+func (u *mergeTransactionsUseCase) Execute(ctx context.Context, userID, transactionID string, input *dtos.MergeInput) (*dtos.MergeOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "merge_transactions_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if input.DuplicateID == transactionID {
+		return nil, fmt.Errorf("%w: a transaction cannot be merged into itself", transactionDomain.ErrInvalidMerge)
+	}
+
+	kept, err := u.findMergeable(ctx, userID, transactionID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	duplicate, err := u.findMergeable(ctx, userID, input.DuplicateID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if duplicate.InvoiceID != nil {
+		status, err := u.invoiceProvider.GetStatus(ctx, *duplicate.InvoiceID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if !duplicate.IsEditable(status) {
+			return nil, transactionDomain.ErrInvoiceClosed
+		}
+	}
+
+	attachments, err := u.attachmentRepository.ListByOwner(ctx, entities.AttachmentOwnerTransaction, duplicate.ID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	keptAttachments, err := u.attachmentRepository.ListByOwner(ctx, entities.AttachmentOwnerTransaction, kept.ID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	keptHashes := make(map[string]bool, len(keptAttachments))
+	for _, attachment := range keptAttachments {
+		keptHashes[attachment.SHA256] = true
+	}
+
+	duplicateTagIDs := make([]vos.UUID, 0, len(duplicate.Tags))
+	for _, tag := range duplicate.Tags {
+		duplicateTagIDs = append(duplicateTagIDs, tag.ID)
+	}
+	addedTags := missingTags(kept, duplicateTagIDs)
+
+	before := statesOf(duplicate)
+	if err := duplicate.Cancel(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	moved := 0
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		if err := u.repository.UpdateAll(ctx, tx, []*entities.Transaction{duplicate}); err != nil {
+			return err
+		}
+		if err := saveRevisions(ctx, tx, u.revisionRepository, &duplicate.UserID, before, duplicate); err != nil {
+			return err
+		}
+		if duplicate.InvoiceID != nil {
+			if err := u.invoiceProvider.RemoveItems(ctx, tx, []vos.UUID{duplicate.ID}); err != nil {
+				return err
+			}
+		}
+		if len(addedTags) > 0 {
+			if err := u.repository.AddTags(ctx, tx, []vos.UUID{kept.ID}, addedTags); err != nil {
+				return err
+			}
+		}
+		for _, attachment := range attachments {
+			if keptHashes[attachment.SHA256] {
+				attachment.Delete()
+				if err := u.attachmentRepository.Delete(ctx, tx, attachment); err != nil {
+					return err
+				}
+				continue
+			}
+			attachment.MoveTo(kept.ID)
+			if err := u.attachmentRepository.UpdateOwner(ctx, tx, attachment); err != nil {
+				return err
+			}
+			keptHashes[attachment.SHA256] = true
+			moved++
+		}
+
+		event := events.NewTransactionReversedEvent(
+			duplicate.ID,
+			duplicate.UserID,
+			duplicate.CategoryID,
+			duplicate.Amount,
+			resolveReferenceMonth(duplicate, duplicate.TransactionDate),
+			duplicate.InvoiceID,
+			duplicate.InstallmentNumber,
+			duplicate.InstallmentGroupID,
+			*duplicate.UpdatedAt,
+			toSplitSnapshots(duplicate),
+		)
+		aggregateID, _ := uuid.Parse(duplicate.ID.String())
+		return u.outboxService.SaveDomainEvent(
+			ctx,
+			tx,
+			aggregateID,
+			"transaction",
+			event.EventType(),
+			outbox.JSONBPayload(event.Payload()),
+		)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "MergeTransactions"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	kept.SetTags(append(kept.Tags, duplicate.Tags...))
+	addedTagIDs := make([]string, 0, len(addedTags))
+	for _, tagID := range addedTags {
+		addedTagIDs = append(addedTagIDs, tagID.String())
+	}
+	return &dtos.MergeOutput{
+		Transaction:      toOutput(kept),
+		Cancelled:        toOutput(duplicate),
+		MovedAttachments: moved,
+		AddedTagIDs:      addedTagIDs,
+	}, nil
+}
+
+// findMergeable loads an active transaction of the user that can take part in a merge.
+func (u *mergeTransactionsUseCase) findMergeable(ctx context.Context, userID, transactionID string) (*entities.Transaction, error) {
+	id, err := vos.NewUUIDFromString(transactionID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction_id: %w", err)
+	}
+
+	transaction, err := u.repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, transactionDomain.ErrTransactionNotFound
+	}
+	if transaction.UserID.String() != userID {
+		return nil, transactionDomain.ErrTransactionNotOwned
+	}
+	if !transaction.Status.IsActive() {
+		return nil, fmt.Errorf("%w: transaction %s is not active", transactionDomain.ErrInvalidMerge, transactionID)
+	}
+	if transaction.InstallmentTotal != nil && *transaction.InstallmentTotal > 1 {
+		return nil, fmt.Errorf("%w: installments of a purchase cannot be merged", transactionDomain.ErrInvalidMerge)
+	}
+	return transaction, nil
+}
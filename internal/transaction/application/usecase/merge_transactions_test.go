@@ -0,0 +1,199 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type MergeTransactionsUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.TransactionRepository
+	revisionRepo    *transactionMocks.TransactionRevisionRepository
+	attachmentRepo  *transactionMocks.AttachmentRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+	outboxService   *outboxMocks.Service
+}
+
+func TestMergeTransactionsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(MergeTransactionsUseCaseSuite))
+}
+
+func (s *MergeTransactionsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.attachmentRepo = transactionMocks.NewAttachmentRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func (s *MergeTransactionsUseCaseSuite) useCase() MergeTransactionsUseCase {
+	return NewMergeTransactionsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.attachmentRepo, s.invoiceProvider, s.outboxService)
+}
+
+func (s *MergeTransactionsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	otherUserID := "550e8400-e29b-41d4-a716-446655440099"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+
+	s.Run("should cancel the duplicate and move its tags and attachments", func() {
+		kept := buildTransaction(userID, categoryID, nil)
+		duplicate := buildTransaction(userID, categoryID, nil)
+		vacation := buildTag(userID, "vacation-2026")
+		food := buildTag(userID, "food")
+		kept.SetTags([]*entities.Tag{food})
+		duplicate.SetTags([]*entities.Tag{food, vacation})
+		receipt := buildAttachment(userID, entities.AttachmentOwnerTransaction, duplicate.ID, receiptPDF)
+		repeated := buildAttachment(userID, entities.AttachmentOwnerTransaction, duplicate.ID, "%PDF-1.4 repeated")
+		keptReceipt := buildAttachment(userID, entities.AttachmentOwnerTransaction, kept.ID, "%PDF-1.4 repeated")
+
+		s.repo.EXPECT().FindByID(mock.Anything, kept.ID).Return(kept, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, duplicate.ID).Return(duplicate, nil).Once()
+		s.attachmentRepo.EXPECT().ListByOwner(mock.Anything, entities.AttachmentOwnerTransaction, duplicate.ID).Return([]*entities.Attachment{receipt, repeated}, nil).Once()
+		s.attachmentRepo.EXPECT().ListByOwner(mock.Anything, entities.AttachmentOwnerTransaction, kept.ID).Return([]*entities.Attachment{keptReceipt}, nil).Once()
+		s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+			return len(ts) == 1 && ts[0].ID == duplicate.ID && ts[0].Status.IsCancelled()
+		})).Return(nil).Once()
+		s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.repo.EXPECT().AddTags(mock.Anything, mock.Anything, []vos.UUID{kept.ID}, []vos.UUID{vacation.ID}).Return(nil).Once()
+		s.attachmentRepo.EXPECT().UpdateOwner(mock.Anything, mock.Anything, mock.MatchedBy(func(a *entities.Attachment) bool {
+			return a.ID == receipt.ID && a.OwnerID == kept.ID
+		})).Return(nil).Once()
+		s.attachmentRepo.EXPECT().Delete(mock.Anything, mock.Anything, mock.MatchedBy(func(a *entities.Attachment) bool {
+			return a.ID == repeated.ID && a.DeletedAt != nil
+		})).Return(nil).Once()
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.reversed", mock.Anything).Return(nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: duplicate.ID.String()})
+
+		s.NoError(err)
+		s.Equal(kept.ID.String(), output.Transaction.ID)
+		s.Len(output.Transaction.Tags, 2)
+		s.Equal("cancelled", output.Cancelled.Status)
+		s.Equal(1, output.MovedAttachments)
+		s.Equal([]string{vacation.ID.String()}, output.AddedTagIDs)
+	})
+
+	s.Run("should remove the invoice item of a billed duplicate", func() {
+		invoiceID, _ := vos.NewUUID()
+		kept := buildTransaction(userID, categoryID, nil)
+		duplicate := buildTransaction(userID, categoryID, &invoiceID)
+		s.repo.EXPECT().FindByID(mock.Anything, kept.ID).Return(kept, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, duplicate.ID).Return(duplicate, nil).Once()
+		s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invoiceID).Return("open", nil).Once()
+		s.attachmentRepo.EXPECT().ListByOwner(mock.Anything, entities.AttachmentOwnerTransaction, mock.Anything).Return([]*entities.Attachment{}, nil).Times(2)
+		s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.invoiceProvider.EXPECT().RemoveItems(mock.Anything, mock.Anything, []vos.UUID{duplicate.ID}).Return(nil).Once()
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: duplicate.ID.String()})
+
+		s.NoError(err)
+		s.Zero(output.MovedAttachments)
+		s.Empty(output.AddedTagIDs)
+	})
+
+	s.Run("should reject a duplicate on a closed invoice", func() {
+		invoiceID, _ := vos.NewUUID()
+		kept := buildTransaction(userID, categoryID, nil)
+		duplicate := buildTransaction(userID, categoryID, &invoiceID)
+		s.repo.EXPECT().FindByID(mock.Anything, kept.ID).Return(kept, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, duplicate.ID).Return(duplicate, nil).Once()
+		s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invoiceID).Return("closed", nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: duplicate.ID.String()})
+
+		s.ErrorIs(err, transactionDomain.ErrInvoiceClosed)
+		s.Nil(output)
+	})
+
+	s.Run("should reject installments of a purchase", func() {
+		kept := buildTransaction(userID, categoryID, nil)
+		installment := buildTransaction(userID, categoryID, nil)
+		total := 3
+		installment.InstallmentTotal = &total
+		s.repo.EXPECT().FindByID(mock.Anything, kept.ID).Return(kept, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, installment.ID).Return(installment, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: installment.ID.String()})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidMerge)
+		s.Nil(output)
+	})
+
+	s.Run("should reject a cancelled transaction", func() {
+		kept := buildTransaction(userID, categoryID, nil)
+		duplicate := buildTransaction(userID, categoryID, nil)
+		_ = duplicate.Cancel()
+		s.repo.EXPECT().FindByID(mock.Anything, kept.ID).Return(kept, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, duplicate.ID).Return(duplicate, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: duplicate.ID.String()})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidMerge)
+		s.Nil(output)
+	})
+
+	s.Run("should reject merging a transaction into itself", func() {
+		kept := buildTransaction(userID, categoryID, nil)
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: kept.ID.String()})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidMerge)
+		s.Nil(output)
+	})
+
+	s.Run("should reject a transaction of another user", func() {
+		kept := buildTransaction(userID, categoryID, nil)
+		duplicate := buildTransaction(otherUserID, categoryID, nil)
+		s.repo.EXPECT().FindByID(mock.Anything, kept.ID).Return(kept, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, duplicate.ID).Return(duplicate, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: duplicate.ID.String()})
+
+		s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+		s.Nil(output)
+	})
+
+	s.Run("should return not found when the duplicate does not exist", func() {
+		kept := buildTransaction(userID, categoryID, nil)
+		missingID, _ := vos.NewUUID()
+		s.repo.EXPECT().FindByID(mock.Anything, kept.ID).Return(kept, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, missingID).Return(nil, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: missingID.String()})
+
+		s.ErrorIs(err, transactionDomain.ErrTransactionNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should return repository error", func() {
+		kept := buildTransaction(userID, categoryID, nil)
+		duplicate := buildTransaction(userID, categoryID, nil)
+		s.repo.EXPECT().FindByID(mock.Anything, kept.ID).Return(kept, nil).Once()
+		s.repo.EXPECT().FindByID(mock.Anything, duplicate.ID).Return(duplicate, nil).Once()
+		s.attachmentRepo.EXPECT().ListByOwner(mock.Anything, entities.AttachmentOwnerTransaction, mock.Anything).Return([]*entities.Attachment{}, nil).Times(2)
+		s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, kept.ID.String(), &dtos.MergeInput{DuplicateID: duplicate.ID.String()})
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	invoiceFactories "github.com/jailtonjunior94/financial/internal/invoice/domain/factories"
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+type (
+	PayOffInstallmentsUseCase interface {
+		Execute(ctx context.Context, userID, installmentGroupID string, input *dtos.PayoffInput) (*dtos.PayoffOutput, error)
+	}
+
+	payOffInstallmentsUseCase struct {
+		o11y               observability.Observability
+		uow                uow.UnitOfWork
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+		payoffRepository   transactionInterfaces.InstallmentPayoffRepository
+		invoiceProvider    transactionInterfaces.InvoiceProvider
+		cardProvider       invoiceInterfaces.CardProvider
+		outboxService      outbox.Service
+	}
+)
+
+// NewPayOffInstallmentsUseCase creates a new PayOffInstallmentsUseCase.
+func NewPayOffInstallmentsUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	payoffRepository transactionInterfaces.InstallmentPayoffRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	cardProvider invoiceInterfaces.CardProvider,
+	outboxService outbox.Service,
+) PayOffInstallmentsUseCase {
+	return &payOffInstallmentsUseCase{
+		o11y:               o11y,
+		uow:                unitOfWork,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		payoffRepository:   payoffRepository,
+		invoiceProvider:    invoiceProvider,
+		cardProvider:       cardProvider,
+		outboxService:      outboxService,
+	}
+}
+
+// Execute pays off the installments of a card purchase billed after the invoice that is
+// open on the payoff date. They are cancelled and replaced by a single item on that
+// invoice, worth their sum minus the discount. Each cancelled installment emits a
+// reversal for the month of its own invoice, so future budgets drop, and the
+// consolidated item is created in the month of the open invoice.
+func (u *payOffInstallmentsUseCase) Execute(ctx context.Context, userID, installmentGroupID string, input *dtos.PayoffInput) (*dtos.PayoffOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "pay_off_installments_usecase.execute")
+	defer span.End()
+
+	payoffDate, err := input.Validate(time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	groupID, err := vos.NewUUIDFromString(installmentGroupID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid installment_group_id: %w", err)
+	}
+
+	group, err := u.repository.FindByInstallmentGroup(ctx, groupID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(group) == 0 {
+		return nil, transactionDomain.ErrInstallmentGroupNotFound
+	}
+	if group[0].UserID.String() != userID {
+		return nil, transactionDomain.ErrTransactionNotOwned
+	}
+	if group[0].CardID == nil {
+		return nil, fmt.Errorf("%w: only card purchases can be paid off", transactionDomain.ErrInvalidPayoff)
+	}
+
+	openInvoice, err := u.findOpenInvoice(ctx, group[0], payoffDate)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	future, months, err := u.findFutureInstallments(ctx, group, openInvoice.ReferenceMonth)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	discount, err := vos.NewMoney(0, group[0].Amount.Currency())
+	if err != nil {
+		return nil, err
+	}
+	if input.Discount != nil {
+		if discount, err = vos.NewMoneyFromFloat(*input.Discount, group[0].Amount.Currency()); err != nil {
+			return nil, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidPayoff, err)
+		}
+	}
+
+	before := statesOf(future...)
+	payoff, consolidated, err := entities.PayOffInstallments(future, openInvoice.ID, discount, payoffDate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		if err := u.repository.UpdateAll(ctx, tx, future); err != nil {
+			return err
+		}
+		removed := make([]vos.UUID, 0, len(future))
+		for _, t := range future {
+			removed = append(removed, t.ID)
+		}
+		if err := u.invoiceProvider.RemoveItems(ctx, tx, removed); err != nil {
+			return err
+		}
+		if err := u.repository.SaveAll(ctx, tx, []*entities.Transaction{consolidated}); err != nil {
+			return err
+		}
+		if err := saveRevisions(ctx, tx, u.revisionRepository, &consolidated.UserID, before, append(append([]*entities.Transaction{}, future...), consolidated)...); err != nil {
+			return err
+		}
+		if err := u.invoiceProvider.AddItems(ctx, tx, []transactionInterfaces.InvoiceItemInfo{toInvoiceItem(consolidated, consolidated.Amount)}); err != nil {
+			return err
+		}
+		if err := u.payoffRepository.Save(ctx, tx, payoff); err != nil {
+			return err
+		}
+
+		for i, t := range future {
+			event := events.NewTransactionReversedEvent(
+				t.ID,
+				t.UserID,
+				t.CategoryID,
+				t.Amount,
+				months[i],
+				t.InvoiceID,
+				t.InstallmentNumber,
+				t.InstallmentGroupID,
+				*t.UpdatedAt,
+				toSplitSnapshots(t),
+			)
+			if err := u.saveEvent(ctx, tx, t.ID, event.EventType(), event.Payload()); err != nil {
+				return err
+			}
+		}
+		event := events.NewTransactionCreatedEvent(
+			consolidated.ID,
+			consolidated.UserID,
+			consolidated.CategoryID,
+			consolidated.Amount,
+			consolidated.Direction,
+			consolidated.PaymentMethod,
+			consolidated.TransactionDate,
+			openInvoice.ReferenceMonth,
+			consolidated.InvoiceID,
+			consolidated.InstallmentNumber,
+			consolidated.InstallmentTotal,
+			consolidated.InstallmentGroupID,
+			toSplitSnapshots(consolidated),
+		)
+		return u.saveEvent(ctx, tx, consolidated.ID, event.EventType(), event.Payload())
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "PayOffInstallments"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return &dtos.PayoffOutput{
+		ID:                 payoff.ID.String(),
+		InstallmentGroupID: payoff.InstallmentGroupID.String(),
+		InvoiceID:          payoff.InvoiceID.String(),
+		Installments:       payoff.Installments,
+		OriginalAmount:     payoff.OriginalAmount.Float(),
+		Discount:           payoff.Discount.Float(),
+		Amount:             payoff.Amount.Float(),
+		PayoffDate:         payoff.PayoffDate.Format("2006-01-02"),
+		Transaction:        toOutput(consolidated),
+		Cancelled:          toOutputList(future),
+	}, nil
+}
+
+// findOpenInvoice returns the invoice of the card that covers payoffDate, creating it
+// when needed. It must still be open to receive the consolidated item.
+func (u *payOffInstallmentsUseCase) findOpenInvoice(ctx context.Context, t *entities.Transaction, payoffDate time.Time) (*transactionInterfaces.InvoiceInfo, error) {
+	billingInfo, err := u.cardProvider.GetCardBillingInfo(ctx, t.UserID, *t.CardID)
+	if err != nil {
+		return nil, err
+	}
+	calculator, err := invoiceFactories.NewInvoiceCalculator(billingInfo.DueDay, billingInfo.ClosingOffsetDays)
+	if err != nil {
+		return nil, fmt.Errorf("invalid card billing configuration: %w", err)
+	}
+
+	month := calculator.CalculateInvoiceMonth(payoffDate)
+	info, err := u.invoiceProvider.FindOrCreate(ctx, t.UserID, *t.CardID, month, calculator.CalculateDueDate(month))
+	if err != nil {
+		return nil, err
+	}
+	if info.Status != "open" {
+		return nil, fmt.Errorf("%w: the invoice of %s that would receive the payoff is %s", transactionDomain.ErrInvoiceClosed, month.String(), info.Status)
+	}
+	return info, nil
+}
+
+// findFutureInstallments returns the active installments billed on open invoices after
+// current, with the month of each one's invoice. Installments already billed on current
+// or earlier invoices stay where they are.
+func (u *payOffInstallmentsUseCase) findFutureInstallments(
+	ctx context.Context,
+	group []*entities.Transaction,
+	current pkgVos.ReferenceMonth,
+) ([]*entities.Transaction, []pkgVos.ReferenceMonth, error) {
+	future := make([]*entities.Transaction, 0, len(group))
+	months := make([]pkgVos.ReferenceMonth, 0, len(group))
+	for _, t := range group {
+		if !t.Status.IsActive() || t.InstallmentNumber == nil || t.InvoiceID == nil {
+			continue
+		}
+		info, err := u.invoiceProvider.FindByID(ctx, *t.InvoiceID)
+		if err != nil {
+			return nil, nil, err
+		}
+		if info == nil {
+			return nil, nil, transactionDomain.ErrInvoiceNotFound
+		}
+		if info.Status != "open" || !info.ReferenceMonth.FirstDay().After(current.FirstDay()) {
+			continue
+		}
+		future = append(future, t)
+		months = append(months, info.ReferenceMonth)
+	}
+	return future, months, nil
+}
+
+func (u *payOffInstallmentsUseCase) saveEvent(ctx context.Context, tx database.DBTX, transactionID vos.UUID, eventType string, payload map[string]any) error {
+	aggregateID, _ := uuid.Parse(transactionID.String())
+	return u.outboxService.SaveDomainEvent(ctx, tx, aggregateID, "transaction", eventType, outbox.JSONBPayload(payload))
+}
@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type PayOffInstallmentsUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.TransactionRepository
+	revisionRepo    *transactionMocks.TransactionRevisionRepository
+	payoffRepo      *transactionMocks.InstallmentPayoffRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+	cardProvider    *invoiceMocks.CardProvider
+	outboxService   *outboxMocks.Service
+}
+
+func TestPayOffInstallmentsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(PayOffInstallmentsUseCaseSuite))
+}
+
+func (s *PayOffInstallmentsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.payoffRepo = transactionMocks.NewInstallmentPayoffRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.cardProvider = invoiceMocks.NewCardProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func (s *PayOffInstallmentsUseCaseSuite) useCase() PayOffInstallmentsUseCase {
+	return NewPayOffInstallmentsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.payoffRepo, s.invoiceProvider, s.cardProvider, s.outboxService)
+}
+
+func (s *PayOffInstallmentsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	otherUserID := "550e8400-e29b-41d4-a716-446655440099"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	groupID, _ := vos.NewUUID()
+	cardID, _ := vos.NewUUID()
+	billingInfo := &invoiceInterfaces.CardBillingInfo{CardID: cardID, DueDay: 10, ClosingOffsetDays: 7}
+	months := []string{"2026-04", "2026-05", "2026-06", "2026-07"}
+	statuses := []string{"paid", "open", "open", "open"}
+
+	type invoice struct {
+		id   vos.UUID
+		info *transactionInterfaces.InvoiceInfo
+	}
+	buildGroup := func() ([]*entities.Transaction, []invoice) {
+		group := make([]*entities.Transaction, 0, len(months))
+		invoices := make([]invoice, 0, len(months))
+		for i, value := range months {
+			id, _ := vos.NewUUID()
+			month, _ := pkgVos.NewReferenceMonth(value)
+			invoices = append(invoices, invoice{id: id, info: &transactionInterfaces.InvoiceInfo{ID: id, Status: statuses[i], ReferenceMonth: month}})
+
+			number, total := i+1, len(months)
+			tx := buildTransaction(userID, categoryID, &id)
+			tx.CardID = &cardID
+			tx.InstallmentGroupID = &groupID
+			tx.InstallmentNumber = &number
+			tx.InstallmentTotal = &total
+			group = append(group, tx)
+		}
+		return group, invoices
+	}
+	expectInvoices := func(invoices []invoice) {
+		for _, inv := range invoices {
+			s.invoiceProvider.EXPECT().FindByID(mock.Anything, inv.id).Return(inv.info, nil).Once()
+		}
+	}
+	expectOpenInvoice := func(inv invoice) {
+		s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, mock.Anything, cardID).Return(billingInfo, nil).Once()
+		s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, mock.Anything, cardID, mock.MatchedBy(func(month pkgVos.ReferenceMonth) bool {
+			return month.String() == "2026-05"
+		}), mock.Anything).Return(inv.info, nil).Once()
+	}
+	discount := func(value float64) *float64 { return &value }
+
+	s.Run("should move the future installments into the open invoice", func() {
+		group, invoices := buildGroup()
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+		expectOpenInvoice(invoices[1])
+		expectInvoices(invoices)
+		s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+			return len(ts) == 2 && ts[0].ID == group[2].ID && ts[1].ID == group[3].ID && ts[0].Status.IsCancelled()
+		})).Return(nil).Once()
+		s.invoiceProvider.EXPECT().RemoveItems(mock.Anything, mock.Anything, []vos.UUID{group[2].ID, group[3].ID}).Return(nil).Once()
+		s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+			return len(ts) == 1 && *ts[0].InvoiceID == invoices[1].id && ts[0].Amount.Cents() == 17000 && *ts[0].InstallmentGroupID == groupID
+		})).Return(nil).Once()
+		s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.InvoiceItemInfo) bool {
+			return len(items) == 1 && items[0].InvoiceID == invoices[1].id && items[0].InstallmentAmount.Cents() == 17000
+		})).Return(nil).Once()
+		s.payoffRepo.EXPECT().Save(mock.Anything, mock.Anything, mock.MatchedBy(func(p *entities.InstallmentPayoff) bool {
+			return p.InstallmentGroupID == groupID && p.Installments == 2 && p.Discount.Cents() == 3000
+		})).Return(nil).Once()
+		for _, month := range []string{"2026-06", "2026-07"} {
+			s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.reversed", mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+				return payload["reference_month"] == month
+			})).Return(nil).Once()
+		}
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.created", mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+			return payload["reference_month"] == "2026-05"
+		})).Return(nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.PayoffInput{Discount: discount(30), PayoffDate: "2026-04-20"})
+
+		s.NoError(err)
+		s.Equal(2, output.Installments)
+		s.Equal(200.0, output.OriginalAmount)
+		s.Equal(170.0, output.Amount)
+		s.Equal(invoices[1].id.String(), output.InvoiceID)
+		s.Len(output.Cancelled, 2)
+		s.Equal("Original (antecipação de 2 parcelas)", output.Transaction.Description)
+	})
+
+	s.Run("should return error when nothing is left to pay off", func() {
+		group, invoices := buildGroup()
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group[:2], nil).Once()
+		expectOpenInvoice(invoices[1])
+		expectInvoices(invoices[:2])
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.PayoffInput{PayoffDate: "2026-04-20"})
+
+		s.ErrorIs(err, transactionDomain.ErrNothingToPayOff)
+		s.Nil(output)
+	})
+
+	s.Run("should return error when the discount is not below the amount", func() {
+		group, invoices := buildGroup()
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+		expectOpenInvoice(invoices[1])
+		expectInvoices(invoices)
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.PayoffInput{Discount: discount(200), PayoffDate: "2026-04-20"})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidPayoff)
+		s.Nil(output)
+	})
+
+	s.Run("should return error when the invoice of the payoff date is closed", func() {
+		group, invoices := buildGroup()
+		closed := invoice{id: invoices[1].id, info: &transactionInterfaces.InvoiceInfo{ID: invoices[1].id, Status: "closed"}}
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+		expectOpenInvoice(closed)
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.PayoffInput{PayoffDate: "2026-04-20"})
+
+		s.ErrorIs(err, transactionDomain.ErrInvoiceClosed)
+		s.Nil(output)
+	})
+
+	s.Run("should return error when the group does not exist", func() {
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(nil, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.PayoffInput{})
+
+		s.ErrorIs(err, transactionDomain.ErrInstallmentGroupNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should return error when the group belongs to another user", func() {
+		group, _ := buildGroup()
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, otherUserID, groupID.String(), &dtos.PayoffInput{})
+
+		s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+		s.Nil(output)
+	})
+
+	s.Run("should return error for an invalid payoff request", func() {
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.PayoffInput{Discount: discount(-1)})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidPayoff)
+		s.Nil(output)
+	})
+}
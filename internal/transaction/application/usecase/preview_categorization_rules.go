@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	PreviewCategorizationRulesUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.CategorizationPreviewInput) (*dtos.CategorizationPreviewOutput, error)
+	}
+
+	previewCategorizationRulesUseCase struct {
+		o11y       observability.Observability
+		repository transactionInterfaces.CategorizationRuleRepository
+	}
+)
+
+// NewPreviewCategorizationRulesUseCase creates a new PreviewCategorizationRulesUseCase.
+func NewPreviewCategorizationRulesUseCase(
+	o11y observability.Observability,
+	repository transactionInterfaces.CategorizationRuleRepository,
+) PreviewCategorizationRulesUseCase {
+	return &previewCategorizationRulesUseCase{o11y: o11y, repository: repository}
+}
+
+// Execute evaluates the rules of the user against a sample transaction, without creating
+// anything, so a rule can be checked before real transactions depend on it.
+func (u *previewCategorizationRulesUseCase) Execute(ctx context.Context, userID string, input *dtos.CategorizationPreviewInput) (*dtos.CategorizationPreviewOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "preview_categorization_rules_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	rules, err := u.repository.ListByUser(ctx, userUUID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	matched := entities.MatchingCategorizationRules(rules, newCategorizationSubject(input.Description, input.Amount, input.PaymentMethod, input.CardID))
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "PreviewCategorizationRules"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "categorization_rule"),
+		observability.String("user_id", userID),
+		observability.Int("matched", len(matched)),
+	)
+
+	output := &dtos.CategorizationPreviewOutput{MatchedRules: make([]*dtos.CategorizationRuleOutput, 0, len(matched))}
+	for _, rule := range matched {
+		output.MatchedRules = append(output.MatchedRules, toCategorizationRuleOutput(rule))
+	}
+	if len(output.MatchedRules) > 0 {
+		output.AppliedRule = output.MatchedRules[0]
+	}
+	return output, nil
+}
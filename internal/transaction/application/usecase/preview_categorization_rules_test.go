@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type PreviewCategorizationRulesUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.CategorizationRuleRepository
+}
+
+func TestPreviewCategorizationRulesUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(PreviewCategorizationRulesUseCaseSuite))
+}
+
+func (s *PreviewCategorizationRulesUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewCategorizationRuleRepository(s.T())
+}
+
+func (s *PreviewCategorizationRulesUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	uber := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 10, entities.CategorizationRuleConditions{DescriptionContains: "uber"})
+	cheap := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440003", 1, entities.CategorizationRuleConditions{MaxAmount: mustMoney(30)})
+	card := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440004", 0, entities.CategorizationRuleConditions{PaymentMethod: "credit"})
+	rules := []*entities.CategorizationRule{card, cheap, uber}
+
+	s.Run("should list the matching rules with the one that applies first", func() {
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(rules, nil).Once()
+
+		output, err := NewPreviewCategorizationRulesUseCase(s.obs, s.repo).Execute(s.ctx, userID,
+			&dtos.CategorizationPreviewInput{Description: "UBER *TRIP", Amount: 23.90, PaymentMethod: "pix"})
+
+		s.NoError(err)
+		s.Len(output.MatchedRules, 2)
+		s.Equal(cheap.ID.String(), output.AppliedRule.ID)
+		s.Equal(uber.ID.String(), output.MatchedRules[1].ID)
+	})
+
+	s.Run("should report no applied rule when nothing matches", func() {
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(rules, nil).Once()
+
+		output, err := NewPreviewCategorizationRulesUseCase(s.obs, s.repo).Execute(s.ctx, userID,
+			&dtos.CategorizationPreviewInput{Description: "Rent", Amount: 2500, PaymentMethod: "ted"})
+
+		s.NoError(err)
+		s.Empty(output.MatchedRules)
+		s.Nil(output.AppliedRule)
+	})
+
+	s.Run("should require a description", func() {
+		output, err := NewPreviewCategorizationRulesUseCase(s.obs, s.repo).Execute(s.ctx, userID,
+			&dtos.CategorizationPreviewInput{Amount: 10})
+
+		s.ErrorIs(err, transactionDomain.ErrDescriptionRequired)
+		s.Nil(output)
+	})
+
+	s.Run("should return repository error", func() {
+		s.repo.EXPECT().ListByUser(mock.Anything, userUUID).Return(nil, errors.New("db error")).Once()
+
+		output, err := NewPreviewCategorizationRulesUseCase(s.obs, s.repo).Execute(s.ctx, userID,
+			&dtos.CategorizationPreviewInput{Description: "Uber", Amount: 10})
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
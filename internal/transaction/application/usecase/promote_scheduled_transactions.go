@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+// promoteScheduledPageSize limits how many due scheduled transactions are loaded per page.
+const promoteScheduledPageSize = 200
+
+type (
+	PromoteScheduledTransactionsUseCase interface {
+		Execute(ctx context.Context, now time.Time) (int, error)
+	}
+
+	promoteScheduledTransactionsUseCase struct {
+		o11y               observability.Observability
+		uow                uow.UnitOfWork
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+		outboxService      outbox.Service
+	}
+)
+
+// NewPromoteScheduledTransactionsUseCase creates the use case that turns scheduled
+// transactions into active ones on their date.
+func NewPromoteScheduledTransactionsUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	outboxService outbox.Service,
+) PromoteScheduledTransactionsUseCase {
+	return &promoteScheduledTransactionsUseCase{
+		o11y:               o11y,
+		uow:                unitOfWork,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		outboxService:      outboxService,
+	}
+}
+
+// Execute promotes every scheduled transaction dated up to the date of now, including
+// the ones missed while the worker was down. Returns how many were promoted. A failing
+// transaction does not stop the others; errors are joined.
+func (u *promoteScheduledTransactionsUseCase) Execute(ctx context.Context, now time.Time) (int, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "promote_scheduled_transactions_usecase.execute")
+	defer span.End()
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	promoted := 0
+	due := 0
+	var errs []error
+
+	// Walks every due transaction page by page: the ones that fail stay scheduled and
+	// must not fill the page and hide the rest until the next run.
+	var after *transactionInterfaces.ScheduledCursor
+	for {
+		page, err := u.repository.ListDueScheduled(ctx, today, after, promoteScheduledPageSize)
+		if err != nil {
+			span.RecordError(err)
+			return promoted, errors.Join(append(errs, err)...)
+		}
+
+		for _, t := range page {
+			ok, err := u.promote(ctx, t, today)
+			if err != nil {
+				span.RecordError(err)
+				u.o11y.Logger().Error(ctx, "query_failed",
+					observability.String("operation", "PromoteScheduledTransactions"),
+					observability.String("layer", "usecase"),
+					observability.String("entity", "transaction"),
+					observability.String("transaction_id", t.ID.String()),
+					observability.Error(err),
+				)
+				errs = append(errs, err)
+				continue
+			}
+			if ok {
+				promoted++
+			}
+		}
+		due += len(page)
+
+		if len(page) < promoteScheduledPageSize {
+			break
+		}
+		last := page[len(page)-1]
+		after = &transactionInterfaces.ScheduledCursor{TransactionDate: last.TransactionDate, ID: last.ID}
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "PromoteScheduledTransactions"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.Int("due", due),
+		observability.Int("promoted", promoted),
+	)
+
+	return promoted, errors.Join(errs...)
+}
+
+// promote confirms a due transaction. Returns false when the user confirmed or skipped
+// it after it was loaded.
+func (u *promoteScheduledTransactionsUseCase) promote(ctx context.Context, t *entities.Transaction, today time.Time) (bool, error) {
+	before := statesOf(t)
+	if err := t.Confirm(today); err != nil {
+		return false, err
+	}
+	promoted := false
+	err := u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		ok, err := resolveScheduled(ctx, tx, u.repository, u.revisionRepository, u.outboxService, t, before, nil)
+		promoted = ok
+		return err
+	})
+	return promoted, err
+}
+
+// resolveScheduled persists a confirmed or skipped scheduled transaction with a guarded
+// update and records the change from before in its history; a nil changedBy marks the
+// promotion job. A confirmed transaction emits the transaction.created event held back
+// since its creation, so budgets count it from now on. Returns false, saving no revision
+// or event, when the transaction was resolved concurrently.
+func resolveScheduled(
+	ctx context.Context,
+	tx database.DBTX,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	outboxService outbox.Service,
+	t *entities.Transaction,
+	before map[vos.UUID]entities.TransactionState,
+	changedBy *vos.UUID,
+) (bool, error) {
+	resolved, err := repository.ResolveScheduled(ctx, tx, t)
+	if err != nil || !resolved {
+		return false, err
+	}
+	if err := saveRevisions(ctx, tx, revisionRepository, changedBy, before, t); err != nil {
+		return false, err
+	}
+	if !t.Status.IsActive() {
+		return true, nil
+	}
+
+	event := events.NewTransactionCreatedEvent(
+		t.ID,
+		t.UserID,
+		t.CategoryID,
+		t.Amount,
+		t.Direction,
+		t.PaymentMethod,
+		t.TransactionDate,
+		resolveReferenceMonth(t, t.TransactionDate),
+		t.InvoiceID,
+		t.InstallmentNumber,
+		t.InstallmentTotal,
+		t.InstallmentGroupID,
+		toSplitSnapshots(t),
+	)
+	aggregateID, _ := uuid.Parse(t.ID.String())
+	if err := outboxService.SaveDomainEvent(
+		ctx,
+		tx,
+		aggregateID,
+		"transaction",
+		event.EventType(),
+		outbox.JSONBPayload(event.Payload()),
+	); err != nil {
+		return false, err
+	}
+	return true, nil
+}
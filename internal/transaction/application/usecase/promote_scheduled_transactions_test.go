@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+func buildScheduledTransaction(userID, categoryID string, date time.Time) *entities.Transaction {
+	t := buildTransaction(userID, categoryID, nil)
+	t.Status, _ = transactionVos.NewTransactionStatus(transactionVos.TransactionStatusScheduled)
+	t.TransactionDate = date
+	return t
+}
+
+type PromoteScheduledTransactionsUseCaseSuite struct {
+	suite.Suite
+	ctx           context.Context
+	obs           *fake.Provider
+	repo          *transactionMocks.TransactionRepository
+	revisionRepo  *transactionMocks.TransactionRevisionRepository
+	outboxService *outboxMocks.Service
+}
+
+func TestPromoteScheduledTransactionsUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(PromoteScheduledTransactionsUseCaseSuite))
+}
+
+func (s *PromoteScheduledTransactionsUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func (s *PromoteScheduledTransactionsUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	now := time.Date(2026, 3, 10, 1, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	scenarios := []struct {
+		name         string
+		dependencies func() []*entities.Transaction
+		expect       func(transactions []*entities.Transaction, count int, err error)
+	}{
+		{
+			name: "should promote due transactions emitting transaction.created on their month",
+			dependencies: func() []*entities.Transaction {
+				missed := buildScheduledTransaction(userID, categoryID, time.Date(2026, 2, 27, 0, 0, 0, 0, time.UTC))
+				due := buildScheduledTransaction(userID, categoryID, today)
+				s.repo.EXPECT().ListDueScheduled(mock.Anything, today, (*transactionInterfaces.ScheduledCursor)(nil), promoteScheduledPageSize).
+					Return([]*entities.Transaction{missed, due}, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, missed).Return(true, nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, due).Return(true, nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.created",
+					mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+						return payload["reference_month"] == "2026-02"
+					})).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.created",
+					mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+						return payload["reference_month"] == "2026-03"
+					})).Return(nil).Once()
+				return []*entities.Transaction{missed, due}
+			},
+			expect: func(transactions []*entities.Transaction, count int, err error) {
+				s.NoError(err)
+				s.Equal(2, count)
+				s.True(transactions[0].Status.IsActive())
+				s.Equal("2026-02-27", transactions[0].TransactionDate.Format("2006-01-02"))
+			},
+		},
+		{
+			name: "should skip a transaction the user resolved in the meantime",
+			dependencies: func() []*entities.Transaction {
+				due := buildScheduledTransaction(userID, categoryID, today)
+				s.repo.EXPECT().ListDueScheduled(mock.Anything, today, (*transactionInterfaces.ScheduledCursor)(nil), promoteScheduledPageSize).
+					Return([]*entities.Transaction{due}, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, due).Return(false, nil).Once()
+				return []*entities.Transaction{due}
+			},
+			expect: func(transactions []*entities.Transaction, count int, err error) {
+				s.NoError(err)
+				s.Equal(0, count)
+			},
+		},
+		{
+			name: "should keep promoting the others when one fails",
+			dependencies: func() []*entities.Transaction {
+				failing := buildScheduledTransaction(userID, categoryID, today)
+				due := buildScheduledTransaction(userID, categoryID, today)
+				s.repo.EXPECT().ListDueScheduled(mock.Anything, today, (*transactionInterfaces.ScheduledCursor)(nil), promoteScheduledPageSize).
+					Return([]*entities.Transaction{failing, due}, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, failing).Return(false, errors.New("db error")).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, due).Return(true, nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.created", mock.Anything).
+					Return(nil).Once()
+				return []*entities.Transaction{failing, due}
+			},
+			expect: func(transactions []*entities.Transaction, count int, err error) {
+				s.Error(err)
+				s.Equal(1, count)
+			},
+		},
+		{
+			name: "should keep paging past a full page of transactions that failed",
+			dependencies: func() []*entities.Transaction {
+				firstPage := make([]*entities.Transaction, promoteScheduledPageSize)
+				for i := range firstPage {
+					firstPage[i] = buildScheduledTransaction(userID, categoryID, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+				}
+				last := firstPage[len(firstPage)-1]
+				due := buildScheduledTransaction(userID, categoryID, today)
+
+				s.repo.EXPECT().ListDueScheduled(mock.Anything, today, (*transactionInterfaces.ScheduledCursor)(nil), promoteScheduledPageSize).
+					Return(firstPage, nil).Once()
+				s.repo.EXPECT().ListDueScheduled(mock.Anything, today, mock.MatchedBy(func(after *transactionInterfaces.ScheduledCursor) bool {
+					return after != nil && after.ID == last.ID && after.TransactionDate.Equal(last.TransactionDate)
+				}), promoteScheduledPageSize).
+					Return([]*entities.Transaction{due}, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, mock.MatchedBy(func(t *entities.Transaction) bool {
+					return t.ID != due.ID
+				})).Return(false, errors.New("db error")).Times(promoteScheduledPageSize)
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, due).Return(true, nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.created", mock.Anything).
+					Return(nil).Once()
+				return append(firstPage, due)
+			},
+			expect: func(transactions []*entities.Transaction, count int, err error) {
+				s.Error(err)
+				s.Equal(1, count)
+			},
+		},
+		{
+			name: "should return error when due transactions cannot be listed",
+			dependencies: func() []*entities.Transaction {
+				s.repo.EXPECT().ListDueScheduled(mock.Anything, today, (*transactionInterfaces.ScheduledCursor)(nil), promoteScheduledPageSize).
+					Return(nil, errors.New("db error")).Once()
+				return nil
+			},
+			expect: func(transactions []*entities.Transaction, count int, err error) {
+				s.Error(err)
+				s.Equal(0, count)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			transactions := scenario.dependencies()
+			uc := NewPromoteScheduledTransactionsUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.outboxService)
+			count, err := uc.Execute(s.ctx, now)
+			scenario.expect(transactions, count, err)
+		})
+	}
+}
@@ -0,0 +1,262 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	invoiceFactories "github.com/jailtonjunior94/financial/internal/invoice/domain/factories"
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+// maxInvoiceItemDescriptionLength is the size of invoice_items.description.
+const maxInvoiceItemDescriptionLength = 255
+
+type (
+	RefundTransactionUseCase interface {
+		Execute(ctx context.Context, userID, transactionID string, input *dtos.RefundInput) (*dtos.RefundOutput, error)
+	}
+
+	refundTransactionUseCase struct {
+		o11y             observability.Observability
+		uow              uow.UnitOfWork
+		repository       transactionInterfaces.TransactionRepository
+		refundRepository transactionInterfaces.RefundRepository
+		invoiceProvider  transactionInterfaces.InvoiceProvider
+		cardProvider     invoiceInterfaces.CardProvider
+		outboxService    outbox.Service
+	}
+)
+
+// NewRefundTransactionUseCase creates a new RefundTransactionUseCase.
+func NewRefundTransactionUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	refundRepository transactionInterfaces.RefundRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	cardProvider invoiceInterfaces.CardProvider,
+	outboxService outbox.Service,
+) RefundTransactionUseCase {
+	return &refundTransactionUseCase{
+		o11y:             o11y,
+		uow:              unitOfWork,
+		repository:       repository,
+		refundRepository: refundRepository,
+		invoiceProvider:  invoiceProvider,
+		cardProvider:     cardProvider,
+		outboxService:    outboxService,
+	}
+}
+
+// Execute refunds a billed card purchase. The credit goes to the invoice of the card that
+// covers the refund date, which must still be open, while the event carries the month of
+// the invoice that billed the purchase so budgets are reduced where it was counted.
+func (u *refundTransactionUseCase) Execute(ctx context.Context, userID, transactionID string, input *dtos.RefundInput) (*dtos.RefundOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "refund_transaction_usecase.execute")
+	defer span.End()
+
+	refundDate, err := input.Validate(time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := vos.NewUUIDFromString(transactionID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid transaction_id: %w", err)
+	}
+
+	transaction, err := u.repository.FindByID(ctx, txID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, transactionDomain.ErrTransactionNotFound
+	}
+	if transaction.UserID.String() != userID {
+		return nil, transactionDomain.ErrTransactionNotOwned
+	}
+
+	var billedOn *transactionInterfaces.InvoiceInfo
+	status := ""
+	if transaction.InvoiceID != nil {
+		billedOn, err = u.invoiceProvider.FindByID(ctx, *transaction.InvoiceID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if billedOn == nil {
+			return nil, transactionDomain.ErrInvoiceNotFound
+		}
+		status = billedOn.Status
+	}
+	if err := transaction.CheckRefundable(status); err != nil {
+		return nil, err
+	}
+
+	creditOn, err := u.findCreditInvoice(ctx, transaction, refundDate)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	// The refundable amount is checked with the transaction row locked, so concurrent
+	// refunds of the same purchase never add up to more than it.
+	var refund *entities.Refund
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		if err := u.repository.LockByID(ctx, tx, transaction.ID); err != nil {
+			return err
+		}
+		previous, err := u.refundRepository.ListByTransactionInTx(ctx, tx, transaction.ID)
+		if err != nil {
+			return err
+		}
+		amount, err := u.resolveAmount(transaction, input, previous)
+		if err != nil {
+			return err
+		}
+		refund, err = entities.NewRefund(transaction, creditOn.ID, amount, input.Reason, refundDate, previous)
+		if err != nil {
+			return err
+		}
+		shares := refund.Shares(transaction)
+
+		if err := u.refundRepository.Save(ctx, tx, refund); err != nil {
+			return err
+		}
+		if err := u.invoiceProvider.AddRefundItems(ctx, tx, toRefundItems(transaction, refund, shares, billedOn)); err != nil {
+			return err
+		}
+		event := events.NewTransactionRefundedEvent(
+			refund.ID,
+			transaction.ID,
+			transaction.UserID,
+			transaction.CategoryID,
+			refund.Amount,
+			billedOn.ReferenceMonth,
+			refund.InvoiceID,
+			refund.CreatedAt,
+			toRefundSnapshots(transaction, shares),
+		)
+		aggregateID, _ := uuid.Parse(transaction.ID.String())
+		return u.outboxService.SaveDomainEvent(
+			ctx,
+			tx,
+			aggregateID,
+			"transaction",
+			event.EventType(),
+			outbox.JSONBPayload(event.Payload()),
+		)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "RefundTransaction"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return toRefundOutput(refund), nil
+}
+
+// resolveAmount returns the requested amount, or everything left to refund when the
+// request has no amount.
+func (u *refundTransactionUseCase) resolveAmount(t *entities.Transaction, input *dtos.RefundInput, previous []*entities.Refund) (vos.Money, error) {
+	if input.Amount != nil {
+		return vos.NewMoneyFromFloat(*input.Amount, t.Amount.Currency())
+	}
+	refundable, err := t.RefundableAmount(previous)
+	if err != nil {
+		return vos.Money{}, err
+	}
+	if refundable.IsZero() {
+		return vos.Money{}, fmt.Errorf("%w: the transaction was already fully refunded", transactionDomain.ErrRefundExceedsRefundable)
+	}
+	return refundable, nil
+}
+
+// findCreditInvoice returns the invoice of the card that covers refundDate, creating it
+// when needed. Credits are never posted on a closed invoice.
+func (u *refundTransactionUseCase) findCreditInvoice(ctx context.Context, t *entities.Transaction, refundDate time.Time) (*transactionInterfaces.InvoiceInfo, error) {
+	billingInfo, err := u.cardProvider.GetCardBillingInfo(ctx, t.UserID, *t.CardID)
+	if err != nil {
+		return nil, err
+	}
+	calculator, err := invoiceFactories.NewInvoiceCalculator(billingInfo.DueDay, billingInfo.ClosingOffsetDays)
+	if err != nil {
+		return nil, fmt.Errorf("invalid card billing configuration: %w", err)
+	}
+
+	month := calculator.CalculateInvoiceMonth(refundDate)
+	info, err := u.invoiceProvider.FindOrCreate(ctx, t.UserID, *t.CardID, month, calculator.CalculateDueDate(month))
+	if err != nil {
+		return nil, err
+	}
+	if info.Status != "open" {
+		return nil, fmt.Errorf("%w: the invoice of %s that would receive the refund is %s", transactionDomain.ErrInvoiceClosed, month.String(), info.Status)
+	}
+	return info, nil
+}
+
+// toRefundItems maps each share of a refund to a credit on the invoice that receives it.
+func toRefundItems(
+	t *entities.Transaction,
+	refund *entities.Refund,
+	shares []entities.RefundShare,
+	billedOn *transactionInterfaces.InvoiceInfo,
+) []transactionInterfaces.RefundItemInfo {
+	description := "Estorno: " + t.Description
+	if utf8.RuneCountInString(description) > maxInvoiceItemDescriptionLength {
+		description = string([]rune(description)[:maxInvoiceItemDescriptionLength])
+	}
+
+	items := make([]transactionInterfaces.RefundItemInfo, 0, len(shares))
+	for _, share := range shares {
+		items = append(items, transactionInterfaces.RefundItemInfo{
+			RefundedTransactionID: t.ID,
+			InvoiceID:             refund.InvoiceID,
+			CategoryID:            share.CategoryID,
+			RefundDate:            refund.RefundDate,
+			Description:           description,
+			Amount:                share.Amount,
+			BudgetMonth:           billedOn.ReferenceMonth,
+		})
+	}
+	return items
+}
+
+// toRefundSnapshots carries the shares of a split transaction in the event; a transaction
+// without splits is given back to its own category.
+func toRefundSnapshots(t *entities.Transaction, shares []entities.RefundShare) []events.SplitSnapshot {
+	if !t.IsSplit() {
+		return nil
+	}
+	snapshots := make([]events.SplitSnapshot, 0, len(shares))
+	for _, share := range shares {
+		snapshots = append(snapshots, events.SplitSnapshot{
+			CategoryID:    share.CategoryID,
+			SubcategoryID: share.SubcategoryID,
+			Amount:        share.Amount,
+		})
+	}
+	return snapshots
+}
@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type RefundTransactionUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.TransactionRepository
+	refundRepo      *transactionMocks.RefundRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+	cardProvider    *invoiceMocks.CardProvider
+	outboxService   *outboxMocks.Service
+}
+
+func TestRefundTransactionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(RefundTransactionUseCaseSuite))
+}
+
+func (s *RefundTransactionUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.refundRepo = transactionMocks.NewRefundRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.cardProvider = invoiceMocks.NewCardProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func (s *RefundTransactionUseCaseSuite) useCase() RefundTransactionUseCase {
+	return NewRefundTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.refundRepo, s.invoiceProvider, s.cardProvider, s.outboxService)
+}
+
+func (s *RefundTransactionUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	otherUserID := "550e8400-e29b-41d4-a716-446655440099"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	cardID, _ := vos.NewUUID()
+	billedInvoiceID, _ := vos.NewUUID()
+	openInvoiceID, _ := vos.NewUUID()
+	billedMonth, _ := pkgVos.NewReferenceMonth("2026-03")
+	billingInfo := &invoiceInterfaces.CardBillingInfo{CardID: cardID, DueDay: 10, ClosingOffsetDays: 7}
+
+	purchase := func() *entities.Transaction {
+		tx := buildTransaction(userID, categoryID, &billedInvoiceID)
+		tx.CardID = &cardID
+		return tx
+	}
+	billedOn := func(status string) *transactionInterfaces.InvoiceInfo {
+		return &transactionInterfaces.InvoiceInfo{ID: billedInvoiceID, Status: status, ReferenceMonth: billedMonth}
+	}
+	creditOn := func(status string) *transactionInterfaces.InvoiceInfo {
+		return &transactionInterfaces.InvoiceInfo{ID: openInvoiceID, Status: status}
+	}
+	amount := func(value float64) *float64 { return &value }
+
+	s.Run("should post a partial refund on the open invoice of the card", func() {
+		tx := purchase()
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, billedInvoiceID).Return(billedOn("paid"), nil).Once()
+		s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, tx.UserID, cardID).Return(billingInfo, nil).Once()
+		s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, tx.UserID, cardID, mock.MatchedBy(func(month pkgVos.ReferenceMonth) bool {
+			return month.String() == "2026-05"
+		}), mock.Anything).Return(creditOn("open"), nil).Once()
+		s.repo.EXPECT().LockByID(mock.Anything, mock.Anything, tx.ID).Return(nil).Once()
+		s.refundRepo.EXPECT().ListByTransactionInTx(mock.Anything, mock.Anything, tx.ID).Return(nil, nil).Once()
+		s.refundRepo.EXPECT().Save(mock.Anything, mock.Anything, mock.MatchedBy(func(r *entities.Refund) bool {
+			return r.TransactionID == tx.ID && r.InvoiceID == openInvoiceID && r.Amount.Cents() == 4000
+		})).Return(nil).Once()
+		s.invoiceProvider.EXPECT().AddRefundItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.RefundItemInfo) bool {
+			return len(items) == 1 &&
+				items[0].InvoiceID == openInvoiceID &&
+				items[0].RefundedTransactionID == tx.ID &&
+				items[0].Amount.Cents() == 4000 &&
+				items[0].BudgetMonth.Equal(billedMonth) &&
+				items[0].Description == "Estorno: Original"
+		})).Return(nil).Once()
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.refunded", mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+			return payload["reference_month"] == "2026-03"
+		})).Return(nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, tx.ID.String(), &dtos.RefundInput{
+			Amount:     amount(40),
+			Reason:     "defective item",
+			RefundDate: "2026-04-20",
+		})
+
+		s.NoError(err)
+		s.Equal(40.0, output.Amount)
+		s.Equal(openInvoiceID.String(), output.InvoiceID)
+		s.Equal("2026-04-20", output.RefundDate)
+	})
+
+	s.Run("should refund what is left when no amount is given", func() {
+		tx := purchase()
+		previous, _ := entities.NewRefund(tx, openInvoiceID, *mustMoney(30), "", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), nil)
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, billedInvoiceID).Return(billedOn("closed"), nil).Once()
+		s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, tx.UserID, cardID).Return(billingInfo, nil).Once()
+		s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, tx.UserID, cardID, mock.Anything, mock.Anything).Return(creditOn("open"), nil).Once()
+		s.repo.EXPECT().LockByID(mock.Anything, mock.Anything, tx.ID).Return(nil).Once()
+		s.refundRepo.EXPECT().ListByTransactionInTx(mock.Anything, mock.Anything, tx.ID).Return([]*entities.Refund{previous}, nil).Once()
+		s.refundRepo.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.invoiceProvider.EXPECT().AddRefundItems(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.refunded", mock.Anything).Return(nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, tx.ID.String(), &dtos.RefundInput{RefundDate: "2026-04-20"})
+
+		s.NoError(err)
+		s.Equal(70.0, output.Amount)
+	})
+
+	s.Run("should split the credit among the categories of a split purchase", func() {
+		tx := purchase()
+		home, _ := vos.NewUUID()
+		first, _ := entities.NewTransactionSplit(tx.CategoryID, nil, *mustMoney(60))
+		second, _ := entities.NewTransactionSplit(home, nil, *mustMoney(40))
+		s.Require().NoError(tx.SetSplits([]*entities.TransactionSplit{first, second}))
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, billedInvoiceID).Return(billedOn("closed"), nil).Once()
+		s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, tx.UserID, cardID).Return(billingInfo, nil).Once()
+		s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, tx.UserID, cardID, mock.Anything, mock.Anything).Return(creditOn("open"), nil).Once()
+		s.repo.EXPECT().LockByID(mock.Anything, mock.Anything, tx.ID).Return(nil).Once()
+		s.refundRepo.EXPECT().ListByTransactionInTx(mock.Anything, mock.Anything, tx.ID).Return(nil, nil).Once()
+		s.refundRepo.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.invoiceProvider.EXPECT().AddRefundItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.RefundItemInfo) bool {
+			return len(items) == 2 && items[0].Amount.Cents() == 3000 && items[1].Amount.Cents() == 2000 && items[1].CategoryID == home
+		})).Return(nil).Once()
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.refunded", mock.Anything).Return(nil).Once()
+
+		_, err := s.useCase().Execute(s.ctx, userID, tx.ID.String(), &dtos.RefundInput{Amount: amount(50), RefundDate: "2026-04-20"})
+
+		s.NoError(err)
+	})
+
+	s.Run("should not refund a purchase on an open invoice", func() {
+		tx := purchase()
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, billedInvoiceID).Return(billedOn("open"), nil).Once()
+
+		_, err := s.useCase().Execute(s.ctx, userID, tx.ID.String(), &dtos.RefundInput{Amount: amount(40)})
+
+		s.ErrorIs(err, transactionDomain.ErrRefundNotAllowed)
+	})
+
+	s.Run("should not refund a transaction paid without a card", func() {
+		tx := buildTransaction(userID, categoryID, nil)
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+
+		_, err := s.useCase().Execute(s.ctx, userID, tx.ID.String(), &dtos.RefundInput{Amount: amount(40)})
+
+		s.ErrorIs(err, transactionDomain.ErrRefundNotAllowed)
+	})
+
+	s.Run("should not refund more than what is left", func() {
+		tx := purchase()
+		previous, _ := entities.NewRefund(tx, openInvoiceID, *mustMoney(80), "", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC), nil)
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, billedInvoiceID).Return(billedOn("paid"), nil).Once()
+		s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, tx.UserID, cardID).Return(billingInfo, nil).Once()
+		s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, tx.UserID, cardID, mock.Anything, mock.Anything).Return(creditOn("open"), nil).Once()
+		s.repo.EXPECT().LockByID(mock.Anything, mock.Anything, tx.ID).Return(nil).Once()
+		s.refundRepo.EXPECT().ListByTransactionInTx(mock.Anything, mock.Anything, tx.ID).Return([]*entities.Refund{previous}, nil).Once()
+
+		_, err := s.useCase().Execute(s.ctx, userID, tx.ID.String(), &dtos.RefundInput{Amount: amount(40), RefundDate: "2026-04-20"})
+
+		s.ErrorIs(err, transactionDomain.ErrRefundExceedsRefundable)
+	})
+
+	s.Run("should count refunds committed concurrently once the transaction is locked", func() {
+		tx := purchase()
+		concurrent, _ := entities.NewRefund(tx, openInvoiceID, *mustMoney(70), "", time.Date(2026, 4, 19, 0, 0, 0, 0, time.UTC), nil)
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, billedInvoiceID).Return(billedOn("paid"), nil).Once()
+		s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, tx.UserID, cardID).Return(billingInfo, nil).Once()
+		s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, tx.UserID, cardID, mock.Anything, mock.Anything).Return(creditOn("open"), nil).Once()
+		lock := s.repo.EXPECT().LockByID(mock.Anything, mock.Anything, tx.ID).Return(nil).Once()
+		s.refundRepo.EXPECT().ListByTransactionInTx(mock.Anything, mock.Anything, tx.ID).
+			Return([]*entities.Refund{concurrent}, nil).Once().NotBefore(lock)
+
+		_, err := s.useCase().Execute(s.ctx, userID, tx.ID.String(), &dtos.RefundInput{Amount: amount(40), RefundDate: "2026-04-20"})
+
+		s.ErrorIs(err, transactionDomain.ErrRefundExceedsRefundable)
+	})
+
+	s.Run("should not post the credit on a closed invoice", func() {
+		tx := purchase()
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, billedInvoiceID).Return(billedOn("paid"), nil).Once()
+		s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, tx.UserID, cardID).Return(billingInfo, nil).Once()
+		s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, tx.UserID, cardID, mock.Anything, mock.Anything).Return(creditOn("closed"), nil).Once()
+
+		_, err := s.useCase().Execute(s.ctx, userID, tx.ID.String(), &dtos.RefundInput{Amount: amount(40), RefundDate: "2026-04-20"})
+
+		s.ErrorIs(err, transactionDomain.ErrInvoiceClosed)
+	})
+
+	s.Run("should return error when the transaction belongs to another user", func() {
+		tx := purchase()
+		s.repo.EXPECT().FindByID(mock.Anything, tx.ID).Return(tx, nil).Once()
+
+		_, err := s.useCase().Execute(s.ctx, otherUserID, tx.ID.String(), &dtos.RefundInput{Amount: amount(40)})
+
+		s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+	})
+
+	s.Run("should reject an invalid request before loading the transaction", func() {
+		_, err := s.useCase().Execute(s.ctx, userID, billedInvoiceID.String(), &dtos.RefundInput{Amount: amount(-1)})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidRefund)
+	})
+}
@@ -8,11 +8,14 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
 
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
 	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
 	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
 )
 
 type (
@@ -21,10 +24,12 @@ type (
 	}
 
 	reverseTransactionUseCase struct {
-		o11y            observability.Observability
-		uow             uow.UnitOfWork
-		repository      transactionInterfaces.TransactionRepository
-		invoiceProvider transactionInterfaces.InvoiceProvider
+		o11y               observability.Observability
+		uow                uow.UnitOfWork
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+		invoiceProvider    transactionInterfaces.InvoiceProvider
+		outboxService      outbox.Service
 	}
 )
 
@@ -33,13 +38,17 @@ func NewReverseTransactionUseCase(
 	o11y observability.Observability,
 	unitOfWork uow.UnitOfWork,
 	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
 	invoiceProvider transactionInterfaces.InvoiceProvider,
+	outboxService outbox.Service,
 ) ReverseTransactionUseCase {
 	return &reverseTransactionUseCase{
-		o11y:            o11y,
-		uow:             unitOfWork,
-		repository:      repository,
-		invoiceProvider: invoiceProvider,
+		o11y:               o11y,
+		uow:                unitOfWork,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		invoiceProvider:    invoiceProvider,
+		outboxService:      outboxService,
 	}
 }
 
@@ -75,10 +84,16 @@ func (u *reverseTransactionUseCase) Execute(ctx context.Context, userID, transac
 		}
 	}
 
+	before := statesOf(scope...)
 	cancelled := make([]*entities.Transaction, 0)
 	kept := make([]*entities.Transaction, 0)
 
 	for _, t := range scope {
+		// Installments already cancelled (e.g. replaced by a payoff) were reversed before;
+		// cancelling them again would emit a second reversal.
+		if !t.Status.IsActive() {
+			continue
+		}
 		if t.InvoiceID == nil {
 			if err := t.Cancel(); err != nil {
 				span.RecordError(err)
@@ -108,7 +123,49 @@ func (u *reverseTransactionUseCase) Execute(ctx context.Context, userID, transac
 	}
 
 	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
-		return u.repository.UpdateAll(ctx, tx, cancelled)
+		if err := u.repository.UpdateAll(ctx, tx, cancelled); err != nil {
+			return err
+		}
+		if err := saveRevisions(ctx, tx, u.revisionRepository, &transaction.UserID, before, cancelled...); err != nil {
+			return err
+		}
+		billed := make([]vos.UUID, 0, len(cancelled))
+		for _, t := range cancelled {
+			if t.InvoiceID != nil {
+				billed = append(billed, t.ID)
+			}
+		}
+		if len(billed) > 0 {
+			if err := u.invoiceProvider.RemoveItems(ctx, tx, billed); err != nil {
+				return err
+			}
+		}
+		for _, t := range cancelled {
+			event := events.NewTransactionReversedEvent(
+				t.ID,
+				t.UserID,
+				t.CategoryID,
+				t.Amount,
+				resolveReferenceMonth(t, t.TransactionDate),
+				t.InvoiceID,
+				t.InstallmentNumber,
+				t.InstallmentGroupID,
+				*t.UpdatedAt,
+				toSplitSnapshots(t),
+			)
+			aggregateID, _ := uuid.Parse(t.ID.String())
+			if err := u.outboxService.SaveDomainEvent(
+				ctx,
+				tx,
+				aggregateID,
+				"transaction",
+				event.EventType(),
+				outbox.JSONBPayload(event.Payload()),
+			); err != nil {
+				return err
+			}
+		}
+		return nil
 	})
 	if err != nil {
 		span.RecordError(err)
@@ -15,6 +15,7 @@ import (
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
 	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
 )
 
 type ReverseTransactionUseCaseSuite struct {
@@ -22,7 +23,9 @@ type ReverseTransactionUseCaseSuite struct {
 	ctx             context.Context
 	obs             *fake.Provider
 	repo            *transactionMocks.TransactionRepository
+	revisionRepo    *transactionMocks.TransactionRevisionRepository
 	invoiceProvider *transactionMocks.InvoiceProvider
+	outboxService   *outboxMocks.Service
 }
 
 func TestReverseTransactionUseCaseSuite(t *testing.T) {
@@ -33,7 +36,9 @@ func (s *ReverseTransactionUseCaseSuite) SetupTest() {
 	s.obs = fake.NewProvider()
 	s.ctx = context.Background()
 	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
 	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
 }
 
 func makeTransaction(userID string, invoiceID *vos.UUID, groupID *vos.UUID) *entities.Transaction {
@@ -89,6 +94,9 @@ func (s *ReverseTransactionUseCaseSuite) TestExecute() {
 				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
 				s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invoiceID).Return("open", nil).Once()
 				s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().RemoveItems(mock.Anything, mock.Anything, []vos.UUID{txID}).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.reversed", mock.Anything).Return(nil).Once()
 			},
 			expect: func(output *dtos.ReverseOutput, err error) {
 				s.NoError(err)
@@ -123,6 +131,11 @@ func (s *ReverseTransactionUseCaseSuite) TestExecute() {
 					s.invoiceProvider.EXPECT().GetStatus(mock.Anything, inv).Return(status, nil).Once()
 				}
 				s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().RemoveItems(mock.Anything, mock.Anything, mock.MatchedBy(func(ids []vos.UUID) bool {
+					return len(ids) == 7
+				})).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.reversed", mock.Anything).Return(nil).Times(7)
 			},
 			expect: func(output *dtos.ReverseOutput, err error) {
 				s.NoError(err)
@@ -131,6 +144,46 @@ func (s *ReverseTransactionUseCaseSuite) TestExecute() {
 				s.Len(output.Kept, 3)
 			},
 		},
+		{
+			name: "should reverse only the payoff transaction after a group was paid off",
+			args: args{userID: userID, transactionID: "660e8400-e29b-41d4-a716-446655440005"},
+			dependencies: func(txIDStr string) {
+				txID, _ := vos.NewUUIDFromString(txIDStr)
+				groupID, _ := vos.NewUUID()
+				payoff := makeTransaction(userID, &invoiceID, &groupID)
+				payoff.ID = txID
+				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(payoff, nil).Once()
+
+				group := make([]*entities.Transaction, 0, 6)
+				for i := 0; i < 5; i++ {
+					invID, _ := vos.NewUUID()
+					installment := makeTransaction(userID, &invID, &groupID)
+					if i < 2 {
+						s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invID).Return("closed", nil).Once()
+					} else {
+						// paid off: cancelled when the payoff was registered
+						s.Require().NoError(installment.Cancel())
+					}
+					group = append(group, installment)
+				}
+				group = append(group, payoff)
+				s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+
+				s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invoiceID).Return("open", nil).Once()
+				s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.MatchedBy(func(txs []*entities.Transaction) bool {
+					return len(txs) == 1 && txs[0].ID == txID
+				})).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().RemoveItems(mock.Anything, mock.Anything, []vos.UUID{txID}).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.reversed", mock.Anything).Return(nil).Once()
+			},
+			expect: func(output *dtos.ReverseOutput, err error) {
+				s.NoError(err)
+				s.NotNil(output)
+				s.Len(output.Cancelled, 1)
+				s.Len(output.Kept, 2)
+			},
+		},
 		{
 			name: "should return error when all invoices are closed",
 			args: args{userID: userID, transactionID: "660e8400-e29b-41d4-a716-446655440002"},
@@ -196,7 +249,9 @@ func (s *ReverseTransactionUseCaseSuite) TestExecute() {
 				s.obs,
 				&mockUnitOfWork{},
 				s.repo,
+				s.revisionRepo,
 				s.invoiceProvider,
+				s.outboxService,
 			)
 			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.transactionID)
 			scenario.expect(output, err)
@@ -0,0 +1,96 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+type (
+	SaveExchangeRateUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.ExchangeRateInput) (*dtos.ExchangeRateOutput, error)
+	}
+
+	saveExchangeRateUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.ExchangeRateRepository
+	}
+)
+
+// NewSaveExchangeRateUseCase creates a new SaveExchangeRateUseCase.
+func NewSaveExchangeRateUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.ExchangeRateRepository,
+) SaveExchangeRateUseCase {
+	return &saveExchangeRateUseCase{o11y: o11y, uow: unitOfWork, repository: repository}
+}
+
+// Execute saves a rate entered by hand, replacing the rate of the currency on the same date.
+// Transactions already converted keep the rate they were converted with.
+func (u *saveExchangeRateUseCase) Execute(ctx context.Context, userID string, input *dtos.ExchangeRateInput) (*dtos.ExchangeRateOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "save_exchange_rate_usecase.execute")
+	defer span.End()
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+
+	rate, err := newExchangeRate(userUUID, *input, entities.ExchangeRateSourceManual)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Upsert(ctx, tx, []*entities.ExchangeRate{rate})
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "SaveExchangeRate"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "exchange_rate"),
+		observability.String("user_id", userID),
+	)
+
+	return toExchangeRateOutput(rate), nil
+}
+
+// newExchangeRate validates the input and builds the rate of its currency into the base currency.
+func newExchangeRate(userID vos.UUID, input dtos.ExchangeRateInput, source string) (*entities.ExchangeRate, error) {
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+	currency, err := vos.NewCurrency(strings.TrimSpace(input.Currency))
+	if err != nil {
+		return nil, err
+	}
+	rate, err := transactionVos.NewExchangeRate(input.Rate)
+	if err != nil {
+		return nil, err
+	}
+	rateDate, err := time.Parse("2006-01-02", input.RateDate)
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewExchangeRate(userID, currency, factories.BaseCurrency, rate, rateDate, source)
+}
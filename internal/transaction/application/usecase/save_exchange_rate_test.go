@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type SaveExchangeRateUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.ExchangeRateRepository
+}
+
+func TestSaveExchangeRateUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(SaveExchangeRateUseCaseSuite))
+}
+
+func (s *SaveExchangeRateUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewExchangeRateRepository(s.T())
+}
+
+func (s *SaveExchangeRateUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+
+	scenarios := []struct {
+		name         string
+		input        *dtos.ExchangeRateInput
+		dependencies func()
+		expect       func(output *dtos.ExchangeRateOutput, err error)
+	}{
+		{
+			name:  "should save a manual rate into the base currency",
+			input: &dtos.ExchangeRateInput{Currency: "usd", Rate: 5.4321, RateDate: "2026-03-10"},
+			dependencies: func() {
+				s.repo.EXPECT().Upsert(mock.Anything, mock.Anything, mock.MatchedBy(func(rates []*entities.ExchangeRate) bool {
+					return len(rates) == 1 &&
+						rates[0].UserID.String() == userID &&
+						rates[0].Currency.String() == "USD" &&
+						rates[0].BaseCurrency.String() == "BRL" &&
+						rates[0].Source == entities.ExchangeRateSourceManual
+				})).Return(nil).Once()
+			},
+			expect: func(output *dtos.ExchangeRateOutput, err error) {
+				s.NoError(err)
+				s.Equal("USD", output.Currency)
+				s.Equal("BRL", output.BaseCurrency)
+				s.Equal(5.4321, output.Rate)
+				s.Equal("2026-03-10", output.RateDate)
+				s.Equal(entities.ExchangeRateSourceManual, output.Source)
+			},
+		},
+		{
+			name:         "should reject a non-positive rate",
+			input:        &dtos.ExchangeRateInput{Currency: "USD", Rate: 0, RateDate: "2026-03-10"},
+			dependencies: func() {},
+			expect: func(output *dtos.ExchangeRateOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidExchangeRate)
+				s.Nil(output)
+			},
+		},
+		{
+			name:         "should reject a rate of the base currency",
+			input:        &dtos.ExchangeRateInput{Currency: "BRL", Rate: 1, RateDate: "2026-03-10"},
+			dependencies: func() {},
+			expect: func(output *dtos.ExchangeRateOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrInvalidExchangeRate)
+				s.Nil(output)
+			},
+		},
+		{
+			name:  "should return repository error",
+			input: &dtos.ExchangeRateInput{Currency: "EUR", Rate: 6.1, RateDate: "2026-03-10"},
+			dependencies: func() {
+				s.repo.EXPECT().Upsert(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("db error")).Once()
+			},
+			expect: func(output *dtos.ExchangeRateOutput, err error) {
+				s.Error(err)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			output, err := NewSaveExchangeRateUseCase(s.obs, &mockUnitOfWork{}, s.repo).Execute(s.ctx, userID, scenario.input)
+			scenario.expect(output, err)
+		})
+	}
+}
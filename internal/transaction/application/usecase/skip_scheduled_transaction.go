@@ -0,0 +1,88 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+type (
+	SkipScheduledTransactionUseCase interface {
+		Execute(ctx context.Context, userID, transactionID string) (*dtos.TransactionOutput, error)
+	}
+
+	skipScheduledTransactionUseCase struct {
+		o11y               observability.Observability
+		uow                uow.UnitOfWork
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+		outboxService      outbox.Service
+	}
+)
+
+// NewSkipScheduledTransactionUseCase creates a new SkipScheduledTransactionUseCase.
+func NewSkipScheduledTransactionUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	outboxService outbox.Service,
+) SkipScheduledTransactionUseCase {
+	return &skipScheduledTransactionUseCase{
+		o11y:               o11y,
+		uow:                unitOfWork,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		outboxService:      outboxService,
+	}
+}
+
+// Execute cancels a scheduled transaction that will not happen. It never counted in
+// budgets, so no event is emitted.
+func (u *skipScheduledTransactionUseCase) Execute(ctx context.Context, userID, transactionID string) (*dtos.TransactionOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "skip_scheduled_transaction_usecase.execute")
+	defer span.End()
+
+	transaction, err := findOwnedTransaction(ctx, u.repository, userID, transactionID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	before := statesOf(transaction)
+	if err := transaction.Skip(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		resolved, err := resolveScheduled(ctx, tx, u.repository, u.revisionRepository, u.outboxService, transaction, before, &transaction.UserID)
+		if err != nil {
+			return err
+		}
+		if !resolved {
+			return transactionDomain.ErrTransactionNotScheduled
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "SkipScheduledTransaction"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return toOutput(transaction), nil
+}
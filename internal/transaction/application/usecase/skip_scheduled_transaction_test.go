@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type SkipScheduledTransactionUseCaseSuite struct {
+	suite.Suite
+	ctx           context.Context
+	obs           *fake.Provider
+	repo          *transactionMocks.TransactionRepository
+	revisionRepo  *transactionMocks.TransactionRevisionRepository
+	outboxService *outboxMocks.Service
+}
+
+func TestSkipScheduledTransactionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(SkipScheduledTransactionUseCaseSuite))
+}
+
+func (s *SkipScheduledTransactionUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func (s *SkipScheduledTransactionUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	nextWeek := time.Now().UTC().Truncate(24*time.Hour).AddDate(0, 0, 7)
+
+	scenarios := []struct {
+		name         string
+		dependencies func() *entities.Transaction
+		expect       func(output *dtos.TransactionOutput, err error)
+	}{
+		{
+			name: "should cancel the occurrence without emitting events",
+			dependencies: func() *entities.Transaction {
+				t := buildScheduledTransaction(userID, categoryID, nextWeek)
+				s.repo.EXPECT().FindByID(mock.Anything, t.ID).Return(t, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, t).Return(true, nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				return t
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.NoError(err)
+				s.Equal("cancelled", output.Status)
+				s.Equal(nextWeek.Format("2006-01-02"), output.TransactionDate)
+			},
+		},
+		{
+			name: "should reject a transaction that is not scheduled",
+			dependencies: func() *entities.Transaction {
+				t := buildTransaction(userID, categoryID, nil)
+				s.repo.EXPECT().FindByID(mock.Anything, t.ID).Return(t, nil).Once()
+				return t
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrTransactionNotScheduled)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should reject a transaction promoted concurrently",
+			dependencies: func() *entities.Transaction {
+				t := buildScheduledTransaction(userID, categoryID, nextWeek)
+				s.repo.EXPECT().FindByID(mock.Anything, t.ID).Return(t, nil).Once()
+				s.repo.EXPECT().ResolveScheduled(mock.Anything, mock.Anything, t).Return(false, nil).Once()
+				return t
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrTransactionNotScheduled)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			t := scenario.dependencies()
+			uc := NewSkipScheduledTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.outboxService)
+			output, err := uc.Execute(s.ctx, userID, t.ID.String())
+			scenario.expect(output, err)
+		})
+	}
+}
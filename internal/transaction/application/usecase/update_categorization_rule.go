@@ -0,0 +1,108 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	UpdateCategorizationRuleUseCase interface {
+		Execute(ctx context.Context, userID, ruleID string, input *dtos.CategorizationRuleInput) (*dtos.CategorizationRuleOutput, error)
+	}
+
+	updateCategorizationRuleUseCase struct {
+		o11y          observability.Observability
+		uow           uow.UnitOfWork
+		repository    transactionInterfaces.CategorizationRuleRepository
+		tagRepository transactionInterfaces.TagRepository
+	}
+)
+
+// NewUpdateCategorizationRuleUseCase creates a new UpdateCategorizationRuleUseCase.
+func NewUpdateCategorizationRuleUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.CategorizationRuleRepository,
+	tagRepository transactionInterfaces.TagRepository,
+) UpdateCategorizationRuleUseCase {
+	return &updateCategorizationRuleUseCase{o11y: o11y, uow: unitOfWork, repository: repository, tagRepository: tagRepository}
+}
+
+// Execute replaces the definition of the rule. Transactions already categorized by it
+// keep their category until the rules are applied again.
+func (u *updateCategorizationRuleUseCase) Execute(ctx context.Context, userID, ruleID string, input *dtos.CategorizationRuleInput) (*dtos.CategorizationRuleOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "update_categorization_rule_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	rule, err := findOwnedCategorizationRule(ctx, u.repository, userID, ruleID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	conditions, outcome, err := toCategorizationRuleDefinition(ctx, u.tagRepository, rule.UserID, input)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := rule.Update(input.Name, input.Priority, input.IsEnabled(), conditions, outcome); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Update(ctx, tx, rule)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "UpdateCategorizationRule"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "categorization_rule"),
+		observability.String("user_id", userID),
+	)
+
+	return toCategorizationRuleOutput(rule), nil
+}
+
+// findOwnedCategorizationRule loads a rule and checks that it belongs to the user.
+func findOwnedCategorizationRule(
+	ctx context.Context,
+	repository transactionInterfaces.CategorizationRuleRepository,
+	userID, ruleID string,
+) (*entities.CategorizationRule, error) {
+	id, err := vos.NewUUIDFromString(ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid rule_id: %w", err)
+	}
+
+	rule, err := repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rule == nil {
+		return nil, transactionDomain.ErrCategorizationRuleNotFound
+	}
+	if rule.UserID.String() != userID {
+		return nil, transactionDomain.ErrCategorizationRuleNotOwned
+	}
+	return rule, nil
+}
@@ -0,0 +1,112 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type UpdateCategorizationRuleUseCaseSuite struct {
+	suite.Suite
+	ctx     context.Context
+	obs     *fake.Provider
+	repo    *transactionMocks.CategorizationRuleRepository
+	tagRepo *transactionMocks.TagRepository
+}
+
+func TestUpdateCategorizationRuleUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UpdateCategorizationRuleUseCaseSuite))
+}
+
+func (s *UpdateCategorizationRuleUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewCategorizationRuleRepository(s.T())
+	s.tagRepo = transactionMocks.NewTagRepository(s.T())
+}
+
+func (s *UpdateCategorizationRuleUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	disabled := false
+	input := &dtos.CategorizationRuleInput{
+		Name:          "Card",
+		Priority:      5,
+		Enabled:       &disabled,
+		PaymentMethod: "credit",
+		CategoryID:    "550e8400-e29b-41d4-a716-446655440003",
+	}
+
+	s.Run("should replace the definition of the rule", func() {
+		rule := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 0,
+			entities.CategorizationRuleConditions{DescriptionContains: "uber"})
+		s.repo.EXPECT().FindByID(mock.Anything, rule.ID).Return(rule, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.MatchedBy(func(r *entities.CategorizationRule) bool {
+			return !r.Enabled && r.Conditions.DescriptionContains == "" && r.Conditions.PaymentMethod == "credit"
+		})).Return(nil).Once()
+
+		output, err := NewUpdateCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, rule.ID.String(), input)
+
+		s.NoError(err)
+		s.Equal("Card", output.Name)
+		s.Equal(5, output.Priority)
+		s.False(output.Enabled)
+		s.Equal("550e8400-e29b-41d4-a716-446655440003", output.CategoryID)
+		s.NotNil(output.UpdatedAt)
+	})
+
+	s.Run("should return not found for an unknown rule", func() {
+		rule := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 0,
+			entities.CategorizationRuleConditions{DescriptionContains: "uber"})
+		s.repo.EXPECT().FindByID(mock.Anything, rule.ID).Return(nil, nil).Once()
+
+		output, err := NewUpdateCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, rule.ID.String(), input)
+
+		s.ErrorIs(err, transactionDomain.ErrCategorizationRuleNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should reject a rule of another user", func() {
+		rule := buildCategorizationRule("550e8400-e29b-41d4-a716-446655440099", "550e8400-e29b-41d4-a716-446655440002", 0,
+			entities.CategorizationRuleConditions{DescriptionContains: "uber"})
+		s.repo.EXPECT().FindByID(mock.Anything, rule.ID).Return(rule, nil).Once()
+
+		output, err := NewUpdateCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, rule.ID.String(), input)
+
+		s.ErrorIs(err, transactionDomain.ErrCategorizationRuleNotOwned)
+		s.Nil(output)
+	})
+
+	s.Run("should reject an invalid regex", func() {
+		rule := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 0,
+			entities.CategorizationRuleConditions{DescriptionContains: "uber"})
+		s.repo.EXPECT().FindByID(mock.Anything, rule.ID).Return(rule, nil).Once()
+
+		output, err := NewUpdateCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, rule.ID.String(),
+			&dtos.CategorizationRuleInput{Name: "Broken", DescriptionRegex: "uber(", CategoryID: input.CategoryID})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidCategorizationRule)
+		s.Nil(output)
+		s.Equal("uber", rule.Conditions.DescriptionContains)
+	})
+
+	s.Run("should return repository error", func() {
+		rule := buildCategorizationRule(userID, "550e8400-e29b-41d4-a716-446655440002", 0,
+			entities.CategorizationRuleConditions{DescriptionContains: "uber"})
+		s.repo.EXPECT().FindByID(mock.Anything, rule.ID).Return(rule, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, rule).Return(errors.New("db error")).Once()
+
+		output, err := NewUpdateCategorizationRuleUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.tagRepo).Execute(s.ctx, userID, rule.ID.String(), input)
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
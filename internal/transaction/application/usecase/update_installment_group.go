@@ -0,0 +1,334 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	invoiceFactories "github.com/jailtonjunior94/financial/internal/invoice/domain/factories"
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+)
+
+type (
+	UpdateInstallmentGroupUseCase interface {
+		Execute(ctx context.Context, userID, installmentGroupID string, input *dtos.InstallmentGroupUpdateInput) (*dtos.InstallmentGroupOutput, error)
+	}
+
+	updateInstallmentGroupUseCase struct {
+		o11y               observability.Observability
+		uow                uow.UnitOfWork
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+		invoiceProvider    transactionInterfaces.InvoiceProvider
+		cardProvider       invoiceInterfaces.CardProvider
+		outboxService      outbox.Service
+		factory            *factories.TransactionFactory
+	}
+)
+
+// NewUpdateInstallmentGroupUseCase creates a new UpdateInstallmentGroupUseCase.
+func NewUpdateInstallmentGroupUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	cardProvider invoiceInterfaces.CardProvider,
+	outboxService outbox.Service,
+) UpdateInstallmentGroupUseCase {
+	return &updateInstallmentGroupUseCase{
+		o11y:               o11y,
+		uow:                unitOfWork,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		invoiceProvider:    invoiceProvider,
+		cardProvider:       cardProvider,
+		outboxService:      outboxService,
+		factory:            factories.NewTransactionFactory(),
+	}
+}
+
+// Execute changes the total amount and the number of installments of a card purchase.
+// Installments on closed or paid invoices are left as they are and reported; the rest of
+// the total is spread over the other installments, whose invoice items are replaced.
+// Installments added at the end go to the invoices of the following months, created when
+// needed. Each change emits the usual event in the month of the installment's invoice.
+func (u *updateInstallmentGroupUseCase) Execute(ctx context.Context, userID, installmentGroupID string, input *dtos.InstallmentGroupUpdateInput) (*dtos.InstallmentGroupOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "update_installment_group_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	groupID, err := vos.NewUUIDFromString(installmentGroupID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid installment_group_id: %w", err)
+	}
+
+	group, err := u.repository.FindByInstallmentGroup(ctx, groupID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if len(group) == 0 {
+		return nil, transactionDomain.ErrInstallmentGroupNotFound
+	}
+	if group[0].UserID.String() != userID {
+		return nil, transactionDomain.ErrTransactionNotOwned
+	}
+
+	installments, err := activeInstallments(group)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := make(map[int]bool, len(installments))
+	months := make(map[string]pkgVos.ReferenceMonth, input.Installments)
+	last := 0
+	for _, t := range installments {
+		info, err := u.invoiceProvider.FindByID(ctx, *t.InvoiceID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		if info == nil {
+			return nil, transactionDomain.ErrInvoiceNotFound
+		}
+		locked[*t.InstallmentNumber] = !t.IsEditable(info.Status)
+		months[t.ID.String()] = info.ReferenceMonth
+		last = max(last, *t.InstallmentNumber)
+	}
+
+	invoices, err := u.findTrailingInvoices(ctx, installments[0], last, input.Installments)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	invoiceIDs := make([]string, 0, len(invoices))
+	for _, info := range invoices {
+		invoiceIDs = append(invoiceIDs, info.ID.String())
+	}
+
+	previous := make(map[string]events.TransactionSnapshot, len(installments))
+	for _, t := range installments {
+		previous[t.ID.String()] = events.TransactionSnapshot{
+			CategoryID:     t.CategoryID,
+			Amount:         t.Amount,
+			ReferenceMonth: months[t.ID.String()],
+			Splits:         toSplitSnapshots(t),
+		}
+	}
+
+	before := statesOf(installments...)
+	schedule, err := u.factory.RescheduleInstallments(factories.RescheduleParams{
+		Installments: installments,
+		Locked:       locked,
+		Amount:       input.Amount,
+		Count:        input.Installments,
+		InvoiceIDs:   invoiceIDs,
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	for i, t := range schedule.Created {
+		months[t.ID.String()] = invoices[i].ReferenceMonth
+	}
+
+	totalAmount, err := vos.NewMoneyFromFloat(input.Amount, vos.CurrencyBRL)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		changed := append(append([]*entities.Transaction{}, schedule.Updated...), schedule.Cancelled...)
+		if err := u.repository.UpdateAll(ctx, tx, changed); err != nil {
+			return err
+		}
+		if len(schedule.Created) > 0 {
+			if err := u.repository.SaveAll(ctx, tx, schedule.Created); err != nil {
+				return err
+			}
+		}
+		if err := saveRevisions(ctx, tx, u.revisionRepository, &group[0].UserID, before, append(changed, schedule.Created...)...); err != nil {
+			return err
+		}
+		if len(changed) > 0 {
+			removed := make([]vos.UUID, 0, len(changed))
+			for _, t := range changed {
+				removed = append(removed, t.ID)
+			}
+			if err := u.invoiceProvider.RemoveItems(ctx, tx, removed); err != nil {
+				return err
+			}
+		}
+		items := make([]transactionInterfaces.InvoiceItemInfo, 0, len(schedule.Updated)+len(schedule.Created))
+		for _, t := range append(append([]*entities.Transaction{}, schedule.Updated...), schedule.Created...) {
+			items = append(items, toInvoiceItem(t, totalAmount))
+		}
+		if err := u.invoiceProvider.AddItems(ctx, tx, items); err != nil {
+			return err
+		}
+		return u.saveEvents(ctx, tx, schedule, previous, months)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "UpdateInstallmentGroup"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return &dtos.InstallmentGroupOutput{
+		InstallmentGroupID: groupID.String(),
+		Amount:             totalAmount.Float(),
+		Installments:       input.Installments,
+		Updated:            toOutputList(schedule.Updated),
+		Created:            toOutputList(schedule.Created),
+		Cancelled:          toOutputList(schedule.Cancelled),
+		Locked:             toOutputList(schedule.Locked),
+	}, nil
+}
+
+// activeInstallments returns the active installments of a purchase. A purchase whose
+// remaining installments were paid off early can no longer be edited.
+func activeInstallments(group []*entities.Transaction) ([]*entities.Transaction, error) {
+	installments := make([]*entities.Transaction, 0, len(group))
+	for _, t := range group {
+		if !t.Status.IsActive() {
+			continue
+		}
+		if t.InstallmentNumber == nil {
+			return nil, fmt.Errorf("%w: the remaining installments were paid off early", transactionDomain.ErrInstallmentsLocked)
+		}
+		if t.CardID == nil || t.InvoiceID == nil {
+			return nil, fmt.Errorf("%w: only card purchases can be edited as a group", transactionDomain.ErrInvalidInstallmentEdit)
+		}
+		installments = append(installments, t)
+	}
+	if len(installments) == 0 {
+		return nil, fmt.Errorf("%w: the purchase was reversed", transactionDomain.ErrInvalidInstallmentEdit)
+	}
+	return installments, nil
+}
+
+// findTrailingInvoices returns the invoices of installments last+1 to count, creating
+// them when needed. They follow the installment months of the purchase date.
+func (u *updateInstallmentGroupUseCase) findTrailingInvoices(ctx context.Context, t *entities.Transaction, last, count int) ([]*transactionInterfaces.InvoiceInfo, error) {
+	if count <= last {
+		return nil, nil
+	}
+	billingInfo, err := u.cardProvider.GetCardBillingInfo(ctx, t.UserID, *t.CardID)
+	if err != nil {
+		return nil, err
+	}
+	calculator, err := invoiceFactories.NewInvoiceCalculator(billingInfo.DueDay, billingInfo.ClosingOffsetDays)
+	if err != nil {
+		return nil, fmt.Errorf("invalid card billing configuration: %w", err)
+	}
+
+	months := calculator.CalculateInstallmentMonths(t.TransactionDate, count)
+	invoices := make([]*transactionInterfaces.InvoiceInfo, 0, count-last)
+	for _, month := range months[last:] {
+		info, err := u.invoiceProvider.FindOrCreate(ctx, t.UserID, *t.CardID, month, calculator.CalculateDueDate(month))
+		if err != nil {
+			return nil, err
+		}
+		if !t.IsEditable(info.Status) {
+			return nil, fmt.Errorf("%w: the invoice of %s is %s", transactionDomain.ErrInvoiceClosed, month.String(), info.Status)
+		}
+		invoices = append(invoices, info)
+	}
+	return invoices, nil
+}
+
+// saveEvents emits transaction.updated, transaction.created and transaction.reversed for
+// the installments changed, added and cancelled, each in the month of its invoice.
+func (u *updateInstallmentGroupUseCase) saveEvents(
+	ctx context.Context,
+	tx database.DBTX,
+	schedule *factories.InstallmentSchedule,
+	previous map[string]events.TransactionSnapshot,
+	months map[string]pkgVos.ReferenceMonth,
+) error {
+	for _, t := range schedule.Updated {
+		event := events.NewTransactionUpdatedEvent(
+			t.ID,
+			t.UserID,
+			previous[t.ID.String()],
+			events.TransactionSnapshot{
+				CategoryID:     t.CategoryID,
+				Amount:         t.Amount,
+				ReferenceMonth: months[t.ID.String()],
+				Splits:         toSplitSnapshots(t),
+			},
+			*t.UpdatedAt,
+		)
+		if err := u.saveEvent(ctx, tx, t.ID, event.EventType(), event.Payload()); err != nil {
+			return err
+		}
+	}
+	for _, t := range schedule.Created {
+		event := events.NewTransactionCreatedEvent(
+			t.ID,
+			t.UserID,
+			t.CategoryID,
+			t.Amount,
+			t.Direction,
+			t.PaymentMethod,
+			t.TransactionDate,
+			months[t.ID.String()],
+			t.InvoiceID,
+			t.InstallmentNumber,
+			t.InstallmentTotal,
+			t.InstallmentGroupID,
+			toSplitSnapshots(t),
+		)
+		if err := u.saveEvent(ctx, tx, t.ID, event.EventType(), event.Payload()); err != nil {
+			return err
+		}
+	}
+	for _, t := range schedule.Cancelled {
+		event := events.NewTransactionReversedEvent(
+			t.ID,
+			t.UserID,
+			t.CategoryID,
+			t.Amount,
+			months[t.ID.String()],
+			t.InvoiceID,
+			t.InstallmentNumber,
+			t.InstallmentGroupID,
+			*t.UpdatedAt,
+			toSplitSnapshots(t),
+		)
+		if err := u.saveEvent(ctx, tx, t.ID, event.EventType(), event.Payload()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *updateInstallmentGroupUseCase) saveEvent(ctx context.Context, tx database.DBTX, transactionID vos.UUID, eventType string, payload map[string]any) error {
+	aggregateID, _ := uuid.Parse(transactionID.String())
+	return u.outboxService.SaveDomainEvent(ctx, tx, aggregateID, "transaction", eventType, outbox.JSONBPayload(payload))
+}
@@ -0,0 +1,204 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	invoiceInterfaces "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces"
+	invoiceMocks "github.com/jailtonjunior94/financial/internal/invoice/domain/interfaces/mocks"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
+)
+
+type UpdateInstallmentGroupUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.TransactionRepository
+	revisionRepo    *transactionMocks.TransactionRevisionRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+	cardProvider    *invoiceMocks.CardProvider
+	outboxService   *outboxMocks.Service
+}
+
+func TestUpdateInstallmentGroupUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UpdateInstallmentGroupUseCaseSuite))
+}
+
+func (s *UpdateInstallmentGroupUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.cardProvider = invoiceMocks.NewCardProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
+}
+
+func (s *UpdateInstallmentGroupUseCaseSuite) useCase() UpdateInstallmentGroupUseCase {
+	return NewUpdateInstallmentGroupUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.invoiceProvider, s.cardProvider, s.outboxService)
+}
+
+func (s *UpdateInstallmentGroupUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	otherUserID := "550e8400-e29b-41d4-a716-446655440099"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	groupID, _ := vos.NewUUID()
+	cardID, _ := vos.NewUUID()
+	billingInfo := &invoiceInterfaces.CardBillingInfo{CardID: cardID, DueDay: 10, ClosingOffsetDays: 7}
+
+	type invoice struct {
+		id   vos.UUID
+		info *transactionInterfaces.InvoiceInfo
+	}
+	newInvoice := func(value, status string) invoice {
+		id, _ := vos.NewUUID()
+		month, _ := pkgVos.NewReferenceMonth(value)
+		return invoice{id: id, info: &transactionInterfaces.InvoiceInfo{ID: id, Status: status, ReferenceMonth: month}}
+	}
+	// buildGroup returns a purchase of 300 in 3 installments; the first one is on a paid invoice.
+	buildGroup := func() ([]*entities.Transaction, []invoice) {
+		invoices := []invoice{newInvoice("2026-04", "paid"), newInvoice("2026-05", "open"), newInvoice("2026-06", "open")}
+		group := make([]*entities.Transaction, 0, len(invoices))
+		for i := range invoices {
+			number, total := i+1, len(invoices)
+			tx := buildTransaction(userID, categoryID, &invoices[i].id)
+			tx.CardID = &cardID
+			tx.InstallmentGroupID = &groupID
+			tx.InstallmentNumber = &number
+			tx.InstallmentTotal = &total
+			group = append(group, tx)
+		}
+		return group, invoices
+	}
+	expectInvoices := func(invoices []invoice) {
+		for _, inv := range invoices {
+			s.invoiceProvider.EXPECT().FindByID(mock.Anything, inv.id).Return(inv.info, nil).Once()
+		}
+	}
+	expectEvent := func(eventType, month string) {
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", eventType, mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+			return payload["reference_month"] == month
+		})).Return(nil).Once()
+	}
+
+	s.Run("should spread the new total and add an installment", func() {
+		group, invoices := buildGroup()
+		added := newInvoice("2026-07", "open")
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+		expectInvoices(invoices)
+		s.cardProvider.EXPECT().GetCardBillingInfo(mock.Anything, group[0].UserID, cardID).Return(billingInfo, nil).Once()
+		s.invoiceProvider.EXPECT().FindOrCreate(mock.Anything, group[0].UserID, cardID, mock.Anything, mock.Anything).Return(added.info, nil).Once()
+		s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+			return len(ts) == 2 && ts[0].ID == group[1].ID && ts[0].Amount.Cents() == 10000 && *ts[0].InstallmentTotal == 4
+		})).Return(nil).Once()
+		s.repo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+			return len(ts) == 1 && *ts[0].InstallmentNumber == 4 && *ts[0].InvoiceID == added.id && ts[0].Amount.Cents() == 10001
+		})).Return(nil).Once()
+		s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.invoiceProvider.EXPECT().RemoveItems(mock.Anything, mock.Anything, []vos.UUID{group[1].ID, group[2].ID}).Return(nil).Once()
+		s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.InvoiceItemInfo) bool {
+			return len(items) == 3 && items[2].InvoiceID == added.id &&
+				items[0].TotalAmount.Cents() == 40001 && items[0].InstallmentTotal == 4
+		})).Return(nil).Once()
+		expectEvent("transaction.updated", "2026-05")
+		expectEvent("transaction.updated", "2026-06")
+		expectEvent("transaction.created", "2026-07")
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.InstallmentGroupUpdateInput{Amount: 400.01, Installments: 4})
+
+		s.NoError(err)
+		s.Equal(400.01, output.Amount)
+		s.Len(output.Updated, 2)
+		s.Len(output.Created, 1)
+		s.Empty(output.Cancelled)
+		s.Len(output.Locked, 1)
+		s.Equal(group[0].ID.String(), output.Locked[0].ID)
+		s.Equal(100.0, output.Locked[0].Amount)
+	})
+
+	s.Run("should cancel the installments past the new count", func() {
+		group, invoices := buildGroup()
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+		expectInvoices(invoices)
+		s.repo.EXPECT().UpdateAll(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+			return len(ts) == 2 && ts[0].Amount.Cents() == 20000 && ts[1].Status.IsCancelled()
+		})).Return(nil).Once()
+		s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.invoiceProvider.EXPECT().RemoveItems(mock.Anything, mock.Anything, []vos.UUID{group[1].ID, group[2].ID}).Return(nil).Once()
+		s.invoiceProvider.EXPECT().AddItems(mock.Anything, mock.Anything, mock.MatchedBy(func(items []transactionInterfaces.InvoiceItemInfo) bool {
+			return len(items) == 1 && items[0].TransactionID == group[1].ID && items[0].InstallmentTotal == 2
+		})).Return(nil).Once()
+		expectEvent("transaction.updated", "2026-05")
+		expectEvent("transaction.reversed", "2026-06")
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.InstallmentGroupUpdateInput{Amount: 300, Installments: 2})
+
+		s.NoError(err)
+		s.Len(output.Updated, 1)
+		s.Len(output.Cancelled, 1)
+		s.Equal(group[2].ID.String(), output.Cancelled[0].ID)
+	})
+
+	s.Run("should return error when the edit would change locked installments", func() {
+		group, invoices := buildGroup()
+		invoices[1].info.Status = "closed"
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+		expectInvoices(invoices)
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.InstallmentGroupUpdateInput{Amount: 300, Installments: 1})
+
+		s.ErrorIs(err, transactionDomain.ErrInstallmentsLocked)
+		s.Nil(output)
+	})
+
+	s.Run("should return error when the purchase was paid off early", func() {
+		group, _ := buildGroup()
+		payoff := buildTransaction(userID, categoryID, group[1].InvoiceID)
+		payoff.CardID = &cardID
+		payoff.InstallmentGroupID = &groupID
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(append(group[:2], payoff), nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.InstallmentGroupUpdateInput{Amount: 300, Installments: 3})
+
+		s.ErrorIs(err, transactionDomain.ErrInstallmentsLocked)
+		s.Nil(output)
+	})
+
+	s.Run("should return error when the group does not exist", func() {
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(nil, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.InstallmentGroupUpdateInput{Amount: 300, Installments: 3})
+
+		s.ErrorIs(err, transactionDomain.ErrInstallmentGroupNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should return error when the group belongs to another user", func() {
+		group, _ := buildGroup()
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(group, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, otherUserID, groupID.String(), &dtos.InstallmentGroupUpdateInput{Amount: 300, Installments: 3})
+
+		s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+		s.Nil(output)
+	})
+
+	s.Run("should return error for invalid input", func() {
+		output, err := s.useCase().Execute(s.ctx, userID, groupID.String(), &dtos.InstallmentGroupUpdateInput{Amount: 300})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidInstallmentEdit)
+		s.Nil(output)
+	})
+}
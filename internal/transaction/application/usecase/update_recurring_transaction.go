@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	UpdateRecurringTransactionUseCase interface {
+		Execute(ctx context.Context, userID, recurringID string, input *dtos.RecurringTransactionUpdateInput) (*dtos.RecurringTransactionOutput, error)
+	}
+
+	updateRecurringTransactionUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.RecurringTransactionRepository
+	}
+)
+
+// NewUpdateRecurringTransactionUseCase creates a new UpdateRecurringTransactionUseCase.
+func NewUpdateRecurringTransactionUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.RecurringTransactionRepository,
+) UpdateRecurringTransactionUseCase {
+	return &updateRecurringTransactionUseCase{o11y: o11y, uow: unitOfWork, repository: repository}
+}
+
+// Execute updates the template used by future occurrences and pauses or resumes the
+// recurrence. Transactions already materialized are not changed.
+func (u *updateRecurringTransactionUseCase) Execute(
+	ctx context.Context,
+	userID, recurringID string,
+	input *dtos.RecurringTransactionUpdateInput,
+) (*dtos.RecurringTransactionOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "update_recurring_transaction_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	recurring, err := findOwnedRecurring(ctx, u.repository, userID, recurringID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	expectedNext := recurring.NextOccurrence
+
+	categoryID, err := vos.NewUUIDFromString(input.CategoryID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid category_id: %w", err)
+	}
+	var subcategoryID *vos.UUID
+	if input.SubcategoryID != "" {
+		parsed, err := vos.NewUUIDFromString(input.SubcategoryID)
+		if err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("invalid subcategory_id: %w", err)
+		}
+		subcategoryID = &parsed
+	}
+	amount, err := vos.NewMoneyFromFloat(input.Amount, vos.CurrencyBRL)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	if err := recurring.UpdateTemplate(input.Description, amount, categoryID, subcategoryID); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	if input.Active != nil && *input.Active != recurring.Active {
+		if *input.Active {
+			now := time.Now().UTC()
+			if err := recurring.Resume(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)); err != nil {
+				span.RecordError(err)
+				return nil, err
+			}
+		} else {
+			recurring.Pause()
+		}
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		updated, err := u.repository.Update(ctx, tx, recurring, expectedNext)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return transactionDomain.ErrRecurringTransactionConflict
+		}
+		return nil
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "UpdateRecurringTransaction"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "recurring_transaction"),
+		observability.String("user_id", userID),
+	)
+
+	return toRecurringOutput(recurring), nil
+}
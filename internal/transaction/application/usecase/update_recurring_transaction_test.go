@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type UpdateRecurringTransactionUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.RecurringTransactionRepository
+}
+
+func TestUpdateRecurringTransactionUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UpdateRecurringTransactionUseCaseSuite))
+}
+
+func (s *UpdateRecurringTransactionUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewRecurringTransactionRepository(s.T())
+}
+
+func (s *UpdateRecurringTransactionUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	recurringID := "660e8400-e29b-41d4-a716-446655440000"
+	active, paused := true, false
+
+	future := time.Now().UTC().AddDate(0, 1, 0)
+	past := time.Now().UTC().AddDate(0, -3, 0)
+
+	input := func(activeFlag *bool) *dtos.RecurringTransactionUpdateInput {
+		return &dtos.RecurringTransactionUpdateInput{
+			Description: "Aluguel reajustado",
+			Amount:      1650.00,
+			CategoryID:  categoryID,
+			Active:      activeFlag,
+		}
+	}
+
+	scenarios := []struct {
+		name         string
+		input        *dtos.RecurringTransactionUpdateInput
+		dependencies func()
+		expect       func(output *dtos.RecurringTransactionOutput, err error)
+	}{
+		{
+			name:  "should update template guarded by the current next occurrence",
+			input: input(nil),
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", future)
+				expectedNext := recurring.NextOccurrence
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, expectedNext).Return(true, nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.NoError(err)
+				s.Equal("Aluguel reajustado", output.Description)
+				s.Equal(1650.00, output.Amount)
+				s.True(output.Active)
+			},
+		},
+		{
+			name:  "should pause the recurrence",
+			input: input(&paused),
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", future)
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, mock.Anything).Return(true, nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.NoError(err)
+				s.False(output.Active)
+			},
+		},
+		{
+			name:  "should resume skipping occurrences missed while paused",
+			input: input(&active),
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", past)
+				recurring.Pause()
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, recurring, mock.Anything).Return(true, nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.NoError(err)
+				s.True(output.Active)
+				s.Equal(3, output.OccurrenceCount)
+				s.GreaterOrEqual(*output.NextOccurrence, time.Now().UTC().Format("2006-01-02"))
+			},
+		},
+		{
+			name:  "should not resume a finished recurrence",
+			input: input(&active),
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY;COUNT=1", past)
+				recurring.Advance()
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrRecurrenceFinished)
+				s.Nil(output)
+			},
+		},
+		{
+			name:  "should return conflict when the job advanced the recurrence concurrently",
+			input: input(nil),
+			dependencies: func() {
+				recurring := buildRecurringTransaction(userID, categoryID, "FREQ=MONTHLY", future)
+				s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Once()
+			},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrRecurringTransactionConflict)
+				s.Nil(output)
+			},
+		},
+		{
+			name: "should return validation error",
+			input: &dtos.RecurringTransactionUpdateInput{
+				Description: "",
+				Amount:      10,
+				CategoryID:  categoryID,
+			},
+			dependencies: func() {},
+			expect: func(output *dtos.RecurringTransactionOutput, err error) {
+				s.ErrorIs(err, transactionDomain.ErrDescriptionRequired)
+				s.Nil(output)
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		s.Run(scenario.name, func() {
+			scenario.dependencies()
+			uc := NewUpdateRecurringTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo)
+			output, err := uc.Execute(s.ctx, userID, recurringID, scenario.input)
+			scenario.expect(output, err)
+		})
+	}
+}
+
+func (s *UpdateRecurringTransactionUseCaseSuite) TestExecuteNotOwned() {
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	recurring := buildRecurringTransaction("550e8400-e29b-41d4-a716-446655440099", categoryID, "FREQ=MONTHLY", time.Now().UTC())
+	s.repo.EXPECT().FindByID(mock.Anything, mock.Anything).Return(recurring, nil).Once()
+
+	uc := NewUpdateRecurringTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo)
+	output, err := uc.Execute(s.ctx, "550e8400-e29b-41d4-a716-446655440000", recurring.ID.String(), &dtos.RecurringTransactionUpdateInput{
+		Description: "Aluguel",
+		Amount:      1500,
+		CategoryID:  categoryID,
+	})
+
+	s.ErrorIs(err, transactionDomain.ErrRecurringTransactionNotOwned)
+	s.Nil(output)
+}
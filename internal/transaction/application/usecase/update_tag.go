@@ -0,0 +1,144 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+)
+
+type (
+	UpdateTagUseCase interface {
+		Execute(ctx context.Context, userID, tagID string, input *dtos.TagInput) (*dtos.TagOutput, error)
+	}
+
+	updateTagUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.TagRepository
+	}
+)
+
+// NewUpdateTagUseCase creates a new UpdateTagUseCase.
+func NewUpdateTagUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.TagRepository,
+) UpdateTagUseCase {
+	return &updateTagUseCase{o11y: o11y, uow: unitOfWork, repository: repository}
+}
+
+// Execute renames the tag; the transactions carrying it show the new name.
+func (u *updateTagUseCase) Execute(ctx context.Context, userID, tagID string, input *dtos.TagInput) (*dtos.TagOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "update_tag_usecase.execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	tag, err := findOwnedTag(ctx, u.repository, userID, tagID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if err := tag.Rename(input.Name); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	existing, err := u.repository.FindByName(ctx, tag.UserID, tag.Name)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if existing != nil && existing.ID.String() != tag.ID.String() {
+		return nil, transactionDomain.ErrTagAlreadyExists
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Update(ctx, tx, tag)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "UpdateTag"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "tag"),
+		observability.String("user_id", userID),
+	)
+
+	return toTagOutput(tag), nil
+}
+
+// findOwnedTag loads a tag and checks that it belongs to the user.
+func findOwnedTag(
+	ctx context.Context,
+	repository transactionInterfaces.TagRepository,
+	userID, tagID string,
+) (*entities.Tag, error) {
+	id, err := vos.NewUUIDFromString(tagID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tag_id: %w", err)
+	}
+
+	tag, err := repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if tag == nil {
+		return nil, transactionDomain.ErrTagNotFound
+	}
+	if tag.UserID.String() != userID {
+		return nil, transactionDomain.ErrTagNotOwned
+	}
+	return tag, nil
+}
+
+// findOwnedTags resolves tag IDs against the catalog of the user. Repeated IDs are
+// ignored; an ID that is not a tag of the user fails with ErrTagNotFound.
+func findOwnedTags(
+	ctx context.Context,
+	repository transactionInterfaces.TagRepository,
+	userID vos.UUID,
+	tagIDs []string,
+) ([]*entities.Tag, error) {
+	if len(tagIDs) == 0 {
+		return nil, nil
+	}
+
+	seen := make(map[string]bool, len(tagIDs))
+	ids := make([]vos.UUID, 0, len(tagIDs))
+	for _, raw := range tagIDs {
+		id, err := vos.NewUUIDFromString(raw)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tag_ids must be UUIDs", transactionDomain.ErrInvalidTagging)
+		}
+		if seen[id.String()] {
+			continue
+		}
+		seen[id.String()] = true
+		ids = append(ids, id)
+	}
+
+	tags, err := repository.FindByIDs(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) != len(ids) {
+		return nil, transactionDomain.ErrTagNotFound
+	}
+	return tags, nil
+}
@@ -0,0 +1,132 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+)
+
+type UpdateTagUseCaseSuite struct {
+	suite.Suite
+	ctx  context.Context
+	obs  *fake.Provider
+	repo *transactionMocks.TagRepository
+}
+
+func TestUpdateTagUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UpdateTagUseCaseSuite))
+}
+
+func (s *UpdateTagUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewTagRepository(s.T())
+}
+
+func (s *UpdateTagUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	otherUserID := "550e8400-e29b-41d4-a716-446655440099"
+
+	s.Run("should rename the tag", func() {
+		tag := buildTag(userID, "vacation")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+		s.repo.EXPECT().FindByName(mock.Anything, tag.UserID, "vacation-2026").Return(nil, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, tag).Return(nil).Once()
+
+		output, err := NewUpdateTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).
+			Execute(s.ctx, userID, tag.ID.String(), &dtos.TagInput{Name: "Vacation-2026"})
+
+		s.NoError(err)
+		s.Equal("vacation-2026", output.Name)
+		s.NotNil(output.UpdatedAt)
+	})
+
+	s.Run("should keep the name when only the case changes", func() {
+		tag := buildTag(userID, "work")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+		s.repo.EXPECT().FindByName(mock.Anything, tag.UserID, "work").Return(tag, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, tag).Return(nil).Once()
+
+		output, err := NewUpdateTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).
+			Execute(s.ctx, userID, tag.ID.String(), &dtos.TagInput{Name: "WORK"})
+
+		s.NoError(err)
+		s.Equal("work", output.Name)
+	})
+
+	s.Run("should reject a name used by another tag", func() {
+		tag := buildTag(userID, "vacation")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+		s.repo.EXPECT().FindByName(mock.Anything, tag.UserID, "travel").Return(buildTag(userID, "travel"), nil).Once()
+
+		output, err := NewUpdateTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).
+			Execute(s.ctx, userID, tag.ID.String(), &dtos.TagInput{Name: "travel"})
+
+		s.ErrorIs(err, transactionDomain.ErrTagAlreadyExists)
+		s.Nil(output)
+	})
+
+	s.Run("should return not found", func() {
+		tag := buildTag(userID, "vacation")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(nil, nil).Once()
+
+		output, err := NewUpdateTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).
+			Execute(s.ctx, userID, tag.ID.String(), &dtos.TagInput{Name: "travel"})
+
+		s.ErrorIs(err, transactionDomain.ErrTagNotFound)
+		s.Nil(output)
+	})
+
+	s.Run("should reject a tag of another user", func() {
+		tag := buildTag(otherUserID, "vacation")
+		s.repo.EXPECT().FindByID(mock.Anything, tag.ID).Return(tag, nil).Once()
+
+		output, err := NewUpdateTagUseCase(s.obs, &mockUnitOfWork{}, s.repo).
+			Execute(s.ctx, userID, tag.ID.String(), &dtos.TagInput{Name: "travel"})
+
+		s.ErrorIs(err, transactionDomain.ErrTagNotOwned)
+		s.Nil(output)
+	})
+}
+
+func (s *UpdateTagUseCaseSuite) TestFindOwnedTags() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	first := buildTag(userID, "vacation")
+	second := buildTag(userID, "reimbursable")
+
+	s.Run("should ignore repeated IDs", func() {
+		s.repo.EXPECT().FindByIDs(mock.Anything, first.UserID, mock.MatchedBy(func(ids []vos.UUID) bool {
+			return len(ids) == 2
+		})).Return([]*entities.Tag{first, second}, nil).Once()
+
+		tags, err := findOwnedTags(s.ctx, s.repo, first.UserID, []string{first.ID.String(), second.ID.String(), first.ID.String()})
+
+		s.NoError(err)
+		s.Len(tags, 2)
+	})
+
+	s.Run("should return not found when a tag is missing from the catalog", func() {
+		s.repo.EXPECT().FindByIDs(mock.Anything, first.UserID, mock.Anything).Return([]*entities.Tag{first}, nil).Once()
+
+		tags, err := findOwnedTags(s.ctx, s.repo, first.UserID, []string{first.ID.String(), second.ID.String()})
+
+		s.ErrorIs(err, transactionDomain.ErrTagNotFound)
+		s.Nil(tags)
+	})
+
+	s.Run("should reject an invalid ID", func() {
+		tags, err := findOwnedTags(s.ctx, s.repo, first.UserID, []string{"not-a-uuid"})
+
+		s.ErrorIs(err, transactionDomain.ErrInvalidTagging)
+		s.Nil(tags)
+	})
+}
@@ -8,22 +8,31 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
 	"github.com/JailtonJunior94/devkit-go/pkg/observability"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
 
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
 	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
 	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
 )
 
 type (
 	UpdateTransactionUseCase interface {
-		Execute(ctx context.Context, userID, transactionID string, input *dtos.TransactionUpdateInput) (*dtos.TransactionOutput, error)
+		Execute(ctx context.Context, userID, transactionID string, input *dtos.TransactionUpdateInput, expectedVersion *int) (*dtos.TransactionOutput, error)
 	}
 
 	updateTransactionUseCase struct {
-		o11y            observability.Observability
-		uow             uow.UnitOfWork
-		repository      transactionInterfaces.TransactionRepository
-		invoiceProvider transactionInterfaces.InvoiceProvider
+		o11y               observability.Observability
+		uow                uow.UnitOfWork
+		repository         transactionInterfaces.TransactionRepository
+		revisionRepository transactionInterfaces.TransactionRevisionRepository
+		tagRepository      transactionInterfaces.TagRepository
+		invoiceProvider    transactionInterfaces.InvoiceProvider
+		outboxService      outbox.Service
 	}
 )
 
@@ -32,17 +41,23 @@ func NewUpdateTransactionUseCase(
 	o11y observability.Observability,
 	unitOfWork uow.UnitOfWork,
 	repository transactionInterfaces.TransactionRepository,
+	revisionRepository transactionInterfaces.TransactionRevisionRepository,
+	tagRepository transactionInterfaces.TagRepository,
 	invoiceProvider transactionInterfaces.InvoiceProvider,
+	outboxService outbox.Service,
 ) UpdateTransactionUseCase {
 	return &updateTransactionUseCase{
-		o11y:            o11y,
-		uow:             unitOfWork,
-		repository:      repository,
-		invoiceProvider: invoiceProvider,
+		o11y:               o11y,
+		uow:                unitOfWork,
+		repository:         repository,
+		revisionRepository: revisionRepository,
+		tagRepository:      tagRepository,
+		invoiceProvider:    invoiceProvider,
+		outboxService:      outboxService,
 	}
 }
 
-func (u *updateTransactionUseCase) Execute(ctx context.Context, userID, transactionID string, input *dtos.TransactionUpdateInput) (*dtos.TransactionOutput, error) {
+func (u *updateTransactionUseCase) Execute(ctx context.Context, userID, transactionID string, input *dtos.TransactionUpdateInput, expectedVersion *int) (*dtos.TransactionOutput, error) {
 	ctx, span := u.o11y.Tracer().Start(ctx, "update_transaction_usecase.execute")
 	defer span.End()
 
@@ -70,6 +85,10 @@ func (u *updateTransactionUseCase) Execute(ctx context.Context, userID, transact
 		return nil, transactionDomain.ErrTransactionNotOwned
 	}
 
+	if expectedVersion != nil && *expectedVersion != transaction.Version {
+		return nil, customerrors.ErrPreconditionFailed
+	}
+
 	if transaction.InvoiceID != nil {
 		status, err := u.invoiceProvider.GetStatus(ctx, *transaction.InvoiceID)
 		if err != nil {
@@ -81,7 +100,11 @@ func (u *updateTransactionUseCase) Execute(ctx context.Context, userID, transact
 		}
 	}
 
-	categoryID, err := vos.NewUUIDFromString(input.CategoryID)
+	categoryInput := input.CategoryID
+	if categoryInput == "" {
+		categoryInput = input.Splits[0].CategoryID
+	}
+	categoryID, err := vos.NewUUIDFromString(categoryInput)
 	if err != nil {
 		span.RecordError(err)
 		return nil, fmt.Errorf("invalid category_id: %w", err)
@@ -93,13 +116,88 @@ func (u *updateTransactionUseCase) Execute(ctx context.Context, userID, transact
 		return nil, fmt.Errorf("invalid amount: %w", err)
 	}
 
+	splits, err := factories.NewTransactionFactory().CreateSplits(toSplitParams(input.Splits))
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	before := statesOf(transaction)
+	previous := events.TransactionSnapshot{
+		CategoryID:     transaction.CategoryID,
+		Amount:         transaction.Amount,
+		ReferenceMonth: resolveReferenceMonth(transaction, transaction.TransactionDate),
+		Splits:         toSplitSnapshots(transaction),
+	}
+
 	if err := transaction.UpdateDetails(input.Description, amount, categoryID); err != nil {
 		span.RecordError(err)
 		return nil, err
 	}
+	if err := transaction.SetSplits(splits); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	var retagged []*entities.Transaction
+	var suggestion *dtos.TagGroupSuggestion
+	if input.TagIDs != nil {
+		tags, err := findOwnedTags(ctx, u.tagRepository, transaction.UserID, *input.TagIDs)
+		if err != nil {
+			span.RecordError(err)
+			return nil, err
+		}
+		changed := !transaction.HasSameTags(tags)
+		transaction.SetTags(tags)
+		if transaction.InstallmentGroupID != nil && (changed || input.ApplyTagsToGroup) {
+			retagged, suggestion, err = u.tagInstallments(ctx, transaction, input.ApplyTagsToGroup)
+			if err != nil {
+				span.RecordError(err)
+				return nil, err
+			}
+		}
+	}
+
+	current := events.TransactionSnapshot{
+		CategoryID:     transaction.CategoryID,
+		Amount:         transaction.Amount,
+		ReferenceMonth: resolveReferenceMonth(transaction, transaction.TransactionDate),
+		Splits:         toSplitSnapshots(transaction),
+	}
 
 	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
-		return u.repository.Update(ctx, tx, transaction)
+		if err := u.repository.Update(ctx, tx, transaction); err != nil {
+			return err
+		}
+		if err := saveRevisions(ctx, tx, u.revisionRepository, &transaction.UserID, before, transaction); err != nil {
+			return err
+		}
+		if len(retagged) > 0 {
+			if err := u.repository.SetTags(ctx, tx, retagged); err != nil {
+				return err
+			}
+		}
+		if transaction.InvoiceID != nil {
+			if err := u.invoiceProvider.UpdateItem(ctx, tx, toInvoiceItem(transaction, transaction.Amount)); err != nil {
+				return err
+			}
+		}
+		event := events.NewTransactionUpdatedEvent(
+			transaction.ID,
+			transaction.UserID,
+			previous,
+			current,
+			*transaction.UpdatedAt,
+		)
+		aggregateID, _ := uuid.Parse(transaction.ID.String())
+		return u.outboxService.SaveDomainEvent(
+			ctx,
+			tx,
+			aggregateID,
+			"transaction",
+			event.EventType(),
+			outbox.JSONBPayload(event.Payload()),
+		)
 	})
 	if err != nil {
 		span.RecordError(err)
@@ -113,5 +211,42 @@ func (u *updateTransactionUseCase) Execute(ctx context.Context, userID, transact
 		observability.String("user_id", userID),
 	)
 
-	return toOutput(transaction), nil
+	output := toOutput(transaction)
+	output.TagGroupSuggestion = suggestion
+	return output, nil
+}
+
+// tagInstallments copies the tags of an installment to the other active installments of
+// its purchase and returns them to be persisted. Without applyToGroup nothing is copied;
+// the returned suggestion tells the client how many installments were left untouched.
+func (u *updateTransactionUseCase) tagInstallments(
+	ctx context.Context,
+	transaction *entities.Transaction,
+	applyToGroup bool,
+) ([]*entities.Transaction, *dtos.TagGroupSuggestion, error) {
+	installments, err := u.repository.FindByInstallmentGroup(ctx, *transaction.InstallmentGroupID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	others := make([]*entities.Transaction, 0, len(installments))
+	for _, installment := range installments {
+		if installment.ID.String() != transaction.ID.String() && installment.Status.IsActive() {
+			others = append(others, installment)
+		}
+	}
+	if len(others) == 0 {
+		return nil, nil, nil
+	}
+
+	if !applyToGroup {
+		return nil, &dtos.TagGroupSuggestion{
+			InstallmentGroupID: transaction.InstallmentGroupID.String(),
+			OtherInstallments:  len(others),
+		}, nil
+	}
+	for _, other := range others {
+		other.SetTags(transaction.Tags)
+	}
+	return others, nil, nil
 }
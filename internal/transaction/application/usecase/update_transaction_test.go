@@ -14,8 +14,12 @@ import (
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
 	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
 	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
 	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
+	"github.com/jailtonjunior94/financial/pkg/outbox"
+	outboxMocks "github.com/jailtonjunior94/financial/pkg/outbox/mocks"
 )
 
 type UpdateTransactionUseCaseSuite struct {
@@ -23,7 +27,10 @@ type UpdateTransactionUseCaseSuite struct {
 	ctx             context.Context
 	obs             *fake.Provider
 	repo            *transactionMocks.TransactionRepository
+	revisionRepo    *transactionMocks.TransactionRevisionRepository
+	tagRepo         *transactionMocks.TagRepository
 	invoiceProvider *transactionMocks.InvoiceProvider
+	outboxService   *outboxMocks.Service
 }
 
 func TestUpdateTransactionUseCaseSuite(t *testing.T) {
@@ -34,7 +41,10 @@ func (s *UpdateTransactionUseCaseSuite) SetupTest() {
 	s.obs = fake.NewProvider()
 	s.ctx = context.Background()
 	s.repo = transactionMocks.NewTransactionRepository(s.T())
+	s.revisionRepo = transactionMocks.NewTransactionRevisionRepository(s.T())
+	s.tagRepo = transactionMocks.NewTagRepository(s.T())
 	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.outboxService = outboxMocks.NewService(s.T())
 }
 
 func buildTransaction(userID, categoryID string, invoiceID *vos.UUID) *entities.Transaction {
@@ -68,9 +78,10 @@ func (s *UpdateTransactionUseCaseSuite) TestExecute() {
 	invoiceID, _ := vos.NewUUID()
 
 	type args struct {
-		userID        string
-		transactionID string
-		input         *dtos.TransactionUpdateInput
+		userID          string
+		transactionID   string
+		input           *dtos.TransactionUpdateInput
+		expectedVersion *int
 	}
 	type dependencies func(txID string)
 	type expect func(output *dtos.TransactionOutput, err error)
@@ -98,6 +109,11 @@ func (s *UpdateTransactionUseCaseSuite) TestExecute() {
 				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
 				s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invoiceID).Return("open", nil).Once()
 				s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.invoiceProvider.EXPECT().UpdateItem(mock.Anything, mock.Anything, mock.MatchedBy(func(item transactionInterfaces.InvoiceItemInfo) bool {
+					return item.InvoiceID == invoiceID && item.Description == "Updated" && item.InstallmentAmount.Float() == 200.00
+				})).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.updated", mock.Anything).Return(nil).Once()
 			},
 			expect: func(output *dtos.TransactionOutput, err error) {
 				s.NoError(err)
@@ -172,6 +188,51 @@ func (s *UpdateTransactionUseCaseSuite) TestExecute() {
 				s.ErrorIs(err, transactionDomain.ErrTransactionNotFound)
 			},
 		},
+		{
+			name: "should return precondition failed when If-Match version is stale",
+			args: args{
+				userID:        userID,
+				transactionID: "660e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionUpdateInput{
+					Description: "Updated",
+					Amount:      200.00,
+					CategoryID:  categoryID,
+				},
+				expectedVersion: func() *int { v := 2; return &v }(),
+			},
+			dependencies: func(txIDStr string) {
+				txID, _ := vos.NewUUIDFromString(txIDStr)
+				tx := buildTransaction(userID, categoryID, nil)
+				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, customerrors.ErrPreconditionFailed)
+			},
+		},
+		{
+			name: "should return precondition failed when transaction changes before the update",
+			args: args{
+				userID:        userID,
+				transactionID: "660e8400-e29b-41d4-a716-446655440000",
+				input: &dtos.TransactionUpdateInput{
+					Description: "Updated",
+					Amount:      200.00,
+					CategoryID:  categoryID,
+				},
+				expectedVersion: func() *int { v := 1; return &v }(),
+			},
+			dependencies: func(txIDStr string) {
+				txID, _ := vos.NewUUIDFromString(txIDStr)
+				tx := buildTransaction(userID, categoryID, nil)
+				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+				s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(customerrors.ErrPreconditionFailed).Once()
+			},
+			expect: func(output *dtos.TransactionOutput, err error) {
+				s.Nil(output)
+				s.ErrorIs(err, customerrors.ErrPreconditionFailed)
+			},
+		},
 		{
 			name: "should update pix transaction without checking invoice status",
 			args: args{
@@ -188,6 +249,8 @@ func (s *UpdateTransactionUseCaseSuite) TestExecute() {
 				tx := buildTransaction(userID, categoryID, nil)
 				s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
 				s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+				s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.updated", mock.Anything).Return(nil).Once()
 			},
 			expect: func(output *dtos.TransactionOutput, err error) {
 				s.NoError(err)
@@ -204,9 +267,12 @@ func (s *UpdateTransactionUseCaseSuite) TestExecute() {
 				s.obs,
 				&mockUnitOfWork{},
 				s.repo,
+				s.revisionRepo,
+				s.tagRepo,
 				s.invoiceProvider,
+				s.outboxService,
 			)
-			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.transactionID, scenario.args.input)
+			output, err := uc.Execute(s.ctx, scenario.args.userID, scenario.args.transactionID, scenario.args.input, scenario.args.expectedVersion)
 			scenario.expect(output, err)
 		})
 	}
@@ -219,12 +285,180 @@ func (s *UpdateTransactionUseCaseSuite) TestExecuteRepoError() {
 	txID, _ := vos.NewUUIDFromString(txIDStr)
 	s.repo.EXPECT().FindByID(mock.Anything, txID).Return(nil, errors.New("db error")).Once()
 
-	uc := NewUpdateTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.invoiceProvider)
+	uc := NewUpdateTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.tagRepo, s.invoiceProvider, s.outboxService)
 	output, err := uc.Execute(s.ctx, userID, txIDStr, &dtos.TransactionUpdateInput{
 		Description: "Updated",
 		Amount:      200.00,
 		CategoryID:  categoryID,
+	}, nil)
+	s.Error(err)
+	s.Nil(output)
+}
+
+func (s *UpdateTransactionUseCaseSuite) TestExecuteTagsInstallmentGroup() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	invoiceID, _ := vos.NewUUID()
+	groupID, _ := vos.NewUUID()
+	tag := buildTag(userID, "reimbursable")
+
+	buildGroup := func() []*entities.Transaction {
+		installments := make([]*entities.Transaction, 3)
+		for i := range installments {
+			installments[i] = buildTransaction(userID, categoryID, &invoiceID)
+			installments[i].InstallmentGroupID = &groupID
+		}
+		return installments
+	}
+	expectUpdate := func(installments []*entities.Transaction) {
+		s.repo.EXPECT().FindByID(mock.Anything, installments[0].ID).Return(installments[0], nil).Once()
+		s.invoiceProvider.EXPECT().GetStatus(mock.Anything, invoiceID).Return("open", nil).Once()
+		s.tagRepo.EXPECT().FindByIDs(mock.Anything, installments[0].UserID, []vos.UUID{tag.ID}).Return([]*entities.Tag{tag}, nil).Once()
+		s.repo.EXPECT().FindByInstallmentGroup(mock.Anything, groupID).Return(installments, nil).Once()
+		s.repo.EXPECT().Update(mock.Anything, mock.Anything, installments[0]).Return(nil).Once()
+		s.invoiceProvider.EXPECT().UpdateItem(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		s.outboxService.EXPECT().SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.updated", mock.Anything).Return(nil).Once()
+	}
+
+	s.Run("should suggest tagging the other installments", func() {
+		installments := buildGroup()
+		expectUpdate(installments)
+
+		output, err := NewUpdateTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.tagRepo, s.invoiceProvider, s.outboxService).
+			Execute(s.ctx, userID, installments[0].ID.String(), &dtos.TransactionUpdateInput{
+				Description: "Original",
+				Amount:      100.00,
+				CategoryID:  categoryID,
+				TagIDs:      &[]string{tag.ID.String()},
+			}, nil)
+
+		s.NoError(err)
+		s.Len(output.Tags, 1)
+		s.Require().NotNil(output.TagGroupSuggestion)
+		s.Equal(groupID.String(), output.TagGroupSuggestion.InstallmentGroupID)
+		s.Equal(2, output.TagGroupSuggestion.OtherInstallments)
+		s.Empty(installments[1].Tags)
 	})
+
+	s.Run("should tag the whole purchase when requested", func() {
+		installments := buildGroup()
+		expectUpdate(installments)
+		s.repo.EXPECT().SetTags(mock.Anything, mock.Anything, mock.MatchedBy(func(ts []*entities.Transaction) bool {
+			return len(ts) == 2 && len(ts[0].Tags) == 1 && len(ts[1].Tags) == 1
+		})).Return(nil).Once()
+
+		output, err := NewUpdateTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.tagRepo, s.invoiceProvider, s.outboxService).
+			Execute(s.ctx, userID, installments[0].ID.String(), &dtos.TransactionUpdateInput{
+				Description:      "Original",
+				Amount:           100.00,
+				CategoryID:       categoryID,
+				TagIDs:           &[]string{tag.ID.String()},
+				ApplyTagsToGroup: true,
+			}, nil)
+
+		s.NoError(err)
+		s.Nil(output.TagGroupSuggestion)
+	})
+}
+
+func (s *UpdateTransactionUseCaseSuite) TestExecuteEmitsOldAndNewValues() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	oldCategoryID := "550e8400-e29b-41d4-a716-446655440001"
+	newCategoryID := "550e8400-e29b-41d4-a716-446655440002"
+	txIDStr := "660e8400-e29b-41d4-a716-446655440000"
+	txID, _ := vos.NewUUIDFromString(txIDStr)
+	tx := buildTransaction(userID, oldCategoryID, nil)
+	s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+	s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.MatchedBy(func(revisions []*entities.TransactionRevision) bool {
+		if len(revisions) != 1 || revisions[0].Action != entities.RevisionActionUpdated || *revisions[0].ChangedBy != tx.UserID {
+			return false
+		}
+		for _, change := range revisions[0].Changes {
+			if change.Field == "category_id" {
+				return *change.From == oldCategoryID && *change.To == newCategoryID
+			}
+		}
+		return false
+	})).Return(nil).Once()
+	s.outboxService.EXPECT().
+		SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.updated",
+			mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+				return payload["old_category_id"] == oldCategoryID &&
+					payload["category_id"] == newCategoryID &&
+					payload["old_amount"] == int64(10000) &&
+					payload["amount"] == int64(25000) &&
+					payload["old_reference_month"] == "2026-03" &&
+					payload["reference_month"] == "2026-03"
+			})).
+		Return(nil).
+		Once()
+
+	uc := NewUpdateTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.tagRepo, s.invoiceProvider, s.outboxService)
+	output, err := uc.Execute(s.ctx, userID, txIDStr, &dtos.TransactionUpdateInput{
+		Description: "Moved",
+		Amount:      250.00,
+		CategoryID:  newCategoryID,
+	}, nil)
+	s.NoError(err)
+	s.Equal(newCategoryID, output.CategoryID)
+}
+
+func (s *UpdateTransactionUseCaseSuite) TestExecuteReplacesSplits() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	groceriesID := "550e8400-e29b-41d4-a716-446655440001"
+	pharmacyID := "550e8400-e29b-41d4-a716-446655440002"
+	txIDStr := "660e8400-e29b-41d4-a716-446655440000"
+	txID, _ := vos.NewUUIDFromString(txIDStr)
+	tx := buildTransaction(userID, groceriesID, nil)
+	s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+	s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.MatchedBy(func(t *entities.Transaction) bool {
+		return len(t.Splits) == 2 && t.Splits[1].CategoryID.String() == pharmacyID
+	})).Return(nil).Once()
+	s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	s.outboxService.EXPECT().
+		SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, "transaction", "transaction.updated",
+			mock.MatchedBy(func(payload outbox.JSONBPayload) bool {
+				splits, ok := payload["splits"].([]map[string]any)
+				return ok && len(splits) == 2 && splits[1]["category_id"] == pharmacyID
+			})).
+		Return(nil).
+		Once()
+
+	uc := NewUpdateTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.tagRepo, s.invoiceProvider, s.outboxService)
+	output, err := uc.Execute(s.ctx, userID, txIDStr, &dtos.TransactionUpdateInput{
+		Description: "Supermercado",
+		Amount:      100.00,
+		Splits: []dtos.SplitInput{
+			{CategoryID: groceriesID, Amount: 80.00},
+			{CategoryID: pharmacyID, Amount: 20.00},
+		},
+	}, nil)
+	s.NoError(err)
+	s.Equal(groceriesID, output.CategoryID)
+	s.Len(output.Splits, 2)
+}
+
+func (s *UpdateTransactionUseCaseSuite) TestExecuteOutboxError() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	txIDStr := "660e8400-e29b-41d4-a716-446655440000"
+	txID, _ := vos.NewUUIDFromString(txIDStr)
+	tx := buildTransaction(userID, categoryID, nil)
+	s.repo.EXPECT().FindByID(mock.Anything, txID).Return(tx, nil).Once()
+	s.repo.EXPECT().Update(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	s.revisionRepo.EXPECT().SaveAll(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	s.outboxService.EXPECT().
+		SaveDomainEvent(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(errors.New("outbox error")).
+		Once()
+
+	uc := NewUpdateTransactionUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.revisionRepo, s.tagRepo, s.invoiceProvider, s.outboxService)
+	output, err := uc.Execute(s.ctx, userID, txIDStr, &dtos.TransactionUpdateInput{
+		Description: "Updated",
+		Amount:      200.00,
+		CategoryID:  categoryID,
+	}, nil)
 	s.Error(err)
 	s.Nil(output)
 }
@@ -0,0 +1,210 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/database/uow"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/storage"
+)
+
+type (
+	UploadAttachmentUseCase interface {
+		Execute(ctx context.Context, userID string, input *dtos.AttachmentUploadInput) (*dtos.AttachmentOutput, error)
+	}
+
+	uploadAttachmentUseCase struct {
+		o11y       observability.Observability
+		uow        uow.UnitOfWork
+		repository transactionInterfaces.AttachmentRepository
+		owners     attachmentOwners
+		blobStore  storage.BlobStore
+	}
+)
+
+func NewUploadAttachmentUseCase(
+	o11y observability.Observability,
+	unitOfWork uow.UnitOfWork,
+	repository transactionInterfaces.AttachmentRepository,
+	transactionRepository transactionInterfaces.TransactionRepository,
+	invoiceProvider transactionInterfaces.InvoiceProvider,
+	blobStore storage.BlobStore,
+) UploadAttachmentUseCase {
+	return &uploadAttachmentUseCase{
+		o11y:       o11y,
+		uow:        unitOfWork,
+		repository: repository,
+		owners:     attachmentOwners{transactions: transactionRepository, invoices: invoiceProvider},
+		blobStore:  blobStore,
+	}
+}
+
+// Execute stores the file once per content: a blob already uploaded by any attachment is
+// reused, and uploading the same file again to the same owner returns the existing
+// attachment.
+func (u *uploadAttachmentUseCase) Execute(ctx context.Context, userID string, input *dtos.AttachmentUploadInput) (*dtos.AttachmentOutput, error) {
+	ctx, span := u.o11y.Tracer().Start(ctx, "upload_attachment_usecase.execute")
+	defer span.End()
+
+	if input.Content == nil {
+		return nil, transactionDomain.ErrAttachmentRequired
+	}
+
+	ownerType, ownerID, err := u.owners.authorize(ctx, userID, input.OwnerType, input.OwnerID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	content, err := io.ReadAll(io.LimitReader(input.Content, entities.MaxAttachmentSize+1))
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	sum := sha256.Sum256(content)
+
+	userUUID, err := vos.NewUUIDFromString(userID)
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+	attachment, err := entities.NewAttachment(
+		userUUID,
+		ownerType,
+		ownerID,
+		input.FileName,
+		detectContentType(content),
+		int64(len(content)),
+		hex.EncodeToString(sum[:]),
+	)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	existing, err := u.repository.FindByOwnerAndHash(ctx, ownerType, ownerID, attachment.SHA256)
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if existing != nil {
+		return toAttachmentOutput(existing), nil
+	}
+
+	stored, err := u.blobStore.Exists(ctx, attachment.StorageKey())
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+	if !stored {
+		if err := u.blobStore.Put(ctx, attachment.StorageKey(), bytes.NewReader(content)); err != nil {
+			span.RecordError(err)
+			return nil, fmt.Errorf("failed to store attachment: %w", err)
+		}
+	}
+
+	err = u.uow.Do(ctx, func(ctx context.Context, tx database.DBTX) error {
+		return u.repository.Save(ctx, tx, attachment)
+	})
+	if err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	u.o11y.Logger().Info(ctx, "request_completed",
+		observability.String("operation", "UploadAttachment"),
+		observability.String("layer", "usecase"),
+		observability.String("entity", "attachment"),
+		observability.String("user_id", userID),
+	)
+
+	return toAttachmentOutput(attachment), nil
+}
+
+// detectContentType sniffs the content, ignoring the parameters of the media type.
+func detectContentType(content []byte) string {
+	mediaType, _, err := mime.ParseMediaType(http.DetectContentType(content))
+	if err != nil {
+		return "application/octet-stream"
+	}
+	return mediaType
+}
+
+// attachmentOwners enforces that attachments are only reached through a transaction or an
+// invoice of the user, with the same errors the other use cases return for them.
+type attachmentOwners struct {
+	transactions transactionInterfaces.TransactionRepository
+	invoices     transactionInterfaces.InvoiceProvider
+}
+
+func (o attachmentOwners) authorize(ctx context.Context, userID, ownerType, ownerID string) (entities.AttachmentOwnerType, vos.UUID, error) {
+	id, err := vos.NewUUIDFromString(ownerID)
+	if err != nil {
+		return "", vos.UUID{}, fmt.Errorf("invalid %s_id: %w", ownerType, err)
+	}
+
+	switch entities.AttachmentOwnerType(ownerType) {
+	case entities.AttachmentOwnerTransaction:
+		transaction, err := o.transactions.FindByID(ctx, id)
+		if err != nil {
+			return "", vos.UUID{}, err
+		}
+		if transaction == nil {
+			return "", vos.UUID{}, transactionDomain.ErrTransactionNotFound
+		}
+		if transaction.UserID.String() != userID {
+			return "", vos.UUID{}, transactionDomain.ErrTransactionNotOwned
+		}
+	case entities.AttachmentOwnerInvoice:
+		invoice, err := o.invoices.FindByID(ctx, id)
+		if err != nil {
+			return "", vos.UUID{}, err
+		}
+		if invoice == nil {
+			return "", vos.UUID{}, transactionDomain.ErrInvoiceNotFound
+		}
+		if invoice.UserID.String() != userID {
+			return "", vos.UUID{}, transactionDomain.ErrInvoiceNotOwned
+		}
+	default:
+		return "", vos.UUID{}, fmt.Errorf("invalid attachment owner type: %s", ownerType)
+	}
+	return entities.AttachmentOwnerType(ownerType), id, nil
+}
+
+// findAttachment loads an attachment and checks its owner.
+func (o attachmentOwners) findAttachment(
+	ctx context.Context,
+	repository transactionInterfaces.AttachmentRepository,
+	userID, attachmentID string,
+) (*entities.Attachment, error) {
+	id, err := vos.NewUUIDFromString(attachmentID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attachment_id: %w", err)
+	}
+	attachment, err := repository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if attachment == nil {
+		return nil, transactionDomain.ErrAttachmentNotFound
+	}
+	if _, _, err := o.authorize(ctx, userID, string(attachment.OwnerType), attachment.OwnerID.String()); err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
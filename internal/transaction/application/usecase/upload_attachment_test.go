@@ -0,0 +1,200 @@
+package usecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionInterfaces "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionMocks "github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces/mocks"
+	storageMocks "github.com/jailtonjunior94/financial/pkg/storage/mocks"
+)
+
+const receiptPDF = "%PDF-1.4\n1 0 obj << /Type /Catalog >> endobj\ntrailer << /Root 1 0 R >>\n%%EOF\n"
+
+type UploadAttachmentUseCaseSuite struct {
+	suite.Suite
+	ctx             context.Context
+	obs             *fake.Provider
+	repo            *transactionMocks.AttachmentRepository
+	transactionRepo *transactionMocks.TransactionRepository
+	invoiceProvider *transactionMocks.InvoiceProvider
+	blobStore       *storageMocks.BlobStore
+}
+
+func TestUploadAttachmentUseCaseSuite(t *testing.T) {
+	suite.Run(t, new(UploadAttachmentUseCaseSuite))
+}
+
+func (s *UploadAttachmentUseCaseSuite) SetupTest() {
+	s.obs = fake.NewProvider()
+	s.ctx = context.Background()
+	s.repo = transactionMocks.NewAttachmentRepository(s.T())
+	s.transactionRepo = transactionMocks.NewTransactionRepository(s.T())
+	s.invoiceProvider = transactionMocks.NewInvoiceProvider(s.T())
+	s.blobStore = storageMocks.NewBlobStore(s.T())
+}
+
+func (s *UploadAttachmentUseCaseSuite) useCase() UploadAttachmentUseCase {
+	return NewUploadAttachmentUseCase(s.obs, &mockUnitOfWork{}, s.repo, s.transactionRepo, s.invoiceProvider, s.blobStore)
+}
+
+func buildAttachment(userID string, ownerType entities.AttachmentOwnerType, ownerID vos.UUID, content string) *entities.Attachment {
+	userUUID, _ := vos.NewUUIDFromString(userID)
+	sum := sha256.Sum256([]byte(content))
+	attachment, _ := entities.NewAttachment(userUUID, ownerType, ownerID, "nota.pdf", "application/pdf", int64(len(content)), hex.EncodeToString(sum[:]))
+	return attachment
+}
+
+func (s *UploadAttachmentUseCaseSuite) TestExecute() {
+	userID := "550e8400-e29b-41d4-a716-446655440000"
+	categoryID := "550e8400-e29b-41d4-a716-446655440001"
+	sum := sha256.Sum256([]byte(receiptPDF))
+	hash := hex.EncodeToString(sum[:])
+
+	s.Run("should store a new receipt of a transaction", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+		s.repo.EXPECT().FindByOwnerAndHash(mock.Anything, entities.AttachmentOwnerTransaction, transaction.ID, hash).Return(nil, nil).Once()
+		s.blobStore.EXPECT().Exists(mock.Anything, entities.AttachmentStorageKey(hash)).Return(false, nil).Once()
+		s.blobStore.EXPECT().Put(mock.Anything, entities.AttachmentStorageKey(hash), mock.Anything).Return(nil).Once()
+		s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.MatchedBy(func(a *entities.Attachment) bool {
+			return a.ContentType == "application/pdf" && a.Size == int64(len(receiptPDF)) && a.FileName == "nota.pdf"
+		})).Return(nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.AttachmentUploadInput{
+			OwnerType: "transaction",
+			OwnerID:   transaction.ID.String(),
+			FileName:  "nota.pdf",
+			Content:   strings.NewReader(receiptPDF),
+		})
+
+		s.NoError(err)
+		s.Equal(hash, output.SHA256)
+		s.Equal("transaction", output.OwnerType)
+	})
+
+	s.Run("should reuse the blob of an identical file", func() {
+		invoiceID, _ := vos.NewUUID()
+		userUUID, _ := vos.NewUUIDFromString(userID)
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, invoiceID).
+			Return(&transactionInterfaces.InvoiceInfo{ID: invoiceID, UserID: userUUID, Status: "paid"}, nil).Once()
+		s.repo.EXPECT().FindByOwnerAndHash(mock.Anything, entities.AttachmentOwnerInvoice, invoiceID, hash).Return(nil, nil).Once()
+		s.blobStore.EXPECT().Exists(mock.Anything, entities.AttachmentStorageKey(hash)).Return(true, nil).Once()
+		s.repo.EXPECT().Save(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.AttachmentUploadInput{
+			OwnerType: "invoice",
+			OwnerID:   invoiceID.String(),
+			FileName:  "fatura.pdf",
+			Content:   strings.NewReader(receiptPDF),
+		})
+
+		s.NoError(err)
+		s.Equal("invoice", output.OwnerType)
+	})
+
+	s.Run("should return the existing attachment when the file was already uploaded", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		existing := buildAttachment(userID, entities.AttachmentOwnerTransaction, transaction.ID, receiptPDF)
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+		s.repo.EXPECT().FindByOwnerAndHash(mock.Anything, entities.AttachmentOwnerTransaction, transaction.ID, hash).Return(existing, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.AttachmentUploadInput{
+			OwnerType: "transaction",
+			OwnerID:   transaction.ID.String(),
+			FileName:  "copia.pdf",
+			Content:   strings.NewReader(receiptPDF),
+		})
+
+		s.NoError(err)
+		s.Equal(existing.ID.String(), output.ID)
+	})
+
+	s.Run("should reject a transaction of another user", func() {
+		transaction := buildTransaction("550e8400-e29b-41d4-a716-446655440099", categoryID, nil)
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.AttachmentUploadInput{
+			OwnerType: "transaction",
+			OwnerID:   transaction.ID.String(),
+			Content:   strings.NewReader(receiptPDF),
+		})
+
+		s.ErrorIs(err, transactionDomain.ErrTransactionNotOwned)
+		s.Nil(output)
+	})
+
+	s.Run("should reject an invoice of another user", func() {
+		invoiceID, _ := vos.NewUUID()
+		otherUser, _ := vos.NewUUID()
+		s.invoiceProvider.EXPECT().FindByID(mock.Anything, invoiceID).
+			Return(&transactionInterfaces.InvoiceInfo{ID: invoiceID, UserID: otherUser, Status: "open"}, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.AttachmentUploadInput{
+			OwnerType: "invoice",
+			OwnerID:   invoiceID.String(),
+			Content:   strings.NewReader(receiptPDF),
+		})
+
+		s.ErrorIs(err, transactionDomain.ErrInvoiceNotOwned)
+		s.Nil(output)
+	})
+
+	s.Run("should reject content that is not a receipt", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.AttachmentUploadInput{
+			OwnerType: "transaction",
+			OwnerID:   transaction.ID.String(),
+			FileName:  "nota.pdf",
+			Content:   strings.NewReader("#!/bin/sh\nrm -rf /\n"),
+		})
+
+		s.ErrorIs(err, transactionDomain.ErrUnsupportedAttachmentType)
+		s.Nil(output)
+	})
+
+	s.Run("should reject a file above the maximum size", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.AttachmentUploadInput{
+			OwnerType: "transaction",
+			OwnerID:   transaction.ID.String(),
+			Content:   strings.NewReader(receiptPDF + strings.Repeat("0", entities.MaxAttachmentSize)),
+		})
+
+		s.ErrorIs(err, transactionDomain.ErrAttachmentTooLarge)
+		s.Nil(output)
+	})
+
+	s.Run("should return blob store error", func() {
+		transaction := buildTransaction(userID, categoryID, nil)
+		s.transactionRepo.EXPECT().FindByID(mock.Anything, transaction.ID).Return(transaction, nil).Once()
+		s.repo.EXPECT().FindByOwnerAndHash(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+		s.blobStore.EXPECT().Exists(mock.Anything, mock.Anything).Return(false, nil).Once()
+		s.blobStore.EXPECT().Put(mock.Anything, mock.Anything, mock.Anything).Return(errors.New("disk full")).Once()
+
+		output, err := s.useCase().Execute(s.ctx, userID, &dtos.AttachmentUploadInput{
+			OwnerType: "transaction",
+			OwnerID:   transaction.ID.String(),
+			Content:   strings.NewReader(receiptPDF),
+		})
+
+		s.Error(err)
+		s.Nil(output)
+	})
+}
@@ -0,0 +1,142 @@
+package entities
+
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// MaxAttachmentSize is the maximum size of an attachment, in bytes (10 MB).
+const MaxAttachmentSize = 10 << 20
+
+// maxAttachmentFileNameLength matches the file_name column.
+const maxAttachmentFileNameLength = 255
+
+// AttachmentOwnerType identifies what an attachment belongs to.
+type AttachmentOwnerType string
+
+const (
+	AttachmentOwnerTransaction AttachmentOwnerType = "transaction"
+	AttachmentOwnerInvoice     AttachmentOwnerType = "invoice"
+)
+
+// attachmentExtensions maps the accepted content types to the extension used when the
+// uploaded file has no name.
+var attachmentExtensions = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/webp":      ".webp",
+}
+
+// Attachment is a receipt stored in the blob store and linked to a transaction or an
+// invoice. Uploads with the same content share one blob, addressed by SHA256.
+type Attachment struct {
+	ID          vos.UUID
+	UserID      vos.UUID
+	OwnerType   AttachmentOwnerType
+	OwnerID     vos.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	SHA256      string
+	CreatedAt   time.Time
+	DeletedAt   *time.Time
+}
+
+// NewAttachment creates an Attachment, validating its content type and size.
+func NewAttachment(
+	userID vos.UUID,
+	ownerType AttachmentOwnerType,
+	ownerID vos.UUID,
+	fileName, contentType string,
+	size int64,
+	sha256 string,
+) (*Attachment, error) {
+	if ownerType != AttachmentOwnerTransaction && ownerType != AttachmentOwnerInvoice {
+		return nil, fmt.Errorf("invalid attachment owner type: %s", ownerType)
+	}
+	if size <= 0 {
+		return nil, transactionDomain.ErrAttachmentRequired
+	}
+	if size > MaxAttachmentSize {
+		return nil, transactionDomain.ErrAttachmentTooLarge
+	}
+	if !IsAllowedAttachmentType(contentType) {
+		return nil, fmt.Errorf("%w: %s", transactionDomain.ErrUnsupportedAttachmentType, contentType)
+	}
+	if len(sha256) != 64 {
+		return nil, fmt.Errorf("invalid attachment hash")
+	}
+
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &Attachment{
+		ID:          id,
+		UserID:      userID,
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		FileName:    SanitizeAttachmentFileName(fileName, contentType),
+		ContentType: contentType,
+		Size:        size,
+		SHA256:      sha256,
+		CreatedAt:   time.Now().UTC(),
+	}, nil
+}
+
+// StorageKey returns the blob store key of the attachment content.
+func (a *Attachment) StorageKey() string {
+	return AttachmentStorageKey(a.SHA256)
+}
+
+// Delete soft-deletes the attachment.
+func (a *Attachment) Delete() {
+	now := time.Now().UTC()
+	a.DeletedAt = &now
+}
+
+// MoveTo links the attachment to another owner of the same type. The content and its
+// blob are unchanged.
+func (a *Attachment) MoveTo(ownerID vos.UUID) {
+	a.OwnerID = ownerID
+}
+
+// AttachmentStorageKey returns the blob store key of a content hash. The first two
+// characters spread the blobs across directories.
+func AttachmentStorageKey(sha256 string) string {
+	return "attachments/sha256/" + sha256[:2] + "/" + sha256
+}
+
+// IsAllowedAttachmentType reports whether attachments of the content type are accepted.
+func IsAllowedAttachmentType(contentType string) bool {
+	_, ok := attachmentExtensions[contentType]
+	return ok
+}
+
+// SanitizeAttachmentFileName keeps only the base name of the uploaded file, without
+// control characters or quotes, so it can be echoed in a Content-Disposition header.
+func SanitizeAttachmentFileName(name, contentType string) string {
+	name = path.Base(strings.ReplaceAll(name, "\\", "/"))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) || r == '"' {
+			return -1
+		}
+		return r
+	}, name)
+	name = strings.TrimSpace(name)
+	if name == "" || name == "." || name == "/" {
+		return "attachment" + attachmentExtensions[contentType]
+	}
+	if runes := []rune(name); len(runes) > maxAttachmentFileNameLength {
+		name = string(runes[len(runes)-maxAttachmentFileNameLength:])
+	}
+	return name
+}
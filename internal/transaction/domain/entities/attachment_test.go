@@ -0,0 +1,81 @@
+package entities_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+func TestNewAttachment(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	ownerID, _ := vos.NewUUID()
+	hash := strings.Repeat("ab", 32)
+
+	t.Run("should create an attachment addressed by its hash", func(t *testing.T) {
+		attachment, err := entities.NewAttachment(userID, entities.AttachmentOwnerTransaction, ownerID, "nota.pdf", "application/pdf", 1024, hash)
+		require.NoError(t, err)
+		require.Equal(t, "nota.pdf", attachment.FileName)
+		require.Equal(t, "attachments/sha256/ab/"+hash, attachment.StorageKey())
+		require.Nil(t, attachment.DeletedAt)
+
+		attachment.Delete()
+		require.NotNil(t, attachment.DeletedAt)
+	})
+
+	t.Run("should move to another owner keeping its content", func(t *testing.T) {
+		attachment, err := entities.NewAttachment(userID, entities.AttachmentOwnerTransaction, ownerID, "nota.pdf", "application/pdf", 1024, hash)
+		require.NoError(t, err)
+		otherOwnerID, _ := vos.NewUUID()
+
+		attachment.MoveTo(otherOwnerID)
+
+		require.Equal(t, otherOwnerID, attachment.OwnerID)
+		require.Equal(t, entities.AttachmentOwnerTransaction, attachment.OwnerType)
+		require.Equal(t, "attachments/sha256/ab/"+hash, attachment.StorageKey())
+	})
+
+	t.Run("should reject an unsupported content type", func(t *testing.T) {
+		_, err := entities.NewAttachment(userID, entities.AttachmentOwnerInvoice, ownerID, "run.sh", "text/plain", 10, hash)
+		require.ErrorIs(t, err, transactionDomain.ErrUnsupportedAttachmentType)
+	})
+
+	t.Run("should reject an empty or oversized file", func(t *testing.T) {
+		_, err := entities.NewAttachment(userID, entities.AttachmentOwnerInvoice, ownerID, "a.png", "image/png", 0, hash)
+		require.ErrorIs(t, err, transactionDomain.ErrAttachmentRequired)
+
+		_, err = entities.NewAttachment(userID, entities.AttachmentOwnerInvoice, ownerID, "a.png", "image/png", entities.MaxAttachmentSize+1, hash)
+		require.ErrorIs(t, err, transactionDomain.ErrAttachmentTooLarge)
+	})
+
+	t.Run("should reject an unknown owner type", func(t *testing.T) {
+		_, err := entities.NewAttachment(userID, "budget", ownerID, "a.png", "image/png", 10, hash)
+		require.Error(t, err)
+	})
+}
+
+func TestSanitizeAttachmentFileName(t *testing.T) {
+	tests := []struct {
+		name        string
+		fileName    string
+		contentType string
+		want        string
+	}{
+		{name: "keeps a plain name", fileName: "recibo março.jpg", contentType: "image/jpeg", want: "recibo março.jpg"},
+		{name: "drops the client path", fileName: `C:\Users\ana\nota.pdf`, contentType: "application/pdf", want: "nota.pdf"},
+		{name: "drops directory traversal", fileName: "../../etc/passwd", contentType: "application/pdf", want: "passwd"},
+		{name: "removes quotes and control characters", fileName: "a\"b\r\n.png", contentType: "image/png", want: "ab.png"},
+		{name: "names an unnamed file after its type", fileName: "", contentType: "image/webp", want: "attachment.webp"},
+		{name: "keeps the end of a long name", fileName: strings.Repeat("a", 300) + ".pdf", contentType: "application/pdf", want: strings.Repeat("a", 251) + ".pdf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, entities.SanitizeAttachmentFileName(tt.fileName, tt.contentType))
+		})
+	}
+}
@@ -0,0 +1,257 @@
+package entities
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+const (
+	// MaxCategorizationRuleNameLength is the maximum length of a rule name, in characters.
+	MaxCategorizationRuleNameLength = 100
+	// MaxCategorizationRuleTextLength is the maximum length of the description conditions.
+	MaxCategorizationRuleTextLength = 255
+)
+
+// CategorizationRuleConditions are the conditions a transaction must meet, all of them,
+// for a rule to match. Empty conditions are not checked, but at least one is required.
+type CategorizationRuleConditions struct {
+	DescriptionContains string
+	DescriptionRegex    string
+	MinAmount           *vos.Money
+	MaxAmount           *vos.Money
+	PaymentMethod       string
+	CardID              *vos.UUID
+}
+
+// CategorizationRuleOutcome is what a matching rule sets on the transaction.
+type CategorizationRuleOutcome struct {
+	CategoryID    vos.UUID
+	SubcategoryID *vos.UUID
+	TagIDs        []vos.UUID
+}
+
+// CategorizationSubject is the transaction, or purchase, evaluated against the rules.
+// Amount is the purchase total, so installments match as the whole purchase.
+type CategorizationSubject struct {
+	Description   string
+	Amount        vos.Money
+	PaymentMethod string
+	CardID        string
+}
+
+// CategorizationRule resolves the category, subcategory and tags of transactions
+// entered without a category. Rules of a user are evaluated by ascending Priority and
+// the first enabled rule that matches wins.
+type CategorizationRule struct {
+	ID         vos.UUID
+	UserID     vos.UUID
+	Name       string
+	Priority   int
+	Enabled    bool
+	Conditions CategorizationRuleConditions
+	Outcome    CategorizationRuleOutcome
+	CreatedAt  time.Time
+	UpdatedAt  *time.Time
+	DeletedAt  *time.Time
+
+	pattern *regexp.Regexp
+}
+
+// NewCategorizationRule creates an enabled or disabled rule, validating its conditions.
+func NewCategorizationRule(
+	userID vos.UUID,
+	name string,
+	priority int,
+	enabled bool,
+	conditions CategorizationRuleConditions,
+	outcome CategorizationRuleOutcome,
+) (*CategorizationRule, error) {
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	rule := &CategorizationRule{
+		ID:        id,
+		UserID:    userID,
+		CreatedAt: time.Now().UTC(),
+	}
+	if err := rule.set(name, priority, enabled, conditions, outcome); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+// Update replaces the definition of the rule.
+func (r *CategorizationRule) Update(
+	name string,
+	priority int,
+	enabled bool,
+	conditions CategorizationRuleConditions,
+	outcome CategorizationRuleOutcome,
+) error {
+	if err := r.set(name, priority, enabled, conditions, outcome); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	r.UpdatedAt = &now
+	return nil
+}
+
+// Delete soft-deletes the rule.
+func (r *CategorizationRule) Delete() {
+	now := time.Now().UTC()
+	r.UpdatedAt = &now
+	r.DeletedAt = &now
+}
+
+func (r *CategorizationRule) set(
+	name string,
+	priority int,
+	enabled bool,
+	conditions CategorizationRuleConditions,
+	outcome CategorizationRuleOutcome,
+) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	if utf8.RuneCountInString(name) > MaxCategorizationRuleNameLength {
+		return fmt.Errorf("%w: name cannot exceed %d characters", transactionDomain.ErrInvalidCategorizationRule, MaxCategorizationRuleNameLength)
+	}
+	if priority < 0 {
+		return fmt.Errorf("%w: priority cannot be negative", transactionDomain.ErrInvalidCategorizationRule)
+	}
+
+	conditions.DescriptionContains = strings.Join(strings.Fields(conditions.DescriptionContains), " ")
+	conditions.DescriptionRegex = strings.TrimSpace(conditions.DescriptionRegex)
+	pattern, err := validateConditions(conditions)
+	if err != nil {
+		return err
+	}
+
+	r.Name = name
+	r.Priority = priority
+	r.Enabled = enabled
+	r.Conditions = conditions
+	r.Outcome = outcome
+	r.pattern = pattern
+	return nil
+}
+
+func validateConditions(c CategorizationRuleConditions) (*regexp.Regexp, error) {
+	if c.DescriptionContains == "" && c.DescriptionRegex == "" && c.MinAmount == nil &&
+		c.MaxAmount == nil && c.PaymentMethod == "" && c.CardID == nil {
+		return nil, fmt.Errorf("%w: at least one condition is required", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	if utf8.RuneCountInString(c.DescriptionContains) > MaxCategorizationRuleTextLength ||
+		utf8.RuneCountInString(c.DescriptionRegex) > MaxCategorizationRuleTextLength {
+		return nil, fmt.Errorf("%w: description conditions cannot exceed %d characters", transactionDomain.ErrInvalidCategorizationRule, MaxCategorizationRuleTextLength)
+	}
+	if c.MinAmount != nil && !c.MinAmount.IsPositive() || c.MaxAmount != nil && !c.MaxAmount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount limits must be positive", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	if c.MinAmount != nil && c.MaxAmount != nil && c.MinAmount.GreaterThan(*c.MaxAmount) {
+		return nil, fmt.Errorf("%w: min_amount cannot exceed max_amount", transactionDomain.ErrInvalidCategorizationRule)
+	}
+	if c.PaymentMethod != "" {
+		if _, err := transactionVos.NewPaymentMethod(c.PaymentMethod); err != nil {
+			return nil, fmt.Errorf("%w: invalid payment_method", transactionDomain.ErrInvalidCategorizationRule)
+		}
+	}
+	if c.DescriptionRegex == "" {
+		return nil, nil
+	}
+	pattern, err := compileDescriptionRegex(c.DescriptionRegex)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid description_regex: %v", transactionDomain.ErrInvalidCategorizationRule, err)
+	}
+	return pattern, nil
+}
+
+// compileDescriptionRegex compiles the pattern case-insensitively. Go regular
+// expressions run in linear time, so user patterns cannot stall the evaluation.
+func compileDescriptionRegex(expr string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)" + expr)
+}
+
+// Matches reports whether the subject meets every condition of the rule. Disabled rules
+// never match.
+func (r *CategorizationRule) Matches(subject CategorizationSubject) bool {
+	if !r.Enabled {
+		return false
+	}
+	c := r.Conditions
+	if c.DescriptionContains != "" &&
+		!strings.Contains(normalizeRuleText(subject.Description), normalizeRuleText(c.DescriptionContains)) {
+		return false
+	}
+	if c.DescriptionRegex != "" {
+		if r.pattern == nil {
+			pattern, err := compileDescriptionRegex(c.DescriptionRegex)
+			if err != nil {
+				return false
+			}
+			r.pattern = pattern
+		}
+		if !r.pattern.MatchString(subject.Description) {
+			return false
+		}
+	}
+	if c.MinAmount != nil && !subject.Amount.GreaterThanOrEqual(*c.MinAmount) {
+		return false
+	}
+	if c.MaxAmount != nil && !subject.Amount.LessThanOrEqual(*c.MaxAmount) {
+		return false
+	}
+	if c.PaymentMethod != "" && c.PaymentMethod != subject.PaymentMethod {
+		return false
+	}
+	if c.CardID != nil && c.CardID.String() != subject.CardID {
+		return false
+	}
+	return true
+}
+
+// MatchingCategorizationRules returns the rules matching the subject in evaluation order:
+// ascending priority, then creation. The first one is the rule that applies.
+func MatchingCategorizationRules(rules []*CategorizationRule, subject CategorizationSubject) []*CategorizationRule {
+	ordered := make([]*CategorizationRule, len(rules))
+	copy(ordered, rules)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority < ordered[j].Priority
+		}
+		return ordered[i].CreatedAt.Before(ordered[j].CreatedAt)
+	})
+
+	matched := make([]*CategorizationRule, 0, len(ordered))
+	for _, rule := range ordered {
+		if rule.Matches(subject) {
+			matched = append(matched, rule)
+		}
+	}
+	return matched
+}
+
+// normalizeRuleText lowercases the text, strips its accents and collapses its
+// whitespace, so "Cartão  Uber" contains "cartao uber".
+func normalizeRuleText(value string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), value)
+	if err != nil {
+		stripped = value
+	}
+	return strings.ToLower(strings.Join(strings.Fields(stripped), " "))
+}
@@ -0,0 +1,161 @@
+package entities_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+func money(t *testing.T, value float64) *vos.Money {
+	t.Helper()
+	m, err := vos.NewMoneyFromFloat(value, vos.CurrencyBRL)
+	require.NoError(t, err)
+	return &m
+}
+
+func TestNewCategorizationRule(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	outcome := entities.CategorizationRuleOutcome{CategoryID: categoryID}
+
+	t.Run("should create a rule with trimmed conditions", func(t *testing.T) {
+		rule, err := entities.NewCategorizationRule(userID, " Uber ", 10, true,
+			entities.CategorizationRuleConditions{DescriptionContains: "  uber   trip "}, outcome)
+		require.NoError(t, err)
+		require.Equal(t, "Uber", rule.Name)
+		require.Equal(t, "uber trip", rule.Conditions.DescriptionContains)
+		require.Equal(t, 10, rule.Priority)
+		require.True(t, rule.Enabled)
+	})
+
+	t.Run("should reject invalid definitions", func(t *testing.T) {
+		scenarios := []struct {
+			name       string
+			ruleName   string
+			priority   int
+			conditions entities.CategorizationRuleConditions
+		}{
+			{name: "blank name", ruleName: " ", conditions: entities.CategorizationRuleConditions{PaymentMethod: "pix"}},
+			{name: "long name", ruleName: strings.Repeat("a", entities.MaxCategorizationRuleNameLength+1), conditions: entities.CategorizationRuleConditions{PaymentMethod: "pix"}},
+			{name: "negative priority", ruleName: "pix", priority: -1, conditions: entities.CategorizationRuleConditions{PaymentMethod: "pix"}},
+			{name: "no condition", ruleName: "empty"},
+			{name: "invalid regex", ruleName: "regex", conditions: entities.CategorizationRuleConditions{DescriptionRegex: "uber("}},
+			{name: "invalid payment method", ruleName: "cash", conditions: entities.CategorizationRuleConditions{PaymentMethod: "cash"}},
+			{name: "inverted amount range", ruleName: "range", conditions: entities.CategorizationRuleConditions{MinAmount: money(t, 50), MaxAmount: money(t, 10)}},
+		}
+		for _, scenario := range scenarios {
+			t.Run(scenario.name, func(t *testing.T) {
+				_, err := entities.NewCategorizationRule(userID, scenario.ruleName, scenario.priority, true, scenario.conditions, outcome)
+				require.ErrorIs(t, err, transactionDomain.ErrInvalidCategorizationRule)
+			})
+		}
+	})
+}
+
+func TestCategorizationRule_Matches(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	cardID, _ := vos.NewUUID()
+	outcome := entities.CategorizationRuleOutcome{CategoryID: categoryID}
+
+	subject := entities.CategorizationSubject{
+		Description:   "PIX Café São Paulo",
+		Amount:        *money(t, 25.90),
+		PaymentMethod: "debit",
+		CardID:        cardID.String(),
+	}
+
+	scenarios := []struct {
+		name       string
+		conditions entities.CategorizationRuleConditions
+		expected   bool
+	}{
+		{name: "contains ignoring case and accents", conditions: entities.CategorizationRuleConditions{DescriptionContains: "cafe sao"}, expected: true},
+		{name: "contains not found", conditions: entities.CategorizationRuleConditions{DescriptionContains: "uber"}},
+		{name: "regex ignoring case", conditions: entities.CategorizationRuleConditions{DescriptionRegex: `^pix\s+caf`}, expected: true},
+		{name: "regex not matching", conditions: entities.CategorizationRuleConditions{DescriptionRegex: `^ted`}},
+		{name: "amount inside inclusive range", conditions: entities.CategorizationRuleConditions{MinAmount: money(t, 25.90), MaxAmount: money(t, 25.90)}, expected: true},
+		{name: "amount below minimum", conditions: entities.CategorizationRuleConditions{MinAmount: money(t, 26)}},
+		{name: "amount above maximum", conditions: entities.CategorizationRuleConditions{MaxAmount: money(t, 25.89)}},
+		{name: "payment method", conditions: entities.CategorizationRuleConditions{PaymentMethod: "debit"}, expected: true},
+		{name: "other payment method", conditions: entities.CategorizationRuleConditions{PaymentMethod: "pix"}},
+		{name: "card", conditions: entities.CategorizationRuleConditions{CardID: &cardID}, expected: true},
+		{name: "every condition must match", conditions: entities.CategorizationRuleConditions{DescriptionContains: "cafe", PaymentMethod: "credit"}},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			rule, err := entities.NewCategorizationRule(userID, scenario.name, 0, true, scenario.conditions, outcome)
+			require.NoError(t, err)
+			require.Equal(t, scenario.expected, rule.Matches(subject))
+		})
+	}
+
+	t.Run("should never match when disabled", func(t *testing.T) {
+		rule, err := entities.NewCategorizationRule(userID, "disabled", 0, false,
+			entities.CategorizationRuleConditions{DescriptionContains: "cafe"}, outcome)
+		require.NoError(t, err)
+		require.False(t, rule.Matches(subject))
+	})
+
+	t.Run("should compile the regex of a rule loaded from storage", func(t *testing.T) {
+		rule := &entities.CategorizationRule{
+			Enabled:    true,
+			Conditions: entities.CategorizationRuleConditions{DescriptionRegex: "são paulo$"},
+		}
+		require.True(t, rule.Matches(subject))
+	})
+}
+
+func TestMatchingCategorizationRules(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	outcome := entities.CategorizationRuleOutcome{CategoryID: categoryID}
+	build := func(name string, priority int, contains string) *entities.CategorizationRule {
+		rule, err := entities.NewCategorizationRule(userID, name, priority, true,
+			entities.CategorizationRuleConditions{DescriptionContains: contains}, outcome)
+		require.NoError(t, err)
+		return rule
+	}
+
+	older := build("older", 5, "uber")
+	older.CreatedAt = time.Now().Add(-time.Hour)
+	newer := build("newer", 5, "uber")
+	first := build("first", 1, "trip")
+	other := build("other", 0, "ifood")
+
+	matched := entities.MatchingCategorizationRules(
+		[]*entities.CategorizationRule{newer, other, older, first},
+		entities.CategorizationSubject{Description: "Uber trip", Amount: *money(t, 10)},
+	)
+
+	require.Equal(t, []*entities.CategorizationRule{first, older, newer}, matched)
+}
+
+func TestCategorizationRule_UpdateAndDelete(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	rule, err := entities.NewCategorizationRule(userID, "uber", 0, true,
+		entities.CategorizationRuleConditions{DescriptionContains: "uber"},
+		entities.CategorizationRuleOutcome{CategoryID: categoryID})
+	require.NoError(t, err)
+
+	err = rule.Update("ifood", 3, false, entities.CategorizationRuleConditions{DescriptionRegex: "ifood|rappi"}, rule.Outcome)
+	require.NoError(t, err)
+	require.Equal(t, "ifood", rule.Name)
+	require.Equal(t, "ifood|rappi", rule.Conditions.DescriptionRegex)
+	require.False(t, rule.Enabled)
+	require.NotNil(t, rule.UpdatedAt)
+
+	err = rule.Update("broken", 3, true, entities.CategorizationRuleConditions{}, rule.Outcome)
+	require.ErrorIs(t, err, transactionDomain.ErrInvalidCategorizationRule)
+	require.Equal(t, "ifood", rule.Name)
+
+	rule.Delete()
+	require.NotNil(t, rule.DeletedAt)
+}
@@ -0,0 +1,122 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// DuplicateDateWindow is how far apart the dates of two likely duplicates can be. A
+// purchase entered by hand is often dated a day or two before the bank posts it.
+const DuplicateDateWindow = 2 * 24 * time.Hour
+
+// minDescriptionTokenLength ignores short tokens ("de", "*", "sa") when comparing
+// descriptions, so they alone never make two descriptions similar.
+const minDescriptionTokenLength = 3
+
+// DuplicatePair is a likely duplicate and the transaction it repeats. Original is the
+// transaction entered first.
+type DuplicatePair struct {
+	Original  *Transaction
+	Duplicate *Transaction
+}
+
+// IsLikelyDuplicateOf reports whether t and other are probably the same purchase entered
+// twice: same user, direction, payment method and amount, dates at most
+// DuplicateDateWindow apart and similar descriptions. Installments of the same purchase
+// are never duplicates of each other.
+func (t *Transaction) IsLikelyDuplicateOf(other *Transaction) bool {
+	if t.ID.String() == other.ID.String() || t.UserID.String() != other.UserID.String() {
+		return false
+	}
+	if t.InstallmentGroupID != nil && other.InstallmentGroupID != nil &&
+		t.InstallmentGroupID.String() == other.InstallmentGroupID.String() {
+		return false
+	}
+	if t.Direction.String() != other.Direction.String() ||
+		t.PaymentMethod.String() != other.PaymentMethod.String() ||
+		t.Amount.Cents() != other.Amount.Cents() {
+		return false
+	}
+	gap := t.TransactionDate.Sub(other.TransactionDate)
+	if gap < -DuplicateDateWindow || gap > DuplicateDateWindow {
+		return false
+	}
+	return SimilarDescriptions(t.Description, other.Description)
+}
+
+// SimilarDescriptions compares descriptions ignoring case, accents and punctuation. They
+// are similar when the words of one contain the words of the other ("Padaria" and
+// "PIX ENVIADO PADARIA SAO JOSE") or when they share at least half of their words.
+func SimilarDescriptions(a, b string) bool {
+	tokensA, tokensB := descriptionTokens(a), descriptionTokens(b)
+	if len(tokensA) == 0 || len(tokensB) == 0 {
+		return false
+	}
+
+	shared := 0
+	for token := range tokensA {
+		if tokensB[token] {
+			shared++
+		}
+	}
+	if shared == min(len(tokensA), len(tokensB)) {
+		return true
+	}
+	union := len(tokensA) + len(tokensB) - shared
+	return shared*2 >= union
+}
+
+// FindLikelyDuplicates returns the pairs of likely duplicates among the transactions,
+// ordered by the date of the duplicate. A transaction repeated more than once appears in
+// one pair per repetition.
+func FindLikelyDuplicates(transactions []*Transaction) []DuplicatePair {
+	buckets := make(map[int64][]*Transaction)
+	for _, t := range transactions {
+		buckets[t.Amount.Cents()] = append(buckets[t.Amount.Cents()], t)
+	}
+
+	pairs := make([]DuplicatePair, 0)
+	for _, bucket := range buckets {
+		for i, a := range bucket {
+			for _, b := range bucket[i+1:] {
+				if !a.IsLikelyDuplicateOf(b) {
+					continue
+				}
+				if enteredBefore(b, a) {
+					a, b = b, a
+				}
+				pairs = append(pairs, DuplicatePair{Original: a, Duplicate: b})
+			}
+		}
+	}
+
+	sort.Slice(pairs, func(i, j int) bool {
+		if !pairs[i].Duplicate.TransactionDate.Equal(pairs[j].Duplicate.TransactionDate) {
+			return pairs[i].Duplicate.TransactionDate.Before(pairs[j].Duplicate.TransactionDate)
+		}
+		return pairs[i].Duplicate.ID.String() < pairs[j].Duplicate.ID.String()
+	})
+	return pairs
+}
+
+func enteredBefore(a, b *Transaction) bool {
+	if !a.CreatedAt.Equal(b.CreatedAt) {
+		return a.CreatedAt.Before(b.CreatedAt)
+	}
+	return a.ID.String() < b.ID.String()
+}
+
+func descriptionTokens(description string) map[string]bool {
+	words := strings.FieldsFunc(normalizeRuleText(description), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	tokens := make(map[string]bool, len(words))
+	for _, word := range words {
+		if len([]rune(word)) >= minDescriptionTokenLength {
+			tokens[word] = true
+		}
+	}
+	return tokens
+}
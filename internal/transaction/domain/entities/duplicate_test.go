@@ -0,0 +1,112 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+func TestSimilarDescriptions(t *testing.T) {
+	scenarios := []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "Padaria", b: "PIX ENVIADO PADARIA SAO JOSE", expected: true},
+		{a: "Café São Paulo", b: "cafe sao paulo", expected: true},
+		{a: "UBER *TRIP", b: "Uber trip help.uber.com", expected: true},
+		{a: "Mercado Extra Loja 12", b: "Extra Mercado", expected: true},
+		{a: "Mercado Extra", b: "Mercado Carrefour", expected: false},
+		{a: "Padaria", b: "Farmácia", expected: false},
+		{a: "PIX", b: "PIX ENVIADO PADARIA", expected: true},
+		{a: "*", b: "Padaria", expected: false},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.a+" x "+scenario.b, func(t *testing.T) {
+			require.Equal(t, scenario.expected, entities.SimilarDescriptions(scenario.a, scenario.b))
+		})
+	}
+}
+
+func TestTransaction_IsLikelyDuplicateOf(t *testing.T) {
+	build := func(t *testing.T, description string, amount float64, date time.Time) *entities.Transaction {
+		params := validTransactionParams(t)
+		params.Description = params.Description + description
+		params.Amount = *money(t, amount)
+		params.TransactionDate = date
+		tx, err := entities.NewTransaction(params)
+		require.NoError(t, err)
+		return tx
+	}
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+
+	manual := build(t, "", 89.90, date)
+	imported := build(t, " LOJA 12", 89.90, date.AddDate(0, 0, 2))
+	imported.UserID = manual.UserID
+
+	require.True(t, manual.IsLikelyDuplicateOf(imported))
+	require.True(t, imported.IsLikelyDuplicateOf(manual))
+	require.False(t, manual.IsLikelyDuplicateOf(manual))
+
+	t.Run("should not match outside the date window", func(t *testing.T) {
+		late := build(t, "", 89.90, date.AddDate(0, 0, 3))
+		late.UserID = manual.UserID
+		require.False(t, manual.IsLikelyDuplicateOf(late))
+	})
+
+	t.Run("should not match another amount, user or payment method", func(t *testing.T) {
+		other := build(t, "", 89.91, date)
+		other.UserID = manual.UserID
+		require.False(t, manual.IsLikelyDuplicateOf(other))
+
+		require.False(t, manual.IsLikelyDuplicateOf(build(t, "", 89.90, date)))
+
+		pix := build(t, "", 89.90, date)
+		pix.UserID = manual.UserID
+		pix.PaymentMethod, _ = transactionVos.NewPaymentMethod(transactionVos.PaymentMethodPix)
+		require.False(t, manual.IsLikelyDuplicateOf(pix))
+	})
+
+	t.Run("should not match installments of the same purchase", func(t *testing.T) {
+		groupID, _ := vos.NewUUID()
+		first := build(t, "", 89.90, date)
+		second := build(t, "", 89.90, date)
+		second.UserID = first.UserID
+		first.InstallmentGroupID, second.InstallmentGroupID = &groupID, &groupID
+		require.False(t, first.IsLikelyDuplicateOf(second))
+	})
+}
+
+func TestFindLikelyDuplicates(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	build := func(t *testing.T, description string, amount float64, day int, createdAt time.Time) *entities.Transaction {
+		params := validTransactionParams(t)
+		params.UserID = userID
+		params.Description = description
+		params.Amount = *money(t, amount)
+		params.TransactionDate = time.Date(2026, 3, day, 0, 0, 0, 0, time.UTC)
+		params.CreatedAt = createdAt
+		tx, err := entities.NewTransaction(params)
+		require.NoError(t, err)
+		return tx
+	}
+	now := time.Now().UTC()
+
+	imported := build(t, "COMPRA PADARIA SAO JOSE", 12.50, 11, now)
+	manual := build(t, "Padaria", 12.50, 10, now.Add(-time.Hour))
+	lunch := build(t, "Restaurante", 45.00, 10, now)
+	importedLunch := build(t, "RESTAURANTE DO ZE", 45.00, 9, now.Add(time.Minute))
+	unrelated := build(t, "Padaria", 30.00, 10, now)
+
+	pairs := entities.FindLikelyDuplicates([]*entities.Transaction{imported, lunch, manual, unrelated, importedLunch})
+
+	require.Len(t, pairs, 2)
+	require.Equal(t, lunch, pairs[0].Original)
+	require.Equal(t, importedLunch, pairs[0].Duplicate)
+	require.Equal(t, manual, pairs[1].Original)
+	require.Equal(t, imported, pairs[1].Duplicate)
+}
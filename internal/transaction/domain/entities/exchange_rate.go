@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+const (
+	ExchangeRateSourceManual = "manual"
+	ExchangeRateSourceCSV    = "csv"
+)
+
+// ExchangeRate is the value of one unit of Currency in BaseCurrency on RateDate, entered
+// by the user. A foreign currency transaction is converted with the latest rate dated up
+// to its own date.
+type ExchangeRate struct {
+	ID           vos.UUID
+	UserID       vos.UUID
+	Currency     vos.Currency
+	BaseCurrency vos.Currency
+	Rate         transactionVos.ExchangeRate
+	RateDate     time.Time
+	Source       string
+	CreatedAt    time.Time
+	UpdatedAt    *time.Time
+}
+
+// NewExchangeRate creates an exchange rate of currency into the base currency. The
+// currencies must differ.
+func NewExchangeRate(
+	userID vos.UUID,
+	currency, baseCurrency vos.Currency,
+	rate transactionVos.ExchangeRate,
+	rateDate time.Time,
+	source string,
+) (*ExchangeRate, error) {
+	if currency == baseCurrency {
+		return nil, fmt.Errorf("%w: currency must differ from the base currency %s", transactionDomain.ErrInvalidExchangeRate, baseCurrency)
+	}
+	if source != ExchangeRateSourceManual && source != ExchangeRateSourceCSV {
+		return nil, fmt.Errorf("%w: unknown source %q", transactionDomain.ErrInvalidExchangeRate, source)
+	}
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &ExchangeRate{
+		ID:           id,
+		UserID:       userID,
+		Currency:     currency,
+		BaseCurrency: baseCurrency,
+		Rate:         rate,
+		RateDate:     time.Date(rateDate.Year(), rateDate.Month(), rateDate.Day(), 0, 0, 0, 0, time.UTC),
+		Source:       source,
+		CreatedAt:    time.Now().UTC(),
+	}, nil
+}
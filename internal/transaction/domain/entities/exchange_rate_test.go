@@ -0,0 +1,41 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	rate, _ := transactionVos.NewExchangeRate(5.4321)
+
+	t.Run("should create the rate truncating the date to the day", func(t *testing.T) {
+		exchangeRate, err := entities.NewExchangeRate(userID, vos.CurrencyUSD, vos.CurrencyBRL, rate,
+			time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC), entities.ExchangeRateSourceManual)
+
+		require.NoError(t, err)
+		require.Equal(t, userID, exchangeRate.UserID)
+		require.Equal(t, vos.CurrencyUSD, exchangeRate.Currency)
+		require.Equal(t, vos.CurrencyBRL, exchangeRate.BaseCurrency)
+		require.Equal(t, 5.4321, exchangeRate.Rate.Float())
+		require.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), exchangeRate.RateDate)
+		require.Equal(t, entities.ExchangeRateSourceManual, exchangeRate.Source)
+	})
+
+	t.Run("should return error when the currency is the base currency", func(t *testing.T) {
+		_, err := entities.NewExchangeRate(userID, vos.CurrencyBRL, vos.CurrencyBRL, rate, time.Now(), entities.ExchangeRateSourceCSV)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidExchangeRate)
+	})
+
+	t.Run("should return error for an unknown source", func(t *testing.T) {
+		_, err := entities.NewExchangeRate(userID, vos.CurrencyEUR, vos.CurrencyBRL, rate, time.Now(), "api")
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidExchangeRate)
+	})
+}
@@ -0,0 +1,190 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+// maxDescriptionLength is the size of transactions.description.
+const maxDescriptionLength = 255
+
+// InstallmentPayoff records the early payoff of the future installments of a card
+// purchase. The installments are cancelled and replaced by a single transaction
+// (TransactionID) billed on the open invoice InvoiceID, in the same installment group.
+type InstallmentPayoff struct {
+	ID                 vos.UUID
+	UserID             vos.UUID
+	InstallmentGroupID vos.UUID
+	TransactionID      vos.UUID
+	InvoiceID          vos.UUID
+	Installments       int
+	OriginalAmount     vos.Money
+	Discount           vos.Money
+	Amount             vos.Money
+	PayoffDate         time.Time
+	CreatedAt          time.Time
+}
+
+// PayOffInstallments cancels the given installments of a purchase and returns the
+// transaction that consolidates them on invoiceID, worth their sum minus the discount,
+// together with the payoff record. The consolidated transaction keeps the category,
+// splits and tags of the purchase; split shares are reduced in proportion to the discount.
+func PayOffInstallments(installments []*Transaction, invoiceID vos.UUID, discount vos.Money, payoffDate time.Time) (*InstallmentPayoff, *Transaction, error) {
+	if len(installments) == 0 {
+		return nil, nil, transactionDomain.ErrNothingToPayOff
+	}
+	first := installments[0]
+	if first.InstallmentGroupID == nil {
+		return nil, nil, fmt.Errorf("%w: the transaction is not an installment", transactionDomain.ErrInvalidPayoff)
+	}
+
+	original, err := vos.NewMoney(0, first.Amount.Currency())
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, installment := range installments {
+		if !installment.Status.IsActive() || installment.InstallmentGroupID == nil ||
+			installment.InstallmentGroupID.String() != first.InstallmentGroupID.String() {
+			return nil, nil, fmt.Errorf("%w: only active installments of the same purchase can be paid off", transactionDomain.ErrInvalidPayoff)
+		}
+		if original, err = original.Add(installment.Amount); err != nil {
+			return nil, nil, err
+		}
+	}
+	if discount.IsNegative() || discount.GreaterThanOrEqual(original) {
+		return nil, nil, fmt.Errorf("%w: discount must be between 0 and %.2f", transactionDomain.ErrInvalidPayoff, original.Float())
+	}
+	amount, err := original.Subtract(discount)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	splits, err := consolidateSplits(installments, original, amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	transactionID, err := vos.NewUUID()
+	if err != nil {
+		return nil, nil, err
+	}
+	status, err := transactionVos.NewTransactionStatus(transactionVos.TransactionStatusActive)
+	if err != nil {
+		return nil, nil, err
+	}
+	consolidated, err := NewTransaction(TransactionParams{
+		ID:                 transactionID,
+		UserID:             first.UserID,
+		CategoryID:         first.CategoryID,
+		SubcategoryID:      first.SubcategoryID,
+		CardID:             first.CardID,
+		InvoiceID:          &invoiceID,
+		InstallmentGroupID: first.InstallmentGroupID,
+		Description:        payoffDescription(first.Description, len(installments)),
+		Amount:             amount,
+		Direction:          first.Direction,
+		PaymentMethod:      first.PaymentMethod,
+		TransactionDate:    payoffDate,
+		Status:             status,
+		Splits:             splits,
+		Tags:               first.Tags,
+		CreatedAt:          time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, installment := range installments {
+		if err := installment.Cancel(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, nil, err
+	}
+	return &InstallmentPayoff{
+		ID:                 id,
+		UserID:             first.UserID,
+		InstallmentGroupID: *first.InstallmentGroupID,
+		TransactionID:      consolidated.ID,
+		InvoiceID:          invoiceID,
+		Installments:       len(installments),
+		OriginalAmount:     original,
+		Discount:           discount,
+		Amount:             amount,
+		PayoffDate:         payoffDate,
+		CreatedAt:          consolidated.CreatedAt,
+	}, consolidated, nil
+}
+
+// consolidateSplits sums the splits of the installments by category and scales them down
+// to amount. The cents lost to rounding go to the last category.
+func consolidateSplits(installments []*Transaction, original, amount vos.Money) ([]*TransactionSplit, error) {
+	type share struct {
+		categoryID    vos.UUID
+		subcategoryID *vos.UUID
+		cents         int64
+	}
+	var shares []*share
+	byKey := make(map[string]*share)
+	for _, installment := range installments {
+		for _, split := range installment.Splits {
+			key := split.CategoryID.String()
+			if split.SubcategoryID != nil {
+				key += "|" + split.SubcategoryID.String()
+			}
+			if byKey[key] == nil {
+				byKey[key] = &share{categoryID: split.CategoryID, subcategoryID: split.SubcategoryID}
+				shares = append(shares, byKey[key])
+			}
+			byKey[key].cents += split.Amount.Cents()
+		}
+	}
+	if len(shares) == 0 {
+		return nil, nil
+	}
+
+	splits := make([]*TransactionSplit, 0, len(shares))
+	remaining := amount.Cents()
+	for i, s := range shares {
+		cents := s.cents * amount.Cents() / original.Cents()
+		if i == len(shares)-1 {
+			cents = remaining
+		}
+		remaining -= cents
+		if cents == 0 {
+			continue
+		}
+		money, err := vos.NewMoney(cents, amount.Currency())
+		if err != nil {
+			return nil, err
+		}
+		split, err := NewTransactionSplit(s.categoryID, s.subcategoryID, money)
+		if err != nil {
+			return nil, err
+		}
+		splits = append(splits, split)
+	}
+	return splits, nil
+}
+
+// payoffDescription labels the consolidated transaction, shortening the purchase
+// description so the label fits the column.
+func payoffDescription(description string, installments int) string {
+	suffix := fmt.Sprintf(" (antecipação de %d parcelas)", installments)
+	if installments == 1 {
+		suffix = " (antecipação de 1 parcela)"
+	}
+	limit := maxDescriptionLength - utf8.RuneCountInString(suffix)
+	if utf8.RuneCountInString(description) > limit {
+		description = string([]rune(description)[:limit])
+	}
+	return description + suffix
+}
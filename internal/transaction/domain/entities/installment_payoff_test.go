@@ -0,0 +1,104 @@
+package entities_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// futureInstallments builds installments 2..n+1 of a purchase paid in n+1 installments of 100.
+func futureInstallments(t *testing.T, n int) []*entities.Transaction {
+	t.Helper()
+	groupID, _ := vos.NewUUID()
+	userID, _ := vos.NewUUID()
+	cardID, _ := vos.NewUUID()
+	installments := make([]*entities.Transaction, 0, n)
+	for i := range n {
+		params := validTransactionParams(t)
+		number, total := i+2, n+1
+		params.UserID = userID
+		params.CardID = &cardID
+		params.InstallmentGroupID = &groupID
+		params.InstallmentNumber = &number
+		params.InstallmentTotal = &total
+		installment, err := entities.NewTransaction(params)
+		require.NoError(t, err)
+		installments = append(installments, installment)
+	}
+	return installments
+}
+
+func TestPayOffInstallments(t *testing.T) {
+	invoiceID, _ := vos.NewUUID()
+	today := time.Date(2026, 4, 20, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should consolidate the installments with the discount", func(t *testing.T) {
+		installments := futureInstallments(t, 3)
+
+		payoff, consolidated, err := entities.PayOffInstallments(installments, invoiceID, *money(t, 15), today)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(28500), consolidated.Amount.Cents())
+		require.Equal(t, invoiceID, *consolidated.InvoiceID)
+		require.Equal(t, installments[0].InstallmentGroupID, consolidated.InstallmentGroupID)
+		require.Nil(t, consolidated.InstallmentNumber)
+		require.Equal(t, "Notebook (antecipação de 3 parcelas)", consolidated.Description)
+		require.Equal(t, today, consolidated.TransactionDate)
+		require.Equal(t, 3, payoff.Installments)
+		require.Equal(t, int64(30000), payoff.OriginalAmount.Cents())
+		require.Equal(t, int64(1500), payoff.Discount.Cents())
+		require.Equal(t, consolidated.ID, payoff.TransactionID)
+		for _, installment := range installments {
+			require.True(t, installment.Status.IsCancelled())
+		}
+	})
+
+	t.Run("should scale the splits down to the amount paid", func(t *testing.T) {
+		installments := futureInstallments(t, 3)
+		food, _ := vos.NewUUID()
+		home, _ := vos.NewUUID()
+		for _, installment := range installments {
+			first, _ := entities.NewTransactionSplit(food, nil, *money(t, 70))
+			second, _ := entities.NewTransactionSplit(home, nil, *money(t, 30))
+			require.NoError(t, installment.SetSplits([]*entities.TransactionSplit{first, second}))
+		}
+
+		_, consolidated, err := entities.PayOffInstallments(installments, invoiceID, *money(t, 0.01), today)
+
+		require.NoError(t, err)
+		require.Len(t, consolidated.Splits, 2)
+		require.Equal(t, int64(20999), consolidated.Splits[0].Amount.Cents())
+		require.Equal(t, int64(9000), consolidated.Splits[1].Amount.Cents())
+		require.Equal(t, consolidated.ID, consolidated.Splits[0].TransactionID)
+	})
+
+	t.Run("should shorten long descriptions", func(t *testing.T) {
+		installments := futureInstallments(t, 1)
+		installments[0].Description = strings.Repeat("a", 255)
+
+		_, consolidated, err := entities.PayOffInstallments(installments, invoiceID, *money(t, 0), today)
+
+		require.NoError(t, err)
+		require.Len(t, []rune(consolidated.Description), 255)
+		require.True(t, strings.HasSuffix(consolidated.Description, "(antecipação de 1 parcela)"))
+	})
+
+	t.Run("should reject invalid payoffs", func(t *testing.T) {
+		_, _, err := entities.PayOffInstallments(nil, invoiceID, vos.Money{}, today)
+		require.ErrorIs(t, err, transactionDomain.ErrNothingToPayOff)
+
+		_, _, err = entities.PayOffInstallments(futureInstallments(t, 2), invoiceID, *money(t, 200), today)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidPayoff)
+
+		mixed := append(futureInstallments(t, 1), futureInstallments(t, 1)...)
+		_, _, err = entities.PayOffInstallments(mixed, invoiceID, *money(t, 0), today)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidPayoff)
+		require.True(t, mixed[0].Status.IsActive())
+	})
+}
@@ -0,0 +1,158 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+// RecurringTransactionParams holds all fields needed to create a RecurringTransaction.
+type RecurringTransactionParams struct {
+	ID            vos.UUID
+	UserID        vos.UUID
+	CategoryID    vos.UUID
+	SubcategoryID *vos.UUID
+	CardID        *vos.UUID
+	Description   string
+	Amount        vos.Money
+	Direction     transactionVos.TransactionDirection
+	PaymentMethod transactionVos.PaymentMethod
+	Rule          transactionVos.RecurrenceRule
+	StartDate     time.Time
+	CreatedAt     time.Time
+}
+
+// RecurringTransaction is a transaction template plus the schedule that materializes it.
+// NextOccurrence is nil once the schedule has no occurrences left.
+type RecurringTransaction struct {
+	ID              vos.UUID
+	UserID          vos.UUID
+	CategoryID      vos.UUID
+	SubcategoryID   *vos.UUID
+	CardID          *vos.UUID
+	Description     string
+	Amount          vos.Money
+	Direction       transactionVos.TransactionDirection
+	PaymentMethod   transactionVos.PaymentMethod
+	Rule            transactionVos.RecurrenceRule
+	StartDate       time.Time
+	NextOccurrence  *time.Time
+	OccurrenceCount int
+	Active          bool
+	CreatedAt       time.Time
+	UpdatedAt       *time.Time
+	DeletedAt       *time.Time
+}
+
+// NewRecurringTransaction creates an active RecurringTransaction scheduled for the
+// first occurrence of the rule on or after the start date.
+func NewRecurringTransaction(params RecurringTransactionParams) (*RecurringTransaction, error) {
+	if strings.TrimSpace(params.Description) == "" {
+		return nil, fmt.Errorf("%w", transactionDomain.ErrDescriptionRequired)
+	}
+	if !params.Amount.IsPositive() {
+		return nil, fmt.Errorf("%w", transactionDomain.ErrAmountMustBePositive)
+	}
+	direction := params.Direction
+	if direction == "" {
+		direction = transactionVos.DirectionExpense
+	}
+	if !direction.IsValid() {
+		return nil, fmt.Errorf("%w", transactionDomain.ErrInvalidDirection)
+	}
+	first, ok := params.Rule.First(params.StartDate)
+	if !ok {
+		return nil, fmt.Errorf("%w: rule has no occurrences after start_date", transactionDomain.ErrInvalidRecurrenceRule)
+	}
+	return &RecurringTransaction{
+		ID:             params.ID,
+		UserID:         params.UserID,
+		CategoryID:     params.CategoryID,
+		SubcategoryID:  params.SubcategoryID,
+		CardID:         params.CardID,
+		Description:    params.Description,
+		Amount:         params.Amount,
+		Direction:      direction,
+		PaymentMethod:  params.PaymentMethod,
+		Rule:           params.Rule,
+		StartDate:      time.Date(params.StartDate.Year(), params.StartDate.Month(), params.StartDate.Day(), 0, 0, 0, 0, time.UTC),
+		NextOccurrence: &first,
+		Active:         true,
+		CreatedAt:      params.CreatedAt,
+	}, nil
+}
+
+// IsDue reports whether the next occurrence should be materialized on the given day.
+func (r *RecurringTransaction) IsDue(today time.Time) bool {
+	return r.Active && r.NextOccurrence != nil && !r.NextOccurrence.After(today)
+}
+
+// Advance records the current occurrence as materialized and moves to the next one.
+// The recurrence is deactivated when COUNT or UNTIL ends the schedule.
+func (r *RecurringTransaction) Advance() {
+	if r.NextOccurrence == nil {
+		return
+	}
+	r.OccurrenceCount++
+	next, ok := r.Rule.Next(r.StartDate, *r.NextOccurrence, r.OccurrenceCount)
+	if ok {
+		r.NextOccurrence = &next
+	} else {
+		r.NextOccurrence = nil
+		r.Active = false
+	}
+	now := time.Now().UTC()
+	r.UpdatedAt = &now
+}
+
+// UpdateTemplate updates the fields copied into each materialized transaction.
+func (r *RecurringTransaction) UpdateTemplate(description string, amount vos.Money, categoryID vos.UUID, subcategoryID *vos.UUID) error {
+	if strings.TrimSpace(description) == "" {
+		return fmt.Errorf("%w", transactionDomain.ErrDescriptionRequired)
+	}
+	if !amount.IsPositive() {
+		return fmt.Errorf("%w", transactionDomain.ErrAmountMustBePositive)
+	}
+	r.Description = description
+	r.Amount = amount
+	r.CategoryID = categoryID
+	r.SubcategoryID = subcategoryID
+	now := time.Now().UTC()
+	r.UpdatedAt = &now
+	return nil
+}
+
+// Pause stops materializing occurrences until the recurrence is resumed.
+func (r *RecurringTransaction) Pause() {
+	r.Active = false
+	now := time.Now().UTC()
+	r.UpdatedAt = &now
+}
+
+// Resume reactivates a paused recurrence. Occurrences that fell due while paused are
+// skipped, not materialized, but still count towards COUNT.
+func (r *RecurringTransaction) Resume(today time.Time) error {
+	for r.NextOccurrence != nil && r.NextOccurrence.Before(today) {
+		r.Advance()
+	}
+	if r.NextOccurrence == nil {
+		return transactionDomain.ErrRecurrenceFinished
+	}
+	r.Active = true
+	now := time.Now().UTC()
+	r.UpdatedAt = &now
+	return nil
+}
+
+// Delete soft-deletes the recurrence. Transactions already materialized are kept.
+func (r *RecurringTransaction) Delete() {
+	now := time.Now().UTC()
+	r.Active = false
+	r.UpdatedAt = &now
+	r.DeletedAt = &now
+}
@@ -0,0 +1,137 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+func validRecurringParams(t *testing.T, rule string) entities.RecurringTransactionParams {
+	t.Helper()
+	id, _ := vos.NewUUID()
+	userID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	amount, _ := vos.NewMoneyFromFloat(1500.00, vos.CurrencyBRL)
+	pm, _ := transactionVos.NewPaymentMethod(transactionVos.PaymentMethodPix)
+	rrule, err := transactionVos.NewRecurrenceRule(rule)
+	require.NoError(t, err)
+	return entities.RecurringTransactionParams{
+		ID:            id,
+		UserID:        userID,
+		CategoryID:    categoryID,
+		Description:   "Aluguel",
+		Amount:        amount,
+		PaymentMethod: pm,
+		Rule:          rrule,
+		StartDate:     time.Date(2026, 1, 20, 15, 30, 0, 0, time.UTC),
+		CreatedAt:     time.Now().UTC(),
+	}
+}
+
+func TestNewRecurringTransaction(t *testing.T) {
+	t.Run("should schedule the first occurrence on or after start date", func(t *testing.T) {
+		r, err := entities.NewRecurringTransaction(validRecurringParams(t, "FREQ=MONTHLY;BYMONTHDAY=5"))
+		require.NoError(t, err)
+		require.True(t, r.Active)
+		require.Equal(t, transactionVos.DirectionExpense, r.Direction)
+		require.Equal(t, time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC), r.StartDate)
+		require.Equal(t, time.Date(2026, 2, 5, 0, 0, 0, 0, time.UTC), *r.NextOccurrence)
+	})
+
+	t.Run("should reject a rule without occurrences", func(t *testing.T) {
+		_, err := entities.NewRecurringTransaction(validRecurringParams(t, "FREQ=MONTHLY;UNTIL=20260101"))
+		require.ErrorIs(t, err, domain.ErrInvalidRecurrenceRule)
+	})
+
+	t.Run("should reject empty description", func(t *testing.T) {
+		params := validRecurringParams(t, "FREQ=MONTHLY")
+		params.Description = " "
+		_, err := entities.NewRecurringTransaction(params)
+		require.ErrorIs(t, err, domain.ErrDescriptionRequired)
+	})
+
+	t.Run("should reject non-positive amount", func(t *testing.T) {
+		params := validRecurringParams(t, "FREQ=MONTHLY")
+		params.Amount, _ = vos.NewMoneyFromFloat(0, vos.CurrencyBRL)
+		_, err := entities.NewRecurringTransaction(params)
+		require.ErrorIs(t, err, domain.ErrAmountMustBePositive)
+	})
+}
+
+func TestRecurringTransactionAdvance(t *testing.T) {
+	t.Run("should move to the next occurrence and count the current one", func(t *testing.T) {
+		r, _ := entities.NewRecurringTransaction(validRecurringParams(t, "FREQ=MONTHLY"))
+		require.True(t, r.IsDue(time.Date(2026, 1, 20, 0, 0, 0, 0, time.UTC)))
+
+		r.Advance()
+
+		require.Equal(t, 1, r.OccurrenceCount)
+		require.Equal(t, time.Date(2026, 2, 20, 0, 0, 0, 0, time.UTC), *r.NextOccurrence)
+		require.False(t, r.IsDue(time.Date(2026, 2, 19, 0, 0, 0, 0, time.UTC)))
+		require.NotNil(t, r.UpdatedAt)
+	})
+
+	t.Run("should deactivate when the schedule ends", func(t *testing.T) {
+		r, _ := entities.NewRecurringTransaction(validRecurringParams(t, "FREQ=MONTHLY;COUNT=1"))
+
+		r.Advance()
+
+		require.Nil(t, r.NextOccurrence)
+		require.False(t, r.Active)
+		require.False(t, r.IsDue(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)))
+	})
+}
+
+func TestRecurringTransactionPauseResume(t *testing.T) {
+	t.Run("should not be due while paused", func(t *testing.T) {
+		r, _ := entities.NewRecurringTransaction(validRecurringParams(t, "FREQ=MONTHLY"))
+		r.Pause()
+		require.False(t, r.IsDue(time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)))
+	})
+
+	t.Run("should skip occurrences missed while paused", func(t *testing.T) {
+		r, _ := entities.NewRecurringTransaction(validRecurringParams(t, "FREQ=MONTHLY"))
+		r.Pause()
+
+		err := r.Resume(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		require.True(t, r.Active)
+		require.Equal(t, 3, r.OccurrenceCount)
+		require.Equal(t, time.Date(2026, 4, 20, 0, 0, 0, 0, time.UTC), *r.NextOccurrence)
+	})
+
+	t.Run("should fail to resume when the schedule ended while paused", func(t *testing.T) {
+		r, _ := entities.NewRecurringTransaction(validRecurringParams(t, "FREQ=MONTHLY;COUNT=2"))
+		r.Pause()
+
+		err := r.Resume(time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+
+		require.ErrorIs(t, err, domain.ErrRecurrenceFinished)
+		require.False(t, r.Active)
+	})
+}
+
+func TestRecurringTransactionUpdateTemplate(t *testing.T) {
+	r, _ := entities.NewRecurringTransaction(validRecurringParams(t, "FREQ=MONTHLY"))
+	categoryID, _ := vos.NewUUID()
+
+	t.Run("should update template fields", func(t *testing.T) {
+		amount, _ := vos.NewMoneyFromFloat(1650.00, vos.CurrencyBRL)
+		require.NoError(t, r.UpdateTemplate("Aluguel reajustado", amount, categoryID, nil))
+		require.Equal(t, "Aluguel reajustado", r.Description)
+		require.Equal(t, int64(165000), r.Amount.Cents())
+		require.Equal(t, categoryID, r.CategoryID)
+	})
+
+	t.Run("should reject non-positive amount", func(t *testing.T) {
+		amount, _ := vos.NewMoneyFromFloat(0, vos.CurrencyBRL)
+		require.ErrorIs(t, r.UpdateTemplate("Aluguel", amount, categoryID, nil), domain.ErrAmountMustBePositive)
+	})
+}
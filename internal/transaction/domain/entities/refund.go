@@ -0,0 +1,139 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// MaxRefundReasonLength is the maximum length of the reason of a refund.
+const MaxRefundReasonLength = 255
+
+// Refund is a credit the card issuer grants for a billed purchase, in full or in part.
+// It is posted as a negative item on the open invoice of the card (InvoiceID) while the
+// budget gives the amount back in the month the purchase was billed.
+type Refund struct {
+	ID            vos.UUID
+	UserID        vos.UUID
+	TransactionID vos.UUID
+	InvoiceID     vos.UUID
+	Amount        vos.Money
+	Reason        string
+	RefundDate    time.Time
+	CreatedAt     time.Time
+}
+
+// RefundShare is the part of a refund given back to one category.
+type RefundShare struct {
+	CategoryID    vos.UUID
+	SubcategoryID *vos.UUID
+	Amount        vos.Money
+}
+
+// NewRefund creates a refund of t posted to invoiceID. previous are the refunds t already
+// received; together with the new one they cannot exceed the transaction amount.
+func NewRefund(t *Transaction, invoiceID vos.UUID, amount vos.Money, reason string, refundDate time.Time, previous []*Refund) (*Refund, error) {
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: amount must be positive", transactionDomain.ErrInvalidRefund)
+	}
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > MaxRefundReasonLength {
+		return nil, fmt.Errorf("%w: reason cannot exceed %d characters", transactionDomain.ErrInvalidRefund, MaxRefundReasonLength)
+	}
+	if refundDate.Before(t.TransactionDate) {
+		return nil, fmt.Errorf("%w: refund_date cannot be before the purchase", transactionDomain.ErrInvalidRefund)
+	}
+	refundable, err := t.RefundableAmount(previous)
+	if err != nil {
+		return nil, err
+	}
+	if amount.GreaterThan(refundable) {
+		return nil, fmt.Errorf("%w: %.2f left", transactionDomain.ErrRefundExceedsRefundable, refundable.Float())
+	}
+
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &Refund{
+		ID:            id,
+		UserID:        t.UserID,
+		TransactionID: t.ID,
+		InvoiceID:     invoiceID,
+		Amount:        amount,
+		Reason:        reason,
+		RefundDate:    refundDate,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// CheckRefundable reports whether t can be refunded: only active card purchases whose
+// invoice is closed or paid. Purchases on an open invoice are reversed or edited instead.
+func (t *Transaction) CheckRefundable(invoiceStatus string) error {
+	if !t.Status.IsActive() {
+		return fmt.Errorf("%w: the transaction is not active", transactionDomain.ErrRefundNotAllowed)
+	}
+	if t.InvoiceID == nil || t.CardID == nil {
+		return fmt.Errorf("%w: only card purchases can be refunded", transactionDomain.ErrRefundNotAllowed)
+	}
+	if t.IsEditable(invoiceStatus) {
+		return fmt.Errorf("%w: the invoice is still open, reverse or edit the transaction instead", transactionDomain.ErrRefundNotAllowed)
+	}
+	return nil
+}
+
+// RefundedAmount sums the refunds t received.
+func (t *Transaction) RefundedAmount(refunds []*Refund) (vos.Money, error) {
+	total, err := vos.NewMoney(0, t.Amount.Currency())
+	if err != nil {
+		return vos.Money{}, err
+	}
+	for _, refund := range refunds {
+		if total, err = total.Add(refund.Amount); err != nil {
+			return vos.Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// RefundableAmount returns how much of t is left to refund.
+func (t *Transaction) RefundableAmount(refunds []*Refund) (vos.Money, error) {
+	refunded, err := t.RefundedAmount(refunds)
+	if err != nil {
+		return vos.Money{}, err
+	}
+	if refunded.GreaterThanOrEqual(t.Amount) {
+		return vos.NewMoney(0, t.Amount.Currency())
+	}
+	return t.Amount.Subtract(refunded)
+}
+
+// Shares splits the refund among the categories of t in proportion to its splits. The
+// cents lost to rounding go to the last split, so the shares always sum to the refund.
+// A transaction without splits gives the whole refund back to its category.
+func (r *Refund) Shares(t *Transaction) []RefundShare {
+	if !t.IsSplit() {
+		return []RefundShare{{CategoryID: t.CategoryID, SubcategoryID: t.SubcategoryID, Amount: r.Amount}}
+	}
+
+	shares := make([]RefundShare, 0, len(t.Splits))
+	remaining := r.Amount.Cents()
+	for i, split := range t.Splits {
+		cents := r.Amount.Cents() * split.Amount.Cents() / t.Amount.Cents()
+		if i == len(t.Splits)-1 {
+			cents = remaining
+		}
+		remaining -= cents
+		if cents == 0 {
+			continue
+		}
+		amount, _ := vos.NewMoney(cents, r.Amount.Currency())
+		shares = append(shares, RefundShare{CategoryID: split.CategoryID, SubcategoryID: split.SubcategoryID, Amount: amount})
+	}
+	return shares
+}
@@ -0,0 +1,121 @@
+package entities_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+func billedPurchase(t *testing.T) *entities.Transaction {
+	t.Helper()
+	params := validTransactionParams(t)
+	cardID, _ := vos.NewUUID()
+	params.CardID = &cardID
+	tx, err := entities.NewTransaction(params)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestNewRefund(t *testing.T) {
+	purchase := billedPurchase(t)
+	invoiceID, _ := vos.NewUUID()
+	today := time.Now().UTC()
+
+	t.Run("should create a partial refund of the purchase", func(t *testing.T) {
+		refund, err := entities.NewRefund(purchase, invoiceID, *money(t, 40), "  defective item ", today, nil)
+		require.NoError(t, err)
+		require.Equal(t, purchase.ID, refund.TransactionID)
+		require.Equal(t, purchase.UserID, refund.UserID)
+		require.Equal(t, invoiceID, refund.InvoiceID)
+		require.Equal(t, "defective item", refund.Reason)
+	})
+
+	t.Run("should not refund more than what is left", func(t *testing.T) {
+		first, err := entities.NewRefund(purchase, invoiceID, *money(t, 60), "", today, nil)
+		require.NoError(t, err)
+
+		_, err = entities.NewRefund(purchase, invoiceID, *money(t, 40.01), "", today, []*entities.Refund{first})
+		require.ErrorIs(t, err, transactionDomain.ErrRefundExceedsRefundable)
+
+		_, err = entities.NewRefund(purchase, invoiceID, *money(t, 40), "", today, []*entities.Refund{first})
+		require.NoError(t, err)
+	})
+
+	t.Run("should reject invalid refunds", func(t *testing.T) {
+		_, err := entities.NewRefund(purchase, invoiceID, vos.Money{}, "", today, nil)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidRefund)
+
+		_, err = entities.NewRefund(purchase, invoiceID, *money(t, 10), "", purchase.TransactionDate.AddDate(0, 0, -1), nil)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidRefund)
+	})
+}
+
+func TestTransaction_CheckRefundable(t *testing.T) {
+	require.NoError(t, billedPurchase(t).CheckRefundable("closed"))
+	require.NoError(t, billedPurchase(t).CheckRefundable("paid"))
+	require.ErrorIs(t, billedPurchase(t).CheckRefundable("open"), transactionDomain.ErrRefundNotAllowed)
+
+	cancelled := billedPurchase(t)
+	require.NoError(t, cancelled.Cancel())
+	require.ErrorIs(t, cancelled.CheckRefundable("closed"), transactionDomain.ErrRefundNotAllowed)
+
+	pix, err := entities.NewTransaction(validTransactionParams(t))
+	require.NoError(t, err)
+	require.ErrorIs(t, pix.CheckRefundable("closed"), transactionDomain.ErrRefundNotAllowed)
+}
+
+func TestTransaction_RefundableAmount(t *testing.T) {
+	purchase := billedPurchase(t)
+	invoiceID, _ := vos.NewUUID()
+	refund, err := entities.NewRefund(purchase, invoiceID, *money(t, 30), "", time.Now().UTC(), nil)
+	require.NoError(t, err)
+
+	refunded, err := purchase.RefundedAmount([]*entities.Refund{refund, refund})
+	require.NoError(t, err)
+	require.Equal(t, 60.0, refunded.Float())
+
+	refundable, err := purchase.RefundableAmount([]*entities.Refund{refund, refund})
+	require.NoError(t, err)
+	require.Equal(t, 40.0, refundable.Float())
+
+	refundable, err = purchase.RefundableAmount([]*entities.Refund{refund, refund, refund, refund})
+	require.NoError(t, err)
+	require.True(t, refundable.IsZero())
+}
+
+func TestRefund_Shares(t *testing.T) {
+	purchase := billedPurchase(t)
+	invoiceID, _ := vos.NewUUID()
+	refund, err := entities.NewRefund(purchase, invoiceID, *money(t, 10), "", time.Now().UTC(), nil)
+	require.NoError(t, err)
+
+	t.Run("should give the whole refund back to the category", func(t *testing.T) {
+		shares := refund.Shares(purchase)
+		require.Len(t, shares, 1)
+		require.Equal(t, purchase.CategoryID, shares[0].CategoryID)
+		require.Equal(t, int64(1000), shares[0].Amount.Cents())
+	})
+
+	t.Run("should split the refund in proportion to the splits", func(t *testing.T) {
+		food, _ := vos.NewUUID()
+		home, _ := vos.NewUUID()
+		pharmacy, _ := vos.NewUUID()
+		first, _ := entities.NewTransactionSplit(food, nil, *money(t, 33.33))
+		second, _ := entities.NewTransactionSplit(home, nil, *money(t, 33.33))
+		third, _ := entities.NewTransactionSplit(pharmacy, nil, *money(t, 33.34))
+		require.NoError(t, purchase.SetSplits([]*entities.TransactionSplit{first, second, third}))
+
+		shares := refund.Shares(purchase)
+
+		require.Len(t, shares, 3)
+		require.Equal(t, int64(333), shares[0].Amount.Cents())
+		require.Equal(t, int64(333), shares[1].Amount.Cents())
+		require.Equal(t, int64(334), shares[2].Amount.Cents())
+		require.Equal(t, pharmacy, shares[2].CategoryID)
+	})
+}
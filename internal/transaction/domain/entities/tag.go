@@ -0,0 +1,79 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+const (
+	// MaxTagNameLength is the maximum length of a tag name, in characters.
+	MaxTagNameLength = 50
+	// MaxTagsPerRequest is the maximum number of tags set on a transaction at once.
+	MaxTagsPerRequest = 20
+)
+
+// Tag is a free-form label of the user's catalog, applied to any number of transactions.
+type Tag struct {
+	ID        vos.UUID
+	UserID    vos.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt *time.Time
+	DeletedAt *time.Time
+}
+
+// NewTag creates a Tag with a normalized name.
+func NewTag(userID vos.UUID, name string) (*Tag, error) {
+	normalized, err := NormalizeTagName(name)
+	if err != nil {
+		return nil, err
+	}
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &Tag{
+		ID:        id,
+		UserID:    userID,
+		Name:      normalized,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Rename changes the name of the tag.
+func (t *Tag) Rename(name string) error {
+	normalized, err := NormalizeTagName(name)
+	if err != nil {
+		return err
+	}
+	t.Name = normalized
+	now := time.Now().UTC()
+	t.UpdatedAt = &now
+	return nil
+}
+
+// Delete soft-deletes the tag.
+func (t *Tag) Delete() {
+	now := time.Now().UTC()
+	t.UpdatedAt = &now
+	t.DeletedAt = &now
+}
+
+// NormalizeTagName lowercases the name and collapses its whitespace, so "Vacation  2026"
+// and "vacation 2026" are the same tag.
+func NormalizeTagName(name string) (string, error) {
+	normalized := strings.Join(strings.Fields(strings.ToLower(name)), " ")
+	if normalized == "" {
+		return "", fmt.Errorf("%w: name is required", transactionDomain.ErrInvalidTagName)
+	}
+	if utf8.RuneCountInString(normalized) > MaxTagNameLength {
+		return "", fmt.Errorf("%w: name cannot exceed %d characters", transactionDomain.ErrInvalidTagName, MaxTagNameLength)
+	}
+	return normalized, nil
+}
@@ -0,0 +1,53 @@
+package entities_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+func TestNewTag(t *testing.T) {
+	userID, _ := vos.NewUUID()
+
+	t.Run("should lowercase the name and collapse its whitespace", func(t *testing.T) {
+		tag, err := entities.NewTag(userID, "  Vacation   2026 ")
+		require.NoError(t, err)
+		require.Equal(t, "vacation 2026", tag.Name)
+		require.Equal(t, userID, tag.UserID)
+		require.Nil(t, tag.DeletedAt)
+	})
+
+	t.Run("should count characters, not bytes, against the maximum length", func(t *testing.T) {
+		_, err := entities.NewTag(userID, strings.Repeat("ç", entities.MaxTagNameLength))
+		require.NoError(t, err)
+
+		_, err = entities.NewTag(userID, strings.Repeat("a", entities.MaxTagNameLength+1))
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidTagName)
+	})
+
+	t.Run("should return error for a blank name", func(t *testing.T) {
+		_, err := entities.NewTag(userID, "   ")
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidTagName)
+	})
+}
+
+func TestTag_RenameAndDelete(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	tag, err := entities.NewTag(userID, "reimbursable")
+	require.NoError(t, err)
+
+	require.NoError(t, tag.Rename("Reembolsável"))
+	require.Equal(t, "reembolsável", tag.Name)
+	require.NotNil(t, tag.UpdatedAt)
+
+	require.ErrorIs(t, tag.Rename(""), transactionDomain.ErrInvalidTagName)
+	require.Equal(t, "reembolsável", tag.Name)
+
+	tag.Delete()
+	require.NotNil(t, tag.DeletedAt)
+}
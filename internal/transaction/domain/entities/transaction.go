@@ -1,6 +1,8 @@
 package entities
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
 	"time"
@@ -22,17 +24,27 @@ type TransactionParams struct {
 	InstallmentGroupID *vos.UUID
 	Description        string
 	Amount             vos.Money
+	Direction          transactionVos.TransactionDirection
 	PaymentMethod      transactionVos.PaymentMethod
 	TransactionDate    time.Time
 	InstallmentNumber  *int
 	InstallmentTotal   *int
+	ExternalID         *string
 	Status             transactionVos.TransactionStatus
+	OriginalAmount     *vos.Money
+	ExchangeRate       *transactionVos.ExchangeRate
+	IOFAmount          *vos.Money
+	Splits             []*TransactionSplit
+	Tags               []*Tag
 	CreatedAt          time.Time
 	UpdatedAt          *time.Time
 	DeletedAt          *time.Time
 }
 
-// Transaction represents an individual financial transaction.
+// Transaction represents an individual financial transaction. A transaction split
+// across categories keeps its first category in CategoryID and the shares in Splits.
+// A purchase in a foreign currency keeps the converted amount in Amount and the amount
+// paid, with its currency, in OriginalAmount.
 type Transaction struct {
 	ID                 vos.UUID
 	UserID             vos.UUID
@@ -43,17 +55,26 @@ type Transaction struct {
 	InstallmentGroupID *vos.UUID
 	Description        string
 	Amount             vos.Money
+	Direction          transactionVos.TransactionDirection
 	PaymentMethod      transactionVos.PaymentMethod
 	TransactionDate    time.Time
 	InstallmentNumber  *int
 	InstallmentTotal   *int
+	ExternalID         *string
 	Status             transactionVos.TransactionStatus
+	OriginalAmount     *vos.Money
+	ExchangeRate       *transactionVos.ExchangeRate
+	IOFAmount          *vos.Money
+	Splits             []*TransactionSplit
+	Tags               []*Tag
 	CreatedAt          time.Time
 	UpdatedAt          *time.Time
 	DeletedAt          *time.Time
+	Version            int
 }
 
 // NewTransaction creates a Transaction, validating description and amount.
+// An empty direction defaults to EXPENSE.
 func NewTransaction(params TransactionParams) (*Transaction, error) {
 	if strings.TrimSpace(params.Description) == "" {
 		return nil, fmt.Errorf("%w", transactionDomain.ErrDescriptionRequired)
@@ -61,6 +82,21 @@ func NewTransaction(params TransactionParams) (*Transaction, error) {
 	if !params.Amount.IsPositive() {
 		return nil, fmt.Errorf("%w", transactionDomain.ErrAmountMustBePositive)
 	}
+	direction := params.Direction
+	if direction == "" {
+		direction = transactionVos.DirectionExpense
+	}
+	if !direction.IsValid() {
+		return nil, fmt.Errorf("%w", transactionDomain.ErrInvalidDirection)
+	}
+	if len(params.Splits) > 0 {
+		if err := validateSplits(params.Amount, params.Splits); err != nil {
+			return nil, err
+		}
+		for _, split := range params.Splits {
+			split.TransactionID = params.ID
+		}
+	}
 	return &Transaction{
 		ID:                 params.ID,
 		UserID:             params.UserID,
@@ -71,14 +107,22 @@ func NewTransaction(params TransactionParams) (*Transaction, error) {
 		InstallmentGroupID: params.InstallmentGroupID,
 		Description:        params.Description,
 		Amount:             params.Amount,
+		Direction:          direction,
 		PaymentMethod:      params.PaymentMethod,
 		TransactionDate:    params.TransactionDate,
 		InstallmentNumber:  params.InstallmentNumber,
 		InstallmentTotal:   params.InstallmentTotal,
+		ExternalID:         params.ExternalID,
 		Status:             params.Status,
+		OriginalAmount:     params.OriginalAmount,
+		ExchangeRate:       params.ExchangeRate,
+		IOFAmount:          params.IOFAmount,
+		Splits:             params.Splits,
+		Tags:               params.Tags,
 		CreatedAt:          params.CreatedAt,
 		UpdatedAt:          params.UpdatedAt,
 		DeletedAt:          params.DeletedAt,
+		Version:            1,
 	}, nil
 }
 
@@ -94,11 +138,42 @@ func (t *Transaction) Cancel() error {
 	return nil
 }
 
-// UpdateDetails updates the transaction's mutable fields.
+// Confirm turns a scheduled transaction into an active one. A transaction confirmed
+// before its date is moved to today, so it counts from the moment it is confirmed.
+func (t *Transaction) Confirm(today time.Time) error {
+	if !t.Status.IsScheduled() {
+		return fmt.Errorf("%w", transactionDomain.ErrTransactionNotScheduled)
+	}
+	status, err := transactionVos.NewTransactionStatus(transactionVos.TransactionStatusActive)
+	if err != nil {
+		return fmt.Errorf("confirm transaction: %w", err)
+	}
+	if t.TransactionDate.After(today) {
+		t.TransactionDate = today
+	}
+	t.Status = status
+	now := time.Now().UTC()
+	t.UpdatedAt = &now
+	return nil
+}
+
+// Skip cancels a scheduled transaction that will not happen.
+func (t *Transaction) Skip() error {
+	if !t.Status.IsScheduled() {
+		return fmt.Errorf("%w", transactionDomain.ErrTransactionNotScheduled)
+	}
+	return t.Cancel()
+}
+
+// UpdateDetails updates the transaction's mutable fields. The amount of a foreign
+// currency transaction is fixed by its original amount and exchange rate.
 func (t *Transaction) UpdateDetails(description string, amount vos.Money, categoryID vos.UUID) error {
 	if !amount.IsPositive() {
 		return fmt.Errorf("%w", transactionDomain.ErrAmountMustBePositive)
 	}
+	if t.IsForeign() && !amount.Equals(t.Amount) {
+		return fmt.Errorf("%w", transactionDomain.ErrForeignAmountLocked)
+	}
 	t.Description = description
 	t.Amount = amount
 	t.CategoryID = categoryID
@@ -107,7 +182,110 @@ func (t *Transaction) UpdateDetails(description string, amount vos.Money, catego
 	return nil
 }
 
+// Reschedule changes the amount of an installment and the number of installments of its
+// purchase, replacing its splits, which must sum to the new amount.
+func (t *Transaction) Reschedule(amount vos.Money, installmentTotal int, splits []*TransactionSplit) error {
+	if !amount.IsPositive() {
+		return fmt.Errorf("%w", transactionDomain.ErrAmountMustBePositive)
+	}
+	if t.InstallmentNumber == nil || *t.InstallmentNumber > installmentTotal {
+		return fmt.Errorf("%w: installment is beyond the new number of installments", transactionDomain.ErrInvalidInstallmentEdit)
+	}
+	if len(splits) > 0 {
+		if err := validateSplits(amount, splits); err != nil {
+			return err
+		}
+	}
+	t.Amount = amount
+	t.InstallmentTotal = &installmentTotal
+	if err := t.SetSplits(splits); err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	t.UpdatedAt = &now
+	return nil
+}
+
+// Recategorize moves the transaction to the category and subcategory.
+func (t *Transaction) Recategorize(categoryID vos.UUID, subcategoryID *vos.UUID) {
+	t.CategoryID = categoryID
+	t.SubcategoryID = subcategoryID
+	now := time.Now().UTC()
+	t.UpdatedAt = &now
+}
+
+// SetSplits replaces the splits of the transaction, which must sum to its amount. An
+// empty list removes them, leaving the whole amount in the transaction's category.
+func (t *Transaction) SetSplits(splits []*TransactionSplit) error {
+	if len(splits) == 0 {
+		t.Splits = nil
+		return nil
+	}
+	if err := validateSplits(t.Amount, splits); err != nil {
+		return err
+	}
+	for _, split := range splits {
+		split.TransactionID = t.ID
+	}
+	t.Splits = splits
+	return nil
+}
+
+// SetTags replaces the tags of the transaction, ignoring repeated ones.
+func (t *Transaction) SetTags(tags []*Tag) {
+	seen := make(map[string]bool, len(tags))
+	unique := make([]*Tag, 0, len(tags))
+	for _, tag := range tags {
+		if seen[tag.ID.String()] {
+			continue
+		}
+		seen[tag.ID.String()] = true
+		unique = append(unique, tag)
+	}
+	t.Tags = unique
+}
+
+// HasSameTags reports whether the transaction carries exactly the given tags, in any order.
+func (t *Transaction) HasSameTags(tags []*Tag) bool {
+	current := make(map[string]bool, len(t.Tags))
+	for _, tag := range t.Tags {
+		current[tag.ID.String()] = true
+	}
+	given := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		if !current[tag.ID.String()] {
+			return false
+		}
+		given[tag.ID.String()] = true
+	}
+	return len(given) == len(current)
+}
+
+// IsSplit reports whether the transaction amount is split across categories.
+func (t *Transaction) IsSplit() bool {
+	return len(t.Splits) > 0
+}
+
+// IsForeign reports whether the transaction was made in a foreign currency.
+func (t *Transaction) IsForeign() bool {
+	return t.OriginalAmount != nil
+}
+
 // IsEditable returns false when the associated invoice is closed or paid.
 func (t *Transaction) IsEditable(invoiceStatus string) bool {
 	return invoiceStatus != "closed" && invoiceStatus != "paid"
 }
+
+// DuplicateKey returns the hash used to detect the same transaction entered twice.
+func (t *Transaction) DuplicateKey() string {
+	return DuplicateKey(t.TransactionDate, t.Amount.Cents(), t.Description)
+}
+
+// DuplicateKey hashes the date, the amount in cents and the description. The
+// description is compared case-insensitively with whitespace collapsed, so the same
+// entry typed by hand and exported by the bank produce the same key.
+func DuplicateKey(date time.Time, cents int64, description string) string {
+	normalized := strings.Join(strings.Fields(strings.ToLower(description)), " ")
+	sum := sha256.Sum256(fmt.Appendf(nil, "%s|%d|%s", date.Format("2006-01-02"), cents, normalized))
+	return hex.EncodeToString(sum[:])
+}
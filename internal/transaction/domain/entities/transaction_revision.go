@@ -0,0 +1,144 @@
+package entities
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+)
+
+const (
+	RevisionActionCreated   = "created"
+	RevisionActionUpdated   = "updated"
+	RevisionActionCancelled = "cancelled"
+)
+
+// TransactionState is the value of each field of a transaction kept in its history.
+// Optional fields are nil when unset.
+type TransactionState struct {
+	Description     string
+	Amount          string
+	Direction       string
+	CategoryID      string
+	SubcategoryID   *string
+	TransactionDate string
+	Status          string
+	InvoiceID       *string
+}
+
+// FieldChange is the change of one field of a transaction. From is nil on creation and
+// whenever the field was unset.
+type FieldChange struct {
+	Field string  `json:"field"`
+	From  *string `json:"from"`
+	To    *string `json:"to"`
+}
+
+// TransactionRevision records one creation, change or cancellation of a transaction.
+// Revisions are never changed once saved. ChangedBy is nil when the change was made by
+// the worker rather than by a user.
+type TransactionRevision struct {
+	ID            vos.UUID
+	TransactionID vos.UUID
+	UserID        vos.UUID
+	ChangedBy     *vos.UUID
+	Action        string
+	Changes       []FieldChange
+	CreatedAt     time.Time
+}
+
+// State returns the current value of the fields of t kept in its history.
+func (t *Transaction) State() TransactionState {
+	state := TransactionState{
+		Description:     t.Description,
+		Amount:          strconv.FormatFloat(t.Amount.Float(), 'f', 2, 64),
+		Direction:       t.Direction.String(),
+		CategoryID:      t.CategoryID.String(),
+		TransactionDate: t.TransactionDate.Format("2006-01-02"),
+		Status:          t.Status.String(),
+	}
+	if t.SubcategoryID != nil {
+		subcategoryID := t.SubcategoryID.String()
+		state.SubcategoryID = &subcategoryID
+	}
+	if t.InvoiceID != nil {
+		invoiceID := t.InvoiceID.String()
+		state.InvoiceID = &invoiceID
+	}
+	return state
+}
+
+// NewTransactionRevision records the change of t from before to its current state. A nil
+// before records the creation of t, with every set field. Returns nil when no field
+// changed. A change to the cancelled status is recorded as a cancellation.
+func NewTransactionRevision(t *Transaction, before *TransactionState, changedBy *vos.UUID) (*TransactionRevision, error) {
+	after := t.State()
+	action := RevisionActionCreated
+	var changes []FieldChange
+	if before == nil {
+		changes = diffStates(TransactionState{}, after)
+	} else {
+		action = RevisionActionUpdated
+		if after.Status != before.Status && t.Status.IsCancelled() {
+			action = RevisionActionCancelled
+		}
+		changes = diffStates(*before, after)
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionRevision{
+		ID:            id,
+		TransactionID: t.ID,
+		UserID:        t.UserID,
+		ChangedBy:     changedBy,
+		Action:        action,
+		Changes:       changes,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// diffStates lists the fields that differ between before and after, in a fixed order.
+func diffStates(before, after TransactionState) []FieldChange {
+	fields := []struct {
+		name     string
+		from, to *string
+	}{
+		{"description", optionalString(before.Description), optionalString(after.Description)},
+		{"amount", optionalString(before.Amount), optionalString(after.Amount)},
+		{"direction", optionalString(before.Direction), optionalString(after.Direction)},
+		{"category_id", optionalString(before.CategoryID), optionalString(after.CategoryID)},
+		{"subcategory_id", before.SubcategoryID, after.SubcategoryID},
+		{"transaction_date", optionalString(before.TransactionDate), optionalString(after.TransactionDate)},
+		{"status", optionalString(before.Status), optionalString(after.Status)},
+		{"invoice_id", before.InvoiceID, after.InvoiceID},
+	}
+
+	changes := make([]FieldChange, 0, len(fields))
+	for _, field := range fields {
+		if equalOptional(field.from, field.to) {
+			continue
+		}
+		changes = append(changes, FieldChange{Field: field.name, From: field.from, To: field.to})
+	}
+	return changes
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func equalOptional(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,80 @@
+package entities_test
+
+import (
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+func TestNewTransactionRevision(t *testing.T) {
+	userID, _ := vos.NewUUID()
+
+	t.Run("should record every set field on creation", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+
+		revision, err := entities.NewTransactionRevision(tx, nil, &userID)
+		require.NoError(t, err)
+		require.Equal(t, entities.RevisionActionCreated, revision.Action)
+		require.Equal(t, tx.ID, revision.TransactionID)
+		require.Equal(t, tx.UserID, revision.UserID)
+		require.Equal(t, &userID, revision.ChangedBy)
+
+		fields := make([]string, 0, len(revision.Changes))
+		for _, change := range revision.Changes {
+			require.Nil(t, change.From)
+			fields = append(fields, change.Field)
+		}
+		require.Equal(t, []string{"description", "amount", "direction", "category_id", "transaction_date", "status", "invoice_id"}, fields)
+	})
+
+	t.Run("should record only the fields that changed", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+		before := tx.State()
+		categoryID, _ := vos.NewUUID()
+
+		require.NoError(t, tx.UpdateDetails("Laptop", *money(t, 120.5), categoryID))
+
+		revision, err := entities.NewTransactionRevision(tx, &before, &userID)
+		require.NoError(t, err)
+		require.Equal(t, entities.RevisionActionUpdated, revision.Action)
+		require.Len(t, revision.Changes, 3)
+		require.Equal(t, "description", revision.Changes[0].Field)
+		require.Equal(t, "Notebook", *revision.Changes[0].From)
+		require.Equal(t, "Laptop", *revision.Changes[0].To)
+		require.Equal(t, "amount", revision.Changes[1].Field)
+		require.Equal(t, "100.00", *revision.Changes[1].From)
+		require.Equal(t, "120.50", *revision.Changes[1].To)
+		require.Equal(t, "category_id", revision.Changes[2].Field)
+		require.Equal(t, categoryID.String(), *revision.Changes[2].To)
+	})
+
+	t.Run("should record a cancellation made by the worker", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+		before := tx.State()
+		require.NoError(t, tx.Cancel())
+
+		revision, err := entities.NewTransactionRevision(tx, &before, nil)
+		require.NoError(t, err)
+		require.Equal(t, entities.RevisionActionCancelled, revision.Action)
+		require.Nil(t, revision.ChangedBy)
+		require.Len(t, revision.Changes, 1)
+		require.Equal(t, "active", *revision.Changes[0].From)
+		require.Equal(t, "cancelled", *revision.Changes[0].To)
+	})
+
+	t.Run("should return nil when nothing changed", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+		before := tx.State()
+
+		revision, err := entities.NewTransactionRevision(tx, &before, &userID)
+		require.NoError(t, err)
+		require.Nil(t, revision)
+	})
+}
@@ -0,0 +1,61 @@
+package entities
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// MaxSplits is the maximum number of categories a transaction can be split into.
+const MaxSplits = 20
+
+// TransactionSplit is the share of a transaction's amount assigned to one category.
+type TransactionSplit struct {
+	ID            vos.UUID
+	TransactionID vos.UUID
+	CategoryID    vos.UUID
+	SubcategoryID *vos.UUID
+	Amount        vos.Money
+	CreatedAt     time.Time
+}
+
+// NewTransactionSplit creates a TransactionSplit with a positive amount. The transaction
+// ID is assigned when the split is attached to a transaction.
+func NewTransactionSplit(categoryID vos.UUID, subcategoryID *vos.UUID, amount vos.Money) (*TransactionSplit, error) {
+	if !amount.IsPositive() {
+		return nil, fmt.Errorf("%w: split amounts must be positive", transactionDomain.ErrInvalidSplits)
+	}
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionSplit{
+		ID:            id,
+		CategoryID:    categoryID,
+		SubcategoryID: subcategoryID,
+		Amount:        amount,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// validateSplits checks that the splits sum to the transaction amount. A single split
+// is allowed, since an installment may carry only the categories left after rounding.
+func validateSplits(amount vos.Money, splits []*TransactionSplit) error {
+	if len(splits) > MaxSplits {
+		return fmt.Errorf("%w: a transaction can have at most %d splits", transactionDomain.ErrInvalidSplits, MaxSplits)
+	}
+	var sum int64
+	for _, split := range splits {
+		if !split.Amount.IsPositive() {
+			return fmt.Errorf("%w: split amounts must be positive", transactionDomain.ErrInvalidSplits)
+		}
+		sum += split.Amount.Cents()
+	}
+	if sum != amount.Cents() {
+		return fmt.Errorf("%w: splits sum %d cents, transaction has %d", transactionDomain.ErrSplitsTotalMismatch, sum, amount.Cents())
+	}
+	return nil
+}
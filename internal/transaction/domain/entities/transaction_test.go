@@ -7,6 +7,7 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 	"github.com/stretchr/testify/require"
 
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
 )
@@ -80,6 +81,65 @@ func TestTransaction_Cancel(t *testing.T) {
 	})
 }
 
+func TestTransaction_Confirm(t *testing.T) {
+	today := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	scheduled := func(t *testing.T, date time.Time) *entities.Transaction {
+		t.Helper()
+		params := validTransactionParams(t)
+		params.Status, _ = transactionVos.NewTransactionStatus(transactionVos.TransactionStatusScheduled)
+		params.TransactionDate = date
+		tx, err := entities.NewTransaction(params)
+		require.NoError(t, err)
+		return tx
+	}
+
+	t.Run("should activate a scheduled transaction keeping a past date", func(t *testing.T) {
+		date := time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC)
+		tx := scheduled(t, date)
+
+		require.NoError(t, tx.Confirm(today))
+		require.True(t, tx.Status.IsActive())
+		require.Equal(t, date, tx.TransactionDate)
+		require.NotNil(t, tx.UpdatedAt)
+	})
+
+	t.Run("should move a transaction confirmed ahead of time to today", func(t *testing.T) {
+		tx := scheduled(t, time.Date(2026, 3, 20, 0, 0, 0, 0, time.UTC))
+
+		require.NoError(t, tx.Confirm(today))
+		require.Equal(t, today, tx.TransactionDate)
+	})
+
+	t.Run("should reject a transaction that is not scheduled", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+
+		err = tx.Confirm(today)
+
+		require.ErrorIs(t, err, transactionDomain.ErrTransactionNotScheduled)
+		require.True(t, tx.Status.IsActive())
+	})
+}
+
+func TestTransaction_Skip(t *testing.T) {
+	t.Run("should cancel a scheduled transaction", func(t *testing.T) {
+		params := validTransactionParams(t)
+		params.Status, _ = transactionVos.NewTransactionStatus(transactionVos.TransactionStatusScheduled)
+		tx, err := entities.NewTransaction(params)
+		require.NoError(t, err)
+
+		require.NoError(t, tx.Skip())
+		require.True(t, tx.Status.IsCancelled())
+	})
+
+	t.Run("should reject a transaction that is not scheduled", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+
+		require.ErrorIs(t, tx.Skip(), transactionDomain.ErrTransactionNotScheduled)
+	})
+}
+
 func TestTransaction_UpdateDetails(t *testing.T) {
 	t.Run("should update fields successfully", func(t *testing.T) {
 		params := validTransactionParams(t)
@@ -103,6 +163,75 @@ func TestTransaction_UpdateDetails(t *testing.T) {
 		err = tx.UpdateDetails("desc", zeroAmount, categoryID)
 		require.Error(t, err)
 	})
+
+	t.Run("should keep the amount of a foreign currency transaction", func(t *testing.T) {
+		params := validTransactionParams(t)
+		original, _ := vos.NewMoneyFromFloat(20.00, vos.CurrencyUSD)
+		rate, _ := transactionVos.NewExchangeRate(5)
+		params.OriginalAmount = &original
+		params.ExchangeRate = &rate
+		tx, err := entities.NewTransaction(params)
+		require.NoError(t, err)
+		require.True(t, tx.IsForeign())
+
+		err = tx.UpdateDetails("Hotel", params.Amount, params.CategoryID)
+		require.NoError(t, err)
+		require.Equal(t, "Hotel", tx.Description)
+
+		newAmount, _ := vos.NewMoneyFromFloat(120.00, vos.CurrencyBRL)
+		err = tx.UpdateDetails("Hotel", newAmount, params.CategoryID)
+		require.ErrorIs(t, err, transactionDomain.ErrForeignAmountLocked)
+		require.Equal(t, int64(10000), tx.Amount.Cents())
+	})
+}
+
+func TestTransaction_Reschedule(t *testing.T) {
+	t.Run("should change the amount and the number of installments", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+
+		err = tx.Reschedule(*money(t, 40), 3, nil)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(4000), tx.Amount.Cents())
+		require.Equal(t, 3, *tx.InstallmentTotal)
+		require.NotNil(t, tx.UpdatedAt)
+	})
+
+	t.Run("should replace the splits", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+		categoryID, _ := vos.NewUUID()
+		split, _ := entities.NewTransactionSplit(categoryID, nil, *money(t, 40))
+
+		require.NoError(t, tx.Reschedule(*money(t, 40), 2, []*entities.TransactionSplit{split}))
+		require.Equal(t, tx.ID, tx.Splits[0].TransactionID)
+	})
+
+	t.Run("should reject an installment past the new count", func(t *testing.T) {
+		params := validTransactionParams(t)
+		number := 3
+		params.InstallmentNumber = &number
+		tx, err := entities.NewTransaction(params)
+		require.NoError(t, err)
+
+		err = tx.Reschedule(*money(t, 40), 2, nil)
+
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidInstallmentEdit)
+		require.Equal(t, int64(10000), tx.Amount.Cents())
+	})
+
+	t.Run("should reject splits that do not sum to the amount", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+		categoryID, _ := vos.NewUUID()
+		split, _ := entities.NewTransactionSplit(categoryID, nil, *money(t, 30))
+
+		err = tx.Reschedule(*money(t, 40), 2, []*entities.TransactionSplit{split})
+
+		require.ErrorIs(t, err, transactionDomain.ErrSplitsTotalMismatch)
+		require.Equal(t, int64(10000), tx.Amount.Cents())
+	})
 }
 
 func TestTransaction_IsEditable(t *testing.T) {
@@ -130,3 +259,101 @@ func TestTransaction_IsEditable(t *testing.T) {
 		require.True(t, tx.IsEditable(""))
 	})
 }
+
+func TestTransaction_DuplicateKey(t *testing.T) {
+	date := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should ignore case and repeated whitespace in description", func(t *testing.T) {
+		require.Equal(t,
+			entities.DuplicateKey(date, 10050, "Mercado  Extra"),
+			entities.DuplicateKey(date, 10050, " mercado extra "),
+		)
+	})
+
+	t.Run("should differ by date or amount", func(t *testing.T) {
+		key := entities.DuplicateKey(date, 10050, "Mercado")
+		require.NotEqual(t, key, entities.DuplicateKey(date.AddDate(0, 0, 1), 10050, "Mercado"))
+		require.NotEqual(t, key, entities.DuplicateKey(date, 10051, "Mercado"))
+	})
+
+	t.Run("should match the key of an existing transaction", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+		require.Equal(t, entities.DuplicateKey(tx.TransactionDate, 10000, "notebook"), tx.DuplicateKey())
+	})
+}
+
+func TestTransaction_SetSplits(t *testing.T) {
+	newSplit := func(t *testing.T, cents int64) *entities.TransactionSplit {
+		t.Helper()
+		categoryID, _ := vos.NewUUID()
+		amount, _ := vos.NewMoney(cents, vos.CurrencyBRL)
+		split, err := entities.NewTransactionSplit(categoryID, nil, amount)
+		require.NoError(t, err)
+		return split
+	}
+
+	t.Run("should attach splits that sum to the amount", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+
+		err = tx.SetSplits([]*entities.TransactionSplit{newSplit(t, 7000), newSplit(t, 3000)})
+		require.NoError(t, err)
+		require.True(t, tx.IsSplit())
+		require.Equal(t, tx.ID.String(), tx.Splits[1].TransactionID.String())
+	})
+
+	t.Run("should reject splits that do not sum to the amount", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+
+		err = tx.SetSplits([]*entities.TransactionSplit{newSplit(t, 7000), newSplit(t, 2999)})
+		require.ErrorIs(t, err, transactionDomain.ErrSplitsTotalMismatch)
+		require.False(t, tx.IsSplit())
+	})
+
+	t.Run("should remove the splits when the list is empty", func(t *testing.T) {
+		params := validTransactionParams(t)
+		params.Splits = []*entities.TransactionSplit{newSplit(t, 5000), newSplit(t, 5000)}
+		tx, err := entities.NewTransaction(params)
+		require.NoError(t, err)
+		require.True(t, tx.IsSplit())
+
+		require.NoError(t, tx.SetSplits(nil))
+		require.False(t, tx.IsSplit())
+	})
+
+	t.Run("should reject a split without a positive amount", func(t *testing.T) {
+		categoryID, _ := vos.NewUUID()
+		_, err := entities.NewTransactionSplit(categoryID, nil, vos.Money{})
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidSplits)
+	})
+}
+
+func TestTransaction_SetTags(t *testing.T) {
+	userID, _ := vos.NewUUID()
+	vacation, _ := entities.NewTag(userID, "vacation-2026")
+	reimbursable, _ := entities.NewTag(userID, "reimbursable")
+
+	t.Run("should ignore repeated tags", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+
+		tx.SetTags([]*entities.Tag{vacation, reimbursable, vacation})
+		require.Len(t, tx.Tags, 2)
+	})
+
+	t.Run("should compare tags regardless of order", func(t *testing.T) {
+		tx, err := entities.NewTransaction(validTransactionParams(t))
+		require.NoError(t, err)
+		tx.SetTags([]*entities.Tag{vacation, reimbursable})
+
+		require.True(t, tx.HasSameTags([]*entities.Tag{reimbursable, vacation}))
+		require.False(t, tx.HasSameTags([]*entities.Tag{vacation}))
+		require.False(t, tx.HasSameTags(nil))
+
+		tx.SetTags(nil)
+		require.True(t, tx.HasSameTags(nil))
+		require.Empty(t, tx.Tags)
+	})
+}
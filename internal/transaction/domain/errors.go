@@ -16,4 +16,61 @@ var (
 	ErrDescriptionRequired       = errors.New("description is required")
 	ErrAmountMustBePositive      = errors.New("amount must be positive")
 	ErrInstallmentsTooMany       = errors.New("installments cannot exceed 48")
+	ErrInvalidDirection          = errors.New("invalid transaction direction")
+	ErrInvalidListFilter         = errors.New("invalid list filter")
+	ErrIncomeNotAllowedForCredit = errors.New("income transactions cannot use the credit payment method")
+	ErrIncomeInstallments        = errors.New("income transactions cannot have installments")
+	ErrCreditLimitExceeded       = errors.New("purchase exceeds the card available limit")
+	ErrInvalidSplits             = errors.New("invalid transaction splits")
+	ErrSplitsTotalMismatch       = errors.New("splits must sum to the transaction amount")
+
+	ErrRecurringTransactionNotFound = errors.New("recurring transaction not found")
+	ErrRecurringTransactionNotOwned = errors.New("recurring transaction does not belong to user")
+	ErrInvalidRecurrenceRule        = errors.New("invalid recurrence rule")
+	ErrRecurrenceFinished           = errors.New("recurrence has no occurrences left")
+	ErrRecurringTransactionConflict = errors.New("recurring transaction was modified concurrently")
+
+	ErrImportFileRequired   = errors.New("import file is required")
+	ErrInvalidImportFile    = errors.New("invalid import file")
+	ErrInvalidImportOptions = errors.New("invalid import options")
+	ErrImportEmpty          = errors.New("import file has no rows")
+	ErrImportTooManyRows    = errors.New("import file exceeds the maximum number of rows")
+
+	ErrInvalidExportFormat = errors.New("invalid export format")
+
+	ErrTagNotFound      = errors.New("tag not found")
+	ErrTagNotOwned      = errors.New("tag does not belong to user")
+	ErrInvalidTagName   = errors.New("invalid tag name")
+	ErrTagAlreadyExists = errors.New("tag already exists")
+	ErrInvalidTagging   = errors.New("invalid tagging request")
+
+	ErrAttachmentNotFound        = errors.New("attachment not found")
+	ErrAttachmentRequired        = errors.New("attachment file is required")
+	ErrAttachmentTooLarge        = errors.New("attachment exceeds the maximum size")
+	ErrUnsupportedAttachmentType = errors.New("unsupported attachment content type")
+	ErrInvoiceNotFound           = errors.New("invoice not found")
+	ErrInvoiceNotOwned           = errors.New("invoice does not belong to user")
+
+	ErrCategorizationRuleNotFound = errors.New("categorization rule not found")
+	ErrCategorizationRuleNotOwned = errors.New("categorization rule does not belong to user")
+	ErrInvalidCategorizationRule  = errors.New("invalid categorization rule")
+
+	ErrInvalidMerge = errors.New("invalid merge request")
+
+	ErrInvalidRefund           = errors.New("invalid refund request")
+	ErrRefundNotAllowed        = errors.New("transaction cannot be refunded")
+	ErrRefundExceedsRefundable = errors.New("refund exceeds the amount left to refund")
+
+	ErrInstallmentGroupNotFound = errors.New("installment group not found")
+	ErrInvalidPayoff            = errors.New("invalid payoff request")
+	ErrNothingToPayOff          = errors.New("no future installments to pay off")
+	ErrInvalidInstallmentEdit   = errors.New("invalid installment edit")
+	ErrInstallmentsLocked       = errors.New("installments on closed invoices cannot change")
+
+	ErrTransactionNotScheduled = errors.New("transaction is not scheduled")
+
+	ErrInvalidForeignAmount = errors.New("invalid foreign currency amount")
+	ErrInvalidExchangeRate  = errors.New("invalid exchange rate")
+	ErrExchangeRateNotFound = errors.New("no exchange rate for the currency on or before the transaction date")
+	ErrForeignAmountLocked  = errors.New("amount of a foreign currency transaction cannot change")
 )
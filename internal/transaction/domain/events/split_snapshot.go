@@ -0,0 +1,28 @@
+package events
+
+import "github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+// SplitSnapshot holds the share of a transaction in one category. Consumers that total
+// amounts by category use the splits, when present, instead of the transaction category.
+type SplitSnapshot struct {
+	CategoryID    vos.UUID
+	SubcategoryID *vos.UUID
+	Amount        vos.Money
+}
+
+// splitsPayload serializes the splits, always as a list so consumers need no nil checks.
+func splitsPayload(splits []SplitSnapshot) []map[string]any {
+	payload := make([]map[string]any, 0, len(splits))
+	for _, split := range splits {
+		item := map[string]any{
+			"category_id":    split.CategoryID.String(),
+			"subcategory_id": nil,
+			"amount":         split.Amount.Cents(),
+		}
+		if split.SubcategoryID != nil {
+			item["subcategory_id"] = split.SubcategoryID.String()
+		}
+		payload = append(payload, item)
+	}
+	return payload
+}
@@ -9,7 +9,7 @@ import (
 	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
 )
 
-const TransactionCreatedSchemaVersion = "2"
+const TransactionCreatedSchemaVersion = "4"
 
 // TransactionCreatedEvent is emitted when a new transaction is created.
 type TransactionCreatedEvent struct {
@@ -17,6 +17,7 @@ type TransactionCreatedEvent struct {
 	userID             vos.UUID
 	categoryID         vos.UUID
 	amount             vos.Money
+	direction          transactionVos.TransactionDirection
 	paymentMethod      transactionVos.PaymentMethod
 	transactionDate    time.Time
 	referenceMonth     pkgVos.ReferenceMonth
@@ -24,6 +25,7 @@ type TransactionCreatedEvent struct {
 	installmentNumber  *int
 	installmentTotal   *int
 	installmentGroupID *vos.UUID
+	splits             []SplitSnapshot
 }
 
 // NewTransactionCreatedEvent creates a TransactionCreatedEvent.
@@ -32,6 +34,7 @@ func NewTransactionCreatedEvent(
 	userID vos.UUID,
 	categoryID vos.UUID,
 	amount vos.Money,
+	direction transactionVos.TransactionDirection,
 	paymentMethod transactionVos.PaymentMethod,
 	transactionDate time.Time,
 	referenceMonth pkgVos.ReferenceMonth,
@@ -39,12 +42,14 @@ func NewTransactionCreatedEvent(
 	installmentNumber *int,
 	installmentTotal *int,
 	installmentGroupID *vos.UUID,
+	splits []SplitSnapshot,
 ) *TransactionCreatedEvent {
 	return &TransactionCreatedEvent{
 		transactionID:      transactionID,
 		userID:             userID,
 		categoryID:         categoryID,
 		amount:             amount,
+		direction:          direction,
 		paymentMethod:      paymentMethod,
 		transactionDate:    transactionDate,
 		referenceMonth:     referenceMonth,
@@ -52,6 +57,7 @@ func NewTransactionCreatedEvent(
 		installmentNumber:  installmentNumber,
 		installmentTotal:   installmentTotal,
 		installmentGroupID: installmentGroupID,
+		splits:             splits,
 	}
 }
 
@@ -74,6 +80,7 @@ func (e *TransactionCreatedEvent) Payload() map[string]any {
 		"category_id":          e.categoryID.String(),
 		"amount":               e.amount.Cents(),
 		"currency":             e.amount.Currency().String(),
+		"direction":            e.direction.String(),
 		"payment_method":       e.paymentMethod.String(),
 		"transaction_date":     e.transactionDate.Format("2006-01-02"),
 		"reference_month":      e.referenceMonth.String(),
@@ -81,6 +88,7 @@ func (e *TransactionCreatedEvent) Payload() map[string]any {
 		"installment_number":   nil,
 		"installment_total":    nil,
 		"installment_group_id": nil,
+		"splits":               splitsPayload(e.splits),
 	}
 	if e.invoiceID != nil {
 		payload["invoice_id"] = e.invoiceID.String()
@@ -25,6 +25,7 @@ func buildEvent(t *testing.T) *events.TransactionCreatedEvent {
 		userID,
 		categoryID,
 		amount,
+		transactionVos.DirectionExpense,
 		pm,
 		time.Now().Add(-time.Hour),
 		refMonth,
@@ -32,6 +33,7 @@ func buildEvent(t *testing.T) *events.TransactionCreatedEvent {
 		nil,
 		nil,
 		nil,
+		nil,
 	)
 }
 
@@ -48,7 +50,7 @@ func TestTransactionCreatedEvent(t *testing.T) {
 		amount, _ := vos.NewMoneyFromFloat(50.00, vos.CurrencyBRL)
 		pm, _ := transactionVos.NewPaymentMethod(transactionVos.PaymentMethodPix)
 		refMonth, _ := pkgVos.NewReferenceMonth("2026-03")
-		e := events.NewTransactionCreatedEvent(txID, userID, categoryID, amount, pm, time.Now().Add(-time.Hour), refMonth, nil, nil, nil, nil)
+		e := events.NewTransactionCreatedEvent(txID, userID, categoryID, amount, transactionVos.DirectionExpense, pm, time.Now().Add(-time.Hour), refMonth, nil, nil, nil, nil, nil)
 		require.Equal(t, txID.String(), e.IdempotencyKey())
 	})
 
@@ -61,6 +63,7 @@ func TestTransactionCreatedEvent(t *testing.T) {
 		require.Contains(t, payload, "amount")
 		require.Contains(t, payload, "reference_month")
 		require.Equal(t, "2026-03", payload["reference_month"])
+		require.Equal(t, "EXPENSE", payload["direction"])
 	})
 
 	t.Run("Payload with nil invoice_id should have null invoice_id field", func(t *testing.T) {
@@ -79,8 +82,38 @@ func TestTransactionCreatedEvent(t *testing.T) {
 		refMonth, _ := pkgVos.NewReferenceMonth("2026-03")
 		num := 1
 		total := 3
-		e := events.NewTransactionCreatedEvent(txID, userID, categoryID, amount, pm, time.Now().Add(-time.Hour), refMonth, nil, &num, &total, &groupID)
+		e := events.NewTransactionCreatedEvent(txID, userID, categoryID, amount, transactionVos.DirectionExpense, pm, time.Now().Add(-time.Hour), refMonth, nil, &num, &total, &groupID, nil)
 		payload := e.Payload()
 		require.Equal(t, groupID.String(), payload["installment_group_id"])
 	})
+	t.Run("Payload should carry the splits of the transaction", func(t *testing.T) {
+		txID, _ := vos.NewUUID()
+		userID, _ := vos.NewUUID()
+		groceriesID, _ := vos.NewUUID()
+		pharmacyID, _ := vos.NewUUID()
+		subcategoryID, _ := vos.NewUUID()
+		amount, _ := vos.NewMoneyFromFloat(100.00, vos.CurrencyBRL)
+		groceries, _ := vos.NewMoneyFromFloat(80.00, vos.CurrencyBRL)
+		pharmacy, _ := vos.NewMoneyFromFloat(20.00, vos.CurrencyBRL)
+		pm, _ := transactionVos.NewPaymentMethod(transactionVos.PaymentMethodPix)
+		refMonth, _ := pkgVos.NewReferenceMonth("2026-03")
+		splits := []events.SplitSnapshot{
+			{CategoryID: groceriesID, Amount: groceries},
+			{CategoryID: pharmacyID, SubcategoryID: &subcategoryID, Amount: pharmacy},
+		}
+		e := events.NewTransactionCreatedEvent(txID, userID, groceriesID, amount, transactionVos.DirectionExpense, pm, time.Now().Add(-time.Hour), refMonth, nil, nil, nil, nil, splits)
+
+		payload := e.Payload()
+		require.Equal(t, events.TransactionCreatedSchemaVersion, payload["version"])
+		require.Equal(t, []map[string]any{
+			{"category_id": groceriesID.String(), "subcategory_id": nil, "amount": int64(8000)},
+			{"category_id": pharmacyID.String(), "subcategory_id": subcategoryID.String(), "amount": int64(2000)},
+		}, payload["splits"])
+	})
+
+	t.Run("Payload without splits should have an empty splits list", func(t *testing.T) {
+		payload := buildEvent(t).Payload()
+		require.Empty(t, payload["splits"])
+		require.NotNil(t, payload["splits"])
+	})
 }
@@ -0,0 +1,80 @@
+package events
+
+import (
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+const TransactionRefundedSchemaVersion = "1"
+
+// TransactionRefundedEvent is emitted when a billed card purchase is refunded. The
+// reference month is the one of the invoice that billed the purchase, not the one of the
+// invoice that receives the credit, so budgets are reduced where the spending was counted.
+type TransactionRefundedEvent struct {
+	refundID       vos.UUID
+	transactionID  vos.UUID
+	userID         vos.UUID
+	categoryID     vos.UUID
+	amount         vos.Money
+	referenceMonth pkgVos.ReferenceMonth
+	invoiceID      vos.UUID
+	refundedAt     time.Time
+	splits         []SplitSnapshot
+}
+
+// NewTransactionRefundedEvent creates a TransactionRefundedEvent. invoiceID is the invoice
+// that received the credit; splits are the shares of the refund per category.
+func NewTransactionRefundedEvent(
+	refundID vos.UUID,
+	transactionID vos.UUID,
+	userID vos.UUID,
+	categoryID vos.UUID,
+	amount vos.Money,
+	referenceMonth pkgVos.ReferenceMonth,
+	invoiceID vos.UUID,
+	refundedAt time.Time,
+	splits []SplitSnapshot,
+) *TransactionRefundedEvent {
+	return &TransactionRefundedEvent{
+		refundID:       refundID,
+		transactionID:  transactionID,
+		userID:         userID,
+		categoryID:     categoryID,
+		amount:         amount,
+		referenceMonth: referenceMonth,
+		invoiceID:      invoiceID,
+		refundedAt:     refundedAt,
+		splits:         splits,
+	}
+}
+
+// EventType returns the event type identifier.
+func (e *TransactionRefundedEvent) EventType() string {
+	return "transaction.refunded"
+}
+
+// IdempotencyKey returns a unique key for deduplication.
+// A transaction can be refunded several times, so the refund ID identifies the event.
+func (e *TransactionRefundedEvent) IdempotencyKey() string {
+	return e.refundID.String()
+}
+
+// Payload returns the event data as a map for outbox serialization.
+func (e *TransactionRefundedEvent) Payload() map[string]any {
+	return map[string]any{
+		"version":         TransactionRefundedSchemaVersion,
+		"refund_id":       e.refundID.String(),
+		"transaction_id":  e.transactionID.String(),
+		"user_id":         e.userID.String(),
+		"category_id":     e.categoryID.String(),
+		"amount":          e.amount.Cents(),
+		"currency":        e.amount.Currency().String(),
+		"reference_month": e.referenceMonth.String(),
+		"invoice_id":      e.invoiceID.String(),
+		"refunded_at":     e.refundedAt.Format(time.RFC3339),
+		"splits":          splitsPayload(e.splits),
+	}
+}
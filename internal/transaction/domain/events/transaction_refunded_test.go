@@ -0,0 +1,50 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+func TestTransactionRefundedEvent(t *testing.T) {
+	refundID, _ := vos.NewUUID()
+	txID, _ := vos.NewUUID()
+	userID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	otherCategoryID, _ := vos.NewUUID()
+	invoiceID, _ := vos.NewUUID()
+	amount, _ := vos.NewMoneyFromFloat(40, vos.CurrencyBRL)
+	share, _ := vos.NewMoneyFromFloat(20, vos.CurrencyBRL)
+	refMonth, _ := pkgVos.NewReferenceMonth("2026-02")
+	splits := []events.SplitSnapshot{
+		{CategoryID: categoryID, Amount: share},
+		{CategoryID: otherCategoryID, Amount: share},
+	}
+
+	e := events.NewTransactionRefundedEvent(refundID, txID, userID, categoryID, amount, refMonth, invoiceID, time.Now(), splits)
+
+	t.Run("EventType should return transaction.refunded", func(t *testing.T) {
+		require.Equal(t, "transaction.refunded", e.EventType())
+	})
+
+	t.Run("IdempotencyKey should return refund_id as string", func(t *testing.T) {
+		require.Equal(t, refundID.String(), e.IdempotencyKey())
+	})
+
+	t.Run("Payload should carry budget sync fields", func(t *testing.T) {
+		payload := e.Payload()
+		require.Equal(t, events.TransactionRefundedSchemaVersion, payload["version"])
+		require.Equal(t, txID.String(), payload["transaction_id"])
+		require.Equal(t, userID.String(), payload["user_id"])
+		require.Equal(t, categoryID.String(), payload["category_id"])
+		require.Equal(t, "2026-02", payload["reference_month"])
+		require.Equal(t, int64(4000), payload["amount"])
+		require.Equal(t, invoiceID.String(), payload["invoice_id"])
+		require.Len(t, payload["splits"], 2)
+	})
+}
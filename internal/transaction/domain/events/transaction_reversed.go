@@ -0,0 +1,91 @@
+package events
+
+import (
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+const TransactionReversedSchemaVersion = "2"
+
+// TransactionReversedEvent is emitted for each transaction cancelled by a reversal.
+type TransactionReversedEvent struct {
+	transactionID      vos.UUID
+	userID             vos.UUID
+	categoryID         vos.UUID
+	amount             vos.Money
+	referenceMonth     pkgVos.ReferenceMonth
+	invoiceID          *vos.UUID
+	installmentNumber  *int
+	installmentGroupID *vos.UUID
+	reversedAt         time.Time
+	splits             []SplitSnapshot
+}
+
+// NewTransactionReversedEvent creates a TransactionReversedEvent.
+func NewTransactionReversedEvent(
+	transactionID vos.UUID,
+	userID vos.UUID,
+	categoryID vos.UUID,
+	amount vos.Money,
+	referenceMonth pkgVos.ReferenceMonth,
+	invoiceID *vos.UUID,
+	installmentNumber *int,
+	installmentGroupID *vos.UUID,
+	reversedAt time.Time,
+	splits []SplitSnapshot,
+) *TransactionReversedEvent {
+	return &TransactionReversedEvent{
+		transactionID:      transactionID,
+		userID:             userID,
+		categoryID:         categoryID,
+		amount:             amount,
+		referenceMonth:     referenceMonth,
+		invoiceID:          invoiceID,
+		installmentNumber:  installmentNumber,
+		installmentGroupID: installmentGroupID,
+		reversedAt:         reversedAt,
+		splits:             splits,
+	}
+}
+
+// EventType returns the event type identifier.
+func (e *TransactionReversedEvent) EventType() string {
+	return "transaction.reversed"
+}
+
+// IdempotencyKey returns a unique key for deduplication.
+// A transaction can only be cancelled once, so its ID is enough.
+func (e *TransactionReversedEvent) IdempotencyKey() string {
+	return e.transactionID.String()
+}
+
+// Payload returns the event data as a map for outbox serialization.
+func (e *TransactionReversedEvent) Payload() map[string]any {
+	payload := map[string]any{
+		"version":              TransactionReversedSchemaVersion,
+		"transaction_id":       e.transactionID.String(),
+		"user_id":              e.userID.String(),
+		"category_id":          e.categoryID.String(),
+		"amount":               e.amount.Cents(),
+		"currency":             e.amount.Currency().String(),
+		"reference_month":      e.referenceMonth.String(),
+		"reversed_at":          e.reversedAt.Format(time.RFC3339),
+		"invoice_id":           nil,
+		"installment_number":   nil,
+		"installment_group_id": nil,
+		"splits":               splitsPayload(e.splits),
+	}
+	if e.invoiceID != nil {
+		payload["invoice_id"] = e.invoiceID.String()
+	}
+	if e.installmentNumber != nil {
+		payload["installment_number"] = *e.installmentNumber
+	}
+	if e.installmentGroupID != nil {
+		payload["installment_group_id"] = e.installmentGroupID.String()
+	}
+	return payload
+}
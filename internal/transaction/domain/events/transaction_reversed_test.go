@@ -0,0 +1,44 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+func TestTransactionReversedEvent(t *testing.T) {
+	txID, _ := vos.NewUUID()
+	userID, _ := vos.NewUUID()
+	categoryID, _ := vos.NewUUID()
+	invoiceID, _ := vos.NewUUID()
+	amount, _ := vos.NewMoneyFromFloat(33.33, vos.CurrencyBRL)
+	refMonth, _ := pkgVos.NewReferenceMonth("2026-04")
+	num := 2
+
+	e := events.NewTransactionReversedEvent(txID, userID, categoryID, amount, refMonth, &invoiceID, &num, nil, time.Now(), nil)
+
+	t.Run("EventType should return transaction.reversed", func(t *testing.T) {
+		require.Equal(t, "transaction.reversed", e.EventType())
+	})
+
+	t.Run("IdempotencyKey should return transaction_id as string", func(t *testing.T) {
+		require.Equal(t, txID.String(), e.IdempotencyKey())
+	})
+
+	t.Run("Payload should carry budget sync fields", func(t *testing.T) {
+		payload := e.Payload()
+		require.Equal(t, events.TransactionReversedSchemaVersion, payload["version"])
+		require.Equal(t, userID.String(), payload["user_id"])
+		require.Equal(t, categoryID.String(), payload["category_id"])
+		require.Equal(t, "2026-04", payload["reference_month"])
+		require.Equal(t, int64(3333), payload["amount"])
+		require.Equal(t, invoiceID.String(), payload["invoice_id"])
+		require.Equal(t, 2, payload["installment_number"])
+		require.Nil(t, payload["installment_group_id"])
+	})
+}
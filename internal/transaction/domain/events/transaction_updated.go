@@ -0,0 +1,79 @@
+package events
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+const TransactionUpdatedSchemaVersion = "2"
+
+// TransactionSnapshot holds the budget-relevant fields of a transaction at a point in time.
+type TransactionSnapshot struct {
+	CategoryID     vos.UUID
+	Amount         vos.Money
+	ReferenceMonth pkgVos.ReferenceMonth
+	Splits         []SplitSnapshot
+}
+
+// TransactionUpdatedEvent is emitted when a transaction's details change.
+// It carries both the previous and the current values so consumers can
+// adjust the old and the new category/month independently.
+type TransactionUpdatedEvent struct {
+	transactionID vos.UUID
+	userID        vos.UUID
+	previous      TransactionSnapshot
+	current       TransactionSnapshot
+	updatedAt     time.Time
+}
+
+// NewTransactionUpdatedEvent creates a TransactionUpdatedEvent.
+func NewTransactionUpdatedEvent(
+	transactionID vos.UUID,
+	userID vos.UUID,
+	previous TransactionSnapshot,
+	current TransactionSnapshot,
+	updatedAt time.Time,
+) *TransactionUpdatedEvent {
+	return &TransactionUpdatedEvent{
+		transactionID: transactionID,
+		userID:        userID,
+		previous:      previous,
+		current:       current,
+		updatedAt:     updatedAt,
+	}
+}
+
+// EventType returns the event type identifier.
+func (e *TransactionUpdatedEvent) EventType() string {
+	return "transaction.updated"
+}
+
+// IdempotencyKey returns a unique key for deduplication.
+func (e *TransactionUpdatedEvent) IdempotencyKey() string {
+	return fmt.Sprintf("%s:%d", e.transactionID.String(), e.updatedAt.UnixNano())
+}
+
+// Payload returns the event data as a map for outbox serialization.
+// category_id, amount and reference_month hold the new values, keeping the
+// same contract as transaction.created; old_* fields hold the previous ones.
+func (e *TransactionUpdatedEvent) Payload() map[string]any {
+	return map[string]any{
+		"version":             TransactionUpdatedSchemaVersion,
+		"transaction_id":      e.transactionID.String(),
+		"user_id":             e.userID.String(),
+		"category_id":         e.current.CategoryID.String(),
+		"amount":              e.current.Amount.Cents(),
+		"currency":            e.current.Amount.Currency().String(),
+		"reference_month":     e.current.ReferenceMonth.String(),
+		"old_category_id":     e.previous.CategoryID.String(),
+		"old_amount":          e.previous.Amount.Cents(),
+		"old_reference_month": e.previous.ReferenceMonth.String(),
+		"splits":              splitsPayload(e.current.Splits),
+		"old_splits":          splitsPayload(e.previous.Splits),
+		"updated_at":          e.updatedAt.Format(time.RFC3339),
+	}
+}
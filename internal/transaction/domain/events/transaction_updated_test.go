@@ -0,0 +1,76 @@
+package events_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/events"
+	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
+)
+
+func TestTransactionUpdatedEvent(t *testing.T) {
+	txID, _ := vos.NewUUID()
+	userID, _ := vos.NewUUID()
+	oldCategoryID, _ := vos.NewUUID()
+	newCategoryID, _ := vos.NewUUID()
+	oldAmount, _ := vos.NewMoneyFromFloat(100.00, vos.CurrencyBRL)
+	newAmount, _ := vos.NewMoneyFromFloat(150.00, vos.CurrencyBRL)
+	refMonth, _ := pkgVos.NewReferenceMonth("2026-03")
+	updatedAt := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	e := events.NewTransactionUpdatedEvent(
+		txID,
+		userID,
+		events.TransactionSnapshot{CategoryID: oldCategoryID, Amount: oldAmount, ReferenceMonth: refMonth},
+		events.TransactionSnapshot{CategoryID: newCategoryID, Amount: newAmount, ReferenceMonth: refMonth},
+		updatedAt,
+	)
+
+	t.Run("EventType should return transaction.updated", func(t *testing.T) {
+		require.Equal(t, "transaction.updated", e.EventType())
+	})
+
+	t.Run("IdempotencyKey should differ between updates of the same transaction", func(t *testing.T) {
+		other := events.NewTransactionUpdatedEvent(txID, userID, events.TransactionSnapshot{}, events.TransactionSnapshot{}, updatedAt.Add(time.Second))
+		require.NotEqual(t, e.IdempotencyKey(), other.IdempotencyKey())
+	})
+
+	t.Run("Payload should carry old and new values", func(t *testing.T) {
+		payload := e.Payload()
+		require.Equal(t, events.TransactionUpdatedSchemaVersion, payload["version"])
+		require.Equal(t, newCategoryID.String(), payload["category_id"])
+		require.Equal(t, oldCategoryID.String(), payload["old_category_id"])
+		require.Equal(t, int64(15000), payload["amount"])
+		require.Equal(t, int64(10000), payload["old_amount"])
+		require.Equal(t, "2026-03", payload["reference_month"])
+		require.Equal(t, "2026-03", payload["old_reference_month"])
+	})
+	t.Run("Payload should carry the current and previous splits", func(t *testing.T) {
+		pharmacyID, _ := vos.NewUUID()
+		groceries, _ := vos.NewMoneyFromFloat(100.00, vos.CurrencyBRL)
+		pharmacy, _ := vos.NewMoneyFromFloat(50.00, vos.CurrencyBRL)
+		split := events.NewTransactionUpdatedEvent(
+			txID,
+			userID,
+			events.TransactionSnapshot{CategoryID: oldCategoryID, Amount: oldAmount, ReferenceMonth: refMonth},
+			events.TransactionSnapshot{
+				CategoryID:     newCategoryID,
+				Amount:         newAmount,
+				ReferenceMonth: refMonth,
+				Splits: []events.SplitSnapshot{
+					{CategoryID: newCategoryID, Amount: groceries},
+					{CategoryID: pharmacyID, Amount: pharmacy},
+				},
+			},
+			updatedAt,
+		)
+
+		payload := split.Payload()
+		require.Len(t, payload["splits"], 2)
+		require.Equal(t, pharmacyID.String(), payload["splits"].([]map[string]any)[1]["category_id"])
+		require.Empty(t, payload["old_splits"])
+	})
+}
@@ -0,0 +1,125 @@
+package factories
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+const (
+	// BaseCurrency is the currency transactions, invoices and budgets are kept in.
+	// Purchases in other currencies are converted to it.
+	BaseCurrency = vos.CurrencyBRL
+	// MaxIOFPercentage bounds the IOF percentage accepted on a foreign card purchase.
+	MaxIOFPercentage = 25.0
+)
+
+// ForeignAmount is the amount of a purchase made in a foreign currency, converted to
+// the base currency with Rate. IOF, when charged, is computed on the converted amount.
+type ForeignAmount struct {
+	Original  vos.Money
+	Rate      transactionVos.ExchangeRate
+	Converted vos.Money
+	IOF       *vos.Money
+}
+
+// IsForeignCurrency reports whether the currency code names a currency other than the
+// base one. An empty code means the base currency.
+func IsForeignCurrency(currency string) bool {
+	code := strings.ToUpper(strings.TrimSpace(currency))
+	return code != "" && code != string(BaseCurrency)
+}
+
+// NewForeignAmount converts amount, in currency, to the base currency. A non-nil
+// iofPercentage charges that percentage of the converted amount as IOF, rounded to the cent.
+func NewForeignAmount(amount float64, currency string, rate transactionVos.ExchangeRate, iofPercentage *float64) (*ForeignAmount, error) {
+	code, err := vos.NewCurrency(strings.TrimSpace(currency))
+	if err != nil {
+		return nil, fmt.Errorf("%w: unsupported currency %q", transactionDomain.ErrInvalidForeignAmount, currency)
+	}
+	if code == BaseCurrency {
+		return nil, fmt.Errorf("%w: %s is the base currency", transactionDomain.ErrInvalidForeignAmount, code)
+	}
+	original, err := vos.NewMoneyFromFloat(amount, code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	if !original.IsPositive() {
+		return nil, transactionDomain.ErrAmountMustBePositive
+	}
+	converted, err := rate.Convert(original, BaseCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	if !converted.IsPositive() {
+		return nil, fmt.Errorf("%w: the converted amount is below one cent", transactionDomain.ErrInvalidForeignAmount)
+	}
+
+	foreign := &ForeignAmount{Original: original, Rate: rate, Converted: converted}
+	if iofPercentage == nil {
+		return foreign, nil
+	}
+	if *iofPercentage <= 0 || *iofPercentage > MaxIOFPercentage {
+		return nil, fmt.Errorf("%w: iof_percentage must be greater than 0 and at most %.0f", transactionDomain.ErrInvalidForeignAmount, MaxIOFPercentage)
+	}
+	basisPoints := int64(math.Round(*iofPercentage * 100))
+	iofCents := (converted.Cents()*basisPoints + 5_000) / 10_000
+	if iofCents > 0 {
+		iof, err := vos.NewMoney(iofCents, BaseCurrency)
+		if err != nil {
+			return nil, err
+		}
+		foreign.IOF = &iof
+	}
+	return foreign, nil
+}
+
+// Total is the converted amount plus the IOF, what the purchase costs in the base currency.
+func (f *ForeignAmount) Total() vos.Money {
+	if f.IOF == nil {
+		return f.Converted
+	}
+	total, err := f.Converted.Add(*f.IOF)
+	if err != nil {
+		return f.Converted
+	}
+	return total
+}
+
+// convertSplits converts splits entered in the original currency to the base currency.
+// The last split takes what is left of the converted amount, so they still add up to it.
+func convertSplits(splits []*entities.TransactionSplit, foreign *ForeignAmount) ([]*entities.TransactionSplit, error) {
+	if len(splits) == 0 {
+		return nil, nil
+	}
+	converted := make([]*entities.TransactionSplit, 0, len(splits))
+	remaining := foreign.Converted.Cents()
+	for i, split := range splits {
+		original, err := vos.NewMoney(split.Amount.Cents(), foreign.Original.Currency())
+		if err != nil {
+			return nil, err
+		}
+		amount, err := foreign.Rate.Convert(original, BaseCurrency)
+		if err != nil {
+			return nil, err
+		}
+		if i == len(splits)-1 {
+			if amount, err = vos.NewMoney(remaining, BaseCurrency); err != nil {
+				return nil, err
+			}
+		}
+		remaining -= amount.Cents()
+		convertedSplit, err := entities.NewTransactionSplit(split.CategoryID, split.SubcategoryID, amount)
+		if err != nil {
+			return nil, err
+		}
+		converted = append(converted, convertedSplit)
+	}
+	return converted, nil
+}
@@ -0,0 +1,67 @@
+package factories_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+func usdRate(t *testing.T) transactionVos.ExchangeRate {
+	t.Helper()
+	rate, err := transactionVos.NewExchangeRate(5.4321)
+	require.NoError(t, err)
+	return rate
+}
+
+func TestNewForeignAmount(t *testing.T) {
+	t.Run("should convert the amount to the base currency", func(t *testing.T) {
+		foreign, err := factories.NewForeignAmount(19.99, "usd", usdRate(t), nil)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(1999), foreign.Original.Cents())
+		require.Equal(t, "USD", string(foreign.Original.Currency()))
+		require.Equal(t, int64(10859), foreign.Converted.Cents())
+		require.Equal(t, factories.BaseCurrency, foreign.Converted.Currency())
+		require.Nil(t, foreign.IOF)
+		require.Equal(t, int64(10859), foreign.Total().Cents())
+	})
+
+	t.Run("should charge the iof on the converted amount", func(t *testing.T) {
+		iof := 3.38
+		foreign, err := factories.NewForeignAmount(19.99, "USD", usdRate(t), &iof)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(367), foreign.IOF.Cents()) // 108.59 * 3.38% = 3.670342
+		require.Equal(t, int64(11226), foreign.Total().Cents())
+	})
+
+	t.Run("should reject an unsupported or base currency", func(t *testing.T) {
+		_, err := factories.NewForeignAmount(10, "XYZ", usdRate(t), nil)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidForeignAmount)
+
+		_, err = factories.NewForeignAmount(10, "BRL", usdRate(t), nil)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidForeignAmount)
+	})
+
+	t.Run("should reject an iof percentage out of range", func(t *testing.T) {
+		for _, value := range []float64{0, -1, factories.MaxIOFPercentage + 0.01} {
+			_, err := factories.NewForeignAmount(10, "EUR", usdRate(t), &value)
+			require.ErrorIs(t, err, transactionDomain.ErrInvalidForeignAmount)
+		}
+	})
+
+	t.Run("should reject a non positive amount", func(t *testing.T) {
+		_, err := factories.NewForeignAmount(0, "EUR", usdRate(t), nil)
+		require.ErrorIs(t, err, transactionDomain.ErrAmountMustBePositive)
+	})
+}
+
+func TestIsForeignCurrency(t *testing.T) {
+	require.False(t, factories.IsForeignCurrency(""))
+	require.False(t, factories.IsForeignCurrency("brl"))
+	require.True(t, factories.IsForeignCurrency("USD"))
+}
@@ -0,0 +1,98 @@
+package factories
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+// RecurringCreateParams holds the raw input for creating a recurring transaction.
+type RecurringCreateParams struct {
+	UserID        string
+	CategoryID    string
+	SubcategoryID string
+	CardID        string
+	Description   string
+	Amount        float64
+	Direction     string
+	PaymentMethod string
+	Rule          string
+	StartDate     time.Time
+}
+
+// RecurringTransactionFactory creates RecurringTransaction entities from raw input.
+type RecurringTransactionFactory struct{}
+
+// NewRecurringTransactionFactory returns a new RecurringTransactionFactory.
+func NewRecurringTransactionFactory() *RecurringTransactionFactory {
+	return &RecurringTransactionFactory{}
+}
+
+// Create validates input and creates a RecurringTransaction. The template follows the
+// same payment method rules as a single transaction, since every occurrence becomes one.
+func (f *RecurringTransactionFactory) Create(params RecurringCreateParams) (*entities.RecurringTransaction, error) {
+	pm, err := transactionVos.NewPaymentMethod(params.PaymentMethod)
+	if err != nil {
+		return nil, err
+	}
+	if pm.RequiresCard() && params.CardID == "" {
+		return nil, transactionDomain.ErrCardRequiredForCredit
+	}
+	if !pm.RequiresCard() && params.CardID != "" {
+		return nil, transactionDomain.ErrCardNotAllowedForMethod
+	}
+	direction, err := parseDirection(params.Direction)
+	if err != nil {
+		return nil, err
+	}
+	if direction.IsIncome() && pm.IsCredit() {
+		return nil, transactionDomain.ErrIncomeNotAllowedForCredit
+	}
+	rule, err := transactionVos.NewRecurrenceRule(params.Rule)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := vos.NewUUIDFromString(params.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user_id: %w", err)
+	}
+	categoryID, err := vos.NewUUIDFromString(params.CategoryID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid category_id: %w", err)
+	}
+	subcategoryID, err := parseOptionalUUID(params.SubcategoryID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subcategory_id: %w", err)
+	}
+	cardID, err := parseOptionalUUID(params.CardID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid card_id: %w", err)
+	}
+	amount, err := vos.NewMoneyFromFloat(params.Amount, vos.CurrencyBRL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid amount: %w", err)
+	}
+	id, err := vos.NewUUID()
+	if err != nil {
+		return nil, err
+	}
+	return entities.NewRecurringTransaction(entities.RecurringTransactionParams{
+		ID:            id,
+		UserID:        userID,
+		CategoryID:    categoryID,
+		SubcategoryID: subcategoryID,
+		CardID:        cardID,
+		Description:   params.Description,
+		Amount:        amount,
+		Direction:     direction,
+		PaymentMethod: pm,
+		Rule:          rule,
+		StartDate:     params.StartDate,
+		CreatedAt:     time.Now().UTC(),
+	})
+}
@@ -0,0 +1,70 @@
+package factories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+)
+
+func baseRecurringParams() factories.RecurringCreateParams {
+	return factories.RecurringCreateParams{
+		UserID:        testUserID,
+		CategoryID:    testCategoryID,
+		Description:   "Salário",
+		Amount:        5000.00,
+		Direction:     "INCOME",
+		PaymentMethod: "ted",
+		Rule:          "FREQ=MONTHLY;BYMONTHDAY=5",
+		StartDate:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestRecurringTransactionFactory_Create(t *testing.T) {
+	factory := factories.NewRecurringTransactionFactory()
+
+	t.Run("should create income template with its first occurrence", func(t *testing.T) {
+		r, err := factory.Create(baseRecurringParams())
+		require.NoError(t, err)
+		require.Equal(t, "INCOME", r.Direction.String())
+		require.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=5", r.Rule.String())
+		require.Equal(t, time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC), *r.NextOccurrence)
+		require.Nil(t, r.CardID)
+	})
+
+	t.Run("should create credit template with card", func(t *testing.T) {
+		params := baseRecurringParams()
+		params.Direction = ""
+		params.PaymentMethod = "credit"
+		params.CardID = testCardID
+		r, err := factory.Create(params)
+		require.NoError(t, err)
+		require.Equal(t, testCardID, r.CardID.String())
+	})
+
+	t.Run("should reject credit template without card", func(t *testing.T) {
+		params := baseRecurringParams()
+		params.Direction = ""
+		params.PaymentMethod = "credit"
+		_, err := factory.Create(params)
+		require.ErrorIs(t, err, transactionDomain.ErrCardRequiredForCredit)
+	})
+
+	t.Run("should reject income on credit", func(t *testing.T) {
+		params := baseRecurringParams()
+		params.PaymentMethod = "credit"
+		params.CardID = testCardID
+		_, err := factory.Create(params)
+		require.ErrorIs(t, err, transactionDomain.ErrIncomeNotAllowedForCredit)
+	})
+
+	t.Run("should reject invalid rule", func(t *testing.T) {
+		params := baseRecurringParams()
+		params.Rule = "FREQ=HOURLY"
+		_, err := factory.Create(params)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidRecurrenceRule)
+	})
+}
@@ -2,6 +2,7 @@ package factories
 
 import (
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
@@ -20,9 +21,24 @@ type CreateParams struct {
 	InvoiceID       string
 	Description     string
 	Amount          float64
+	Direction       string
 	PaymentMethod   string
 	TransactionDate time.Time
 	Installments    int
+	ExternalID      string
+	Splits          []SplitParams
+	// Scheduled creates the transaction as scheduled, to be promoted to active on its date.
+	Scheduled bool
+	// Foreign holds the conversion of a purchase made in a foreign currency. Its converted
+	// amount replaces Amount, and the split amounts are taken in the original currency.
+	Foreign *ForeignAmount
+}
+
+// SplitParams holds the raw input for the share of a transaction in one category.
+type SplitParams struct {
+	CategoryID    string
+	SubcategoryID string
+	Amount        float64
 }
 
 // InstallmentParams holds the raw input for creating installment transactions.
@@ -51,10 +67,31 @@ func (f *TransactionFactory) Create(params CreateParams) (*entities.Transaction,
 	if !pm.RequiresCard() && params.CardID != "" {
 		return nil, transactionDomain.ErrCardNotAllowedForMethod
 	}
+	if pm.RequiresCard() && params.Scheduled {
+		return nil, transactionDomain.ErrTransactionDateFuture
+	}
+	if params.Foreign != nil && params.Foreign.IOF != nil && !pm.IsCredit() {
+		return nil, fmt.Errorf("%w: iof only applies to credit card purchases", transactionDomain.ErrInvalidForeignAmount)
+	}
+	direction, err := parseDirection(params.Direction)
+	if err != nil {
+		return nil, err
+	}
+	if direction.IsIncome() && pm.IsCredit() {
+		return nil, transactionDomain.ErrIncomeNotAllowedForCredit
+	}
+	if direction.IsIncome() && params.Installments > 1 {
+		return nil, transactionDomain.ErrIncomeInstallments
+	}
 	userID, err := vos.NewUUIDFromString(params.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid user_id: %w", err)
 	}
+	splits, err := parseSplits(params.Splits)
+	if err != nil {
+		return nil, err
+	}
+	params.CategoryID, params.SubcategoryID = primaryCategory(params)
 	categoryID, err := vos.NewUUIDFromString(params.CategoryID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid category_id: %w", err)
@@ -63,10 +100,21 @@ func (f *TransactionFactory) Create(params CreateParams) (*entities.Transaction,
 	if err != nil {
 		return nil, err
 	}
-	amount, err := vos.NewMoneyFromFloat(params.Amount, vos.CurrencyBRL)
+	amount, err := vos.NewMoneyFromFloat(params.Amount, BaseCurrency)
 	if err != nil {
 		return nil, fmt.Errorf("invalid amount: %w", err)
 	}
+	var originalAmount, iofAmount *vos.Money
+	var exchangeRate *transactionVos.ExchangeRate
+	if params.Foreign != nil {
+		amount = params.Foreign.Converted
+		originalAmount = &params.Foreign.Original
+		exchangeRate = &params.Foreign.Rate
+		iofAmount = params.Foreign.IOF
+		if splits, err = convertSplits(splits, params.Foreign); err != nil {
+			return nil, err
+		}
+	}
 	subcategoryID, err := parseOptionalUUID(params.SubcategoryID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid subcategory_id: %w", err)
@@ -84,7 +132,15 @@ func (f *TransactionFactory) Create(params CreateParams) (*entities.Transaction,
 		installments = 1
 	}
 	installmentNumber := 1
-	status, err := transactionVos.NewTransactionStatus(transactionVos.TransactionStatusActive)
+	var externalID *string
+	if params.ExternalID != "" {
+		externalID = &params.ExternalID
+	}
+	statusValue := transactionVos.TransactionStatusActive
+	if params.Scheduled {
+		statusValue = transactionVos.TransactionStatusScheduled
+	}
+	status, err := transactionVos.NewTransactionStatus(statusValue)
 	if err != nil {
 		return nil, fmt.Errorf("invalid transaction status: %w", err)
 	}
@@ -97,17 +153,24 @@ func (f *TransactionFactory) Create(params CreateParams) (*entities.Transaction,
 		InvoiceID:         invoiceID,
 		Description:       params.Description,
 		Amount:            amount,
+		Direction:         direction,
 		PaymentMethod:     pm,
 		TransactionDate:   params.TransactionDate,
 		InstallmentNumber: &installmentNumber,
 		InstallmentTotal:  &installments,
+		ExternalID:        externalID,
 		Status:            status,
+		OriginalAmount:    originalAmount,
+		ExchangeRate:      exchangeRate,
+		IOFAmount:         iofAmount,
+		Splits:            splits,
 		CreatedAt:         time.Now().UTC(),
 	})
 }
 
 // CreateInstallments generates N installment transactions with a shared group ID.
-// The last installment absorbs any rounding difference.
+// The last installment absorbs any rounding difference. Splits are divided among the
+// installments in proportion to each installment amount (see splitInstallment).
 func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*entities.Transaction, error) {
 	n := params.Installments
 	if n <= 0 {
@@ -123,6 +186,16 @@ func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*en
 	if !pm.IsCredit() {
 		return nil, transactionDomain.ErrInstallmentsOnlyForCredit
 	}
+	if params.Foreign != nil {
+		return nil, fmt.Errorf("%w: foreign currency purchases cannot be paid in installments", transactionDomain.ErrInvalidForeignAmount)
+	}
+	direction, err := parseDirection(params.Direction)
+	if err != nil {
+		return nil, err
+	}
+	if direction.IsIncome() {
+		return nil, transactionDomain.ErrIncomeInstallments
+	}
 	if params.CardID == "" {
 		return nil, transactionDomain.ErrCardRequiredForCredit
 	}
@@ -130,6 +203,11 @@ func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*en
 	if err != nil {
 		return nil, fmt.Errorf("invalid user_id: %w", err)
 	}
+	splits, err := parseSplits(params.Splits)
+	if err != nil {
+		return nil, err
+	}
+	params.CategoryID, params.SubcategoryID = primaryCategory(params.CreateParams)
 	categoryID, err := vos.NewUUIDFromString(params.CategoryID)
 	if err != nil {
 		return nil, fmt.Errorf("invalid category_id: %w", err)
@@ -139,7 +217,7 @@ func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*en
 		return nil, fmt.Errorf("invalid card_id: %w", err)
 	}
 	cardID := &cardUUID
-	totalAmount, err := vos.NewMoneyFromFloat(params.Amount, vos.CurrencyBRL)
+	totalAmount, err := vos.NewMoneyFromFloat(params.Amount, BaseCurrency)
 	if err != nil {
 		return nil, fmt.Errorf("invalid amount: %w", err)
 	}
@@ -159,6 +237,11 @@ func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*en
 	perInstallmentCents := totalCents / int64(n)
 	transactions := make([]*entities.Transaction, 0, n)
 	var sumCents int64
+	remainingSplits := make([]int64, len(splits))
+	for i, split := range splits {
+		remainingSplits[i] = split.Amount.Cents()
+	}
+	remainingCents := totalCents
 	for i := 0; i < n; i++ {
 		installmentNumber := i + 1
 		var installmentCents int64
@@ -168,7 +251,7 @@ func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*en
 		} else {
 			installmentCents = totalCents - sumCents
 		}
-		installmentAmount, err := vos.NewMoney(installmentCents, vos.CurrencyBRL)
+		installmentAmount, err := vos.NewMoney(installmentCents, BaseCurrency)
 		if err != nil {
 			return nil, err
 		}
@@ -181,6 +264,11 @@ func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*en
 		if err != nil {
 			return nil, err
 		}
+		installmentSplits, err := splitInstallment(splits, remainingSplits, remainingCents, installmentCents)
+		if err != nil {
+			return nil, err
+		}
+		remainingCents -= installmentCents
 		tx, err := entities.NewTransaction(entities.TransactionParams{
 			ID:                 txID,
 			UserID:             userID,
@@ -191,11 +279,13 @@ func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*en
 			InstallmentGroupID: &groupID,
 			Description:        params.Description,
 			Amount:             installmentAmount,
+			Direction:          direction,
 			PaymentMethod:      pm,
 			TransactionDate:    params.TransactionDate,
 			InstallmentNumber:  &installmentNumber,
 			InstallmentTotal:   &n,
 			Status:             status,
+			Splits:             installmentSplits,
 			CreatedAt:          time.Now().UTC(),
 		})
 		if err != nil {
@@ -206,6 +296,328 @@ func (f *TransactionFactory) CreateInstallments(params InstallmentParams) ([]*en
 	return transactions, nil
 }
 
+// RescheduleParams holds the new terms of an installment purchase. Installments are its
+// active installments in order, and Locked tells which of them are billed on closed
+// invoices. InvoiceIDs holds the invoice of each installment added after the last one.
+type RescheduleParams struct {
+	Installments []*entities.Transaction
+	Locked       map[int]bool
+	Amount       float64
+	Count        int
+	InvoiceIDs   []string
+}
+
+// InstallmentSchedule is the outcome of rescheduling an installment purchase.
+type InstallmentSchedule struct {
+	Updated   []*entities.Transaction
+	Created   []*entities.Transaction
+	Cancelled []*entities.Transaction
+	Locked    []*entities.Transaction
+}
+
+// RescheduleInstallments spreads a new total over a new number of installments. Locked
+// installments keep their amounts; what is left of the total is divided among the other
+// installments with the rounding rule of CreateInstallments, the last one absorbing the
+// difference. Installments past the new count are cancelled and missing ones are created
+// at the end. Split purchases keep the proportions of their splits.
+func (f *TransactionFactory) RescheduleInstallments(params RescheduleParams) (*InstallmentSchedule, error) {
+	if len(params.Installments) == 0 {
+		return nil, transactionDomain.ErrInstallmentGroupNotFound
+	}
+	if params.Count < 1 {
+		return nil, fmt.Errorf("%w: installments must be at least 1", transactionDomain.ErrInvalidInstallmentEdit)
+	}
+	if params.Count > 48 {
+		return nil, transactionDomain.ErrInstallmentsTooMany
+	}
+	totalAmount, err := vos.NewMoneyFromFloat(params.Amount, BaseCurrency)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid amount: %v", transactionDomain.ErrInvalidInstallmentEdit, err)
+	}
+	if !totalAmount.IsPositive() {
+		return nil, transactionDomain.ErrAmountMustBePositive
+	}
+
+	schedule := &InstallmentSchedule{}
+	open := make([]*entities.Transaction, 0, params.Count)
+	last := 0
+	lockedCents := int64(0)
+	for _, t := range params.Installments {
+		number := *t.InstallmentNumber
+		last = max(last, number)
+		switch {
+		case params.Locked[number]:
+			if number > params.Count {
+				return nil, fmt.Errorf("%w: installment %d is billed on a closed invoice", transactionDomain.ErrInstallmentsLocked, number)
+			}
+			lockedCents += t.Amount.Cents()
+			schedule.Locked = append(schedule.Locked, t)
+		case number > params.Count:
+			if err := t.Cancel(); err != nil {
+				return nil, err
+			}
+			schedule.Cancelled = append(schedule.Cancelled, t)
+		default:
+			open = append(open, t)
+		}
+	}
+	added := max(params.Count-last, 0)
+	if len(params.InvoiceIDs) != added {
+		return nil, fmt.Errorf("invoice_ids length (%d) must equal the installments added (%d)", len(params.InvoiceIDs), added)
+	}
+	slots := len(open) + added
+	if slots == 0 {
+		return nil, fmt.Errorf("%w: every installment is billed on a closed invoice", transactionDomain.ErrInstallmentsLocked)
+	}
+	remainingCents := totalAmount.Cents() - lockedCents
+	if remainingCents < int64(slots) {
+		return nil, fmt.Errorf("%w: the closed invoices already billed %.2f", transactionDomain.ErrInstallmentsLocked, float64(lockedCents)/100)
+	}
+
+	splits, err := scaleSplits(params.Installments, remainingCents)
+	if err != nil {
+		return nil, err
+	}
+	remainingSplits := make([]int64, len(splits))
+	for i, split := range splits {
+		remainingSplits[i] = split.Amount.Cents()
+	}
+
+	perInstallmentCents := remainingCents / int64(slots)
+	var sumCents int64
+	leftCents := remainingCents
+	template := params.Installments[0]
+	for i := 0; i < slots; i++ {
+		var installmentCents int64
+		if i < slots-1 {
+			installmentCents = perInstallmentCents
+			sumCents += installmentCents
+		} else {
+			installmentCents = remainingCents - sumCents
+		}
+		installmentAmount, err := vos.NewMoney(installmentCents, BaseCurrency)
+		if err != nil {
+			return nil, err
+		}
+		installmentSplits, err := splitInstallment(splits, remainingSplits, leftCents, installmentCents)
+		if err != nil {
+			return nil, err
+		}
+		leftCents -= installmentCents
+
+		if i < len(open) {
+			if err := open[i].Reschedule(installmentAmount, params.Count, installmentSplits); err != nil {
+				return nil, err
+			}
+			schedule.Updated = append(schedule.Updated, open[i])
+			continue
+		}
+
+		number := last + i - len(open) + 1
+		invoiceUUID, err := vos.NewUUIDFromString(params.InvoiceIDs[i-len(open)])
+		if err != nil {
+			return nil, fmt.Errorf("invalid invoice_id[%d]: %w", i-len(open), err)
+		}
+		txID, err := vos.NewUUID()
+		if err != nil {
+			return nil, err
+		}
+		status, err := transactionVos.NewTransactionStatus(transactionVos.TransactionStatusActive)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction status: %w", err)
+		}
+		count := params.Count
+		tx, err := entities.NewTransaction(entities.TransactionParams{
+			ID:                 txID,
+			UserID:             template.UserID,
+			CategoryID:         template.CategoryID,
+			SubcategoryID:      template.SubcategoryID,
+			CardID:             template.CardID,
+			InvoiceID:          &invoiceUUID,
+			InstallmentGroupID: template.InstallmentGroupID,
+			Description:        template.Description,
+			Amount:             installmentAmount,
+			Direction:          template.Direction,
+			PaymentMethod:      template.PaymentMethod,
+			TransactionDate:    template.TransactionDate,
+			InstallmentNumber:  &number,
+			InstallmentTotal:   &count,
+			Status:             status,
+			Splits:             installmentSplits,
+			Tags:               template.Tags,
+			CreatedAt:          time.Now().UTC(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		schedule.Created = append(schedule.Created, tx)
+	}
+	return schedule, nil
+}
+
+// scaleSplits sums the splits of the installments by category and scales them to cents,
+// giving the rounding difference to the last category. It returns nil for purchases
+// that are not split.
+func scaleSplits(installments []*entities.Transaction, cents int64) ([]*entities.TransactionSplit, error) {
+	var shape []*entities.TransactionSplit
+	var shapeCents []int64
+	index := make(map[string]int)
+	var total int64
+	for _, t := range installments {
+		for _, split := range t.Splits {
+			key := split.CategoryID.String()
+			if split.SubcategoryID != nil {
+				key += "|" + split.SubcategoryID.String()
+			}
+			i, ok := index[key]
+			if !ok {
+				i = len(shape)
+				index[key] = i
+				shape = append(shape, split)
+				shapeCents = append(shapeCents, 0)
+			}
+			shapeCents[i] += split.Amount.Cents()
+			total += split.Amount.Cents()
+		}
+	}
+	if len(shape) == 0 {
+		return nil, nil
+	}
+
+	scaled := make([]*entities.TransactionSplit, 0, len(shape))
+	remaining := cents
+	for i, split := range shape {
+		share := new(big.Int).Quo(new(big.Int).Mul(big.NewInt(shapeCents[i]), big.NewInt(cents)), big.NewInt(total)).Int64()
+		if i == len(shape)-1 {
+			share = remaining
+		}
+		remaining -= share
+		if share == 0 {
+			continue
+		}
+		amount, err := vos.NewMoney(share, BaseCurrency)
+		if err != nil {
+			return nil, err
+		}
+		scaledSplit, err := entities.NewTransactionSplit(split.CategoryID, split.SubcategoryID, amount)
+		if err != nil {
+			return nil, err
+		}
+		scaled = append(scaled, scaledSplit)
+	}
+	return scaled, nil
+}
+
+// CreateSplits validates input and creates the splits that replace those of an existing
+// transaction. Their sum is checked against the amount when they are attached to it.
+func (f *TransactionFactory) CreateSplits(params []SplitParams) ([]*entities.TransactionSplit, error) {
+	return parseSplits(params)
+}
+
+// parseSplits builds the splits of a purchase. Their sum is checked against the amount
+// when they are attached to the transaction.
+func parseSplits(params []SplitParams) ([]*entities.TransactionSplit, error) {
+	if len(params) == 0 {
+		return nil, nil
+	}
+	splits := make([]*entities.TransactionSplit, 0, len(params))
+	for i, p := range params {
+		categoryID, err := vos.NewUUIDFromString(p.CategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid splits[%d].category_id: %w", i, err)
+		}
+		subcategoryID, err := parseOptionalUUID(p.SubcategoryID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid splits[%d].subcategory_id: %w", i, err)
+		}
+		amount, err := vos.NewMoneyFromFloat(p.Amount, BaseCurrency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid splits[%d].amount: %w", i, err)
+		}
+		split, err := entities.NewTransactionSplit(categoryID, subcategoryID, amount)
+		if err != nil {
+			return nil, err
+		}
+		splits = append(splits, split)
+	}
+	return splits, nil
+}
+
+// primaryCategory returns the category of the transaction, which defaults to the first
+// split when the purchase is split and no category was given.
+func primaryCategory(params CreateParams) (string, string) {
+	if params.CategoryID != "" || len(params.Splits) == 0 {
+		return params.CategoryID, params.SubcategoryID
+	}
+	return params.Splits[0].CategoryID, params.Splits[0].SubcategoryID
+}
+
+// splitInstallment divides an installment among the splits in proportion to what is
+// left of each one, giving the rounding cents to the largest remainders. Since the last
+// installment takes all that is left, the shares of every split add up to its amount
+// across the installments. remaining is updated with the cents still to be divided, and
+// splits with no cents in the installment are omitted from it.
+func splitInstallment(splits []*entities.TransactionSplit, remaining []int64, remainingCents, installmentCents int64) ([]*entities.TransactionSplit, error) {
+	if len(splits) == 0 {
+		return nil, nil
+	}
+
+	shares := make([]int64, len(splits))
+	fractions := make([]int64, len(splits))
+	divisor := big.NewInt(remainingCents)
+	var allocated int64
+	for i, cents := range remaining {
+		quotient, modulus := new(big.Int).QuoRem(
+			new(big.Int).Mul(big.NewInt(cents), big.NewInt(installmentCents)), divisor, new(big.Int))
+		shares[i] = quotient.Int64()
+		fractions[i] = modulus.Int64()
+		allocated += shares[i]
+	}
+	for leftover := installmentCents - allocated; leftover > 0; leftover-- {
+		largest := -1
+		for i, fraction := range fractions {
+			if fraction > 0 && (largest < 0 || fraction > fractions[largest]) {
+				largest = i
+			}
+		}
+		if largest < 0 {
+			break
+		}
+		shares[largest]++
+		fractions[largest] = 0
+	}
+
+	result := make([]*entities.TransactionSplit, 0, len(splits))
+	for i, split := range splits {
+		remaining[i] -= shares[i]
+		if shares[i] == 0 {
+			continue
+		}
+		amount, err := vos.NewMoney(shares[i], BaseCurrency)
+		if err != nil {
+			return nil, err
+		}
+		share, err := entities.NewTransactionSplit(split.CategoryID, split.SubcategoryID, amount)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, share)
+	}
+	return result, nil
+}
+
+// parseDirection defaults an empty direction to EXPENSE, keeping the original API contract.
+func parseDirection(value string) (transactionVos.TransactionDirection, error) {
+	if value == "" {
+		return transactionVos.DirectionExpense, nil
+	}
+	direction, err := transactionVos.NewTransactionDirection(value)
+	if err != nil {
+		return "", transactionDomain.ErrInvalidDirection
+	}
+	return direction, nil
+}
+
 func parseOptionalUUID(value string) (*vos.UUID, error) {
 	if value == "" {
 		return nil, nil
@@ -6,7 +6,10 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/factories"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
 )
 
 const (
@@ -14,8 +17,19 @@ const (
 	testCategoryID = "01965b87-b35a-7f18-a3b1-000000000002"
 	testCardID     = "01965b87-b35a-7f18-a3b1-000000000003"
 	testInvoiceID  = "01965b87-b35a-7f18-a3b1-000000000004"
+
+	testCleaningCategoryID = "01965b87-b35a-7f18-a3b1-000000000005"
+	testPharmacyCategoryID = "01965b87-b35a-7f18-a3b1-000000000006"
 )
 
+func supermarketSplits() []factories.SplitParams {
+	return []factories.SplitParams{
+		{CategoryID: testCategoryID, Amount: 60.00},
+		{CategoryID: testCleaningCategoryID, Amount: 25.00},
+		{CategoryID: testPharmacyCategoryID, SubcategoryID: testInvoiceID, Amount: 15.00},
+	}
+}
+
 func baseCreateParams() factories.CreateParams {
 	return factories.CreateParams{
 		UserID:          testUserID,
@@ -38,6 +52,15 @@ func TestTransactionFactory_Create(t *testing.T) {
 		require.NotNil(t, tx)
 		require.Nil(t, tx.InvoiceID)
 		require.Nil(t, tx.CardID)
+		require.Nil(t, tx.ExternalID)
+	})
+
+	t.Run("should keep the external id of imported transactions", func(t *testing.T) {
+		params := baseCreateParams()
+		params.ExternalID = "ofx:12345-6:202603010001"
+		tx, err := factory.Create(params)
+		require.NoError(t, err)
+		require.Equal(t, "ofx:12345-6:202603010001", *tx.ExternalID)
 	})
 
 	t.Run("should create credit transaction with invoice_id", func(t *testing.T) {
@@ -51,11 +74,166 @@ func TestTransactionFactory_Create(t *testing.T) {
 		require.NotNil(t, tx.InvoiceID)
 		require.NotNil(t, tx.CardID)
 	})
+
+	t.Run("should create scheduled transaction", func(t *testing.T) {
+		params := baseCreateParams()
+		params.PaymentMethod = "boleto"
+		params.Scheduled = true
+		tx, err := factory.Create(params)
+		require.NoError(t, err)
+		require.True(t, tx.Status.IsScheduled())
+	})
+
+	t.Run("should reject scheduled transaction on a card", func(t *testing.T) {
+		params := baseCreateParams()
+		params.PaymentMethod = "credit"
+		params.CardID = testCardID
+		params.InvoiceID = testInvoiceID
+		params.Scheduled = true
+		_, err := factory.Create(params)
+		require.ErrorIs(t, err, transactionDomain.ErrTransactionDateFuture)
+	})
+
+	t.Run("should default direction to EXPENSE", func(t *testing.T) {
+		tx, err := factory.Create(baseCreateParams())
+		require.NoError(t, err)
+		require.Equal(t, transactionVos.DirectionExpense, tx.Direction)
+	})
+
+	t.Run("should create income transaction via pix", func(t *testing.T) {
+		params := baseCreateParams()
+		params.Direction = "INCOME"
+		tx, err := factory.Create(params)
+		require.NoError(t, err)
+		require.True(t, tx.Direction.IsIncome())
+	})
+
+	t.Run("should reject income with credit", func(t *testing.T) {
+		params := baseCreateParams()
+		params.Direction = "INCOME"
+		params.PaymentMethod = "credit"
+		params.CardID = testCardID
+		params.InvoiceID = testInvoiceID
+		_, err := factory.Create(params)
+		require.ErrorIs(t, err, transactionDomain.ErrIncomeNotAllowedForCredit)
+	})
+
+	t.Run("should reject invalid direction", func(t *testing.T) {
+		params := baseCreateParams()
+		params.Direction = "UNKNOWN"
+		_, err := factory.Create(params)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidDirection)
+	})
+
+	t.Run("should split the amount across categories", func(t *testing.T) {
+		params := baseCreateParams()
+		params.CategoryID = ""
+		params.Splits = supermarketSplits()
+		tx, err := factory.Create(params)
+		require.NoError(t, err)
+		require.True(t, tx.IsSplit())
+		require.Len(t, tx.Splits, 3)
+		require.Equal(t, testCategoryID, tx.CategoryID.String())
+		require.Equal(t, int64(2500), tx.Splits[1].Amount.Cents())
+		require.Equal(t, testInvoiceID, tx.Splits[2].SubcategoryID.String())
+		for _, split := range tx.Splits {
+			require.Equal(t, tx.ID.String(), split.TransactionID.String())
+		}
+	})
+
+	t.Run("should reject splits that do not sum to the amount", func(t *testing.T) {
+		params := baseCreateParams()
+		params.Splits = supermarketSplits()
+		params.Splits[0].Amount = 59.99
+		_, err := factory.Create(params)
+		require.ErrorIs(t, err, transactionDomain.ErrSplitsTotalMismatch)
+	})
+
+	t.Run("should reject splits without a positive amount", func(t *testing.T) {
+		params := baseCreateParams()
+		params.Splits = supermarketSplits()
+		params.Splits[0].Amount = 0
+		_, err := factory.Create(params)
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidSplits)
+	})
+
+	t.Run("should keep the original amount of a foreign currency card purchase", func(t *testing.T) {
+		iof := 3.5
+		foreign, err := factories.NewForeignAmount(20, "USD", usdRate(t), &iof)
+		require.NoError(t, err)
+		params := baseCreateParams()
+		params.PaymentMethod = "credit"
+		params.CardID = testCardID
+		params.InvoiceID = testInvoiceID
+		params.Foreign = foreign
+
+		tx, err := factory.Create(params)
+
+		require.NoError(t, err)
+		require.True(t, tx.IsForeign())
+		require.Equal(t, int64(10864), tx.Amount.Cents())
+		require.Equal(t, int64(2000), tx.OriginalAmount.Cents())
+		require.Equal(t, "USD", string(tx.OriginalAmount.Currency()))
+		require.Equal(t, 5.4321, tx.ExchangeRate.Float())
+		require.Equal(t, int64(380), tx.IOFAmount.Cents())
+	})
+
+	t.Run("should convert the splits of a foreign currency purchase", func(t *testing.T) {
+		foreign, err := factories.NewForeignAmount(100, "USD", usdRate(t), nil)
+		require.NoError(t, err)
+		params := baseCreateParams()
+		params.CategoryID = ""
+		params.Splits = supermarketSplits()
+		params.Foreign = foreign
+
+		tx, err := factory.Create(params)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(54321), tx.Amount.Cents())
+		require.Equal(t, int64(32593), tx.Splits[0].Amount.Cents())
+		require.Equal(t, int64(13580), tx.Splits[1].Amount.Cents())
+		require.Equal(t, int64(8148), tx.Splits[2].Amount.Cents())
+	})
+
+	t.Run("should reject iof outside credit card purchases", func(t *testing.T) {
+		iof := 3.5
+		foreign, err := factories.NewForeignAmount(20, "USD", usdRate(t), &iof)
+		require.NoError(t, err)
+		params := baseCreateParams()
+		params.Foreign = foreign
+
+		_, err = factory.Create(params)
+
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidForeignAmount)
+	})
 }
 
 func TestTransactionFactory_CreateInstallments(t *testing.T) {
 	factory := factories.NewTransactionFactory()
 
+	t.Run("should reject installments of a foreign currency purchase", func(t *testing.T) {
+		foreign, err := factories.NewForeignAmount(100, "EUR", usdRate(t), nil)
+		require.NoError(t, err)
+		params := factories.InstallmentParams{
+			CreateParams: factories.CreateParams{
+				UserID:          testUserID,
+				CategoryID:      testCategoryID,
+				CardID:          testCardID,
+				Description:     "Hotel",
+				Amount:          100.00,
+				PaymentMethod:   "credit",
+				TransactionDate: time.Now().Add(-time.Hour),
+				Installments:    2,
+				Foreign:         foreign,
+			},
+			InvoiceIDs: []string{testInvoiceID, testCardID},
+		}
+
+		_, err = factory.CreateInstallments(params)
+
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidForeignAmount)
+	})
+
 	t.Run("should split R$100 into 3 installments with correct rounding", func(t *testing.T) {
 		params := factories.InstallmentParams{
 			CreateParams: factories.CreateParams{
@@ -193,4 +371,225 @@ func TestTransactionFactory_CreateInstallments(t *testing.T) {
 		}
 		require.Equal(t, int64(10000), totalCents)
 	})
+
+	t.Run("should reject income installments", func(t *testing.T) {
+		params := factories.InstallmentParams{
+			CreateParams: factories.CreateParams{
+				UserID:          testUserID,
+				CategoryID:      testCategoryID,
+				CardID:          testCardID,
+				Description:     "Salary",
+				Amount:          100.00,
+				Direction:       "INCOME",
+				PaymentMethod:   "credit",
+				TransactionDate: time.Now().Add(-time.Hour),
+				Installments:    2,
+			},
+			InvoiceIDs: []string{testInvoiceID, testCardID},
+		}
+		_, err := factory.CreateInstallments(params)
+		require.ErrorIs(t, err, transactionDomain.ErrIncomeInstallments)
+	})
+	t.Run("should split each installment in proportion to the splits", func(t *testing.T) {
+		params := factories.InstallmentParams{
+			CreateParams: factories.CreateParams{
+				UserID:          testUserID,
+				CardID:          testCardID,
+				Description:     "Supermercado",
+				Amount:          100.00,
+				PaymentMethod:   "credit",
+				TransactionDate: time.Now().Add(-time.Hour),
+				Installments:    3,
+				Splits:          supermarketSplits(),
+			},
+			InvoiceIDs: []string{testInvoiceID, testCardID, testUserID},
+		}
+		txs, err := factory.CreateInstallments(params)
+		require.NoError(t, err)
+		require.Len(t, txs, 3)
+
+		totals := make(map[string]int64)
+		for _, tx := range txs {
+			require.Equal(t, testCategoryID, tx.CategoryID.String())
+			var installmentCents int64
+			for _, split := range tx.Splits {
+				installmentCents += split.Amount.Cents()
+				totals[split.CategoryID.String()] += split.Amount.Cents()
+			}
+			require.Equal(t, tx.Amount.Cents(), installmentCents)
+		}
+		require.Equal(t, int64(2000), txs[0].Splits[0].Amount.Cents())
+		require.Equal(t, int64(833), txs[0].Splits[1].Amount.Cents())
+		require.Equal(t, int64(500), txs[0].Splits[2].Amount.Cents())
+		require.Equal(t, map[string]int64{
+			testCategoryID:         6000,
+			testCleaningCategoryID: 2500,
+			testPharmacyCategoryID: 1500,
+		}, totals)
+	})
+
+	t.Run("should leave out of an installment the splits with no cents in it", func(t *testing.T) {
+		invoiceIDs := make([]string, 12)
+		for i := range invoiceIDs {
+			invoiceIDs[i] = testInvoiceID
+		}
+		params := factories.InstallmentParams{
+			CreateParams: factories.CreateParams{
+				UserID:          testUserID,
+				CategoryID:      testCategoryID,
+				CardID:          testCardID,
+				Description:     "Farmácia",
+				Amount:          10.00,
+				PaymentMethod:   "credit",
+				TransactionDate: time.Now().Add(-time.Hour),
+				Installments:    12,
+				Splits: []factories.SplitParams{
+					{CategoryID: testCategoryID, Amount: 9.95},
+					{CategoryID: testPharmacyCategoryID, Amount: 0.05},
+				},
+			},
+			InvoiceIDs: invoiceIDs,
+		}
+		txs, err := factory.CreateInstallments(params)
+		require.NoError(t, err)
+
+		var pharmacyCents int64
+		for _, tx := range txs {
+			require.NotEmpty(t, tx.Splits)
+			for _, split := range tx.Splits {
+				require.True(t, split.Amount.IsPositive())
+				if split.CategoryID.String() == testPharmacyCategoryID {
+					pharmacyCents += split.Amount.Cents()
+				}
+			}
+		}
+		require.Equal(t, int64(5), pharmacyCents)
+	})
+}
+
+func TestTransactionFactory_RescheduleInstallments(t *testing.T) {
+	factory := factories.NewTransactionFactory()
+	purchase := func(t *testing.T, amount float64, installments int, splits []factories.SplitParams) []*entities.Transaction {
+		t.Helper()
+		invoiceIDs := make([]string, installments)
+		for i := range invoiceIDs {
+			invoiceIDs[i] = testInvoiceID
+		}
+		txs, err := factory.CreateInstallments(factories.InstallmentParams{
+			CreateParams: factories.CreateParams{
+				UserID:          testUserID,
+				CategoryID:      testCategoryID,
+				CardID:          testCardID,
+				Description:     "Geladeira",
+				Amount:          amount,
+				PaymentMethod:   "credit",
+				TransactionDate: time.Now().Add(-time.Hour),
+				Installments:    installments,
+				Splits:          splits,
+			},
+			InvoiceIDs: invoiceIDs,
+		})
+		require.NoError(t, err)
+		return txs
+	}
+	cents := func(ts []*entities.Transaction) []int64 {
+		out := make([]int64, 0, len(ts))
+		for _, tx := range ts {
+			out = append(out, tx.Amount.Cents())
+		}
+		return out
+	}
+
+	t.Run("should round like CreateInstallments when nothing is locked", func(t *testing.T) {
+		txs := purchase(t, 90, 3, nil)
+
+		schedule, err := factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Amount: 100, Count: 3})
+
+		require.NoError(t, err)
+		require.Equal(t, []int64{3333, 3333, 3334}, cents(schedule.Updated))
+		require.Equal(t, 3, *schedule.Updated[0].InstallmentTotal)
+		require.Empty(t, schedule.Created)
+		require.Empty(t, schedule.Cancelled)
+	})
+
+	t.Run("should keep locked installments and spread the rest", func(t *testing.T) {
+		txs := purchase(t, 300, 3, nil)
+
+		schedule, err := factory.RescheduleInstallments(factories.RescheduleParams{
+			Installments: txs,
+			Locked:       map[int]bool{1: true},
+			Amount:       400.01,
+			Count:        4,
+			InvoiceIDs:   []string{testCardID},
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, []*entities.Transaction{txs[0]}, schedule.Locked)
+		require.Equal(t, int64(10000), txs[0].Amount.Cents())
+		require.Equal(t, 3, *txs[0].InstallmentTotal)
+		require.Equal(t, []int64{10000, 10000}, cents(schedule.Updated))
+		require.Len(t, schedule.Created, 1)
+		created := schedule.Created[0]
+		require.Equal(t, int64(10001), created.Amount.Cents())
+		require.Equal(t, 4, *created.InstallmentNumber)
+		require.Equal(t, 4, *created.InstallmentTotal)
+		require.Equal(t, testCardID, created.InvoiceID.String())
+		require.Equal(t, txs[0].InstallmentGroupID, created.InstallmentGroupID)
+	})
+
+	t.Run("should cancel the installments past the new count", func(t *testing.T) {
+		txs := purchase(t, 400, 4, nil)
+
+		schedule, err := factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Amount: 400, Count: 2})
+
+		require.NoError(t, err)
+		require.Equal(t, []int64{20000, 20000}, cents(schedule.Updated))
+		require.Len(t, schedule.Cancelled, 2)
+		require.True(t, txs[3].Status.IsCancelled())
+	})
+
+	t.Run("should keep the proportions of the splits", func(t *testing.T) {
+		txs := purchase(t, 100, 2, []factories.SplitParams{
+			{CategoryID: testCategoryID, Amount: 75},
+			{CategoryID: testCleaningCategoryID, Amount: 25},
+		})
+
+		schedule, err := factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Amount: 200, Count: 2})
+
+		require.NoError(t, err)
+		totals := make(map[string]int64)
+		for _, tx := range schedule.Updated {
+			for _, split := range tx.Splits {
+				totals[split.CategoryID.String()] += split.Amount.Cents()
+			}
+		}
+		require.Equal(t, map[string]int64{testCategoryID: 15000, testCleaningCategoryID: 5000}, totals)
+	})
+
+	t.Run("should reject edits that change locked installments", func(t *testing.T) {
+		txs := purchase(t, 300, 3, nil)
+		locked := map[int]bool{1: true, 2: true}
+
+		_, err := factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Locked: locked, Amount: 300, Count: 1})
+		require.ErrorIs(t, err, transactionDomain.ErrInstallmentsLocked)
+
+		_, err = factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Locked: locked, Amount: 200, Count: 3})
+		require.ErrorIs(t, err, transactionDomain.ErrInstallmentsLocked)
+
+		_, err = factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Locked: map[int]bool{1: true, 2: true, 3: true}, Amount: 400, Count: 3})
+		require.ErrorIs(t, err, transactionDomain.ErrInstallmentsLocked)
+	})
+
+	t.Run("should reject invalid terms", func(t *testing.T) {
+		txs := purchase(t, 300, 3, nil)
+
+		_, err := factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Amount: 300, Count: 0})
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidInstallmentEdit)
+
+		_, err = factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Amount: 300, Count: 49})
+		require.ErrorIs(t, err, transactionDomain.ErrInstallmentsTooMany)
+
+		_, err = factory.RescheduleInstallments(factories.RescheduleParams{Installments: txs, Amount: 0, Count: 3})
+		require.ErrorIs(t, err, transactionDomain.ErrAmountMustBePositive)
+	})
 }
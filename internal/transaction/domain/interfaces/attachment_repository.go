@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// AttachmentRepository defines the persistence contract for attachment metadata. The
+// content itself lives in the storage.BlobStore.
+type AttachmentRepository interface {
+	Save(ctx context.Context, tx database.DBTX, attachment *entities.Attachment) error
+	Delete(ctx context.Context, tx database.DBTX, attachment *entities.Attachment) error
+	// UpdateOwner persists the owner of an attachment moved with Attachment.MoveTo.
+	UpdateOwner(ctx context.Context, tx database.DBTX, attachment *entities.Attachment) error
+	FindByID(ctx context.Context, id vos.UUID) (*entities.Attachment, error)
+	// FindByOwnerAndHash returns the attachment of the owner with the same content, or nil.
+	FindByOwnerAndHash(ctx context.Context, ownerType entities.AttachmentOwnerType, ownerID vos.UUID, sha256 string) (*entities.Attachment, error)
+	ListByOwner(ctx context.Context, ownerType entities.AttachmentOwnerType, ownerID vos.UUID) ([]*entities.Attachment, error)
+	// CountByHash counts the attachments, of any owner, still referencing the content.
+	CountByHash(ctx context.Context, sha256 string) (int, error)
+}
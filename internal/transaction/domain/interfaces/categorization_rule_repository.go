@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// CategorizationRuleRepository defines the persistence contract for categorization rules.
+type CategorizationRuleRepository interface {
+	Save(ctx context.Context, tx database.DBTX, rule *entities.CategorizationRule) error
+	// Update persists the definition of the rule, replacing its tags.
+	Update(ctx context.Context, tx database.DBTX, rule *entities.CategorizationRule) error
+	FindByID(ctx context.Context, id vos.UUID) (*entities.CategorizationRule, error)
+	// ListByUser returns the rules of the user, enabled or not, in evaluation order:
+	// ascending priority, then creation.
+	ListByUser(ctx context.Context, userID vos.UUID) ([]*entities.CategorizationRule, error)
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+)
+
+// CategoryProvider resolves categories owned by the category module.
+type CategoryProvider interface {
+	// FindOrCreateUncategorized returns the user's "Uncategorized" category, creating it
+	// on first use. Imported entries without a matching category are filed under it.
+	FindOrCreateUncategorized(ctx context.Context, userID vos.UUID) (vos.UUID, error)
+}
@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// ExchangeRateRepository defines the persistence contract for the exchange rates of the users.
+type ExchangeRateRepository interface {
+	// Upsert saves the rates, replacing the rate of a currency already set for the same date.
+	Upsert(ctx context.Context, tx database.DBTX, rates []*entities.ExchangeRate) error
+	// ListByUser returns the rates of the user, latest first, only of currency when given.
+	ListByUser(ctx context.Context, userID vos.UUID, currency string) ([]*entities.ExchangeRate, error)
+	// FindLatest returns the latest rate of currency dated up to date, or nil when there is none.
+	FindLatest(ctx context.Context, userID vos.UUID, currency vos.Currency, date time.Time) (*entities.ExchangeRate, error)
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// InstallmentPayoffRepository persists the audit record of early installment payoffs.
+type InstallmentPayoffRepository interface {
+	Save(ctx context.Context, tx database.DBTX, payoff *entities.InstallmentPayoff) error
+}
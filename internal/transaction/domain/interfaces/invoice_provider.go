@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 
 	pkgVos "github.com/jailtonjunior94/financial/pkg/domain/vos"
@@ -11,12 +12,60 @@ import (
 
 // InvoiceInfo holds the invoice data needed by the transaction module.
 type InvoiceInfo struct {
-	ID     vos.UUID
-	Status string
+	ID             vos.UUID
+	UserID         vos.UUID
+	Status         string
+	ReferenceMonth pkgVos.ReferenceMonth
+}
+
+// InvoiceItemInfo describes the invoice item materialized from a credit transaction.
+// TotalAmount is the original purchase amount; InstallmentAmount is what this invoice is charged.
+type InvoiceItemInfo struct {
+	TransactionID     vos.UUID
+	InvoiceID         vos.UUID
+	CategoryID        vos.UUID
+	PurchaseDate      time.Time
+	Description       string
+	TotalAmount       vos.Money
+	InstallmentNumber int
+	InstallmentTotal  int
+	InstallmentAmount vos.Money
+}
+
+// RefundItemInfo describes the credit posted on an open invoice for a refund. Amount is
+// positive; the item is stored negative. BudgetMonth is the month of the invoice that
+// billed the refunded purchase, where the budget gives the amount back.
+type RefundItemInfo struct {
+	RefundedTransactionID vos.UUID
+	InvoiceID             vos.UUID
+	CategoryID            vos.UUID
+	RefundDate            time.Time
+	Description           string
+	Amount                vos.Money
+	BudgetMonth           pkgVos.ReferenceMonth
+}
+
+// IOFItemInfo describes the IOF charged on a foreign currency card purchase, billed as
+// a separate item on the invoice of the purchase.
+type IOFItemInfo struct {
+	TransactionID vos.UUID
+	InvoiceID     vos.UUID
+	CategoryID    vos.UUID
+	PurchaseDate  time.Time
+	Description   string
+	Amount        vos.Money
 }
 
 // InvoiceProvider defines the contract for invoice operations consumed by the transaction module.
+// Item operations run inside the caller's unit of work and keep the invoice totals in sync.
 type InvoiceProvider interface {
 	FindOrCreate(ctx context.Context, userID, cardID vos.UUID, referenceMonth pkgVos.ReferenceMonth, dueDate time.Time) (*InvoiceInfo, error)
 	GetStatus(ctx context.Context, invoiceID vos.UUID) (string, error)
+	// FindByID returns nil when the invoice does not exist.
+	FindByID(ctx context.Context, invoiceID vos.UUID) (*InvoiceInfo, error)
+	AddItems(ctx context.Context, tx database.DBTX, items []InvoiceItemInfo) error
+	UpdateItem(ctx context.Context, tx database.DBTX, item InvoiceItemInfo) error
+	RemoveItems(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID) error
+	AddRefundItems(ctx context.Context, tx database.DBTX, items []RefundItemInfo) error
+	AddIOFItems(ctx context.Context, tx database.DBTX, items []IOFItemInfo) error
 }
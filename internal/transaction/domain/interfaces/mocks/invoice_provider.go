@@ -8,6 +8,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
 	vos0 "github.com/jailtonjunior94/financial/pkg/domain/vos"
@@ -41,6 +42,263 @@ func (_m *InvoiceProvider) EXPECT() *InvoiceProvider_Expecter {
 	return &InvoiceProvider_Expecter{mock: &_m.Mock}
 }
 
+// AddIOFItems provides a mock function for the type InvoiceProvider
+func (_mock *InvoiceProvider) AddIOFItems(ctx context.Context, tx database.DBTX, items []interfaces.IOFItemInfo) error {
+	ret := _mock.Called(ctx, tx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddIOFItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, []interfaces.IOFItemInfo) error); ok {
+		r0 = returnFunc(ctx, tx, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InvoiceProvider_AddIOFItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddIOFItems'
+type InvoiceProvider_AddIOFItems_Call struct {
+	*mock.Call
+}
+
+// AddIOFItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - items []interfaces.IOFItemInfo
+func (_e *InvoiceProvider_Expecter) AddIOFItems(ctx interface{}, tx interface{}, items interface{}) *InvoiceProvider_AddIOFItems_Call {
+	return &InvoiceProvider_AddIOFItems_Call{Call: _e.mock.On("AddIOFItems", ctx, tx, items)}
+}
+
+func (_c *InvoiceProvider_AddIOFItems_Call) Run(run func(ctx context.Context, tx database.DBTX, items []interfaces.IOFItemInfo)) *InvoiceProvider_AddIOFItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 []interfaces.IOFItemInfo
+		if args[2] != nil {
+			arg2 = args[2].([]interfaces.IOFItemInfo)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceProvider_AddIOFItems_Call) Return(_a0 error) *InvoiceProvider_AddIOFItems_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvoiceProvider_AddIOFItems_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, items []interfaces.IOFItemInfo) error) *InvoiceProvider_AddIOFItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddItems provides a mock function for the type InvoiceProvider
+func (_mock *InvoiceProvider) AddItems(ctx context.Context, tx database.DBTX, items []interfaces.InvoiceItemInfo) error {
+	ret := _mock.Called(ctx, tx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, []interfaces.InvoiceItemInfo) error); ok {
+		r0 = returnFunc(ctx, tx, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InvoiceProvider_AddItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddItems'
+type InvoiceProvider_AddItems_Call struct {
+	*mock.Call
+}
+
+// AddItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - items []interfaces.InvoiceItemInfo
+func (_e *InvoiceProvider_Expecter) AddItems(ctx interface{}, tx interface{}, items interface{}) *InvoiceProvider_AddItems_Call {
+	return &InvoiceProvider_AddItems_Call{Call: _e.mock.On("AddItems", ctx, tx, items)}
+}
+
+func (_c *InvoiceProvider_AddItems_Call) Run(run func(ctx context.Context, tx database.DBTX, items []interfaces.InvoiceItemInfo)) *InvoiceProvider_AddItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 []interfaces.InvoiceItemInfo
+		if args[2] != nil {
+			arg2 = args[2].([]interfaces.InvoiceItemInfo)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceProvider_AddItems_Call) Return(err error) *InvoiceProvider_AddItems_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *InvoiceProvider_AddItems_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, items []interfaces.InvoiceItemInfo) error) *InvoiceProvider_AddItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddRefundItems provides a mock function for the type InvoiceProvider
+func (_mock *InvoiceProvider) AddRefundItems(ctx context.Context, tx database.DBTX, items []interfaces.RefundItemInfo) error {
+	ret := _mock.Called(ctx, tx, items)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddRefundItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, []interfaces.RefundItemInfo) error); ok {
+		r0 = returnFunc(ctx, tx, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InvoiceProvider_AddRefundItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddRefundItems'
+type InvoiceProvider_AddRefundItems_Call struct {
+	*mock.Call
+}
+
+// AddRefundItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - items []interfaces.RefundItemInfo
+func (_e *InvoiceProvider_Expecter) AddRefundItems(ctx interface{}, tx interface{}, items interface{}) *InvoiceProvider_AddRefundItems_Call {
+	return &InvoiceProvider_AddRefundItems_Call{Call: _e.mock.On("AddRefundItems", ctx, tx, items)}
+}
+
+func (_c *InvoiceProvider_AddRefundItems_Call) Run(run func(ctx context.Context, tx database.DBTX, items []interfaces.RefundItemInfo)) *InvoiceProvider_AddRefundItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 []interfaces.RefundItemInfo
+		if args[2] != nil {
+			arg2 = args[2].([]interfaces.RefundItemInfo)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceProvider_AddRefundItems_Call) Return(_a0 error) *InvoiceProvider_AddRefundItems_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InvoiceProvider_AddRefundItems_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, items []interfaces.RefundItemInfo) error) *InvoiceProvider_AddRefundItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindByID provides a mock function for the type InvoiceProvider
+func (_mock *InvoiceProvider) FindByID(ctx context.Context, invoiceID vos.UUID) (*interfaces.InvoiceInfo, error) {
+	ret := _mock.Called(ctx, invoiceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindByID")
+	}
+
+	var r0 *interfaces.InvoiceInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID) (*interfaces.InvoiceInfo, error)); ok {
+		return returnFunc(ctx, invoiceID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID) *interfaces.InvoiceInfo); ok {
+		r0 = returnFunc(ctx, invoiceID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*interfaces.InvoiceInfo)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, vos.UUID) error); ok {
+		r1 = returnFunc(ctx, invoiceID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// InvoiceProvider_FindByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindByID'
+type InvoiceProvider_FindByID_Call struct {
+	*mock.Call
+}
+
+// FindByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - invoiceID vos.UUID
+func (_e *InvoiceProvider_Expecter) FindByID(ctx interface{}, invoiceID interface{}) *InvoiceProvider_FindByID_Call {
+	return &InvoiceProvider_FindByID_Call{Call: _e.mock.On("FindByID", ctx, invoiceID)}
+}
+
+func (_c *InvoiceProvider_FindByID_Call) Run(run func(ctx context.Context, invoiceID vos.UUID)) *InvoiceProvider_FindByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 vos.UUID
+		if args[1] != nil {
+			arg1 = args[1].(vos.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceProvider_FindByID_Call) Return(invoiceInfo *interfaces.InvoiceInfo, err error) *InvoiceProvider_FindByID_Call {
+	_c.Call.Return(invoiceInfo, err)
+	return _c
+}
+
+func (_c *InvoiceProvider_FindByID_Call) RunAndReturn(run func(ctx context.Context, invoiceID vos.UUID) (*interfaces.InvoiceInfo, error)) *InvoiceProvider_FindByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindOrCreate provides a mock function for the type InvoiceProvider
 func (_mock *InvoiceProvider) FindOrCreate(ctx context.Context, userID vos.UUID, cardID vos.UUID, referenceMonth vos0.ReferenceMonth, dueDate time.Time) (*interfaces.InvoiceInfo, error) {
 	ret := _mock.Called(ctx, userID, cardID, referenceMonth, dueDate)
@@ -192,3 +450,129 @@ func (_c *InvoiceProvider_GetStatus_Call) RunAndReturn(run func(ctx context.Cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// RemoveItems provides a mock function for the type InvoiceProvider
+func (_mock *InvoiceProvider) RemoveItems(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID) error {
+	ret := _mock.Called(ctx, tx, transactionIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveItems")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, []vos.UUID) error); ok {
+		r0 = returnFunc(ctx, tx, transactionIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InvoiceProvider_RemoveItems_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveItems'
+type InvoiceProvider_RemoveItems_Call struct {
+	*mock.Call
+}
+
+// RemoveItems is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - transactionIDs []vos.UUID
+func (_e *InvoiceProvider_Expecter) RemoveItems(ctx interface{}, tx interface{}, transactionIDs interface{}) *InvoiceProvider_RemoveItems_Call {
+	return &InvoiceProvider_RemoveItems_Call{Call: _e.mock.On("RemoveItems", ctx, tx, transactionIDs)}
+}
+
+func (_c *InvoiceProvider_RemoveItems_Call) Run(run func(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID)) *InvoiceProvider_RemoveItems_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 []vos.UUID
+		if args[2] != nil {
+			arg2 = args[2].([]vos.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceProvider_RemoveItems_Call) Return(err error) *InvoiceProvider_RemoveItems_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *InvoiceProvider_RemoveItems_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID) error) *InvoiceProvider_RemoveItems_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateItem provides a mock function for the type InvoiceProvider
+func (_mock *InvoiceProvider) UpdateItem(ctx context.Context, tx database.DBTX, item interfaces.InvoiceItemInfo) error {
+	ret := _mock.Called(ctx, tx, item)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateItem")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, interfaces.InvoiceItemInfo) error); ok {
+		r0 = returnFunc(ctx, tx, item)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// InvoiceProvider_UpdateItem_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateItem'
+type InvoiceProvider_UpdateItem_Call struct {
+	*mock.Call
+}
+
+// UpdateItem is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - item interfaces.InvoiceItemInfo
+func (_e *InvoiceProvider_Expecter) UpdateItem(ctx interface{}, tx interface{}, item interface{}) *InvoiceProvider_UpdateItem_Call {
+	return &InvoiceProvider_UpdateItem_Call{Call: _e.mock.On("UpdateItem", ctx, tx, item)}
+}
+
+func (_c *InvoiceProvider_UpdateItem_Call) Run(run func(ctx context.Context, tx database.DBTX, item interfaces.InvoiceItemInfo)) *InvoiceProvider_UpdateItem_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 interfaces.InvoiceItemInfo
+		if args[2] != nil {
+			arg2 = args[2].(interfaces.InvoiceItemInfo)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *InvoiceProvider_UpdateItem_Call) Return(err error) *InvoiceProvider_UpdateItem_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *InvoiceProvider_UpdateItem_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, item interfaces.InvoiceItemInfo) error) *InvoiceProvider_UpdateItem_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
@@ -41,6 +42,75 @@ func (_m *TransactionRepository) EXPECT() *TransactionRepository_Expecter {
 	return &TransactionRepository_Expecter{mock: &_m.Mock}
 }
 
+// AddTags provides a mock function for the type TransactionRepository
+func (_mock *TransactionRepository) AddTags(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID, tagIDs []vos.UUID) error {
+	ret := _mock.Called(ctx, tx, transactionIDs, tagIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTags")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, []vos.UUID, []vos.UUID) error); ok {
+		r0 = returnFunc(ctx, tx, transactionIDs, tagIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TransactionRepository_AddTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTags'
+type TransactionRepository_AddTags_Call struct {
+	*mock.Call
+}
+
+// AddTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - transactionIDs []vos.UUID
+//   - tagIDs []vos.UUID
+func (_e *TransactionRepository_Expecter) AddTags(ctx interface{}, tx interface{}, transactionIDs interface{}, tagIDs interface{}) *TransactionRepository_AddTags_Call {
+	return &TransactionRepository_AddTags_Call{Call: _e.mock.On("AddTags", ctx, tx, transactionIDs, tagIDs)}
+}
+
+func (_c *TransactionRepository_AddTags_Call) Run(run func(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID, tagIDs []vos.UUID)) *TransactionRepository_AddTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 []vos.UUID
+		if args[2] != nil {
+			arg2 = args[2].([]vos.UUID)
+		}
+		var arg3 []vos.UUID
+		if args[3] != nil {
+			arg3 = args[3].([]vos.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_AddTags_Call) Return(err error) *TransactionRepository_AddTags_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TransactionRepository_AddTags_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, transactionIDs []vos.UUID, tagIDs []vos.UUID) error) *TransactionRepository_AddTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByID provides a mock function for the type TransactionRepository
 func (_mock *TransactionRepository) FindByID(ctx context.Context, id vos.UUID) (*entities.Transaction, error) {
 	ret := _mock.Called(ctx, id)
@@ -177,6 +247,240 @@ func (_c *TransactionRepository_FindByInstallmentGroup_Call) RunAndReturn(run fu
 	return _c
 }
 
+// FindExistingExternalIDs provides a mock function for the type TransactionRepository
+func (_mock *TransactionRepository) FindExistingExternalIDs(ctx context.Context, userID vos.UUID, externalIDs []string) (map[string]bool, error) {
+	ret := _mock.Called(ctx, userID, externalIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindExistingExternalIDs")
+	}
+
+	var r0 map[string]bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, []string) (map[string]bool, error)); ok {
+		return returnFunc(ctx, userID, externalIDs)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, []string) map[string]bool); ok {
+		r0 = returnFunc(ctx, userID, externalIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]bool)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, vos.UUID, []string) error); ok {
+		r1 = returnFunc(ctx, userID, externalIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TransactionRepository_FindExistingExternalIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindExistingExternalIDs'
+type TransactionRepository_FindExistingExternalIDs_Call struct {
+	*mock.Call
+}
+
+// FindExistingExternalIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID vos.UUID
+//   - externalIDs []string
+func (_e *TransactionRepository_Expecter) FindExistingExternalIDs(ctx interface{}, userID interface{}, externalIDs interface{}) *TransactionRepository_FindExistingExternalIDs_Call {
+	return &TransactionRepository_FindExistingExternalIDs_Call{Call: _e.mock.On("FindExistingExternalIDs", ctx, userID, externalIDs)}
+}
+
+func (_c *TransactionRepository_FindExistingExternalIDs_Call) Run(run func(ctx context.Context, userID vos.UUID, externalIDs []string)) *TransactionRepository_FindExistingExternalIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 vos.UUID
+		if args[1] != nil {
+			arg1 = args[1].(vos.UUID)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_FindExistingExternalIDs_Call) Return(existing map[string]bool, err error) *TransactionRepository_FindExistingExternalIDs_Call {
+	_c.Call.Return(existing, err)
+	return _c
+}
+
+func (_c *TransactionRepository_FindExistingExternalIDs_Call) RunAndReturn(run func(ctx context.Context, userID vos.UUID, externalIDs []string) (map[string]bool, error)) *TransactionRepository_FindExistingExternalIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByDateRange provides a mock function for the type TransactionRepository
+func (_mock *TransactionRepository) ListByDateRange(ctx context.Context, userID vos.UUID, from time.Time, to time.Time) ([]*entities.Transaction, error) {
+	ret := _mock.Called(ctx, userID, from, to)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByDateRange")
+	}
+
+	var r0 []*entities.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, time.Time, time.Time) ([]*entities.Transaction, error)); ok {
+		return returnFunc(ctx, userID, from, to)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, vos.UUID, time.Time, time.Time) []*entities.Transaction); ok {
+		r0 = returnFunc(ctx, userID, from, to)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, vos.UUID, time.Time, time.Time) error); ok {
+		r1 = returnFunc(ctx, userID, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TransactionRepository_ListByDateRange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByDateRange'
+type TransactionRepository_ListByDateRange_Call struct {
+	*mock.Call
+}
+
+// ListByDateRange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID vos.UUID
+//   - from time.Time
+//   - to time.Time
+func (_e *TransactionRepository_Expecter) ListByDateRange(ctx interface{}, userID interface{}, from interface{}, to interface{}) *TransactionRepository_ListByDateRange_Call {
+	return &TransactionRepository_ListByDateRange_Call{Call: _e.mock.On("ListByDateRange", ctx, userID, from, to)}
+}
+
+func (_c *TransactionRepository_ListByDateRange_Call) Run(run func(ctx context.Context, userID vos.UUID, from time.Time, to time.Time)) *TransactionRepository_ListByDateRange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 vos.UUID
+		if args[1] != nil {
+			arg1 = args[1].(vos.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ListByDateRange_Call) Return(transactions []*entities.Transaction, err error) *TransactionRepository_ListByDateRange_Call {
+	_c.Call.Return(transactions, err)
+	return _c
+}
+
+func (_c *TransactionRepository_ListByDateRange_Call) RunAndReturn(run func(ctx context.Context, userID vos.UUID, from time.Time, to time.Time) ([]*entities.Transaction, error)) *TransactionRepository_ListByDateRange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDueScheduled provides a mock function for the type TransactionRepository
+func (_mock *TransactionRepository) ListDueScheduled(ctx context.Context, today time.Time, after *interfaces.ScheduledCursor, limit int) ([]*entities.Transaction, error) {
+	ret := _mock.Called(ctx, today, after, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDueScheduled")
+	}
+
+	var r0 []*entities.Transaction
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, *interfaces.ScheduledCursor, int) ([]*entities.Transaction, error)); ok {
+		return returnFunc(ctx, today, after, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, time.Time, *interfaces.ScheduledCursor, int) []*entities.Transaction); ok {
+		r0 = returnFunc(ctx, today, after, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entities.Transaction)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, time.Time, *interfaces.ScheduledCursor, int) error); ok {
+		r1 = returnFunc(ctx, today, after, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TransactionRepository_ListDueScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDueScheduled'
+type TransactionRepository_ListDueScheduled_Call struct {
+	*mock.Call
+}
+
+// ListDueScheduled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - today time.Time
+//   - after *interfaces.ScheduledCursor
+//   - limit int
+func (_e *TransactionRepository_Expecter) ListDueScheduled(ctx interface{}, today interface{}, after interface{}, limit interface{}) *TransactionRepository_ListDueScheduled_Call {
+	return &TransactionRepository_ListDueScheduled_Call{Call: _e.mock.On("ListDueScheduled", ctx, today, after, limit)}
+}
+
+func (_c *TransactionRepository_ListDueScheduled_Call) Run(run func(ctx context.Context, today time.Time, after *interfaces.ScheduledCursor, limit int)) *TransactionRepository_ListDueScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 time.Time
+		if args[1] != nil {
+			arg1 = args[1].(time.Time)
+		}
+		var arg2 *interfaces.ScheduledCursor
+		if args[2] != nil {
+			arg2 = args[2].(*interfaces.ScheduledCursor)
+		}
+		var arg3 int
+		if args[3] != nil {
+			arg3 = args[3].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ListDueScheduled_Call) Return(_a0 []*entities.Transaction, _a1 error) *TransactionRepository_ListDueScheduled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_ListDueScheduled_Call) RunAndReturn(run func(ctx context.Context, today time.Time, after *interfaces.ScheduledCursor, limit int) ([]*entities.Transaction, error)) *TransactionRepository_ListDueScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListPaginated provides a mock function for the type TransactionRepository
 func (_mock *TransactionRepository) ListPaginated(ctx context.Context, params interfaces.ListParams) ([]*entities.Transaction, string, error) {
 	ret := _mock.Called(ctx, params)
@@ -251,6 +555,141 @@ func (_c *TransactionRepository_ListPaginated_Call) RunAndReturn(run func(ctx co
 	return _c
 }
 
+// LockByID provides a mock function for the type TransactionRepository
+func (_mock *TransactionRepository) LockByID(ctx context.Context, tx database.DBTX, id vos.UUID) error {
+	ret := _mock.Called(ctx, tx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockByID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, vos.UUID) error); ok {
+		r0 = returnFunc(ctx, tx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TransactionRepository_LockByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockByID'
+type TransactionRepository_LockByID_Call struct {
+	*mock.Call
+}
+
+// LockByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - id vos.UUID
+func (_e *TransactionRepository_Expecter) LockByID(ctx interface{}, tx interface{}, id interface{}) *TransactionRepository_LockByID_Call {
+	return &TransactionRepository_LockByID_Call{Call: _e.mock.On("LockByID", ctx, tx, id)}
+}
+
+func (_c *TransactionRepository_LockByID_Call) Run(run func(ctx context.Context, tx database.DBTX, id vos.UUID)) *TransactionRepository_LockByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 vos.UUID
+		if args[2] != nil {
+			arg2 = args[2].(vos.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_LockByID_Call) Return(_a0 error) *TransactionRepository_LockByID_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionRepository_LockByID_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, id vos.UUID) error) *TransactionRepository_LockByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResolveScheduled provides a mock function for the type TransactionRepository
+func (_mock *TransactionRepository) ResolveScheduled(ctx context.Context, tx database.DBTX, t *entities.Transaction) (bool, error) {
+	ret := _mock.Called(ctx, tx, t)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveScheduled")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, *entities.Transaction) (bool, error)); ok {
+		return returnFunc(ctx, tx, t)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, *entities.Transaction) bool); ok {
+		r0 = returnFunc(ctx, tx, t)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, database.DBTX, *entities.Transaction) error); ok {
+		r1 = returnFunc(ctx, tx, t)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// TransactionRepository_ResolveScheduled_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResolveScheduled'
+type TransactionRepository_ResolveScheduled_Call struct {
+	*mock.Call
+}
+
+// ResolveScheduled is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - t *entities.Transaction
+func (_e *TransactionRepository_Expecter) ResolveScheduled(ctx interface{}, tx interface{}, t interface{}) *TransactionRepository_ResolveScheduled_Call {
+	return &TransactionRepository_ResolveScheduled_Call{Call: _e.mock.On("ResolveScheduled", ctx, tx, t)}
+}
+
+func (_c *TransactionRepository_ResolveScheduled_Call) Run(run func(ctx context.Context, tx database.DBTX, t *entities.Transaction)) *TransactionRepository_ResolveScheduled_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 *entities.Transaction
+		if args[2] != nil {
+			arg2 = args[2].(*entities.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ResolveScheduled_Call) Return(_a0 bool, _a1 error) *TransactionRepository_ResolveScheduled_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_ResolveScheduled_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, t *entities.Transaction) (bool, error)) *TransactionRepository_ResolveScheduled_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Save provides a mock function for the type TransactionRepository
 func (_mock *TransactionRepository) Save(ctx context.Context, tx database.DBTX, t *entities.Transaction) error {
 	ret := _mock.Called(ctx, tx, t)
@@ -377,6 +816,132 @@ func (_c *TransactionRepository_SaveAll_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// SetTags provides a mock function for the type TransactionRepository
+func (_mock *TransactionRepository) SetTags(ctx context.Context, tx database.DBTX, ts []*entities.Transaction) error {
+	ret := _mock.Called(ctx, tx, ts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTags")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, database.DBTX, []*entities.Transaction) error); ok {
+		r0 = returnFunc(ctx, tx, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TransactionRepository_SetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTags'
+type TransactionRepository_SetTags_Call struct {
+	*mock.Call
+}
+
+// SetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx database.DBTX
+//   - ts []*entities.Transaction
+func (_e *TransactionRepository_Expecter) SetTags(ctx interface{}, tx interface{}, ts interface{}) *TransactionRepository_SetTags_Call {
+	return &TransactionRepository_SetTags_Call{Call: _e.mock.On("SetTags", ctx, tx, ts)}
+}
+
+func (_c *TransactionRepository_SetTags_Call) Run(run func(ctx context.Context, tx database.DBTX, ts []*entities.Transaction)) *TransactionRepository_SetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 database.DBTX
+		if args[1] != nil {
+			arg1 = args[1].(database.DBTX)
+		}
+		var arg2 []*entities.Transaction
+		if args[2] != nil {
+			arg2 = args[2].([]*entities.Transaction)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_SetTags_Call) Return(err error) *TransactionRepository_SetTags_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TransactionRepository_SetTags_Call) RunAndReturn(run func(ctx context.Context, tx database.DBTX, ts []*entities.Transaction) error) *TransactionRepository_SetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamForExport provides a mock function for the type TransactionRepository
+func (_mock *TransactionRepository) StreamForExport(ctx context.Context, params interfaces.ListParams, fn func(row *interfaces.ExportRow) error) error {
+	ret := _mock.Called(ctx, params, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamForExport")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, interfaces.ListParams, func(row *interfaces.ExportRow) error) error); ok {
+		r0 = returnFunc(ctx, params, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// TransactionRepository_StreamForExport_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamForExport'
+type TransactionRepository_StreamForExport_Call struct {
+	*mock.Call
+}
+
+// StreamForExport is a helper method to define mock.On call
+//   - ctx context.Context
+//   - params interfaces.ListParams
+//   - fn func(row *interfaces.ExportRow) error
+func (_e *TransactionRepository_Expecter) StreamForExport(ctx interface{}, params interface{}, fn interface{}) *TransactionRepository_StreamForExport_Call {
+	return &TransactionRepository_StreamForExport_Call{Call: _e.mock.On("StreamForExport", ctx, params, fn)}
+}
+
+func (_c *TransactionRepository_StreamForExport_Call) Run(run func(ctx context.Context, params interfaces.ListParams, fn func(row *interfaces.ExportRow) error)) *TransactionRepository_StreamForExport_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 interfaces.ListParams
+		if args[1] != nil {
+			arg1 = args[1].(interfaces.ListParams)
+		}
+		var arg2 func(row *interfaces.ExportRow) error
+		if args[2] != nil {
+			arg2 = args[2].(func(row *interfaces.ExportRow) error)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_StreamForExport_Call) Return(err error) *TransactionRepository_StreamForExport_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *TransactionRepository_StreamForExport_Call) RunAndReturn(run func(ctx context.Context, params interfaces.ListParams, fn func(row *interfaces.ExportRow) error) error) *TransactionRepository_StreamForExport_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function for the type TransactionRepository
 func (_mock *TransactionRepository) Update(ctx context.Context, tx database.DBTX, t *entities.Transaction) error {
 	ret := _mock.Called(ctx, tx, t)
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// RecurringTransactionRepository defines the persistence contract for recurring transactions.
+type RecurringTransactionRepository interface {
+	Save(ctx context.Context, tx database.DBTX, r *entities.RecurringTransaction) error
+	// Update persists r only if its stored next_occurrence still equals expectedNext,
+	// so the materialization job and user edits never overwrite each other's schedule.
+	// Returns false when the row changed concurrently.
+	Update(ctx context.Context, tx database.DBTX, r *entities.RecurringTransaction, expectedNext *time.Time) (bool, error)
+	FindByID(ctx context.Context, id vos.UUID) (*entities.RecurringTransaction, error)
+	ListByUser(ctx context.Context, userID vos.UUID) ([]*entities.RecurringTransaction, error)
+	ListDue(ctx context.Context, today time.Time, limit int) ([]*entities.RecurringTransaction, error)
+}
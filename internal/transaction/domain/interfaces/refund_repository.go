@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// RefundRepository defines the persistence contract for refunds. Refunds are never
+// changed once saved.
+type RefundRepository interface {
+	Save(ctx context.Context, tx database.DBTX, refund *entities.Refund) error
+	// ListByTransaction returns the refunds of a transaction, oldest first.
+	ListByTransaction(ctx context.Context, transactionID vos.UUID) ([]*entities.Refund, error)
+	// ListByTransactionInTx is ListByTransaction read inside tx, after the transaction row
+	// is locked, so refunds committed concurrently are taken into account.
+	ListByTransactionInTx(ctx context.Context, tx database.DBTX, transactionID vos.UUID) ([]*entities.Refund, error)
+}
@@ -0,0 +1,34 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// TagTotals is the sum of the active transactions carrying a tag, by direction.
+type TagTotals struct {
+	Expense          vos.Money
+	Income           vos.Money
+	TransactionCount int
+}
+
+// TagRepository defines the persistence contract for the tag catalog.
+type TagRepository interface {
+	Save(ctx context.Context, tx database.DBTX, tag *entities.Tag) error
+	// Update persists the name and, for a deleted tag, also removes it from every transaction
+	// and categorization rule.
+	Update(ctx context.Context, tx database.DBTX, tag *entities.Tag) error
+	FindByID(ctx context.Context, id vos.UUID) (*entities.Tag, error)
+	FindByName(ctx context.Context, userID vos.UUID, name string) (*entities.Tag, error)
+	// FindByIDs returns the tags of the user among ids; unknown and deleted tags are left out.
+	FindByIDs(ctx context.Context, userID vos.UUID, ids []vos.UUID) ([]*entities.Tag, error)
+	ListByUser(ctx context.Context, userID vos.UUID) ([]*entities.Tag, error)
+	// SumTransactions totals the active transactions of the user carrying the tag, dated
+	// between from and to (inclusive) when given.
+	SumTransactions(ctx context.Context, userID, tagID vos.UUID, from, to *time.Time) (TagTotals, error)
+}
@@ -10,15 +10,53 @@ import (
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 )
 
-// ListParams represents the parameters for paginated transaction listing.
+// Sort keys, sort orders and the status filter accepted by ListParams.
+const (
+	SortByTransactionDate = "transaction_date"
+	SortByAmount          = "amount"
+	SortOrderAsc          = "asc"
+	SortOrderDesc         = "desc"
+	StatusFilterAll       = "all"
+)
+
+// ListParams represents the parameters for paginated transaction listing. Empty filters
+// are not applied, except Status, which defaults to active transactions. SortBy defaults
+// to transaction_date and SortOrder to desc. A transaction must carry every tag in TagIDs.
 type ListParams struct {
-	UserID        vos.UUID
-	PaymentMethod string
-	CategoryID    string
-	StartDate     *time.Time
-	EndDate       *time.Time
-	Limit         int
-	Cursor        string
+	UserID             vos.UUID
+	PaymentMethod      string
+	CategoryID         string
+	SubcategoryID      string
+	CardID             string
+	InvoiceID          string
+	InstallmentGroupID string
+	TagIDs             []string
+	Direction          string
+	Status             string
+	MinAmount          *float64
+	MaxAmount          *float64
+	Search             string
+	StartDate          *time.Time
+	EndDate            *time.Time
+	SortBy             string
+	SortOrder          string
+	Limit              int
+	Cursor             string
+}
+
+// ScheduledCursor positions ListDueScheduled right after the last transaction of the
+// previous page.
+type ScheduledCursor struct {
+	TransactionDate time.Time
+	ID              vos.UUID
+}
+
+// ExportRow is a transaction with the names of its category, subcategory and card.
+type ExportRow struct {
+	Transaction     *entities.Transaction
+	CategoryName    string
+	SubcategoryName *string
+	CardName        *string
 }
 
 // TransactionRepository defines the persistence contract for transactions.
@@ -26,8 +64,25 @@ type TransactionRepository interface {
 	Save(ctx context.Context, tx database.DBTX, t *entities.Transaction) error
 	SaveAll(ctx context.Context, tx database.DBTX, ts []*entities.Transaction) error
 	FindByID(ctx context.Context, id vos.UUID) (*entities.Transaction, error)
+	// LockByID locks the transaction row (SELECT ... FOR UPDATE) until tx ends, serializing
+	// writes that depend on what was already recorded for it, such as refunds.
+	LockByID(ctx context.Context, tx database.DBTX, id vos.UUID) error
 	FindByInstallmentGroup(ctx context.Context, groupID vos.UUID) ([]*entities.Transaction, error)
 	Update(ctx context.Context, tx database.DBTX, t *entities.Transaction) error
 	UpdateAll(ctx context.Context, tx database.DBTX, ts []*entities.Transaction) error
 	ListPaginated(ctx context.Context, params ListParams) ([]*entities.Transaction, string, error)
+	ListByDateRange(ctx context.Context, userID vos.UUID, from, to time.Time) ([]*entities.Transaction, error)
+	StreamForExport(ctx context.Context, params ListParams, fn func(row *ExportRow) error) error
+	FindExistingExternalIDs(ctx context.Context, userID vos.UUID, externalIDs []string) (map[string]bool, error)
+	// ListDueScheduled returns scheduled transactions of every user dated up to today,
+	// starting right after the cursor (nil for the first page).
+	ListDueScheduled(ctx context.Context, today time.Time, after *ScheduledCursor, limit int) ([]*entities.Transaction, error)
+	// ResolveScheduled persists the status and date of t only if it is still scheduled, so
+	// the promotion job and the user never resolve the same occurrence twice. Returns false
+	// when the transaction was resolved concurrently.
+	ResolveScheduled(ctx context.Context, tx database.DBTX, t *entities.Transaction) (bool, error)
+	// SetTags replaces the stored tags of each transaction with its current Tags.
+	SetTags(ctx context.Context, tx database.DBTX, ts []*entities.Transaction) error
+	// AddTags adds the tags to the transactions, keeping the tags they already have.
+	AddTags(ctx context.Context, tx database.DBTX, transactionIDs, tagIDs []vos.UUID) error
 }
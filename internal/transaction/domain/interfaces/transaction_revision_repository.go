@@ -0,0 +1,18 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+)
+
+// TransactionRevisionRepository defines the persistence contract for the history of
+// transactions. Revisions are never changed once saved.
+type TransactionRevisionRepository interface {
+	SaveAll(ctx context.Context, tx database.DBTX, revisions []*entities.TransactionRevision) error
+	// ListByTransaction returns the revisions of a transaction, oldest first.
+	ListByTransaction(ctx context.Context, transactionID vos.UUID) ([]*entities.TransactionRevision, error)
+}
@@ -0,0 +1,52 @@
+package vos
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// exchangeRateScale is the number of rate units in 1, matching the six decimal places
+// of the exchange_rate columns.
+const exchangeRateScale = 1_000_000
+
+// ExchangeRate is the value of one unit of a foreign currency in the base currency,
+// kept in millionths so conversions do not accumulate float errors.
+type ExchangeRate struct {
+	micros int64
+}
+
+// NewExchangeRate rounds the rate to six decimal places. The rate must be positive.
+func NewExchangeRate(rate float64) (ExchangeRate, error) {
+	if math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return ExchangeRate{}, domain.ErrInvalidExchangeRate
+	}
+	micros := int64(math.Round(rate * exchangeRateScale))
+	if micros <= 0 {
+		return ExchangeRate{}, domain.ErrInvalidExchangeRate
+	}
+	return ExchangeRate{micros: micros}, nil
+}
+
+// Convert multiplies the amount by the rate, rounding half away from zero to the cent,
+// and returns it in the given currency.
+func (r ExchangeRate) Convert(amount vos.Money, to vos.Currency) (vos.Money, error) {
+	product := new(big.Int).Mul(big.NewInt(amount.Cents()), big.NewInt(r.micros))
+	half := big.NewInt(exchangeRateScale / 2)
+	if product.Sign() < 0 {
+		half.Neg(half)
+	}
+	product.Add(product, half)
+	cents := product.Quo(product, big.NewInt(exchangeRateScale))
+	if !cents.IsInt64() {
+		return vos.Money{}, vos.ErrOverflow
+	}
+	return vos.NewMoney(cents.Int64(), to)
+}
+
+func (r ExchangeRate) Float() float64 {
+	return float64(r.micros) / exchangeRateScale
+}
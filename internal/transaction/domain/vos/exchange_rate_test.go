@@ -0,0 +1,57 @@
+package vos_test
+
+import (
+	"math"
+	"testing"
+
+	devkitVos "github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	t.Run("should round rate to six decimal places", func(t *testing.T) {
+		rate, err := vos.NewExchangeRate(5.43219876)
+		require.NoError(t, err)
+		require.Equal(t, 5.432199, rate.Float())
+	})
+
+	t.Run("should reject zero, negative and non finite rates", func(t *testing.T) {
+		for _, value := range []float64{0, -5.2, 0.0000001, math.NaN(), math.Inf(1)} {
+			_, err := vos.NewExchangeRate(value)
+			require.ErrorIs(t, err, domain.ErrInvalidExchangeRate)
+		}
+	})
+}
+
+func TestExchangeRate_Convert(t *testing.T) {
+	t.Run("should convert to the target currency rounding to the cent", func(t *testing.T) {
+		rate, err := vos.NewExchangeRate(5.4321)
+		require.NoError(t, err)
+		amount, err := devkitVos.NewMoney(1999, devkitVos.CurrencyUSD)
+		require.NoError(t, err)
+
+		converted, err := rate.Convert(amount, devkitVos.CurrencyBRL)
+
+		require.NoError(t, err)
+		require.Equal(t, int64(10859), converted.Cents()) // 19.99 * 5.4321 = 108.587679
+		require.Equal(t, devkitVos.CurrencyBRL, converted.Currency())
+	})
+
+	t.Run("should round half away from zero", func(t *testing.T) {
+		rate, err := vos.NewExchangeRate(0.5)
+		require.NoError(t, err)
+		positive, err := devkitVos.NewMoney(1, devkitVos.CurrencyEUR)
+		require.NoError(t, err)
+
+		converted, err := rate.Convert(positive, devkitVos.CurrencyBRL)
+		require.NoError(t, err)
+		require.Equal(t, int64(1), converted.Cents())
+
+		converted, err = rate.Convert(positive.Negate(), devkitVos.CurrencyBRL)
+		require.NoError(t, err)
+		require.Equal(t, int64(-1), converted.Cents())
+	})
+}
@@ -0,0 +1,278 @@
+package vos
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+const (
+	FrequencyDaily   = "DAILY"
+	FrequencyWeekly  = "WEEKLY"
+	FrequencyMonthly = "MONTHLY"
+	FrequencyYearly  = "YEARLY"
+
+	// LastDayOfMonth is the BYMONTHDAY value for the last day of each month.
+	LastDayOfMonth = -1
+
+	maxRecurrenceInterval = 999
+	untilLayout           = "20060102"
+)
+
+var weekdayCodes = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule is the subset of the iCalendar RRULE (RFC 5545) used to schedule
+// recurring transactions: FREQ, INTERVAL, BYDAY (weekly), BYMONTHDAY (monthly),
+// COUNT and UNTIL. Occurrences are calendar dates anchored on the series start date.
+// Unlike RFC 5545, a month day that does not exist in a month (e.g. 31 in April)
+// falls on that month's last day instead of being skipped, so rent and salaries
+// never silently miss a month.
+type RecurrenceRule struct {
+	Frequency  string
+	Interval   int
+	ByDay      []time.Weekday
+	ByMonthDay int
+	Count      int
+	Until      *time.Time
+}
+
+// NewRecurrenceRule parses a rule such as "FREQ=MONTHLY;BYMONTHDAY=5;COUNT=12".
+// An optional "RRULE:" prefix is accepted.
+func NewRecurrenceRule(value string) (RecurrenceRule, error) {
+	raw := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(value)), "RRULE:")
+	if raw == "" {
+		return RecurrenceRule{}, fmt.Errorf("%w: rule is empty", domain.ErrInvalidRecurrenceRule)
+	}
+
+	rule := RecurrenceRule{Interval: 1}
+	for part := range strings.SplitSeq(raw, ";") {
+		if part == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(part, "=")
+		if !ok || val == "" {
+			return RecurrenceRule{}, fmt.Errorf("%w: malformed part %q", domain.ErrInvalidRecurrenceRule, part)
+		}
+		if err := rule.set(key, val); err != nil {
+			return RecurrenceRule{}, err
+		}
+	}
+
+	if err := rule.validate(); err != nil {
+		return RecurrenceRule{}, err
+	}
+	return rule, nil
+}
+
+func (r *RecurrenceRule) set(key, val string) error {
+	switch key {
+	case "FREQ":
+		r.Frequency = val
+	case "INTERVAL":
+		interval, err := strconv.Atoi(val)
+		if err != nil || interval < 1 || interval > maxRecurrenceInterval {
+			return fmt.Errorf("%w: INTERVAL must be between 1 and %d", domain.ErrInvalidRecurrenceRule, maxRecurrenceInterval)
+		}
+		r.Interval = interval
+	case "BYDAY":
+		days := make([]time.Weekday, 0, 7)
+		for code := range strings.SplitSeq(val, ",") {
+			day, ok := weekdayCodes[code]
+			if !ok {
+				return fmt.Errorf("%w: unknown BYDAY value %q", domain.ErrInvalidRecurrenceRule, code)
+			}
+			if !slices.Contains(days, day) {
+				days = append(days, day)
+			}
+		}
+		slices.Sort(days)
+		r.ByDay = days
+	case "BYMONTHDAY":
+		day, err := strconv.Atoi(val)
+		if err != nil || (day != LastDayOfMonth && (day < 1 || day > 31)) {
+			return fmt.Errorf("%w: BYMONTHDAY must be between 1 and 31, or -1", domain.ErrInvalidRecurrenceRule)
+		}
+		r.ByMonthDay = day
+	case "COUNT":
+		count, err := strconv.Atoi(val)
+		if err != nil || count < 1 {
+			return fmt.Errorf("%w: COUNT must be a positive number", domain.ErrInvalidRecurrenceRule)
+		}
+		r.Count = count
+	case "UNTIL":
+		// Only the date part matters; a trailing "T235959Z" time is ignored.
+		if len(val) < len(untilLayout) {
+			return fmt.Errorf("%w: UNTIL must be in YYYYMMDD format", domain.ErrInvalidRecurrenceRule)
+		}
+		until, err := time.Parse(untilLayout, val[:len(untilLayout)])
+		if err != nil {
+			return fmt.Errorf("%w: UNTIL must be in YYYYMMDD format", domain.ErrInvalidRecurrenceRule)
+		}
+		r.Until = &until
+	default:
+		return fmt.Errorf("%w: unsupported part %q", domain.ErrInvalidRecurrenceRule, key)
+	}
+	return nil
+}
+
+func (r RecurrenceRule) validate() error {
+	switch r.Frequency {
+	case FrequencyDaily, FrequencyWeekly, FrequencyMonthly, FrequencyYearly:
+	case "":
+		return fmt.Errorf("%w: FREQ is required", domain.ErrInvalidRecurrenceRule)
+	default:
+		return fmt.Errorf("%w: unsupported FREQ %q", domain.ErrInvalidRecurrenceRule, r.Frequency)
+	}
+	if len(r.ByDay) > 0 && r.Frequency != FrequencyWeekly {
+		return fmt.Errorf("%w: BYDAY is only supported with FREQ=WEEKLY", domain.ErrInvalidRecurrenceRule)
+	}
+	if r.ByMonthDay != 0 && r.Frequency != FrequencyMonthly {
+		return fmt.Errorf("%w: BYMONTHDAY is only supported with FREQ=MONTHLY", domain.ErrInvalidRecurrenceRule)
+	}
+	if r.Count > 0 && r.Until != nil {
+		return fmt.Errorf("%w: COUNT and UNTIL cannot be combined", domain.ErrInvalidRecurrenceRule)
+	}
+	return nil
+}
+
+// First returns the first occurrence on or after start.
+// Returns false when UNTIL leaves the series without occurrences.
+func (r RecurrenceRule) First(start time.Time) (time.Time, bool) {
+	start = truncateDate(start)
+	return r.within(r.nextFrom(start, start))
+}
+
+// Next returns the occurrence that follows previous, given how many occurrences
+// the series already produced. Returns false when COUNT or UNTIL ended the series.
+func (r RecurrenceRule) Next(start, previous time.Time, produced int) (time.Time, bool) {
+	if r.Count > 0 && produced >= r.Count {
+		return time.Time{}, false
+	}
+	start = truncateDate(start)
+	return r.within(r.nextFrom(start, truncateDate(previous).AddDate(0, 0, 1)))
+}
+
+// String returns the canonical form of the rule.
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + r.Frequency}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		codes := make([]string, 0, len(r.ByDay))
+		for _, day := range r.ByDay {
+			codes = append(codes, strings.ToUpper(day.String()[:2]))
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.ByMonthDay != 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.ByMonthDay))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if r.Until != nil {
+		parts = append(parts, "UNTIL="+r.Until.Format(untilLayout))
+	}
+	return strings.Join(parts, ";")
+}
+
+func (r RecurrenceRule) within(candidate time.Time) (time.Time, bool) {
+	if r.Until != nil && candidate.After(*r.Until) {
+		return time.Time{}, false
+	}
+	return candidate, true
+}
+
+// nextFrom returns the earliest occurrence on or after from (from is never before start).
+func (r RecurrenceRule) nextFrom(start, from time.Time) time.Time {
+	switch r.Frequency {
+	case FrequencyDaily:
+		return stepDays(start, from, r.Interval)
+	case FrequencyWeekly:
+		if len(r.ByDay) == 0 {
+			return stepDays(start, from, 7*r.Interval)
+		}
+		return r.nextWeekday(start, from)
+	case FrequencyMonthly:
+		day := r.ByMonthDay
+		if day == 0 {
+			day = start.Day()
+		}
+		k := monthsBetween(start, from) / r.Interval
+		for {
+			candidate := monthDate(start.Year(), start.Month()+time.Month(k*r.Interval), day)
+			if !candidate.Before(from) {
+				return candidate
+			}
+			k++
+		}
+	default:
+		k := (from.Year() - start.Year()) / r.Interval
+		for {
+			candidate := monthDate(start.Year()+k*r.Interval, start.Month(), start.Day())
+			if !candidate.Before(from) {
+				return candidate
+			}
+			k++
+		}
+	}
+}
+
+// nextWeekday walks day by day until a BYDAY weekday in an active week (weeks start on Monday).
+func (r RecurrenceRule) nextWeekday(start, from time.Time) time.Time {
+	firstWeek := weekStart(start)
+	candidate := from
+	for {
+		weeks := daysBetween(firstWeek, weekStart(candidate)) / 7
+		if weeks%r.Interval == 0 && slices.Contains(r.ByDay, candidate.Weekday()) {
+			return candidate
+		}
+		candidate = candidate.AddDate(0, 0, 1)
+	}
+}
+
+func stepDays(start, from time.Time, step int) time.Time {
+	steps := (daysBetween(start, from) + step - 1) / step
+	return start.AddDate(0, 0, steps*step)
+}
+
+func truncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func daysBetween(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+func monthsBetween(from, to time.Time) int {
+	return (to.Year()-from.Year())*12 + int(to.Month()) - int(from.Month())
+}
+
+func weekStart(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return t.AddDate(0, 0, -offset)
+}
+
+// monthDate builds the date for day in the given month, clamping to the month's last day.
+// Months beyond December roll over into the following years.
+func monthDate(year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+	if day == LastDayOfMonth || day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,166 @@
+package vos_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// occurrences collects up to n occurrences of the rule, stopping when the series ends.
+func occurrences(t *testing.T, rule vos.RecurrenceRule, start time.Time, n int) []string {
+	t.Helper()
+	out := make([]string, 0, n)
+	current, ok := rule.First(start)
+	for ok && len(out) < n {
+		out = append(out, current.Format("2006-01-02"))
+		current, ok = rule.Next(start, current, len(out))
+	}
+	return out
+}
+
+func TestNewRecurrenceRule(t *testing.T) {
+	t.Run("should parse monthly rule with prefix and lowercase", func(t *testing.T) {
+		rule, err := vos.NewRecurrenceRule("rrule:freq=monthly;bymonthday=5;count=12")
+		require.NoError(t, err)
+		require.Equal(t, vos.FrequencyMonthly, rule.Frequency)
+		require.Equal(t, 1, rule.Interval)
+		require.Equal(t, 5, rule.ByMonthDay)
+		require.Equal(t, 12, rule.Count)
+		require.Equal(t, "FREQ=MONTHLY;BYMONTHDAY=5;COUNT=12", rule.String())
+	})
+
+	t.Run("should parse weekly rule sorting weekdays", func(t *testing.T) {
+		rule, err := vos.NewRecurrenceRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=FR,MO;UNTIL=20261231T235959Z")
+		require.NoError(t, err)
+		require.Equal(t, []time.Weekday{time.Monday, time.Friday}, rule.ByDay)
+		require.Equal(t, date(2026, 12, 31), *rule.Until)
+		require.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR;UNTIL=20261231", rule.String())
+	})
+
+	invalid := map[string]string{
+		"empty rule":                 "",
+		"missing FREQ":               "INTERVAL=2",
+		"unsupported FREQ":           "FREQ=HOURLY",
+		"zero INTERVAL":              "FREQ=DAILY;INTERVAL=0",
+		"unknown BYDAY":              "FREQ=WEEKLY;BYDAY=XX",
+		"BYDAY outside weekly":       "FREQ=MONTHLY;BYDAY=MO",
+		"BYMONTHDAY out of range":    "FREQ=MONTHLY;BYMONTHDAY=32",
+		"BYMONTHDAY outside monthly": "FREQ=YEARLY;BYMONTHDAY=5",
+		"COUNT and UNTIL together":   "FREQ=DAILY;COUNT=3;UNTIL=20261231",
+		"malformed UNTIL":            "FREQ=DAILY;UNTIL=2026-12",
+		"unsupported part":           "FREQ=DAILY;BYHOUR=10",
+		"part without value":         "FREQ=DAILY;COUNT",
+		"negative COUNT":             "FREQ=DAILY;COUNT=-1",
+		"negative BYMONTHDAY":        "FREQ=MONTHLY;BYMONTHDAY=-2",
+	}
+	for name, value := range invalid {
+		t.Run("should reject "+name, func(t *testing.T) {
+			_, err := vos.NewRecurrenceRule(value)
+			require.ErrorIs(t, err, domain.ErrInvalidRecurrenceRule)
+		})
+	}
+}
+
+func TestRecurrenceRuleOccurrences(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		rule     string
+		start    time.Time
+		n        int
+		expected []string
+	}{
+		{
+			name:     "daily every other day",
+			rule:     "FREQ=DAILY;INTERVAL=2",
+			start:    date(2026, 1, 30),
+			n:        3,
+			expected: []string{"2026-01-30", "2026-02-01", "2026-02-03"},
+		},
+		{
+			name:     "weekly on the start weekday",
+			rule:     "FREQ=WEEKLY",
+			start:    date(2026, 3, 4),
+			n:        3,
+			expected: []string{"2026-03-04", "2026-03-11", "2026-03-18"},
+		},
+		{
+			name:     "biweekly on monday and friday starting mid-week",
+			rule:     "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,FR",
+			start:    date(2026, 3, 4), // Wednesday
+			n:        4,
+			expected: []string{"2026-03-06", "2026-03-16", "2026-03-20", "2026-03-30"},
+		},
+		{
+			name:     "monthly on day 31 clamps to the last day",
+			rule:     "FREQ=MONTHLY",
+			start:    date(2026, 1, 31),
+			n:        4,
+			expected: []string{"2026-01-31", "2026-02-28", "2026-03-31", "2026-04-30"},
+		},
+		{
+			name:     "monthly on day 5 starting after the 5th",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=5",
+			start:    date(2026, 1, 20),
+			n:        2,
+			expected: []string{"2026-02-05", "2026-03-05"},
+		},
+		{
+			name:     "monthly on the last day",
+			rule:     "FREQ=MONTHLY;BYMONTHDAY=-1",
+			start:    date(2027, 12, 10),
+			n:        3,
+			expected: []string{"2027-12-31", "2028-01-31", "2028-02-29"},
+		},
+		{
+			name:     "quarterly",
+			rule:     "FREQ=MONTHLY;INTERVAL=3",
+			start:    date(2026, 11, 15),
+			n:        3,
+			expected: []string{"2026-11-15", "2027-02-15", "2027-05-15"},
+		},
+		{
+			name:     "yearly on a leap day",
+			rule:     "FREQ=YEARLY",
+			start:    date(2028, 2, 29),
+			n:        2,
+			expected: []string{"2028-02-29", "2029-02-28"},
+		},
+		{
+			name:     "count ends the series",
+			rule:     "FREQ=MONTHLY;COUNT=2",
+			start:    date(2026, 1, 10),
+			n:        5,
+			expected: []string{"2026-01-10", "2026-02-10"},
+		},
+		{
+			name:     "until is inclusive",
+			rule:     "FREQ=DAILY;UNTIL=20260103",
+			start:    date(2026, 1, 1),
+			n:        5,
+			expected: []string{"2026-01-01", "2026-01-02", "2026-01-03"},
+		},
+		{
+			name:     "until before the first occurrence",
+			rule:     "FREQ=MONTHLY;UNTIL=20260101",
+			start:    date(2026, 1, 2),
+			n:        5,
+			expected: []string{},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			rule, err := vos.NewRecurrenceRule(scenario.rule)
+			require.NoError(t, err)
+			require.Equal(t, scenario.expected, occurrences(t, rule, scenario.start, scenario.n))
+		})
+	}
+}
@@ -5,6 +5,9 @@ import "github.com/jailtonjunior94/financial/internal/transaction/domain"
 const (
 	TransactionStatusActive    = "active"
 	TransactionStatusCancelled = "cancelled"
+	// TransactionStatusScheduled marks a future-dated transaction that does not count
+	// until it is promoted to active on its date.
+	TransactionStatusScheduled = "scheduled"
 )
 
 type TransactionStatus struct {
@@ -13,7 +16,7 @@ type TransactionStatus struct {
 
 func NewTransactionStatus(v string) (TransactionStatus, error) {
 	switch v {
-	case TransactionStatusActive, TransactionStatusCancelled:
+	case TransactionStatusActive, TransactionStatusCancelled, TransactionStatusScheduled:
 		return TransactionStatus{Value: v}, nil
 	default:
 		return TransactionStatus{}, domain.ErrInvalidTransactionStatus
@@ -28,6 +31,10 @@ func (s TransactionStatus) IsCancelled() bool {
 	return s.Value == TransactionStatusCancelled
 }
 
+func (s TransactionStatus) IsScheduled() bool {
+	return s.Value == TransactionStatusScheduled
+}
+
 func (s TransactionStatus) String() string {
 	return s.Value
 }
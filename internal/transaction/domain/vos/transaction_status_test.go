@@ -26,6 +26,14 @@ func TestNewTransactionStatus(t *testing.T) {
 		require.Equal(t, "cancelled", status.String())
 	})
 
+	t.Run("should create scheduled transaction status", func(t *testing.T) {
+		status, err := vos.NewTransactionStatus("scheduled")
+		require.NoError(t, err)
+		require.False(t, status.IsActive())
+		require.True(t, status.IsScheduled())
+		require.Equal(t, "scheduled", status.String())
+	})
+
 	t.Run("should return error for unknown status pending", func(t *testing.T) {
 		_, err := vos.NewTransactionStatus("pending")
 		require.ErrorIs(t, err, domain.ErrInvalidTransactionStatus)
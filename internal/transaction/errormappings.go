@@ -23,5 +23,52 @@ func ErrorMappings() map[error]httperrors.ErrorMapping {
 		domain.ErrDescriptionRequired:       {Status: http.StatusBadRequest, Message: "Description is required"},
 		domain.ErrAmountMustBePositive:      {Status: http.StatusBadRequest, Message: "Amount must be positive"},
 		domain.ErrInstallmentsTooMany:       {Status: http.StatusBadRequest, Message: "Installments cannot exceed 48"},
+		domain.ErrInvalidDirection:          {Status: http.StatusBadRequest, Message: "Invalid transaction direction"},
+		domain.ErrInvalidListFilter:         {Status: http.StatusBadRequest, Message: "Invalid list filter"},
+		domain.ErrIncomeNotAllowedForCredit: {Status: http.StatusBadRequest, Message: "Income is not allowed for credit payments"},
+		domain.ErrIncomeInstallments:        {Status: http.StatusBadRequest, Message: "Income cannot have installments"},
+		domain.ErrCreditLimitExceeded:       {Status: http.StatusUnprocessableEntity, Message: "Purchase exceeds the card available limit"},
+		domain.ErrInvalidSplits:             {Status: http.StatusBadRequest, Message: "Invalid transaction splits"},
+		domain.ErrSplitsTotalMismatch:       {Status: http.StatusUnprocessableEntity, Message: "Splits must sum to the transaction amount"},
+
+		domain.ErrRecurringTransactionNotFound: {Status: http.StatusNotFound, Message: "Recurring transaction not found"},
+		domain.ErrRecurringTransactionNotOwned: {Status: http.StatusForbidden, Message: "Access denied"},
+		domain.ErrInvalidRecurrenceRule:        {Status: http.StatusBadRequest, Message: "Invalid recurrence rule"},
+		domain.ErrRecurrenceFinished:           {Status: http.StatusConflict, Message: "Recurrence has no occurrences left"},
+		domain.ErrRecurringTransactionConflict: {Status: http.StatusConflict, Message: "Recurring transaction was modified concurrently, try again"},
+		domain.ErrImportFileRequired:           {Status: http.StatusBadRequest, Message: "Import file is required"},
+		domain.ErrInvalidImportFile:            {Status: http.StatusBadRequest, Message: "Invalid import file"},
+		domain.ErrInvalidImportOptions:         {Status: http.StatusBadRequest, Message: "Invalid import options"},
+		domain.ErrImportEmpty:                  {Status: http.StatusBadRequest, Message: "Import file has no rows"},
+		domain.ErrImportTooManyRows:            {Status: http.StatusRequestEntityTooLarge, Message: "Import file exceeds the maximum number of rows"},
+		domain.ErrInvalidExportFormat:          {Status: http.StatusBadRequest, Message: "Export format must be csv, ofx or jsonl"},
+		domain.ErrTagNotFound:                  {Status: http.StatusNotFound, Message: "Tag not found"},
+		domain.ErrTagNotOwned:                  {Status: http.StatusForbidden, Message: "Access denied"},
+		domain.ErrInvalidTagName:               {Status: http.StatusBadRequest, Message: "Invalid tag name"},
+		domain.ErrTagAlreadyExists:             {Status: http.StatusConflict, Message: "Tag already exists"},
+		domain.ErrInvalidTagging:               {Status: http.StatusBadRequest, Message: "Invalid tagging request"},
+		domain.ErrAttachmentNotFound:           {Status: http.StatusNotFound, Message: "Attachment not found"},
+		domain.ErrAttachmentRequired:           {Status: http.StatusBadRequest, Message: "Attachment file is required"},
+		domain.ErrAttachmentTooLarge:           {Status: http.StatusRequestEntityTooLarge, Message: "Attachment exceeds the maximum size of 10 MB"},
+		domain.ErrUnsupportedAttachmentType:    {Status: http.StatusUnsupportedMediaType, Message: "Attachments must be PDF, JPEG, PNG or WebP"},
+		domain.ErrInvoiceNotFound:              {Status: http.StatusNotFound, Message: "Invoice not found"},
+		domain.ErrInvoiceNotOwned:              {Status: http.StatusForbidden, Message: "Access denied"},
+		domain.ErrCategorizationRuleNotFound:   {Status: http.StatusNotFound, Message: "Categorization rule not found"},
+		domain.ErrCategorizationRuleNotOwned:   {Status: http.StatusForbidden, Message: "Access denied"},
+		domain.ErrInvalidCategorizationRule:    {Status: http.StatusBadRequest, Message: "Invalid categorization rule"},
+		domain.ErrInvalidMerge:                 {Status: http.StatusBadRequest, Message: "Invalid merge request"},
+		domain.ErrInvalidRefund:                {Status: http.StatusBadRequest, Message: "Invalid refund request"},
+		domain.ErrRefundNotAllowed:             {Status: http.StatusUnprocessableEntity, Message: "Only billed card purchases can be refunded"},
+		domain.ErrRefundExceedsRefundable:      {Status: http.StatusUnprocessableEntity, Message: "Refund exceeds the amount left to refund"},
+		domain.ErrInstallmentGroupNotFound:     {Status: http.StatusNotFound, Message: "Installment group not found"},
+		domain.ErrInvalidPayoff:                {Status: http.StatusBadRequest, Message: "Invalid payoff request"},
+		domain.ErrNothingToPayOff:              {Status: http.StatusUnprocessableEntity, Message: "No future installments to pay off"},
+		domain.ErrInvalidInstallmentEdit:       {Status: http.StatusBadRequest, Message: "Invalid installment edit"},
+		domain.ErrInstallmentsLocked:           {Status: http.StatusUnprocessableEntity, Message: "Installments on closed invoices cannot change"},
+		domain.ErrTransactionNotScheduled:      {Status: http.StatusUnprocessableEntity, Message: "Transaction is not scheduled"},
+		domain.ErrInvalidForeignAmount:         {Status: http.StatusBadRequest, Message: "Invalid foreign currency amount"},
+		domain.ErrInvalidExchangeRate:          {Status: http.StatusBadRequest, Message: "Invalid exchange rate"},
+		domain.ErrExchangeRateNotFound:         {Status: http.StatusUnprocessableEntity, Message: "No exchange rate for the currency on or before the transaction date"},
+		domain.ErrForeignAmountLocked:          {Status: http.StatusUnprocessableEntity, Message: "Amount of a foreign currency transaction cannot change"},
 	}
 }
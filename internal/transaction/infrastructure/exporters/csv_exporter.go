@@ -0,0 +1,89 @@
+package exporters
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+)
+
+// csvHeader uses the field names of the CSV import, so an export can be imported back.
+// Installments are exported one row each, with their number, never as "installments".
+var csvHeader = []string{
+	"id", "transaction_date", "description", "amount", "direction", "payment_method",
+	"category_id", "category_name", "subcategory_id", "subcategory_name", "card_id", "card_name",
+	"invoice_id", "installment_group_id", "installment_number", "installment_total",
+	"status", "created_at",
+}
+
+type csvExporter struct {
+	writer        *csv.Writer
+	headerWritten bool
+}
+
+func newCSVExporter(w io.Writer) *csvExporter {
+	return &csvExporter{writer: csv.NewWriter(w)}
+}
+
+func (e *csvExporter) ContentType() string   { return "text/csv; charset=utf-8" }
+func (e *csvExporter) FileExtension() string { return "csv" }
+
+func (e *csvExporter) Write(row *dtos.TransactionExportOutput) error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	return e.writer.Write([]string{
+		row.ID,
+		row.TransactionDate,
+		sanitizeCell(row.Description),
+		strconv.FormatFloat(row.Amount, 'f', 2, 64),
+		row.Direction,
+		row.PaymentMethod,
+		row.CategoryID,
+		sanitizeCell(row.CategoryName),
+		optionalString(row.SubcategoryID),
+		sanitizeCell(optionalString(row.SubcategoryName)),
+		optionalString(row.CardID),
+		sanitizeCell(optionalString(row.CardName)),
+		optionalString(row.InvoiceID),
+		optionalString(row.InstallmentGroupID),
+		optionalInt(row.InstallmentNumber),
+		optionalInt(row.InstallmentTotal),
+		row.Status,
+		row.CreatedAt,
+	})
+}
+
+func (e *csvExporter) Close() error {
+	if err := e.writeHeader(); err != nil {
+		return err
+	}
+	e.writer.Flush()
+	return e.writer.Error()
+}
+
+func (e *csvExporter) writeHeader() error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+	return e.writer.Write(csvHeader)
+}
+
+// sanitizeCell prefixes text starting with a formula character with a quote, so
+// spreadsheets do not evaluate user-typed descriptions as formulas.
+func sanitizeCell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+func optionalInt(value *int) string {
+	if value == nil {
+		return ""
+	}
+	return strconv.Itoa(*value)
+}
@@ -0,0 +1,50 @@
+package exporters_test
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/exporters"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/importers"
+)
+
+func TestCSVExporter(t *testing.T) {
+	t.Run("should write a header and one line per transaction with names", func(t *testing.T) {
+		output := export(t, "csv", exporters.Options{}, exportRows())
+
+		records, err := csv.NewReader(strings.NewReader(output)).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 3)
+		require.Equal(t, "id", records[0][0])
+		require.Equal(t, []string{
+			"990e8400-e29b-41d4-a716-446655440002", "2026-03-05", "'=Mercado & Cia", "150.75", "EXPENSE", "credit",
+			"550e8400-e29b-41d4-a716-446655440002", "Alimentação", "550e8400-e29b-41d4-a716-446655440003", "Supermercado",
+			"550e8400-e29b-41d4-a716-446655440010", "Nubank", "", "", "1", "3", "active", "2026-03-05T10:00:00Z",
+		}, records[2])
+	})
+
+	t.Run("should write only the header when there is nothing to export", func(t *testing.T) {
+		output := export(t, "csv", exporters.Options{}, nil)
+
+		require.Equal(t, 1, strings.Count(output, "\n"))
+		require.True(t, strings.HasPrefix(output, "id,transaction_date,description,amount"))
+	})
+
+	t.Run("should be readable by the CSV import", func(t *testing.T) {
+		output := export(t, "csv", exporters.Options{}, exportRows()[:1])
+
+		rows, err := importers.ParseCSV(strings.NewReader(output), dtos.CSVImportOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Empty(t, rows[0].Errors)
+		require.Equal(t, "Salário", rows[0].Input.Description)
+		require.Equal(t, 5000.00, rows[0].Input.Amount)
+		require.Equal(t, "INCOME", rows[0].Input.Direction)
+		require.Equal(t, "2026-03-01", rows[0].Input.TransactionDate)
+	})
+}
@@ -0,0 +1,53 @@
+package exporters
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// Exporter writes exported transactions to a stream in one file format. Nothing is
+// written before the first Write or Close, so a failure before the first row leaves the
+// stream untouched.
+type Exporter interface {
+	ContentType() string
+	FileExtension() string
+	Write(row *dtos.TransactionExportOutput) error
+	Close() error
+}
+
+// Options describes the exported period, used by formats that declare it in a header.
+// StartDate and EndDate are the YYYY-MM-DD filters of the export, empty when not given.
+type Options struct {
+	StartDate   string
+	EndDate     string
+	GeneratedAt time.Time
+}
+
+// New returns the Exporter of the format (csv when empty) writing to w.
+func New(format string, w io.Writer, options Options) (Exporter, error) {
+	if options.GeneratedAt.IsZero() {
+		options.GeneratedAt = time.Now().UTC()
+	}
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", dtos.ExportFormatCSV:
+		return newCSVExporter(w), nil
+	case dtos.ExportFormatOFX:
+		return newOFXExporter(w, options), nil
+	case dtos.ExportFormatJSONL:
+		return newJSONLExporter(w), nil
+	default:
+		return nil, fmt.Errorf("%w: %q", transactionDomain.ErrInvalidExportFormat, format)
+	}
+}
+
+func optionalString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
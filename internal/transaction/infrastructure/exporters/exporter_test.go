@@ -0,0 +1,100 @@
+package exporters_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/exporters"
+)
+
+var generatedAt = time.Date(2026, 4, 1, 9, 30, 0, 0, time.UTC)
+
+func exportRows() []*dtos.TransactionExportOutput {
+	subcategoryID := "550e8400-e29b-41d4-a716-446655440003"
+	subcategoryName := "Supermercado"
+	cardID := "550e8400-e29b-41d4-a716-446655440010"
+	cardName := "Nubank"
+	number, total := 1, 3
+	return []*dtos.TransactionExportOutput{
+		{
+			TransactionOutput: &dtos.TransactionOutput{
+				ID:              "990e8400-e29b-41d4-a716-446655440001",
+				CategoryID:      "550e8400-e29b-41d4-a716-446655440001",
+				Description:     "Salário",
+				Amount:          5000,
+				Direction:       "INCOME",
+				PaymentMethod:   "ted",
+				TransactionDate: "2026-03-01",
+				Status:          "active",
+				CreatedAt:       "2026-03-01T10:00:00Z",
+			},
+			CategoryName: "Renda",
+		},
+		{
+			TransactionOutput: &dtos.TransactionOutput{
+				ID:                "990e8400-e29b-41d4-a716-446655440002",
+				CategoryID:        "550e8400-e29b-41d4-a716-446655440002",
+				SubcategoryID:     &subcategoryID,
+				CardID:            &cardID,
+				Description:       "=Mercado & Cia",
+				Amount:            150.75,
+				Direction:         "EXPENSE",
+				PaymentMethod:     "credit",
+				TransactionDate:   "2026-03-05",
+				InstallmentNumber: &number,
+				InstallmentTotal:  &total,
+				Status:            "active",
+				CreatedAt:         "2026-03-05T10:00:00Z",
+			},
+			CategoryName:    "Alimentação",
+			SubcategoryName: &subcategoryName,
+			CardName:        &cardName,
+		},
+	}
+}
+
+func export(t *testing.T, format string, options exporters.Options, rows []*dtos.TransactionExportOutput) string {
+	t.Helper()
+	var out bytes.Buffer
+	exporter, err := exporters.New(format, &out, options)
+	require.NoError(t, err)
+	for _, row := range rows {
+		require.NoError(t, exporter.Write(row))
+	}
+	require.NoError(t, exporter.Close())
+	return out.String()
+}
+
+func TestNew(t *testing.T) {
+	t.Run("should choose the exporter of each format", func(t *testing.T) {
+		for format, contentType := range map[string]string{
+			"":      "text/csv; charset=utf-8",
+			"CSV":   "text/csv; charset=utf-8",
+			"ofx":   "application/x-ofx",
+			"jsonl": "application/x-ndjson",
+		} {
+			exporter, err := exporters.New(format, &bytes.Buffer{}, exporters.Options{})
+			require.NoError(t, err)
+			require.Equal(t, contentType, exporter.ContentType())
+		}
+	})
+
+	t.Run("should return error for unknown formats", func(t *testing.T) {
+		_, err := exporters.New("xlsx", &bytes.Buffer{}, exporters.Options{})
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidExportFormat)
+	})
+
+	t.Run("should write nothing before the first row", func(t *testing.T) {
+		for _, format := range []string{"csv", "ofx", "jsonl"} {
+			var out bytes.Buffer
+			_, err := exporters.New(format, &out, exporters.Options{})
+			require.NoError(t, err)
+			require.Zero(t, out.Len())
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package exporters
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+)
+
+// jsonlExporter writes one JSON object per line (JSON Lines), with the API field names.
+type jsonlExporter struct {
+	buffer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+func newJSONLExporter(w io.Writer) *jsonlExporter {
+	buffer := bufio.NewWriter(w)
+	return &jsonlExporter{buffer: buffer, encoder: json.NewEncoder(buffer)}
+}
+
+func (e *jsonlExporter) ContentType() string   { return "application/x-ndjson" }
+func (e *jsonlExporter) FileExtension() string { return "jsonl" }
+
+func (e *jsonlExporter) Write(row *dtos.TransactionExportOutput) error {
+	return e.encoder.Encode(row)
+}
+
+func (e *jsonlExporter) Close() error {
+	return e.buffer.Flush()
+}
@@ -0,0 +1,32 @@
+package exporters_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/exporters"
+)
+
+func TestJSONLExporter(t *testing.T) {
+	t.Run("should write one JSON object per line with the names", func(t *testing.T) {
+		output := export(t, "jsonl", exporters.Options{}, exportRows())
+
+		lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+		require.Len(t, lines, 2)
+
+		var row map[string]any
+		require.NoError(t, json.Unmarshal([]byte(lines[1]), &row))
+		require.Equal(t, "990e8400-e29b-41d4-a716-446655440002", row["id"])
+		require.Equal(t, 150.75, row["amount"])
+		require.Equal(t, "Alimentação", row["category_name"])
+		require.Equal(t, "Supermercado", row["subcategory_name"])
+		require.Equal(t, "Nubank", row["card_name"])
+	})
+
+	t.Run("should write nothing when there is nothing to export", func(t *testing.T) {
+		require.Empty(t, export(t, "jsonl", exporters.Options{}, nil))
+	})
+}
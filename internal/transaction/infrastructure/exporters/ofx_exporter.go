@@ -0,0 +1,164 @@
+package exporters
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+const (
+	// ofxAccountID identifies the exported "account": the transactions of the user in
+	// all payment methods, not a bank account.
+	ofxAccountID = "FINANCIAL"
+	// ofxNameLength is the maximum size of the NAME element in OFX 1.x.
+	ofxNameLength = 32
+)
+
+const ofxHeader = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+SECURITY:NONE
+ENCODING:UTF-8
+CHARSET:NONE
+COMPRESSION:NONE
+OLDFILEUID:NONE
+NEWFILEUID:NONE
+
+<OFX>
+<SIGNONMSGSRSV1>
+<SONRS>
+<STATUS>
+<CODE>0</CODE>
+<SEVERITY>INFO</SEVERITY>
+</STATUS>
+<DTSERVER>%[1]s</DTSERVER>
+<LANGUAGE>POR</LANGUAGE>
+</SONRS>
+</SIGNONMSGSRSV1>
+<BANKMSGSRSV1>
+<STMTTRNRS>
+<TRNUID>1</TRNUID>
+<STATUS>
+<CODE>0</CODE>
+<SEVERITY>INFO</SEVERITY>
+</STATUS>
+<STMTRS>
+<CURDEF>BRL</CURDEF>
+<BANKACCTFROM>
+<BANKID>0000</BANKID>
+<ACCTID>%[2]s</ACCTID>
+<ACCTTYPE>CHECKING</ACCTTYPE>
+</BANKACCTFROM>
+<BANKTRANLIST>
+<DTSTART>%[3]s</DTSTART>
+<DTEND>%[4]s</DTEND>
+`
+
+const ofxFooter = `</BANKTRANLIST>
+</STMTRS>
+</STMTTRNRS>
+</BANKMSGSRSV1>
+</OFX>
+`
+
+// ofxExporter writes an OFX 1.0.2 (SGML) bank statement, with closing tags on every
+// element so XML-minded readers accept it too. Expenses are negative amounts and the
+// transaction id is the FITID.
+type ofxExporter struct {
+	buffer        *bufio.Writer
+	options       Options
+	headerWritten bool
+}
+
+func newOFXExporter(w io.Writer, options Options) *ofxExporter {
+	return &ofxExporter{buffer: bufio.NewWriter(w), options: options}
+}
+
+func (e *ofxExporter) ContentType() string   { return "application/x-ofx" }
+func (e *ofxExporter) FileExtension() string { return "ofx" }
+
+func (e *ofxExporter) Write(row *dtos.TransactionExportOutput) error {
+	// Rows come oldest first, so the first one starts the period when no start date is given.
+	if err := e.writeHeader(row.TransactionDate); err != nil {
+		return err
+	}
+
+	trnType, amount := "DEBIT", -row.Amount
+	if row.Direction == transactionVos.DirectionIncome.String() {
+		trnType, amount = "CREDIT", row.Amount
+	}
+	name := row.Description
+	if utf8.RuneCountInString(name) > ofxNameLength {
+		name = string([]rune(name)[:ofxNameLength])
+	}
+
+	_, err := fmt.Fprintf(e.buffer,
+		"<STMTTRN>\n<TRNTYPE>%s</TRNTYPE>\n<DTPOSTED>%s</DTPOSTED>\n<TRNAMT>%s</TRNAMT>\n<FITID>%s</FITID>\n<NAME>%s</NAME>\n<MEMO>%s</MEMO>\n</STMTTRN>\n",
+		trnType,
+		ofxDate(row.TransactionDate),
+		strconv.FormatFloat(amount, 'f', 2, 64),
+		row.ID,
+		html.EscapeString(name),
+		html.EscapeString(ofxMemo(row)),
+	)
+	return err
+}
+
+func (e *ofxExporter) Close() error {
+	if err := e.writeHeader(""); err != nil {
+		return err
+	}
+	if _, err := e.buffer.WriteString(ofxFooter); err != nil {
+		return err
+	}
+	return e.buffer.Flush()
+}
+
+func (e *ofxExporter) writeHeader(firstDate string) error {
+	if e.headerWritten {
+		return nil
+	}
+	e.headerWritten = true
+
+	generatedAt := e.options.GeneratedAt.Format("20060102150405")
+	start := ofxDate(e.options.StartDate)
+	if start == "" {
+		start = ofxDate(firstDate)
+	}
+	if start == "" {
+		start = e.options.GeneratedAt.Format("20060102")
+	}
+	end := ofxDate(e.options.EndDate)
+	if end == "" {
+		end = e.options.GeneratedAt.Format("20060102")
+	}
+
+	_, err := fmt.Fprintf(e.buffer, ofxHeader, generatedAt, ofxAccountID, start, end)
+	return err
+}
+
+// ofxMemo keeps the description and adds the category, subcategory and card names,
+// which have no element of their own in OFX.
+func ofxMemo(row *dtos.TransactionExportOutput) string {
+	category := row.CategoryName
+	if row.SubcategoryName != nil {
+		category += " / " + *row.SubcategoryName
+	}
+	parts := []string{row.Description, category}
+	if row.CardName != nil {
+		parts = append(parts, *row.CardName)
+	}
+	return strings.Join(parts, " | ")
+}
+
+// ofxDate converts a YYYY-MM-DD date to the OFX YYYYMMDD format.
+func ofxDate(date string) string {
+	return strings.ReplaceAll(date, "-", "")
+}
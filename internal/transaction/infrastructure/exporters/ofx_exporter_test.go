@@ -0,0 +1,47 @@
+package exporters_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/exporters"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/importers"
+)
+
+func TestOFXExporter(t *testing.T) {
+	t.Run("should write a statement readable by the OFX import", func(t *testing.T) {
+		output := export(t, "ofx", exporters.Options{GeneratedAt: generatedAt}, exportRows())
+
+		require.Contains(t, output, "<DTSERVER>20260401093000</DTSERVER>")
+		require.Contains(t, output, "<DTSTART>20260301</DTSTART>")
+		require.Contains(t, output, "<DTEND>20260401</DTEND>")
+		require.Contains(t, output, "<NAME>=Mercado &amp; Cia</NAME>")
+
+		rows, err := importers.ParseOFX(strings.NewReader(output), dtos.OFXImportOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Empty(t, rows[1].Errors)
+		require.Equal(t, "INCOME", rows[0].Input.Direction)
+		require.Equal(t, 5000.00, rows[0].Input.Amount)
+		require.Equal(t, "EXPENSE", rows[1].Input.Direction)
+		require.Equal(t, 150.75, rows[1].Input.Amount)
+		require.Equal(t, "2026-03-05", rows[1].Input.TransactionDate)
+		require.Equal(t, "=Mercado & Cia | Alimentação / Supermercado | Nubank", rows[1].Input.Description)
+		require.Equal(t, "ofx:FINANCIAL:990e8400-e29b-41d4-a716-446655440002", rows[1].Input.ExternalID)
+	})
+
+	t.Run("should declare the requested period", func(t *testing.T) {
+		options := exporters.Options{StartDate: "2026-02-01", EndDate: "2026-02-28", GeneratedAt: generatedAt}
+
+		output := export(t, "ofx", options, nil)
+
+		require.Contains(t, output, "<DTSTART>20260201</DTSTART>")
+		require.Contains(t, output, "<DTEND>20260228</DTEND>")
+		require.True(t, strings.HasSuffix(output, "</OFX>\n"))
+		require.NotContains(t, output, "<STMTTRN>")
+	})
+}
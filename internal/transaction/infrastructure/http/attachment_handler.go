@@ -0,0 +1,310 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// maxAttachmentRequestSize leaves room for the multipart envelope around the largest file.
+const maxAttachmentRequestSize = entities.MaxAttachmentSize + 1<<20
+
+// AttachmentHandler handles HTTP requests for transaction and invoice attachments.
+type AttachmentHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	uploadUC     usecase.UploadAttachmentUseCase
+	listUC       usecase.ListAttachmentsUseCase
+	downloadUC   usecase.DownloadAttachmentUseCase
+	deleteUC     usecase.DeleteAttachmentUseCase
+}
+
+// NewAttachmentHandler creates a new AttachmentHandler.
+func NewAttachmentHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	uploadUC usecase.UploadAttachmentUseCase,
+	listUC usecase.ListAttachmentsUseCase,
+	downloadUC usecase.DownloadAttachmentUseCase,
+	deleteUC usecase.DeleteAttachmentUseCase,
+) *AttachmentHandler {
+	return &AttachmentHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		uploadUC:     uploadUC,
+		listUC:       listUC,
+		downloadUC:   downloadUC,
+		deleteUC:     deleteUC,
+	}
+}
+
+func (h *AttachmentHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "attachment"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *AttachmentHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "attachment"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// UploadToTransaction godoc
+//
+//	@Summary		Attach a receipt to a transaction
+//	@Description	Uploads a PDF, JPEG, PNG or WebP file (max 10 MB). The content type is detected from the file. Uploading a file the transaction already has returns the existing attachment; identical files are stored once.
+//	@Tags			attachments
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Transaction ID"
+//	@Param			file	formData	file	true	"Receipt file"
+//	@Success		201		{object}	dtos.AttachmentOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		413		{object}	httperrors.ProblemDetail
+//	@Failure		415		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/{id}/attachments [post]
+func (h *AttachmentHandler) UploadToTransaction(w http.ResponseWriter, r *http.Request) {
+	h.upload(w, r, entities.AttachmentOwnerTransaction)
+}
+
+// UploadToInvoice godoc
+//
+//	@Summary		Attach a receipt to an invoice
+//	@Description	Same rules as POST /api/v1/transactions/{id}/attachments.
+//	@Tags			attachments
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string	true	"Invoice ID"
+//	@Param			file	formData	file	true	"Receipt file"
+//	@Success		201		{object}	dtos.AttachmentOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		413		{object}	httperrors.ProblemDetail
+//	@Failure		415		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/invoices/{id}/attachments [post]
+func (h *AttachmentHandler) UploadToInvoice(w http.ResponseWriter, r *http.Request) {
+	h.upload(w, r, entities.AttachmentOwnerInvoice)
+}
+
+// ListByTransaction godoc
+//
+//	@Summary		List the attachments of a transaction
+//	@Tags			attachments
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Transaction ID"
+//	@Success		200	{array}		dtos.AttachmentOutput
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/{id}/attachments [get]
+func (h *AttachmentHandler) ListByTransaction(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, entities.AttachmentOwnerTransaction)
+}
+
+// ListByInvoice godoc
+//
+//	@Summary		List the attachments of an invoice
+//	@Tags			attachments
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Invoice ID"
+//	@Success		200	{array}		dtos.AttachmentOutput
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/invoices/{id}/attachments [get]
+func (h *AttachmentHandler) ListByInvoice(w http.ResponseWriter, r *http.Request) {
+	h.list(w, r, entities.AttachmentOwnerInvoice)
+}
+
+// Download godoc
+//
+//	@Summary		Download an attachment
+//	@Tags			attachments
+//	@Produce		application/pdf
+//	@Produce		image/jpeg
+//	@Produce		image/png
+//	@Produce		image/webp
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Attachment ID"
+//	@Success		200	{file}		file
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/attachments/{id}/content [get]
+func (h *AttachmentHandler) Download(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "attachment_handler.download")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "download_attachment", correlationID, user.ID)
+
+	output, err := h.downloadUC.Execute(ctx, user.ID, chi.URLParam(r, "id"))
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "download_attachment", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	defer func() { _ = output.Content.Close() }()
+
+	w.Header().Set("Content-Type", output.Attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(output.Attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": output.Attachment.FileName}))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "private, no-store")
+	w.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(w, output.Content); err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "download_attachment", correlationID, user.ID, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "download_attachment", correlationID, user.ID)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete an attachment
+//	@Tags			attachments
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Attachment ID"
+//	@Success		204
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/attachments/{id} [delete]
+func (h *AttachmentHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "attachment_handler.delete")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "delete_attachment", correlationID, user.ID)
+
+	if err := h.deleteUC.Execute(ctx, user.ID, chi.URLParam(r, "id")); err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "delete_attachment", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "delete_attachment", correlationID, user.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *AttachmentHandler) upload(w http.ResponseWriter, r *http.Request, ownerType entities.AttachmentOwnerType) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "attachment_handler.upload")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	operation := "upload_" + string(ownerType) + "_attachment"
+	h.logInfo(ctx, "request_received", operation, correlationID, user.ID)
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentRequestSize)
+	if err := r.ParseMultipartForm(maxAttachmentRequestSize); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			err = transactionDomain.ErrAttachmentTooLarge
+		} else {
+			err = fmt.Errorf("%w: %v", transactionDomain.ErrAttachmentRequired, err)
+		}
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		h.errorHandler.HandleError(w, r, transactionDomain.ErrAttachmentRequired)
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	output, err := h.uploadUC.Execute(ctx, user.ID, &dtos.AttachmentUploadInput{
+		OwnerType: string(ownerType),
+		OwnerID:   chi.URLParam(r, "id"),
+		FileName:  header.Filename,
+		Content:   file,
+	})
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, operation, correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", operation, correlationID, user.ID)
+	responses.JSON(w, http.StatusCreated, output)
+}
+
+func (h *AttachmentHandler) list(w http.ResponseWriter, r *http.Request, ownerType entities.AttachmentOwnerType) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "attachment_handler.list")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	operation := "list_" + string(ownerType) + "_attachments"
+	h.logInfo(ctx, "request_received", operation, correlationID, user.ID)
+
+	output, err := h.listUC.Execute(ctx, user.ID, string(ownerType), chi.URLParam(r, "id"))
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, operation, correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", operation, correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
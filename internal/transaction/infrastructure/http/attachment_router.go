@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// AttachmentRouter registers attachment HTTP routes.
+type AttachmentRouter struct {
+	handlers              *AttachmentHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewAttachmentRouter creates a new AttachmentRouter.
+func NewAttachmentRouter(handlers *AttachmentHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *AttachmentRouter {
+	return &AttachmentRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r AttachmentRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Post("/api/v1/transactions/{id}/attachments", r.handlers.UploadToTransaction)
+		protected.Get("/api/v1/transactions/{id}/attachments", r.handlers.ListByTransaction)
+		protected.Post("/api/v1/invoices/{id}/attachments", r.handlers.UploadToInvoice)
+		protected.Get("/api/v1/invoices/{id}/attachments", r.handlers.ListByInvoice)
+		protected.Get("/api/v1/attachments/{id}/content", r.handlers.Download)
+		protected.Delete("/api/v1/attachments/{id}", r.handlers.Delete)
+	})
+}
@@ -0,0 +1,303 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// CategorizationRuleHandler handles HTTP requests for categorization rules.
+type CategorizationRuleHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	createUC     usecase.CreateCategorizationRuleUseCase
+	updateUC     usecase.UpdateCategorizationRuleUseCase
+	deleteUC     usecase.DeleteCategorizationRuleUseCase
+	listUC       usecase.ListCategorizationRulesUseCase
+	previewUC    usecase.PreviewCategorizationRulesUseCase
+	applyUC      usecase.ApplyCategorizationRulesUseCase
+}
+
+// NewCategorizationRuleHandler creates a new CategorizationRuleHandler.
+func NewCategorizationRuleHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	createUC usecase.CreateCategorizationRuleUseCase,
+	updateUC usecase.UpdateCategorizationRuleUseCase,
+	deleteUC usecase.DeleteCategorizationRuleUseCase,
+	listUC usecase.ListCategorizationRulesUseCase,
+	previewUC usecase.PreviewCategorizationRulesUseCase,
+	applyUC usecase.ApplyCategorizationRulesUseCase,
+) *CategorizationRuleHandler {
+	return &CategorizationRuleHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		createUC:     createUC,
+		updateUC:     updateUC,
+		deleteUC:     deleteUC,
+		listUC:       listUC,
+		previewUC:    previewUC,
+		applyUC:      applyUC,
+	}
+}
+
+func (h *CategorizationRuleHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "categorization_rule"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *CategorizationRuleHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "categorization_rule"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// Create godoc
+//
+//	@Summary		Create a categorization rule
+//	@Description	Adds a rule that sets the category, subcategory and tags of new transactions entered without a category. Every condition given must match; rules are evaluated by ascending priority and the first match wins.
+//	@Tags			categorization-rules
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dtos.CategorizationRuleInput	true	"Categorization rule input"
+//	@Success		201		{object}	dtos.CategorizationRuleOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/categorization-rules [post]
+func (h *CategorizationRuleHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "categorization_rule_handler.create")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "create_categorization_rule", correlationID, user.ID)
+	var input dtos.CategorizationRuleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.createUC.Execute(ctx, user.ID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "create_categorization_rule", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "create_categorization_rule", correlationID, user.ID)
+	responses.JSON(w, http.StatusCreated, output)
+}
+
+// List godoc
+//
+//	@Summary		List categorization rules
+//	@Description	Lists the rules of the user in evaluation order.
+//	@Tags			categorization-rules
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dtos.CategorizationRuleOutput
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/categorization-rules [get]
+func (h *CategorizationRuleHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "categorization_rule_handler.list")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "list_categorization_rules", correlationID, user.ID)
+	output, err := h.listUC.Execute(ctx, user.ID)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "list_categorization_rules", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "list_categorization_rules", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Update godoc
+//
+//	@Summary		Update a categorization rule
+//	@Description	Replaces the definition of the rule. Transactions already categorized keep their category until the rules are applied again.
+//	@Tags			categorization-rules
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string							true	"Rule ID"	format(uuid)
+//	@Param			request	body		dtos.CategorizationRuleInput	true	"Categorization rule input"
+//	@Success		200		{object}	dtos.CategorizationRuleOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/categorization-rules/{id} [put]
+func (h *CategorizationRuleHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "categorization_rule_handler.update")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	ruleID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "update_categorization_rule", correlationID, user.ID)
+	var input dtos.CategorizationRuleInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.updateUC.Execute(ctx, user.ID, ruleID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "update_categorization_rule", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "update_categorization_rule", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Delete godoc
+//
+//	@Summary	Delete a categorization rule
+//	@Tags		categorization-rules
+//	@Security	BearerAuth
+//	@Param		id	path	string	true	"Rule ID"	format(uuid)
+//	@Success	204
+//	@Failure	401	{object}	httperrors.ProblemDetail
+//	@Failure	403	{object}	httperrors.ProblemDetail
+//	@Failure	404	{object}	httperrors.ProblemDetail
+//	@Failure	500	{object}	httperrors.ProblemDetail
+//	@Router		/api/v1/categorization-rules/{id} [delete]
+func (h *CategorizationRuleHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "categorization_rule_handler.delete")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	ruleID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "delete_categorization_rule", correlationID, user.ID)
+	if err := h.deleteUC.Execute(ctx, user.ID, ruleID); err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "delete_categorization_rule", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "delete_categorization_rule", correlationID, user.ID)
+	responses.JSON(w, http.StatusNoContent, nil)
+}
+
+// Preview godoc
+//
+//	@Summary		Preview which rules match
+//	@Description	Evaluates the rules against a sample transaction without creating it. applied_rule is the rule a new transaction would get.
+//	@Tags			categorization-rules
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dtos.CategorizationPreviewInput	true	"Sample transaction"
+//	@Success		200		{object}	dtos.CategorizationPreviewOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/categorization-rules/preview [post]
+func (h *CategorizationRuleHandler) Preview(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "categorization_rule_handler.preview")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "preview_categorization_rules", correlationID, user.ID)
+	var input dtos.CategorizationPreviewInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.previewUC.Execute(ctx, user.ID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "preview_categorization_rules", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "preview_categorization_rules", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Apply godoc
+//
+//	@Summary		Re-apply the rules to past transactions
+//	@Description	Evaluates the rules against the active transactions of a period of up to 366 days, moving the matches to the rule's category and adding its tags. Split transactions are skipped and transactions on closed or paid invoices are reported as locked. dry_run reports the changes without making them.
+//	@Tags			categorization-rules
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dtos.ApplyCategorizationRulesInput	true	"Period to re-categorize"
+//	@Success		200		{object}	dtos.ApplyCategorizationRulesOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/categorization-rules/apply [post]
+func (h *CategorizationRuleHandler) Apply(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "categorization_rule_handler.apply")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "apply_categorization_rules", correlationID, user.ID)
+	var input dtos.ApplyCategorizationRulesInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.applyUC.Execute(ctx, user.ID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "apply_categorization_rules", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "apply_categorization_rules", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// CategorizationRuleRouter registers categorization rule HTTP routes.
+type CategorizationRuleRouter struct {
+	handlers              *CategorizationRuleHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewCategorizationRuleRouter creates a new CategorizationRuleRouter.
+func NewCategorizationRuleRouter(handlers *CategorizationRuleHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *CategorizationRuleRouter {
+	return &CategorizationRuleRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r CategorizationRuleRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Post("/api/v1/categorization-rules", r.handlers.Create)
+		protected.Get("/api/v1/categorization-rules", r.handlers.List)
+		protected.Post("/api/v1/categorization-rules/preview", r.handlers.Preview)
+		protected.Post("/api/v1/categorization-rules/apply", r.handlers.Apply)
+		protected.Put("/api/v1/categorization-rules/{id}", r.handlers.Update)
+		protected.Delete("/api/v1/categorization-rules/{id}", r.handlers.Delete)
+	})
+}
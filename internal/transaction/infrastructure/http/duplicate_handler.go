@@ -0,0 +1,146 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// DuplicateHandler handles HTTP requests for reviewing and merging duplicate transactions.
+type DuplicateHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	listUC       usecase.ListDuplicatesUseCase
+	mergeUC      usecase.MergeTransactionsUseCase
+}
+
+// NewDuplicateHandler creates a new DuplicateHandler.
+func NewDuplicateHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	listUC usecase.ListDuplicatesUseCase,
+	mergeUC usecase.MergeTransactionsUseCase,
+) *DuplicateHandler {
+	return &DuplicateHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		listUC:       listUC,
+		mergeUC:      mergeUC,
+	}
+}
+
+func (h *DuplicateHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *DuplicateHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// List godoc
+//
+//	@Summary		List likely duplicate transactions
+//	@Description	Pairs active transactions with the same amount, payment method and direction, dated at most 2 days apart and with similar descriptions. The original is the one entered first.
+//	@Tags			transactions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			start_date	query		string	false	"Start date (YYYY-MM-DD, default 90 days before end_date)"
+//	@Param			end_date	query		string	false	"End date (YYYY-MM-DD, default today)"
+//	@Success		200			{array}		dtos.DuplicatePairOutput
+//	@Failure		400			{object}	httperrors.ProblemDetail
+//	@Failure		401			{object}	httperrors.ProblemDetail
+//	@Failure		500			{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/duplicates [get]
+func (h *DuplicateHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "duplicate_handler.list")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "list_duplicates", correlationID, user.ID)
+	query := r.URL.Query()
+	params := &dtos.DuplicateReviewParams{
+		StartDate: query.Get("start_date"),
+		EndDate:   query.Get("end_date"),
+	}
+	output, err := h.listUC.Execute(ctx, user.ID, params)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "list_duplicates", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "list_duplicates", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Merge godoc
+//
+//	@Summary		Merge a duplicate into a transaction
+//	@Description	Cancels the duplicate like a reversal and moves its tags and attachments to the transaction in the path.
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string			true	"ID of the transaction to keep"	format(uuid)
+//	@Param			request	body		dtos.MergeInput	true	"Duplicate to cancel"
+//	@Success		200		{object}	dtos.MergeOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		422		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/{id}/merge [post]
+func (h *DuplicateHandler) Merge(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "duplicate_handler.merge")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	transactionID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "merge_transactions", correlationID, user.ID)
+	var input dtos.MergeInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.mergeUC.Execute(ctx, user.ID, transactionID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "merge_transactions", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "merge_transactions", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
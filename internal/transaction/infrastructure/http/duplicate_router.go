@@ -0,0 +1,28 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// DuplicateRouter registers the duplicate review HTTP routes.
+type DuplicateRouter struct {
+	handlers              *DuplicateHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewDuplicateRouter creates a new DuplicateRouter.
+func NewDuplicateRouter(handlers *DuplicateHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *DuplicateRouter {
+	return &DuplicateRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r DuplicateRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Get("/api/v1/transactions/duplicates", r.handlers.List)
+		protected.Post("/api/v1/transactions/{id}/merge", r.handlers.Merge)
+	})
+}
@@ -0,0 +1,199 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/importers"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// ExchangeRateHandler handles HTTP requests for the exchange rates foreign currency
+// transactions are converted with.
+type ExchangeRateHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	saveUC       usecase.SaveExchangeRateUseCase
+	listUC       usecase.ListExchangeRatesUseCase
+	importUC     usecase.ImportExchangeRatesUseCase
+}
+
+// NewExchangeRateHandler creates a new ExchangeRateHandler.
+func NewExchangeRateHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	saveUC usecase.SaveExchangeRateUseCase,
+	listUC usecase.ListExchangeRatesUseCase,
+	importUC usecase.ImportExchangeRatesUseCase,
+) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		saveUC:       saveUC,
+		listUC:       listUC,
+		importUC:     importUC,
+	}
+}
+
+func (h *ExchangeRateHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "exchange_rate"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *ExchangeRateHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "exchange_rate"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// Save godoc
+//
+//	@Summary		Save an exchange rate
+//	@Description	Sets the value of one unit of a foreign currency in BRL on a date, replacing the rate of that currency already set for the date. Foreign currency transactions are converted with the latest rate dated up to their own date; transactions already converted are not affected.
+//	@Tags			exchange-rates
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dtos.ExchangeRateInput	true	"Exchange rate input"
+//	@Success		201		{object}	dtos.ExchangeRateOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/exchange-rates [post]
+func (h *ExchangeRateHandler) Save(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "exchange_rate_handler.save")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "save_exchange_rate", correlationID, user.ID)
+	var input dtos.ExchangeRateInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.saveUC.Execute(ctx, user.ID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "save_exchange_rate", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "save_exchange_rate", correlationID, user.ID)
+	responses.JSON(w, http.StatusCreated, output)
+}
+
+// List godoc
+//
+//	@Summary		List exchange rates
+//	@Tags			exchange-rates
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			currency	query		string	false	"Only rates of this currency (ISO 4217)"
+//	@Success		200			{array}		dtos.ExchangeRateOutput
+//	@Failure		400			{object}	httperrors.ProblemDetail
+//	@Failure		401			{object}	httperrors.ProblemDetail
+//	@Failure		500			{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/exchange-rates [get]
+func (h *ExchangeRateHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "exchange_rate_handler.list")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "list_exchange_rates", correlationID, user.ID)
+	output, err := h.listUC.Execute(ctx, user.ID, r.URL.Query().Get("currency"))
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "list_exchange_rates", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "list_exchange_rates", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Import godoc
+//
+//	@Summary		Import exchange rates from a CSV file
+//	@Description	Imports a CSV file with the columns currency, rate and rate_date (YYYY-MM-DD) in any order, separated by "," or ";"; rates may use a decimal comma. The import is all-or-nothing: any invalid line rejects the file. Rates of a currency already set for the same date are replaced.
+//	@Tags			exchange-rates
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			file	formData	file	true	"CSV file (max 5 MB, 5000 rows)"
+//	@Success		200		{object}	dtos.ExchangeRateImportOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		413		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/exchange-rates/import [post]
+func (h *ExchangeRateHandler) Import(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "exchange_rate_handler.import")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "import_exchange_rates", correlationID, user.ID)
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportFileSize)
+	if err := r.ParseMultipartForm(maxImportFileSize); err != nil {
+		h.errorHandler.HandleError(w, r, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err))
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		h.errorHandler.HandleError(w, r, transactionDomain.ErrImportFileRequired)
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	rates, err := importers.ParseExchangeRatesCSV(file)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "import_exchange_rates", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	output, err := h.importUC.Execute(ctx, user.ID, rates)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "import_exchange_rates", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "import_exchange_rates", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// ExchangeRateRouter registers exchange rate HTTP routes.
+type ExchangeRateRouter struct {
+	handlers              *ExchangeRateHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewExchangeRateRouter creates a new ExchangeRateRouter.
+func NewExchangeRateRouter(handlers *ExchangeRateHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *ExchangeRateRouter {
+	return &ExchangeRateRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r ExchangeRateRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Post("/api/v1/exchange-rates", r.handlers.Save)
+		protected.Get("/api/v1/exchange-rates", r.handlers.List)
+		protected.Post("/api/v1/exchange-rates/import", r.handlers.Import)
+	})
+}
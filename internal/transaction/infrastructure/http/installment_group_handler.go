@@ -0,0 +1,153 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// InstallmentGroupHandler handles HTTP requests on the installments of a card purchase.
+type InstallmentGroupHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	updateUC     usecase.UpdateInstallmentGroupUseCase
+	payOffUC     usecase.PayOffInstallmentsUseCase
+}
+
+// NewInstallmentGroupHandler creates a new InstallmentGroupHandler.
+func NewInstallmentGroupHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	updateUC usecase.UpdateInstallmentGroupUseCase,
+	payOffUC usecase.PayOffInstallmentsUseCase,
+) *InstallmentGroupHandler {
+	return &InstallmentGroupHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		updateUC:     updateUC,
+		payOffUC:     payOffUC,
+	}
+}
+
+func (h *InstallmentGroupHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *InstallmentGroupHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// Update godoc
+//
+//	@Summary		Edit an installment purchase
+//	@Description	Changes the total amount and the number of installments of a card purchase. Installments on closed or paid invoices keep their amounts and are returned in locked; the rest of the total is spread over the other installments with the rounding of the purchase creation. Installments past the new count are cancelled and missing ones are added to the invoices of the following months.
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string								true	"Installment group ID"	format(uuid)
+//	@Param			request	body		dtos.InstallmentGroupUpdateInput	true	"New terms of the purchase"
+//	@Success		200		{object}	dtos.InstallmentGroupOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		422		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/installment-groups/{id} [put]
+func (h *InstallmentGroupHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "installment_group_handler.update")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	installmentGroupID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "update_installment_group", correlationID, user.ID)
+	var input dtos.InstallmentGroupUpdateInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.updateUC.Execute(ctx, user.ID, installmentGroupID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "update_installment_group", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "update_installment_group", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// PayOff godoc
+//
+//	@Summary		Pay off the remaining installments
+//	@Description	Moves every installment billed after the open invoice of the card into that invoice as a single item, with an optional discount. The future installments are cancelled, the new item keeps the installment group and the budgets of the future months are reduced.
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string				true	"Installment group ID"	format(uuid)
+//	@Param			request	body		dtos.PayoffInput	true	"Payoff"
+//	@Param			Idempotency-Key	header	string	false	"Key that makes retries of this request replay the first response"
+//	@Success		201		{object}	dtos.PayoffOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		409		{object}	httperrors.ProblemDetail
+//	@Failure		422		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/installment-groups/{id}/payoff [post]
+func (h *InstallmentGroupHandler) PayOff(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "installment_group_handler.pay_off")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	installmentGroupID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "pay_off_installments", correlationID, user.ID)
+	var input dtos.PayoffInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.payOffUC.Execute(ctx, user.ID, installmentGroupID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "pay_off_installments", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "pay_off_installments", correlationID, user.ID)
+	responses.JSON(w, http.StatusCreated, output)
+}
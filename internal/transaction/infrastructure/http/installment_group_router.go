@@ -0,0 +1,28 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// InstallmentGroupRouter registers the installment group HTTP routes.
+type InstallmentGroupRouter struct {
+	handlers              *InstallmentGroupHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewInstallmentGroupRouter creates a new InstallmentGroupRouter.
+func NewInstallmentGroupRouter(handlers *InstallmentGroupHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *InstallmentGroupRouter {
+	return &InstallmentGroupRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r InstallmentGroupRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Put("/api/v1/installment-groups/{id}", r.handlers.Update)
+		protected.Post("/api/v1/installment-groups/{id}/payoff", r.handlers.PayOff)
+	})
+}
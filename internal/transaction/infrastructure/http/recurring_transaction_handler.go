@@ -0,0 +1,256 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// RecurringTransactionHandler handles HTTP requests for the recurring transaction resource.
+type RecurringTransactionHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	createUC     usecase.CreateRecurringTransactionUseCase
+	updateUC     usecase.UpdateRecurringTransactionUseCase
+	deleteUC     usecase.DeleteRecurringTransactionUseCase
+	listUC       usecase.ListRecurringTransactionsUseCase
+	getUC        usecase.GetRecurringTransactionUseCase
+}
+
+// NewRecurringTransactionHandler creates a new RecurringTransactionHandler.
+func NewRecurringTransactionHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	createUC usecase.CreateRecurringTransactionUseCase,
+	updateUC usecase.UpdateRecurringTransactionUseCase,
+	deleteUC usecase.DeleteRecurringTransactionUseCase,
+	listUC usecase.ListRecurringTransactionsUseCase,
+	getUC usecase.GetRecurringTransactionUseCase,
+) *RecurringTransactionHandler {
+	return &RecurringTransactionHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		createUC:     createUC,
+		updateUC:     updateUC,
+		deleteUC:     deleteUC,
+		listUC:       listUC,
+		getUC:        getUC,
+	}
+}
+
+func (h *RecurringTransactionHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "recurring_transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *RecurringTransactionHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "recurring_transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// Create godoc
+//
+//	@Summary		Create a recurring transaction
+//	@Description	Stores a transaction template and an RRULE schedule (FREQ, INTERVAL, BYDAY, BYMONTHDAY, COUNT, UNTIL). The worker materializes each due occurrence as a regular transaction.
+//	@Tags			recurring-transactions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dtos.RecurringTransactionInput	true	"Recurring transaction input"
+//	@Success		201		{object}	dtos.RecurringTransactionOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/recurring-transactions [post]
+func (h *RecurringTransactionHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "recurring_transaction_handler.create")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "create_recurring_transaction", correlationID, user.ID)
+	var input dtos.RecurringTransactionInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.createUC.Execute(ctx, user.ID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "create_recurring_transaction", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "create_recurring_transaction", correlationID, user.ID)
+	responses.JSON(w, http.StatusCreated, output)
+}
+
+// List godoc
+//
+//	@Summary		List recurring transactions
+//	@Tags			recurring-transactions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dtos.RecurringTransactionOutput
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/recurring-transactions [get]
+func (h *RecurringTransactionHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "recurring_transaction_handler.list")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "list_recurring_transactions", correlationID, user.ID)
+	output, err := h.listUC.Execute(ctx, user.ID)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "list_recurring_transactions", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "list_recurring_transactions", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Get godoc
+//
+//	@Summary		Get a recurring transaction by ID
+//	@Tags			recurring-transactions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Recurring transaction ID"	format(uuid)
+//	@Success		200	{object}	dtos.RecurringTransactionOutput
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/recurring-transactions/{id} [get]
+func (h *RecurringTransactionHandler) Get(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "recurring_transaction_handler.get")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	recurringID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "get_recurring_transaction", correlationID, user.ID)
+	output, err := h.getUC.Execute(ctx, user.ID, recurringID)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "get_recurring_transaction", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "get_recurring_transaction", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Update godoc
+//
+//	@Summary		Update a recurring transaction
+//	@Description	Updates the template used by future occurrences and pauses or resumes the schedule (active). Occurrences missed while paused are skipped.
+//	@Tags			recurring-transactions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string									true	"Recurring transaction ID"	format(uuid)
+//	@Param			request	body		dtos.RecurringTransactionUpdateInput	true	"Update input"
+//	@Success		200		{object}	dtos.RecurringTransactionOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		409		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/recurring-transactions/{id} [put]
+func (h *RecurringTransactionHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "recurring_transaction_handler.update")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	recurringID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "update_recurring_transaction", correlationID, user.ID)
+	var input dtos.RecurringTransactionUpdateInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.updateUC.Execute(ctx, user.ID, recurringID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "update_recurring_transaction", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "update_recurring_transaction", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete a recurring transaction
+//	@Description	Stops the schedule. Transactions already materialized are kept.
+//	@Tags			recurring-transactions
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Recurring transaction ID"	format(uuid)
+//	@Success		204
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		409	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/recurring-transactions/{id} [delete]
+func (h *RecurringTransactionHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "recurring_transaction_handler.delete")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	recurringID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "delete_recurring_transaction", correlationID, user.ID)
+	if err := h.deleteUC.Execute(ctx, user.ID, recurringID); err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "delete_recurring_transaction", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "delete_recurring_transaction", correlationID, user.ID)
+	responses.JSON(w, http.StatusNoContent, nil)
+}
@@ -0,0 +1,31 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// RecurringTransactionRouter registers recurring transaction HTTP routes.
+type RecurringTransactionRouter struct {
+	handlers              *RecurringTransactionHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewRecurringTransactionRouter creates a new RecurringTransactionRouter.
+func NewRecurringTransactionRouter(handlers *RecurringTransactionHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *RecurringTransactionRouter {
+	return &RecurringTransactionRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r RecurringTransactionRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Post("/api/v1/recurring-transactions", r.handlers.Create)
+		protected.Get("/api/v1/recurring-transactions", r.handlers.List)
+		protected.Get("/api/v1/recurring-transactions/{id}", r.handlers.Get)
+		protected.Put("/api/v1/recurring-transactions/{id}", r.handlers.Update)
+		protected.Delete("/api/v1/recurring-transactions/{id}", r.handlers.Delete)
+	})
+}
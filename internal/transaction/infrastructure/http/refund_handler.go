@@ -0,0 +1,105 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// RefundHandler handles HTTP requests for refunds of card purchases.
+type RefundHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	refundUC     usecase.RefundTransactionUseCase
+}
+
+// NewRefundHandler creates a new RefundHandler.
+func NewRefundHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	refundUC usecase.RefundTransactionUseCase,
+) *RefundHandler {
+	return &RefundHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		refundUC:     refundUC,
+	}
+}
+
+func (h *RefundHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *RefundHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// Refund godoc
+//
+//	@Summary		Refund a card purchase
+//	@Description	Refunds a purchase billed on a closed or paid invoice, in full or in part. The credit is posted on the open invoice of the same card and the budget of the month the purchase was billed is reduced. The refund history is shown on GET /api/v1/transactions/{id}.
+//	@Tags			transactions
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string				true	"Transaction ID"	format(uuid)
+//	@Param			request	body		dtos.RefundInput	true	"Refund (without amount, the whole amount left is refunded)"
+//	@Param			Idempotency-Key	header	string	false	"Key that makes retries of this request replay the first response"
+//	@Success		201		{object}	dtos.RefundOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		409		{object}	httperrors.ProblemDetail
+//	@Failure		422		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/{id}/refunds [post]
+func (h *RefundHandler) Refund(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "refund_handler.refund")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	transactionID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "refund_transaction", correlationID, user.ID)
+	var input dtos.RefundInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.refundUC.Execute(ctx, user.ID, transactionID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "refund_transaction", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "refund_transaction", correlationID, user.ID)
+	responses.JSON(w, http.StatusCreated, output)
+}
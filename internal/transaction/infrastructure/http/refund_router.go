@@ -0,0 +1,27 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// RefundRouter registers the refund HTTP routes.
+type RefundRouter struct {
+	handlers              *RefundHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewRefundRouter creates a new RefundRouter.
+func NewRefundRouter(handlers *RefundHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *RefundRouter {
+	return &RefundRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r RefundRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Post("/api/v1/transactions/{id}/refunds", r.handlers.Refund)
+	})
+}
@@ -0,0 +1,135 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// ScheduledTransactionHandler handles HTTP requests that resolve scheduled transactions.
+type ScheduledTransactionHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	confirmUC    usecase.ConfirmScheduledTransactionUseCase
+	skipUC       usecase.SkipScheduledTransactionUseCase
+}
+
+// NewScheduledTransactionHandler creates a new ScheduledTransactionHandler.
+func NewScheduledTransactionHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	confirmUC usecase.ConfirmScheduledTransactionUseCase,
+	skipUC usecase.SkipScheduledTransactionUseCase,
+) *ScheduledTransactionHandler {
+	return &ScheduledTransactionHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		confirmUC:    confirmUC,
+		skipUC:       skipUC,
+	}
+}
+
+func (h *ScheduledTransactionHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *ScheduledTransactionHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// Confirm godoc
+//
+//	@Summary		Confirm a scheduled transaction
+//	@Description	Activates a scheduled transaction before the worker promotes it on its date. A transaction confirmed ahead of time is dated today and starts counting in the budget of the current month.
+//	@Tags			transactions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Transaction ID"	format(uuid)
+//	@Success		200	{object}	dtos.TransactionOutput
+//	@Failure		400	{object}	httperrors.ProblemDetail
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		422	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/{id}/confirm [post]
+func (h *ScheduledTransactionHandler) Confirm(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "scheduled_transaction_handler.confirm")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	transactionID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "confirm_scheduled_transaction", correlationID, user.ID)
+	output, err := h.confirmUC.Execute(ctx, user.ID, transactionID)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "confirm_scheduled_transaction", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "confirm_scheduled_transaction", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Skip godoc
+//
+//	@Summary		Skip a scheduled transaction
+//	@Description	Cancels a scheduled transaction that will not happen. It never counted in any budget.
+//	@Tags			transactions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Transaction ID"	format(uuid)
+//	@Success		200	{object}	dtos.TransactionOutput
+//	@Failure		400	{object}	httperrors.ProblemDetail
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		422	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/{id}/skip [post]
+func (h *ScheduledTransactionHandler) Skip(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "scheduled_transaction_handler.skip")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	transactionID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "skip_scheduled_transaction", correlationID, user.ID)
+	output, err := h.skipUC.Execute(ctx, user.ID, transactionID)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "skip_scheduled_transaction", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "skip_scheduled_transaction", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
@@ -0,0 +1,28 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// ScheduledTransactionRouter registers the scheduled transaction HTTP routes.
+type ScheduledTransactionRouter struct {
+	handlers              *ScheduledTransactionHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewScheduledTransactionRouter creates a new ScheduledTransactionRouter.
+func NewScheduledTransactionRouter(handlers *ScheduledTransactionHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *ScheduledTransactionRouter {
+	return &ScheduledTransactionRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r ScheduledTransactionRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Post("/api/v1/transactions/{id}/confirm", r.handlers.Confirm)
+		protected.Post("/api/v1/transactions/{id}/skip", r.handlers.Skip)
+	})
+}
@@ -0,0 +1,308 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// TagHandler handles HTTP requests for the tag catalog and bulk tagging.
+type TagHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	createUC     usecase.CreateTagUseCase
+	updateUC     usecase.UpdateTagUseCase
+	deleteUC     usecase.DeleteTagUseCase
+	listUC       usecase.ListTagsUseCase
+	summaryUC    usecase.GetTagSummaryUseCase
+	applyUC      usecase.ApplyTagsUseCase
+}
+
+// NewTagHandler creates a new TagHandler.
+func NewTagHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	createUC usecase.CreateTagUseCase,
+	updateUC usecase.UpdateTagUseCase,
+	deleteUC usecase.DeleteTagUseCase,
+	listUC usecase.ListTagsUseCase,
+	summaryUC usecase.GetTagSummaryUseCase,
+	applyUC usecase.ApplyTagsUseCase,
+) *TagHandler {
+	return &TagHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		createUC:     createUC,
+		updateUC:     updateUC,
+		deleteUC:     deleteUC,
+		listUC:       listUC,
+		summaryUC:    summaryUC,
+		applyUC:      applyUC,
+	}
+}
+
+func (h *TagHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "tag"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *TagHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "tag"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// Create godoc
+//
+//	@Summary		Create a tag
+//	@Description	Adds a tag to the user's catalog. Names are lowercased and unique per user.
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dtos.TagInput	true	"Tag input"
+//	@Success		201		{object}	dtos.TagOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		409		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/tags [post]
+func (h *TagHandler) Create(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "tag_handler.create")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "create_tag", correlationID, user.ID)
+	var input dtos.TagInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.createUC.Execute(ctx, user.ID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "create_tag", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "create_tag", correlationID, user.ID)
+	responses.JSON(w, http.StatusCreated, output)
+}
+
+// List godoc
+//
+//	@Summary		List tags
+//	@Tags			tags
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Success		200	{array}		dtos.TagOutput
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/tags [get]
+func (h *TagHandler) List(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "tag_handler.list")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "list_tags", correlationID, user.ID)
+	output, err := h.listUC.Execute(ctx, user.ID)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "list_tags", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "list_tags", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Update godoc
+//
+//	@Summary		Rename a tag
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id		path		string			true	"Tag ID"	format(uuid)
+//	@Param			request	body		dtos.TagInput	true	"Tag input"
+//	@Success		200		{object}	dtos.TagOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		409		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/tags/{id} [put]
+func (h *TagHandler) Update(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "tag_handler.update")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	tagID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "update_tag", correlationID, user.ID)
+	var input dtos.TagInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.updateUC.Execute(ctx, user.ID, tagID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "update_tag", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "update_tag", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Delete godoc
+//
+//	@Summary		Delete a tag
+//	@Description	Removes the tag from the catalog and from every transaction carrying it.
+//	@Tags			tags
+//	@Security		BearerAuth
+//	@Param			id	path	string	true	"Tag ID"	format(uuid)
+//	@Success		204
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/tags/{id} [delete]
+func (h *TagHandler) Delete(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "tag_handler.delete")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	tagID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "delete_tag", correlationID, user.ID)
+	if err := h.deleteUC.Execute(ctx, user.ID, tagID); err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "delete_tag", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "delete_tag", correlationID, user.ID)
+	responses.JSON(w, http.StatusNoContent, nil)
+}
+
+// Summary godoc
+//
+//	@Summary		Get the totals of a tag
+//	@Description	Sums the active transactions carrying the tag by direction. Each installment counts once, and split purchases count their whole amount.
+//	@Tags			tags
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id			path		string	true	"Tag ID"	format(uuid)
+//	@Param			start_date	query		string	false	"Start date (YYYY-MM-DD)"
+//	@Param			end_date	query		string	false	"End date (YYYY-MM-DD)"
+//	@Success		200			{object}	dtos.TagSummaryOutput
+//	@Failure		400			{object}	httperrors.ProblemDetail
+//	@Failure		401			{object}	httperrors.ProblemDetail
+//	@Failure		403			{object}	httperrors.ProblemDetail
+//	@Failure		404			{object}	httperrors.ProblemDetail
+//	@Failure		500			{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/tags/{id}/summary [get]
+func (h *TagHandler) Summary(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "tag_handler.summary")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	tagID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "get_tag_summary", correlationID, user.ID)
+	params := &dtos.TagSummaryParams{
+		StartDate: r.URL.Query().Get("start_date"),
+		EndDate:   r.URL.Query().Get("end_date"),
+	}
+	output, err := h.summaryUC.Execute(ctx, user.ID, tagID, params)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "get_tag_summary", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "get_tag_summary", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
+
+// Apply godoc
+//
+//	@Summary		Tag transactions in bulk
+//	@Description	Adds the tags to up to 100 transactions, keeping the tags they already have. apply_to_groups also tags every installment of the purchases involved.
+//	@Tags			tags
+//	@Accept			json
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			request	body		dtos.ApplyTagsInput	true	"Bulk tagging input"
+//	@Success		200		{object}	dtos.ApplyTagsOutput
+//	@Failure		400		{object}	httperrors.ProblemDetail
+//	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		403		{object}	httperrors.ProblemDetail
+//	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		500		{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/tags [post]
+func (h *TagHandler) Apply(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "tag_handler.apply")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "apply_tags", correlationID, user.ID)
+	var input dtos.ApplyTagsInput
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	output, err := h.applyUC.Execute(ctx, user.ID, &input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "apply_tags", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "apply_tags", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
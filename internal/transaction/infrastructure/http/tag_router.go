@@ -0,0 +1,32 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// TagRouter registers tag HTTP routes.
+type TagRouter struct {
+	handlers              *TagHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
+}
+
+// NewTagRouter creates a new TagRouter.
+func NewTagRouter(handlers *TagHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *TagRouter {
+	return &TagRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r TagRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
+		protected.Post("/api/v1/tags", r.handlers.Create)
+		protected.Get("/api/v1/tags", r.handlers.List)
+		protected.Put("/api/v1/tags/{id}", r.handlers.Update)
+		protected.Delete("/api/v1/tags/{id}", r.handlers.Delete)
+		protected.Get("/api/v1/tags/{id}/summary", r.handlers.Summary)
+		protected.Post("/api/v1/transactions/tags", r.handlers.Apply)
+	})
+}
@@ -0,0 +1,109 @@
+package http
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/exporters"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// exportResponseWriter sends the status and the download headers on the first write, so
+// an error found before any output (invalid filter, failed query) can still be answered
+// with a problem detail.
+type exportResponseWriter struct {
+	w           http.ResponseWriter
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (e *exportResponseWriter) Write(p []byte) (int, error) {
+	e.start()
+	return e.w.Write(p)
+}
+
+func (e *exportResponseWriter) start() {
+	if e.started {
+		return
+	}
+	e.started = true
+	e.w.Header().Set("Content-Type", e.contentType)
+	e.w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, e.filename))
+	e.w.Header().Set("Cache-Control", "no-store")
+	e.w.WriteHeader(http.StatusOK)
+}
+
+// Export godoc
+//
+//	@Summary		Export transactions as CSV, OFX or JSON Lines
+//	@Description	Streams every transaction matching the same filters as GET /api/v1/transactions (limit, cursor and sorting are ignored), oldest first, with the category, subcategory and card names. CSV uses the column names of the CSV import; OFX is a 1.0.2 statement where expenses are negative and the transaction id is the FITID, with the names in MEMO; jsonl has one transaction per line with the API field names. Errors found before the first row are returned as problem details; an error while streaming ends the download early.
+//	@Tags			transactions
+//	@Produce		text/csv
+//	@Produce		application/x-ofx
+//	@Produce		application/x-ndjson
+//	@Security		BearerAuth
+//	@Param			format			query	string	false	"File format (default csv)"	Enums(csv, ofx, jsonl)
+//	@Param			payment_method	query	string	false	"Filter by payment method"
+//	@Param			category_id				query	string	false	"Filter by category ID"
+//	@Param			subcategory_id			query	string	false	"Filter by subcategory ID"
+//	@Param			card_id					query	string	false	"Filter by card ID"
+//	@Param			invoice_id				query	string	false	"Filter by invoice ID"
+//	@Param			installment_group_id	query	string	false	"Filter by installment group ID"
+//	@Param			tag_id					query	string	false	"Filter by tag IDs (comma-separated); every tag must be present"
+//	@Param			direction				query	string	false	"Filter by direction (INCOME, EXPENSE)"
+//	@Param			status					query	string	false	"Filter by status (default active)"	Enums(active, scheduled, cancelled, all)
+//	@Param			min_amount				query	number	false	"Minimum amount"
+//	@Param			max_amount				query	number	false	"Maximum amount"
+//	@Param			search					query	string	false	"Case-insensitive search in the description"
+//	@Param			start_date				query	string	false	"Start date (YYYY-MM-DD)"
+//	@Param			end_date				query	string	false	"End date (YYYY-MM-DD)"
+//	@Success		200	{file}		file
+//	@Failure		400	{object}	httperrors.ProblemDetail
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/export [get]
+func (h *TransactionHandler) Export(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "transaction_handler.export")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", "export_transactions", correlationID, user.ID)
+
+	params := parseListParams(r)
+	generatedAt := time.Now().UTC()
+	out := &exportResponseWriter{w: w}
+	exporter, err := exporters.New(r.URL.Query().Get("format"), out, exporters.Options{
+		StartDate:   params.StartDate,
+		EndDate:     params.EndDate,
+		GeneratedAt: generatedAt,
+	})
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	out.contentType = exporter.ContentType()
+	out.filename = fmt.Sprintf("transactions-%s.%s", generatedAt.Format("20060102-150405"), exporter.FileExtension())
+
+	err = h.exportUC.Execute(ctx, user.ID, params, exporter.Write)
+	if err == nil {
+		err = exporter.Close()
+	}
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "export_transactions", correlationID, user.ID, err)
+		if !out.started {
+			h.errorHandler.HandleError(w, r, err)
+		}
+		return
+	}
+	out.start()
+	h.logInfo(ctx, "request_completed", "export_transactions", correlationID, user.ID)
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
 	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	apihttp "github.com/jailtonjunior94/financial/pkg/api/http"
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 )
@@ -26,6 +27,8 @@ type TransactionHandler struct {
 	reverseUC    usecase.ReverseTransactionUseCase
 	listUC       usecase.ListTransactionsUseCase
 	getUC        usecase.GetTransactionUseCase
+	importUC     usecase.ImportTransactionsUseCase
+	exportUC     usecase.ExportTransactionsUseCase
 }
 
 // NewTransactionHandler creates a new TransactionHandler.
@@ -37,6 +40,8 @@ func NewTransactionHandler(
 	reverseUC usecase.ReverseTransactionUseCase,
 	listUC usecase.ListTransactionsUseCase,
 	getUC usecase.GetTransactionUseCase,
+	importUC usecase.ImportTransactionsUseCase,
+	exportUC usecase.ExportTransactionsUseCase,
 ) *TransactionHandler {
 	return &TransactionHandler{
 		o11y:         o11y,
@@ -46,6 +51,8 @@ func NewTransactionHandler(
 		reverseUC:    reverseUC,
 		listUC:       listUC,
 		getUC:        getUC,
+		importUC:     importUC,
+		exportUC:     exportUC,
 	}
 }
 
@@ -78,14 +85,17 @@ const (
 // Create godoc
 //
 //	@Summary		Create a new transaction
+//	@Description	A currency other than BRL is converted with the user's latest exchange rate dated up to transaction_date (422 when there is none). iof_percentage, only on credit card purchases in a foreign currency, bills the IOF as a separate invoice item. Foreign currency purchases cannot be paid in installments.
 //	@Tags			transactions
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			request	body		dtos.TransactionInput	true	"Transaction input"
+//	@Param			Idempotency-Key	header	string	false	"Key that makes retries of this request replay the first response"
 //	@Success		201		{array}		dtos.TransactionOutput
 //	@Failure		400		{object}	httperrors.ProblemDetail
 //	@Failure		401		{object}	httperrors.ProblemDetail
+//	@Failure		409		{object}	httperrors.ProblemDetail
 //	@Failure		422		{object}	httperrors.ProblemDetail
 //	@Failure		500		{object}	httperrors.ProblemDetail
 //	@Router			/api/v1/transactions [post]
@@ -122,12 +132,25 @@ func (h *TransactionHandler) Create(w http.ResponseWriter, r *http.Request) {
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			payment_method	query	string	false	"Filter by payment method"
-//	@Param			category_id		query	string	false	"Filter by category ID"
-//	@Param			start_date		query	string	false	"Start date (YYYY-MM-DD)"
-//	@Param			end_date		query	string	false	"End date (YYYY-MM-DD)"
-//	@Param			limit			query	int		false	"Page size (default 20, max 100)"
-//	@Param			cursor			query	string	false	"Pagination cursor"
+//	@Param			category_id				query	string	false	"Filter by category ID"
+//	@Param			subcategory_id			query	string	false	"Filter by subcategory ID"
+//	@Param			card_id					query	string	false	"Filter by card ID"
+//	@Param			invoice_id				query	string	false	"Filter by invoice ID"
+//	@Param			installment_group_id	query	string	false	"Filter by installment group ID"
+//	@Param			tag_id					query	string	false	"Filter by tag IDs (comma-separated); every tag must be present"
+//	@Param			direction				query	string	false	"Filter by direction (INCOME, EXPENSE)"
+//	@Param			status					query	string	false	"Filter by status (default active)"	Enums(active, scheduled, cancelled, all)
+//	@Param			min_amount				query	number	false	"Minimum amount"
+//	@Param			max_amount				query	number	false	"Maximum amount"
+//	@Param			search					query	string	false	"Case-insensitive search in the description"
+//	@Param			start_date				query	string	false	"Start date (YYYY-MM-DD)"
+//	@Param			end_date				query	string	false	"End date (YYYY-MM-DD)"
+//	@Param			sort_by					query	string	false	"Sort key (default transaction_date)"	Enums(transaction_date, amount)
+//	@Param			sort_order				query	string	false	"Sort order (default desc)"			Enums(asc, desc)
+//	@Param			limit					query	int		false	"Page size (default 20, max 100)"
+//	@Param			cursor					query	string	false	"Pagination cursor; a cursor of another sort starts over"
 //	@Success		200	{object}	dtos.TransactionListOutput
+//	@Failure		400	{object}	httperrors.ProblemDetail
 //	@Failure		401	{object}	httperrors.ProblemDetail
 //	@Failure		500	{object}	httperrors.ProblemDetail
 //	@Router			/api/v1/transactions [get]
@@ -141,14 +164,7 @@ func (h *TransactionHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	h.logInfo(ctx, "request_received", "list_transactions", correlationID, user.ID)
-	params := &dtos.ListParams{
-		PaymentMethod: r.URL.Query().Get("payment_method"),
-		CategoryID:    r.URL.Query().Get("category_id"),
-		StartDate:     r.URL.Query().Get("start_date"),
-		EndDate:       r.URL.Query().Get("end_date"),
-		Limit:         parseTransactionLimit(r.URL.Query().Get("limit")),
-		Cursor:        r.URL.Query().Get("cursor"),
-	}
+	params := parseListParams(r)
 	output, err := h.listUC.Execute(ctx, user.ID, params)
 	if err != nil {
 		span.RecordError(err)
@@ -168,6 +184,7 @@ func (h *TransactionHandler) List(w http.ResponseWriter, r *http.Request) {
 //	@Security		BearerAuth
 //	@Param			id	path		string	true	"Transaction ID"	format(uuid)
 //	@Success		200	{object}	dtos.TransactionOutput
+//	@Header			200	{string}	ETag	"Current version of the transaction"
 //	@Failure		401	{object}	httperrors.ProblemDetail
 //	@Failure		403	{object}	httperrors.ProblemDetail
 //	@Failure		404	{object}	httperrors.ProblemDetail
@@ -192,23 +209,29 @@ func (h *TransactionHandler) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	h.logInfo(ctx, "request_completed", "get_transaction", correlationID, user.ID)
+	apihttp.SetETag(w, output.Version)
 	responses.JSON(w, http.StatusOK, output)
 }
 
 // Update godoc
 //
 //	@Summary		Update a transaction
+//	@Description	tag_ids replaces the tags when present. When the tags of an installment change without apply_tags_to_group, tag_group_suggestion reports the installments left untouched.
+//	@Description	If-Match, when sent, must carry the ETag last read; a stale version is rejected with 412.
 //	@Tags			transactions
 //	@Accept			json
 //	@Produce		json
 //	@Security		BearerAuth
 //	@Param			id		path		string						true	"Transaction ID"	format(uuid)
-//	@Param			request	body		dtos.TransactionUpdateInput	true	"Update input"
-//	@Success		200		{object}	dtos.TransactionOutput
+//	@Param			request		body		dtos.TransactionUpdateInput	true	"Update input"
+//	@Param			If-Match	header		string						false	"ETag of the version being updated"
+//	@Success		200			{object}	dtos.TransactionOutput
+//	@Header			200			{string}	ETag	"New version of the transaction"
 //	@Failure		400		{object}	httperrors.ProblemDetail
 //	@Failure		401		{object}	httperrors.ProblemDetail
 //	@Failure		403		{object}	httperrors.ProblemDetail
 //	@Failure		404		{object}	httperrors.ProblemDetail
+//	@Failure		412		{object}	httperrors.ProblemDetail
 //	@Failure		422		{object}	httperrors.ProblemDetail
 //	@Failure		500		{object}	httperrors.ProblemDetail
 //	@Router			/api/v1/transactions/{id} [put]
@@ -222,13 +245,18 @@ func (h *TransactionHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	transactionID := chi.URLParam(r, "id")
+	expectedVersion, err := apihttp.IfMatch(r)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
 	h.logInfo(ctx, "request_received", "update_transaction", correlationID, user.ID)
 	var input dtos.TransactionUpdateInput
 	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
 		h.errorHandler.HandleError(w, r, err)
 		return
 	}
-	output, err := h.updateUC.Execute(ctx, user.ID, transactionID, &input)
+	output, err := h.updateUC.Execute(ctx, user.ID, transactionID, &input, expectedVersion)
 	if err != nil {
 		span.RecordError(err)
 		h.logError(ctx, "update_transaction", correlationID, user.ID, err)
@@ -236,6 +264,7 @@ func (h *TransactionHandler) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	h.logInfo(ctx, "request_completed", "update_transaction", correlationID, user.ID)
+	apihttp.SetETag(w, output.Version)
 	responses.JSON(w, http.StatusOK, output)
 }
 
@@ -288,3 +317,28 @@ func parseTransactionLimit(raw string) int {
 	}
 	return parsed
 }
+
+// parseListParams reads the listing filters shared by the listing and the export.
+func parseListParams(r *http.Request) *dtos.ListParams {
+	query := r.URL.Query()
+	return &dtos.ListParams{
+		PaymentMethod:      query.Get("payment_method"),
+		CategoryID:         query.Get("category_id"),
+		SubcategoryID:      query.Get("subcategory_id"),
+		CardID:             query.Get("card_id"),
+		InvoiceID:          query.Get("invoice_id"),
+		InstallmentGroupID: query.Get("installment_group_id"),
+		TagIDs:             query.Get("tag_id"),
+		Direction:          query.Get("direction"),
+		Status:             query.Get("status"),
+		MinAmount:          query.Get("min_amount"),
+		MaxAmount:          query.Get("max_amount"),
+		Search:             query.Get("search"),
+		StartDate:          query.Get("start_date"),
+		EndDate:            query.Get("end_date"),
+		SortBy:             query.Get("sort_by"),
+		SortOrder:          query.Get("sort_order"),
+		Limit:              parseTransactionLimit(query.Get("limit")),
+		Cursor:             query.Get("cursor"),
+	}
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"github.com/go-chi/chi/v5"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// TransactionHistoryHandler handles HTTP requests for the change history of transactions.
+type TransactionHistoryHandler struct {
+	o11y         observability.Observability
+	errorHandler httperrors.ErrorHandler
+	historyUC    usecase.GetTransactionHistoryUseCase
+}
+
+// NewTransactionHistoryHandler creates a new TransactionHistoryHandler.
+func NewTransactionHistoryHandler(
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+	historyUC usecase.GetTransactionHistoryUseCase,
+) *TransactionHistoryHandler {
+	return &TransactionHistoryHandler{
+		o11y:         o11y,
+		errorHandler: errorHandler,
+		historyUC:    historyUC,
+	}
+}
+
+func (h *TransactionHistoryHandler) logInfo(ctx context.Context, event, operation, correlationID, userID string) {
+	h.o11y.Logger().Info(ctx, event,
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+	)
+}
+
+func (h *TransactionHistoryHandler) logError(ctx context.Context, operation, correlationID, userID string, err error) {
+	h.o11y.Logger().Error(ctx, "request_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "handler"),
+		observability.String("entity", "transaction"),
+		observability.String("correlation_id", correlationID),
+		observability.String("user_id", userID),
+		observability.Error(err),
+	)
+}
+
+// History godoc
+//
+//	@Summary		Get the change history of a transaction
+//	@Description	Lists the creation, changes and cancellation of a transaction, oldest first, with the fields each one changed. changed_by is omitted for changes made by the worker.
+//	@Tags			transactions
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			id	path		string	true	"Transaction ID"	format(uuid)
+//	@Success		200	{array}		dtos.TransactionRevisionOutput
+//	@Failure		400	{object}	httperrors.ProblemDetail
+//	@Failure		401	{object}	httperrors.ProblemDetail
+//	@Failure		403	{object}	httperrors.ProblemDetail
+//	@Failure		404	{object}	httperrors.ProblemDetail
+//	@Failure		500	{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/{id}/history [get]
+func (h *TransactionHistoryHandler) History(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "transaction_history_handler.history")
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	transactionID := chi.URLParam(r, "id")
+	h.logInfo(ctx, "request_received", "get_transaction_history", correlationID, user.ID)
+	output, err := h.historyUC.Execute(ctx, user.ID, transactionID)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, "get_transaction_history", correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", "get_transaction_history", correlationID, user.ID)
+	responses.JSON(w, http.StatusOK, output)
+}
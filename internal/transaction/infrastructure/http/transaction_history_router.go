@@ -0,0 +1,26 @@
+package http
+
+import (
+	"github.com/go-chi/chi/v5"
+
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// TransactionHistoryRouter registers the transaction history HTTP routes.
+type TransactionHistoryRouter struct {
+	handlers       *TransactionHistoryHandler
+	authMiddleware middlewares.Authorization
+}
+
+// NewTransactionHistoryRouter creates a new TransactionHistoryRouter.
+func NewTransactionHistoryRouter(handlers *TransactionHistoryHandler, authMiddleware middlewares.Authorization) *TransactionHistoryRouter {
+	return &TransactionHistoryRouter{handlers: handlers, authMiddleware: authMiddleware}
+}
+
+// Register registers routes on the provided chi.Router.
+func (r TransactionHistoryRouter) Register(router chi.Router) {
+	router.Group(func(protected chi.Router) {
+		protected.Use(r.authMiddleware.Authorization)
+		protected.Get("/api/v1/transactions/{id}/history", r.handlers.History)
+	})
+}
@@ -0,0 +1,228 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/responses"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/importers"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+)
+
+// maxImportFileSize limits the size of an uploaded import file (5 MB).
+const maxImportFileSize = 5 << 20
+
+// Import godoc
+//
+//	@Summary		Import transactions from a CSV file
+//	@Description	Imports a CSV file with a header line. Every row follows the same rules as POST /api/v1/transactions, including the categorization rules for rows without category_id. Rows matching an existing transaction (same date, amount and description) are reported as duplicates and skipped unless allow_duplicates is set. The import is all-or-nothing: when any row is invalid nothing is persisted and the response (422) lists the errors of each row. Use dry_run to validate a file without importing it.
+//	@Tags			transactions
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			file				formData	file	true	"CSV file (max 5 MB, 5000 rows)"
+//	@Param			mapping				formData	string	false	"JSON object mapping fields to CSV headers, e.g. {\"transaction_date\":\"Data\",\"description\":\"Histórico\",\"amount\":\"Valor\"}"
+//	@Param			delimiter			formData	string	false	"Column delimiter"	Enums(",", ";", tab)
+//	@Param			date_format			formData	string	false	"Date format"		Enums(YYYY-MM-DD, DD/MM/YYYY, MM/DD/YYYY, DD-MM-YYYY, DD.MM.YYYY, YYYY/MM/DD)
+//	@Param			decimal_separator	formData	string	false	"Decimal separator"	Enums(".", ",")
+//	@Param			payment_method		formData	string	false	"Default payment method for rows without one"
+//	@Param			category_id			formData	string	false	"Default category for rows without one"
+//	@Param			subcategory_id		formData	string	false	"Default subcategory for rows without one"
+//	@Param			card_id				formData	string	false	"Default card for rows without one"
+//	@Param			direction			formData	string	false	"Default direction for rows without one; negative amounts are always expenses"	Enums(INCOME, EXPENSE)
+//	@Param			dry_run				formData	bool	false	"Validate only"
+//	@Param			allow_duplicates	formData	bool	false	"Import rows flagged as duplicates"
+//	@Success		200					{object}	dtos.ImportOutput
+//	@Failure		400					{object}	httperrors.ProblemDetail
+//	@Failure		401					{object}	httperrors.ProblemDetail
+//	@Failure		413					{object}	httperrors.ProblemDetail
+//	@Failure		422					{object}	dtos.ImportOutput
+//	@Failure		500					{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/import [post]
+func (h *TransactionHandler) Import(w http.ResponseWriter, r *http.Request) {
+	h.handleImport(w, r, "import", "import_transactions", func(file multipart.File) (*dtos.ImportInput, error) {
+		options, input, err := parseImportForm(r)
+		if err != nil {
+			return nil, err
+		}
+		input.Rows, err = importers.ParseCSV(file, options)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	})
+}
+
+// ImportOFX godoc
+//
+//	@Summary		Import transactions from an OFX bank statement
+//	@Description	Imports the entries of a checking or savings account statement in OFX (1.x SGML or 2.x XML, UTF-8 or Windows-1252). The direction comes from TRNTYPE (or the sign of TRNAMT) and the payment method is inferred from the description (pix, ted, boleto; card purchases are debit when card_id is given), falling back to payment_method. The category comes from the first matching rule of category_rules, then category_id, then the user's stored categorization rules, then the user's "Uncategorized" category, created on first use. Each entry is identified by its FITID, so importing the same statement again skips the entries already imported, even with allow_duplicates. Credit card statements are rejected. The import is all-or-nothing, like the CSV import.
+//	@Tags			transactions
+//	@Accept			multipart/form-data
+//	@Produce		json
+//	@Security		BearerAuth
+//	@Param			file				formData	file	true	"OFX file (max 5 MB, 5000 entries)"
+//	@Param			payment_method		formData	string	false	"Payment method of the entries whose method cannot be inferred (default ted)"
+//	@Param			card_id				formData	string	false	"Debit card of the account, used for card purchases"
+//	@Param			category_id			formData	string	false	"Category of the entries not matched by a rule"
+//	@Param			subcategory_id		formData	string	false	"Subcategory of the entries not matched by a rule"
+//	@Param			category_rules		formData	string	false	"JSON array of rules, e.g. [{\"contains\":\"uber\",\"category_id\":\"...\"}]"
+//	@Param			dry_run				formData	bool	false	"Validate only"
+//	@Param			allow_duplicates	formData	bool	false	"Import entries matching a transaction entered by hand"
+//	@Success		200					{object}	dtos.ImportOutput
+//	@Failure		400					{object}	httperrors.ProblemDetail
+//	@Failure		401					{object}	httperrors.ProblemDetail
+//	@Failure		413					{object}	httperrors.ProblemDetail
+//	@Failure		422					{object}	dtos.ImportOutput
+//	@Failure		500					{object}	httperrors.ProblemDetail
+//	@Router			/api/v1/transactions/import/ofx [post]
+func (h *TransactionHandler) ImportOFX(w http.ResponseWriter, r *http.Request) {
+	h.handleImport(w, r, "import_ofx", "import_ofx_transactions", func(file multipart.File) (*dtos.ImportInput, error) {
+		options, input, err := parseOFXImportForm(r)
+		if err != nil {
+			return nil, err
+		}
+		input.Rows, err = importers.ParseOFX(file, options)
+		if err != nil {
+			return nil, err
+		}
+		return input, nil
+	})
+}
+
+// handleImport reads the uploaded file, parses it with parse and runs the import. The
+// response is 422 when the import was not a dry run and nothing was committed.
+func (h *TransactionHandler) handleImport(
+	w http.ResponseWriter,
+	r *http.Request,
+	spanName, operation string,
+	parse func(file multipart.File) (*dtos.ImportInput, error),
+) {
+	ctx, span := h.o11y.Tracer().Start(r.Context(), "transaction_handler."+spanName)
+	defer span.End()
+	correlationID := trace.SpanFromContext(ctx).SpanContext().TraceID().String()
+	user, err := middlewares.GetUserFromContext(ctx)
+	if err != nil {
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_received", operation, correlationID, user.ID)
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportFileSize)
+	if err := r.ParseMultipartForm(maxImportFileSize); err != nil {
+		h.errorHandler.HandleError(w, r, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err))
+		return
+	}
+	defer func() { _ = r.MultipartForm.RemoveAll() }()
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		h.errorHandler.HandleError(w, r, transactionDomain.ErrImportFileRequired)
+		return
+	}
+	defer func() { _ = file.Close() }()
+
+	input, err := parse(file)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, operation, correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	output, err := h.importUC.Execute(ctx, user.ID, input)
+	if err != nil {
+		span.RecordError(err)
+		h.logError(ctx, operation, correlationID, user.ID, err)
+		h.errorHandler.HandleError(w, r, err)
+		return
+	}
+	h.logInfo(ctx, "request_completed", operation, correlationID, user.ID)
+
+	status := http.StatusOK
+	if !output.DryRun && !output.Committed {
+		status = http.StatusUnprocessableEntity
+	}
+	responses.JSON(w, status, output)
+}
+
+func parseImportForm(r *http.Request) (dtos.CSVImportOptions, *dtos.ImportInput, error) {
+	options := dtos.CSVImportOptions{
+		Delimiter:        r.FormValue("delimiter"),
+		DateFormat:       r.FormValue("date_format"),
+		DecimalSeparator: r.FormValue("decimal_separator"),
+		Defaults: dtos.TransactionInput{
+			PaymentMethod: r.FormValue("payment_method"),
+			CategoryID:    r.FormValue("category_id"),
+			SubcategoryID: r.FormValue("subcategory_id"),
+			CardID:        r.FormValue("card_id"),
+			Direction:     r.FormValue("direction"),
+		},
+	}
+	if raw := r.FormValue("mapping"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &options.Mapping); err != nil {
+			return options, nil, fmt.Errorf("%w: mapping must be a JSON object", transactionDomain.ErrInvalidImportOptions)
+		}
+	}
+
+	input, err := parseImportFlags(r)
+	return options, input, err
+}
+
+func parseOFXImportForm(r *http.Request) (dtos.OFXImportOptions, *dtos.ImportInput, error) {
+	options := dtos.OFXImportOptions{
+		PaymentMethod: r.FormValue("payment_method"),
+		CardID:        r.FormValue("card_id"),
+		CategoryID:    r.FormValue("category_id"),
+		SubcategoryID: r.FormValue("subcategory_id"),
+	}
+	if raw := r.FormValue("category_rules"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &options.CategoryRules); err != nil {
+			return options, nil, fmt.Errorf("%w: category_rules must be a JSON array", transactionDomain.ErrInvalidImportOptions)
+		}
+		for _, rule := range options.CategoryRules {
+			if strings.TrimSpace(rule.Contains) == "" || rule.CategoryID == "" {
+				return options, nil, fmt.Errorf("%w: every category rule needs contains and category_id", transactionDomain.ErrInvalidImportOptions)
+			}
+		}
+	}
+
+	input, err := parseImportFlags(r)
+	if err != nil {
+		return options, nil, err
+	}
+	input.UncategorizedFallback = true
+	return options, input, nil
+}
+
+func parseImportFlags(r *http.Request) (*dtos.ImportInput, error) {
+	dryRun, err := parseFormBool(r, "dry_run")
+	if err != nil {
+		return nil, err
+	}
+	allowDuplicates, err := parseFormBool(r, "allow_duplicates")
+	if err != nil {
+		return nil, err
+	}
+	return &dtos.ImportInput{DryRun: dryRun, AllowDuplicates: allowDuplicates}, nil
+}
+
+func parseFormBool(r *http.Request, field string) (bool, error) {
+	raw := r.FormValue(field)
+	if raw == "" {
+		return false, nil
+	}
+	value, err := strconv.ParseBool(raw)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s must be a boolean", transactionDomain.ErrInvalidImportOptions, field)
+	}
+	return value, nil
+}
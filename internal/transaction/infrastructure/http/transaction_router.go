@@ -8,21 +8,25 @@ import (
 
 // TransactionRouter registers transaction HTTP routes.
 type TransactionRouter struct {
-	handlers       *TransactionHandler
-	authMiddleware middlewares.Authorization
+	handlers              *TransactionHandler
+	authMiddleware        middlewares.Authorization
+	idempotencyMiddleware middlewares.Idempotency
 }
 
 // NewTransactionRouter creates a new TransactionRouter.
-func NewTransactionRouter(handlers *TransactionHandler, authMiddleware middlewares.Authorization) *TransactionRouter {
-	return &TransactionRouter{handlers: handlers, authMiddleware: authMiddleware}
+func NewTransactionRouter(handlers *TransactionHandler, authMiddleware middlewares.Authorization, idempotencyMiddleware middlewares.Idempotency) *TransactionRouter {
+	return &TransactionRouter{handlers: handlers, authMiddleware: authMiddleware, idempotencyMiddleware: idempotencyMiddleware}
 }
 
 // Register registers routes on the provided chi.Router.
 func (r TransactionRouter) Register(router chi.Router) {
 	router.Group(func(protected chi.Router) {
-		protected.Use(r.authMiddleware.Authorization)
+		protected.Use(r.authMiddleware.Authorization, r.idempotencyMiddleware.Idempotency)
 		protected.Post("/api/v1/transactions", r.handlers.Create)
+		protected.Post("/api/v1/transactions/import", r.handlers.Import)
+		protected.Post("/api/v1/transactions/import/ofx", r.handlers.ImportOFX)
 		protected.Get("/api/v1/transactions", r.handlers.List)
+		protected.Get("/api/v1/transactions/export", r.handlers.Export)
 		protected.Get("/api/v1/transactions/{id}", r.handlers.Get)
 		protected.Put("/api/v1/transactions/{id}", r.handlers.Update)
 		protected.Post("/api/v1/transactions/{id}/reverse", r.handlers.Reverse)
@@ -0,0 +1,234 @@
+package importers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+// dateFormats maps the date formats accepted in the import options to Go layouts.
+var dateFormats = map[string]string{
+	"YYYY-MM-DD": "2006-01-02",
+	"DD/MM/YYYY": "02/01/2006",
+	"MM/DD/YYYY": "01/02/2006",
+	"DD-MM-YYYY": "02-01-2006",
+	"DD.MM.YYYY": "02.01.2006",
+	"YYYY/MM/DD": "2006/01/02",
+}
+
+// csvColumns holds the index of each mapped column; -1 when the file does not have it.
+type csvColumns struct {
+	transactionDate, description, amount                        int
+	direction, paymentMethod, categoryID, subcategoryID, cardID int
+	installments                                                int
+}
+
+// ParseCSV reads a CSV file with a header line into import rows. The file-level problems
+// (unreadable file, missing required columns, invalid options) fail the whole parse;
+// problems of a single row are reported in the row, so a dry run can list all of them.
+//
+// Negative amounts, common in bank exports, are read as expenses even when a default
+// direction is given, so an export can be imported with direction INCOME as default.
+func ParseCSV(r io.Reader, options dtos.CSVImportOptions) ([]*dtos.ImportRow, error) {
+	delimiter, err := parseDelimiter(options.Delimiter)
+	if err != nil {
+		return nil, err
+	}
+	dateFormat := options.DateFormat
+	if dateFormat == "" {
+		dateFormat = "YYYY-MM-DD"
+	}
+	layout, ok := dateFormats[strings.ToUpper(dateFormat)]
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported date_format %q", transactionDomain.ErrInvalidImportOptions, options.DateFormat)
+	}
+	decimalSeparator := options.DecimalSeparator
+	if decimalSeparator == "" {
+		decimalSeparator = "."
+	}
+	if decimalSeparator != "." && decimalSeparator != "," {
+		return nil, fmt.Errorf("%w: decimal_separator must be \".\" or \",\"", transactionDomain.ErrInvalidImportOptions)
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comma = delimiter
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, transactionDomain.ErrImportEmpty
+		}
+		return nil, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err)
+	}
+	columns, err := resolveColumns(header, options.Mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*dtos.ImportRow, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err)
+		}
+		if len(rows) == dtos.MaxImportRows {
+			return nil, fmt.Errorf("%w: maximum is %d", transactionDomain.ErrImportTooManyRows, dtos.MaxImportRows)
+		}
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, parseRecord(line, record, columns, layout, decimalSeparator, options.Defaults))
+	}
+
+	return rows, nil
+}
+
+func parseDelimiter(value string) (rune, error) {
+	switch strings.ToLower(value) {
+	case "", ",":
+		return ',', nil
+	case ";":
+		return ';', nil
+	case "tab", "\t":
+		return '\t', nil
+	default:
+		return 0, fmt.Errorf("%w: unsupported delimiter %q", transactionDomain.ErrInvalidImportOptions, value)
+	}
+}
+
+// resolveColumns finds the mapped headers. Headers are matched case-insensitively and a
+// UTF-8 BOM left by spreadsheet tools is ignored.
+func resolveColumns(header []string, mapping dtos.CSVImportMapping) (*csvColumns, error) {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff")
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	find := func(mapped, field string, required bool) (int, error) {
+		name := mapped
+		if name == "" {
+			name = field
+		}
+		if i, ok := index[strings.ToLower(strings.TrimSpace(name))]; ok {
+			return i, nil
+		}
+		if required || mapped != "" {
+			return -1, fmt.Errorf("%w: column %q for %s not found", transactionDomain.ErrInvalidImportFile, name, field)
+		}
+		return -1, nil
+	}
+
+	var columns csvColumns
+	var err error
+	fields := []struct {
+		target   *int
+		mapped   string
+		field    string
+		required bool
+	}{
+		{&columns.transactionDate, mapping.TransactionDate, "transaction_date", true},
+		{&columns.description, mapping.Description, "description", true},
+		{&columns.amount, mapping.Amount, "amount", true},
+		{&columns.direction, mapping.Direction, "direction", false},
+		{&columns.paymentMethod, mapping.PaymentMethod, "payment_method", false},
+		{&columns.categoryID, mapping.CategoryID, "category_id", false},
+		{&columns.subcategoryID, mapping.SubcategoryID, "subcategory_id", false},
+		{&columns.cardID, mapping.CardID, "card_id", false},
+		{&columns.installments, mapping.Installments, "installments", false},
+	}
+	for _, f := range fields {
+		if *f.target, err = find(f.mapped, f.field, f.required); err != nil {
+			return nil, err
+		}
+	}
+	return &columns, nil
+}
+
+func parseRecord(line int, record []string, columns *csvColumns, layout, decimalSeparator string, defaults dtos.TransactionInput) *dtos.ImportRow {
+	cell := func(i int, fallback string) string {
+		if i < 0 || i >= len(record) || strings.TrimSpace(record[i]) == "" {
+			return fallback
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	row := &dtos.ImportRow{
+		Line: line,
+		Input: dtos.TransactionInput{
+			Description:   cell(columns.description, defaults.Description),
+			Direction:     strings.ToUpper(cell(columns.direction, defaults.Direction)),
+			PaymentMethod: strings.ToLower(cell(columns.paymentMethod, defaults.PaymentMethod)),
+			CategoryID:    cell(columns.categoryID, defaults.CategoryID),
+			SubcategoryID: cell(columns.subcategoryID, defaults.SubcategoryID),
+			CardID:        cell(columns.cardID, defaults.CardID),
+			Installments:  defaults.Installments,
+		},
+	}
+
+	if value := cell(columns.transactionDate, ""); value != "" {
+		date, err := time.Parse(layout, value)
+		if err != nil {
+			row.Errors = append(row.Errors, fmt.Sprintf("transaction_date: %q does not match the date format", value))
+		} else {
+			row.Input.TransactionDate = date.Format("2006-01-02")
+		}
+	}
+
+	if value := cell(columns.amount, ""); value != "" {
+		amount, err := parseAmount(value, decimalSeparator)
+		if err != nil {
+			row.Errors = append(row.Errors, fmt.Sprintf("amount: %q is not a valid number", value))
+		} else {
+			if amount < 0 && cell(columns.direction, "") == "" {
+				row.Input.Direction = transactionVos.DirectionExpense.String()
+			}
+			row.Input.Amount = math.Abs(amount)
+		}
+	}
+
+	if value := cell(columns.installments, ""); value != "" {
+		installments, err := strconv.Atoi(value)
+		if err != nil {
+			row.Errors = append(row.Errors, fmt.Sprintf("installments: %q is not a valid number", value))
+		} else {
+			row.Input.Installments = installments
+		}
+	}
+
+	return row
+}
+
+// parseAmount accepts values such as "1234.56", "-1,234.56", "1.234,56" (decimal comma)
+// and "R$ 10,00", dropping the currency symbol and thousands separators.
+func parseAmount(value, decimalSeparator string) (float64, error) {
+	value = strings.ReplaceAll(value, "R$", "")
+	value = strings.ReplaceAll(value, " ", "")
+	thousandsSeparator := ","
+	if decimalSeparator == "," {
+		thousandsSeparator = "."
+	}
+	value = strings.ReplaceAll(value, thousandsSeparator, "")
+	value = strings.Replace(value, decimalSeparator, ".", 1)
+	amount, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(amount) || math.IsInf(amount, 0) {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	return amount, nil
+}
@@ -0,0 +1,90 @@
+package importers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/importers"
+)
+
+const testCategoryID = "550e8400-e29b-41d4-a716-446655440001"
+
+func TestParseCSV(t *testing.T) {
+	t.Run("should read columns named after the fields", func(t *testing.T) {
+		file := "transaction_date,description,amount,payment_method,category_id\n" +
+			"2026-03-01,Mercado,150.75,pix," + testCategoryID + "\n"
+
+		rows, err := importers.ParseCSV(strings.NewReader(file), dtos.CSVImportOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 1)
+		require.Equal(t, 2, rows[0].Line)
+		require.Empty(t, rows[0].Errors)
+		require.Equal(t, dtos.TransactionInput{
+			Description:     "Mercado",
+			Amount:          150.75,
+			PaymentMethod:   "pix",
+			TransactionDate: "2026-03-01",
+			CategoryID:      testCategoryID,
+		}, rows[0].Input)
+	})
+
+	t.Run("should apply mapping, defaults and bank export formats", func(t *testing.T) {
+		file := "\ufeffData;Histórico;Valor\n" +
+			"01/03/2026;Salário;\"R$ 5.000,00\"\n" +
+			"02/03/2026;Padaria;-12,50\n"
+		options := dtos.CSVImportOptions{
+			Mapping:          dtos.CSVImportMapping{TransactionDate: "Data", Description: "Histórico", Amount: "Valor"},
+			Delimiter:        ";",
+			DateFormat:       "DD/MM/YYYY",
+			DecimalSeparator: ",",
+			Defaults:         dtos.TransactionInput{PaymentMethod: "ted", CategoryID: testCategoryID, Direction: "INCOME"},
+		}
+
+		rows, err := importers.ParseCSV(strings.NewReader(file), options)
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, "2026-03-01", rows[0].Input.TransactionDate)
+		require.Equal(t, 5000.00, rows[0].Input.Amount)
+		require.Equal(t, "INCOME", rows[0].Input.Direction)
+		require.Equal(t, "ted", rows[0].Input.PaymentMethod)
+		require.Equal(t, 12.50, rows[1].Input.Amount)
+		require.Equal(t, "EXPENSE", rows[1].Input.Direction)
+	})
+
+	t.Run("should report unreadable cells in the row", func(t *testing.T) {
+		file := "transaction_date,description,amount\n" +
+			"2026-13-01,Mercado,abc\n"
+
+		rows, err := importers.ParseCSV(strings.NewReader(file), dtos.CSVImportOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, rows[0].Errors, 2)
+	})
+
+	t.Run("should return error when a required column is missing", func(t *testing.T) {
+		file := "date,description,amount\n2026-03-01,Mercado,10\n"
+
+		_, err := importers.ParseCSV(strings.NewReader(file), dtos.CSVImportOptions{})
+
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidImportFile)
+	})
+
+	t.Run("should return error for unsupported options", func(t *testing.T) {
+		_, err := importers.ParseCSV(strings.NewReader(""), dtos.CSVImportOptions{DateFormat: "YYYYMMDD"})
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidImportOptions)
+
+		_, err = importers.ParseCSV(strings.NewReader(""), dtos.CSVImportOptions{Delimiter: "|"})
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidImportOptions)
+	})
+
+	t.Run("should return error for empty file", func(t *testing.T) {
+		_, err := importers.ParseCSV(strings.NewReader(""), dtos.CSVImportOptions{})
+		require.ErrorIs(t, err, transactionDomain.ErrImportEmpty)
+	})
+}
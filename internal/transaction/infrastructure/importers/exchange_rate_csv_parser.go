@@ -0,0 +1,97 @@
+package importers
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+)
+
+// ParseExchangeRatesCSV reads a CSV file with the columns currency, rate and rate_date
+// (YYYY-MM-DD), in any order, separated by commas or semicolons. Rates may use a decimal
+// comma. Unlike transaction imports, a row that cannot be read fails the whole file,
+// reported with its line.
+func ParseExchangeRatesCSV(r io.Reader) ([]dtos.ExchangeRateInput, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err)
+	}
+	reader := csv.NewReader(strings.NewReader(string(content)))
+	reader.Comma = detectDelimiter(string(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, transactionDomain.ErrImportEmpty
+		}
+		return nil, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimPrefix(name, "\ufeff")
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, column := range []string{"currency", "rate", "rate_date"} {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("%w: column %q not found", transactionDomain.ErrInvalidImportFile, column)
+		}
+	}
+
+	rates := make([]dtos.ExchangeRateInput, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err)
+		}
+		if len(rates) == dtos.MaxExchangeRateImportRows {
+			return nil, fmt.Errorf("%w: maximum is %d", transactionDomain.ErrImportTooManyRows, dtos.MaxExchangeRateImportRows)
+		}
+		line, _ := reader.FieldPos(0)
+		cell := func(column string) string {
+			i := index[column]
+			if i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		value := cell("rate")
+		rate, err := strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: line %d: rate %q is not a valid number", transactionDomain.ErrInvalidImportFile, line, value)
+		}
+		input := dtos.ExchangeRateInput{
+			Currency: strings.ToUpper(cell("currency")),
+			Rate:     rate,
+			RateDate: cell("rate_date"),
+		}
+		if err := input.Validate(); err != nil {
+			return nil, fmt.Errorf("%w: line %d: %v", transactionDomain.ErrInvalidImportFile, line, err)
+		}
+		rates = append(rates, input)
+	}
+	if len(rates) == 0 {
+		return nil, transactionDomain.ErrImportEmpty
+	}
+	return rates, nil
+}
+
+// detectDelimiter picks the semicolon when the header uses it, as spreadsheets with a
+// decimal comma export, and the comma otherwise.
+func detectDelimiter(content string) rune {
+	header, _, _ := strings.Cut(content, "\n")
+	if strings.Count(header, ";") > strings.Count(header, ",") {
+		return ';'
+	}
+	return ','
+}
@@ -0,0 +1,63 @@
+package importers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/importers"
+)
+
+func TestParseExchangeRatesCSV(t *testing.T) {
+	t.Run("should read the rates in any column order", func(t *testing.T) {
+		file := "rate_date,currency,rate\n" +
+			"2026-03-10,usd,5.4321\n" +
+			"2026-03-10,EUR,5.9\n"
+
+		rates, err := importers.ParseExchangeRatesCSV(strings.NewReader(file))
+
+		require.NoError(t, err)
+		require.Equal(t, []dtos.ExchangeRateInput{
+			{Currency: "USD", Rate: 5.4321, RateDate: "2026-03-10"},
+			{Currency: "EUR", Rate: 5.9, RateDate: "2026-03-10"},
+		}, rates)
+	})
+
+	t.Run("should read semicolon separated files with decimal comma", func(t *testing.T) {
+		file := "\ufeffCurrency;Rate;Rate_Date\n" +
+			"USD;5,4321;2026-03-11\n"
+
+		rates, err := importers.ParseExchangeRatesCSV(strings.NewReader(file))
+
+		require.NoError(t, err)
+		require.Len(t, rates, 1)
+		require.Equal(t, 5.4321, rates[0].Rate)
+	})
+
+	t.Run("should fail the file with the line of an invalid row", func(t *testing.T) {
+		file := "currency,rate,rate_date\n" +
+			"USD,5.43,2026-03-10\n" +
+			"BRL,1,2026-03-10\n"
+
+		_, err := importers.ParseExchangeRatesCSV(strings.NewReader(file))
+
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidImportFile)
+		require.ErrorContains(t, err, "line 3")
+	})
+
+	t.Run("should return error for a missing column", func(t *testing.T) {
+		_, err := importers.ParseExchangeRatesCSV(strings.NewReader("currency,rate\nUSD,5.43\n"))
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidImportFile)
+	})
+
+	t.Run("should return error for a file without rates", func(t *testing.T) {
+		_, err := importers.ParseExchangeRatesCSV(strings.NewReader("currency,rate,rate_date\n"))
+		require.ErrorIs(t, err, transactionDomain.ErrImportEmpty)
+
+		_, err = importers.ParseExchangeRatesCSV(strings.NewReader(""))
+		require.ErrorIs(t, err, transactionDomain.ErrImportEmpty)
+	})
+}
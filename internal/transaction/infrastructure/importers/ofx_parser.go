@@ -0,0 +1,319 @@
+package importers
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+)
+
+// maxDescriptionLength is the size of the transactions.description column.
+const maxDescriptionLength = 255
+
+// ofxDirections maps the TRNTYPE of an entry to the transaction direction. Types not
+// listed here (XFER, OTHER) follow the sign of TRNAMT.
+var ofxDirections = map[string]string{
+	"CREDIT":      transactionVos.DirectionIncome.String(),
+	"DEP":         transactionVos.DirectionIncome.String(),
+	"INT":         transactionVos.DirectionIncome.String(),
+	"DIV":         transactionVos.DirectionIncome.String(),
+	"DIRECTDEP":   transactionVos.DirectionIncome.String(),
+	"DEBIT":       transactionVos.DirectionExpense.String(),
+	"FEE":         transactionVos.DirectionExpense.String(),
+	"SRVCHG":      transactionVos.DirectionExpense.String(),
+	"ATM":         transactionVos.DirectionExpense.String(),
+	"POS":         transactionVos.DirectionExpense.String(),
+	"PAYMENT":     transactionVos.DirectionExpense.String(),
+	"CHECK":       transactionVos.DirectionExpense.String(),
+	"CASH":        transactionVos.DirectionExpense.String(),
+	"DIRECTDEBIT": transactionVos.DirectionExpense.String(),
+	"REPEATPMT":   transactionVos.DirectionExpense.String(),
+}
+
+// ofxPaymentMethods infers the payment method from the description, checked in order.
+var ofxPaymentMethods = []struct {
+	method   string
+	keywords []string
+}{
+	{transactionVos.PaymentMethodPix, []string{"PIX"}},
+	{transactionVos.PaymentMethodTed, []string{"TED", "DOC", "TRANSF"}},
+	{transactionVos.PaymentMethodBoleto, []string{"BOLETO", "PAGTO TITULO", "COBRANCA"}},
+	{transactionVos.PaymentMethodDebit, []string{"COMPRA", "CARTAO", "POS", "SAQUE"}},
+}
+
+// ofxEntry holds the elements of one STMTTRN aggregate.
+type ofxEntry struct {
+	line   int
+	fields map[string]string
+}
+
+// ParseOFX reads the entries of a bank statement in OFX format into import rows. Both
+// OFX 1.x (SGML, where elements usually have no closing tag) and OFX 2.x (XML) files are
+// accepted, in UTF-8 or in the Windows-1252 charset still used by most Brazilian banks.
+//
+// Each entry keeps its FITID as external id ("ofx:<ACCTID>:<FITID>"), so importing the
+// same statement again skips the entries already imported. Credit card statements are
+// rejected: card purchases are billed through invoices and must be entered as credit.
+func ParseOFX(r io.Reader, options dtos.OFXImportOptions) ([]*dtos.ImportRow, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err)
+	}
+	if len(strings.TrimSpace(string(content))) == 0 {
+		return nil, transactionDomain.ErrImportEmpty
+	}
+
+	text, err := decodeOFX(content)
+	if err != nil {
+		return nil, err
+	}
+	start := strings.Index(strings.ToUpper(text), "<OFX>")
+	if start < 0 {
+		return nil, fmt.Errorf("%w: missing <OFX> element", transactionDomain.ErrInvalidImportFile)
+	}
+
+	accountID, entries, err := scanOFX(text, start)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, transactionDomain.ErrImportEmpty
+	}
+	if len(entries) > dtos.MaxImportRows {
+		return nil, fmt.Errorf("%w: maximum is %d", transactionDomain.ErrImportTooManyRows, dtos.MaxImportRows)
+	}
+
+	defaultMethod := strings.ToLower(strings.TrimSpace(options.PaymentMethod))
+	if defaultMethod == "" {
+		defaultMethod = transactionVos.PaymentMethodTed
+	}
+	rules := normalizeRules(options.CategoryRules)
+
+	rows := make([]*dtos.ImportRow, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, parseEntry(entry, accountID, defaultMethod, rules, options))
+	}
+	return rows, nil
+}
+
+// decodeOFX converts the file to UTF-8. The charset comes from the SGML header
+// (CHARSET:1252) or the XML declaration; files that are not valid UTF-8 are read as
+// Windows-1252, which also covers ISO-8859-1.
+func decodeOFX(content []byte) (string, error) {
+	header := strings.ToUpper(string(content[:min(len(content), 512)]))
+	latin := strings.Contains(header, "CHARSET:1252") ||
+		strings.Contains(header, "CHARSET:8859-1") ||
+		strings.Contains(header, "CHARSET:ISO-8859-1") ||
+		strings.Contains(header, "WINDOWS-1252") ||
+		strings.Contains(header, "ISO-8859-1")
+	if !latin && utf8.Valid(content) {
+		return strings.TrimPrefix(string(content), "\ufeff"), nil
+	}
+
+	decoded, err := charmap.Windows1252.NewDecoder().Bytes(content)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", transactionDomain.ErrInvalidImportFile, err)
+	}
+	return string(decoded), nil
+}
+
+// scanOFX walks the tags of the file, collecting the bank account id and the STMTTRN
+// aggregates. It does not validate the structure: unknown and unclosed elements are
+// ignored, which keeps it tolerant to the many dialects exported by banks.
+func scanOFX(text string, start int) (string, []*ofxEntry, error) {
+	var (
+		accountID string
+		inAccount bool
+		current   *ofxEntry
+		entries   []*ofxEntry
+	)
+
+	pos := start
+	for {
+		open := strings.IndexByte(text[pos:], '<')
+		if open < 0 {
+			break
+		}
+		open += pos
+		end := strings.IndexByte(text[open:], '>')
+		if end < 0 {
+			break
+		}
+		end += open
+		tag := strings.ToUpper(strings.TrimSpace(text[open+1 : end]))
+		next := strings.IndexByte(text[end+1:], '<')
+		if next < 0 {
+			next = len(text)
+		} else {
+			next += end + 1
+		}
+		value := strings.TrimSpace(html.UnescapeString(text[end+1 : next]))
+		pos = next
+
+		switch tag {
+		case "CCACCTFROM", "CCSTMTRS":
+			return "", nil, fmt.Errorf("%w: credit card statements are not supported, import the card purchases as credit transactions", transactionDomain.ErrInvalidImportFile)
+		case "BANKACCTFROM":
+			inAccount = true
+		case "/BANKACCTFROM":
+			inAccount = false
+		case "ACCTID":
+			if inAccount && accountID == "" {
+				accountID = value
+			}
+		case "STMTTRN":
+			if current != nil {
+				entries = append(entries, current)
+			}
+			current = &ofxEntry{line: strings.Count(text[:open], "\n") + 1, fields: make(map[string]string)}
+		case "/STMTTRN", "/BANKTRANLIST":
+			if current != nil {
+				entries = append(entries, current)
+				current = nil
+			}
+		default:
+			if current != nil && !strings.HasPrefix(tag, "/") && value != "" {
+				current.fields[tag] = value
+			}
+		}
+	}
+	if current != nil {
+		entries = append(entries, current)
+	}
+	return accountID, entries, nil
+}
+
+func parseEntry(entry *ofxEntry, accountID, defaultMethod string, rules []dtos.CategoryRule, options dtos.OFXImportOptions) *dtos.ImportRow {
+	fields := entry.fields
+	description := entryDescription(fields["NAME"], fields["MEMO"])
+	row := &dtos.ImportRow{
+		Line: entry.line,
+		Input: dtos.TransactionInput{
+			Description:   description,
+			CategoryID:    options.CategoryID,
+			SubcategoryID: options.SubcategoryID,
+		},
+	}
+
+	if fitID := fields["FITID"]; fitID != "" {
+		row.Input.ExternalID = fmt.Sprintf("ofx:%s:%s", accountID, fitID)
+	}
+
+	posted := fields["DTPOSTED"]
+	if len(posted) < 8 {
+		row.Errors = append(row.Errors, fmt.Sprintf("DTPOSTED: %q is not a valid date", posted))
+	} else if date, err := time.Parse("20060102", posted[:8]); err != nil {
+		row.Errors = append(row.Errors, fmt.Sprintf("DTPOSTED: %q is not a valid date", posted))
+	} else {
+		row.Input.TransactionDate = date.Format("2006-01-02")
+	}
+
+	value := fields["TRNAMT"]
+	decimalSeparator := "."
+	if strings.Contains(value, ",") {
+		decimalSeparator = ","
+	}
+	amount, err := parseAmount(value, decimalSeparator)
+	if err != nil || value == "" {
+		row.Errors = append(row.Errors, fmt.Sprintf("TRNAMT: %q is not a valid number", value))
+	} else {
+		row.Input.Amount = math.Abs(amount)
+		direction, ok := ofxDirections[strings.ToUpper(fields["TRNTYPE"])]
+		if !ok {
+			direction = transactionVos.DirectionIncome.String()
+			if amount < 0 {
+				direction = transactionVos.DirectionExpense.String()
+			}
+		}
+		row.Input.Direction = direction
+	}
+
+	normalized := normalizeText(description)
+	row.Input.PaymentMethod = inferPaymentMethod(normalized, defaultMethod, options.CardID != "")
+	if row.Input.PaymentMethod == transactionVos.PaymentMethodDebit {
+		row.Input.CardID = options.CardID
+	}
+	for _, rule := range rules {
+		if strings.Contains(normalized, rule.Contains) {
+			row.Input.CategoryID = rule.CategoryID
+			row.Input.SubcategoryID = rule.SubcategoryID
+			break
+		}
+	}
+
+	return row
+}
+
+// entryDescription joins NAME and MEMO, since banks split the payee and the details
+// between them differently, dropping one when it repeats the other.
+func entryDescription(name, memo string) string {
+	name = strings.Join(strings.Fields(name), " ")
+	memo = strings.Join(strings.Fields(memo), " ")
+	description := memo
+	switch {
+	case name == "" || strings.Contains(strings.ToUpper(memo), strings.ToUpper(name)):
+	case memo == "" || strings.Contains(strings.ToUpper(name), strings.ToUpper(memo)):
+		description = name
+	default:
+		description = name + " - " + memo
+	}
+	if utf8.RuneCountInString(description) > maxDescriptionLength {
+		description = string([]rune(description)[:maxDescriptionLength])
+	}
+	return description
+}
+
+// inferPaymentMethod looks for keywords of each method in the normalized description.
+// Card purchases are only read as debit when the import names the debit card.
+func inferPaymentMethod(normalized, defaultMethod string, hasCard bool) string {
+	words := strings.FieldsFunc(normalized, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	padded := " " + strings.Join(words, " ") + " "
+	for _, candidate := range ofxPaymentMethods {
+		if candidate.method == transactionVos.PaymentMethodDebit && !hasCard {
+			continue
+		}
+		for _, keyword := range candidate.keywords {
+			if strings.Contains(padded, " "+keyword+" ") || (len(keyword) > 3 && strings.Contains(padded, " "+keyword)) {
+				return candidate.method
+			}
+		}
+	}
+	return defaultMethod
+}
+
+func normalizeRules(rules []dtos.CategoryRule) []dtos.CategoryRule {
+	normalized := make([]dtos.CategoryRule, 0, len(rules))
+	for _, rule := range rules {
+		contains := normalizeText(rule.Contains)
+		if contains == "" {
+			continue
+		}
+		rule.Contains = contains
+		normalized = append(normalized, rule)
+	}
+	return normalized
+}
+
+// normalizeText upper-cases the text and strips its accents, so "Cartão" matches "CARTAO".
+func normalizeText(value string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC), value)
+	if err != nil {
+		stripped = value
+	}
+	return strings.ToUpper(strings.Join(strings.Fields(stripped), " "))
+}
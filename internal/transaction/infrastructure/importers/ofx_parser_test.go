@@ -0,0 +1,162 @@
+package importers_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/dtos"
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
+	"github.com/jailtonjunior94/financial/internal/transaction/infrastructure/importers"
+)
+
+const (
+	testCardID          = "550e8400-e29b-41d4-a716-446655440010"
+	testRuleCategoryID  = "550e8400-e29b-41d4-a716-446655440002"
+	testRuleSubcategory = "550e8400-e29b-41d4-a716-446655440003"
+)
+
+func parseOFXFixture(t *testing.T, name string, options dtos.OFXImportOptions) ([]*dtos.ImportRow, error) {
+	t.Helper()
+	file, err := os.Open(filepath.Join("testdata", "ofx", name))
+	require.NoError(t, err)
+	defer func() { _ = file.Close() }()
+	return importers.ParseOFX(file, options)
+}
+
+func TestParseOFX(t *testing.T) {
+	t.Run("should read a Windows-1252 SGML statement without closing tags", func(t *testing.T) {
+		rows, err := parseOFXFixture(t, "itau.ofx", dtos.OFXImportOptions{CategoryID: testCategoryID})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 3)
+		require.Empty(t, rows[0].Errors)
+		require.Equal(t, dtos.TransactionInput{
+			Description:     "PIX TRANSF JOÃO SÁ",
+			Amount:          45.90,
+			Direction:       "EXPENSE",
+			PaymentMethod:   "pix",
+			TransactionDate: "2026-03-02",
+			CategoryID:      testCategoryID,
+			ExternalID:      "ofx:12345-6:20260302001",
+		}, rows[0].Input)
+		require.Equal(t, "INCOME", rows[1].Input.Direction)
+		require.Equal(t, 5000.00, rows[1].Input.Amount)
+		require.Equal(t, "ted", rows[2].Input.PaymentMethod)
+		require.Empty(t, rows[2].Input.CardID)
+	})
+
+	t.Run("should read card purchases as debit when the card is given", func(t *testing.T) {
+		rows, err := parseOFXFixture(t, "itau.ofx", dtos.OFXImportOptions{CategoryID: testCategoryID, CardID: testCardID})
+
+		require.NoError(t, err)
+		require.Equal(t, "pix", rows[0].Input.PaymentMethod)
+		require.Empty(t, rows[0].Input.CardID)
+		require.Equal(t, "debit", rows[2].Input.PaymentMethod)
+		require.Equal(t, testCardID, rows[2].Input.CardID)
+	})
+
+	t.Run("should read a SGML statement with closing tags", func(t *testing.T) {
+		rows, err := parseOFXFixture(t, "bradesco.ofx", dtos.OFXImportOptions{PaymentMethod: "pix"})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, "boleto", rows[0].Input.PaymentMethod)
+		require.Equal(t, "EXPENSE", rows[0].Input.Direction)
+		require.Equal(t, "ted", rows[1].Input.PaymentMethod)
+		require.Equal(t, "INCOME", rows[1].Input.Direction)
+		require.Equal(t, "ofx:9876543:000002", rows[1].Input.ExternalID)
+	})
+
+	t.Run("should join NAME and MEMO unless one repeats the other", func(t *testing.T) {
+		rows, err := parseOFXFixture(t, "bb.ofx", dtos.OFXImportOptions{})
+
+		require.NoError(t, err)
+		require.Equal(t, "Pix - Enviado - 12/03 10:22 Maria Silva", rows[0].Input.Description)
+		require.Equal(t, "pix", rows[0].Input.PaymentMethod)
+		require.Equal(t, "Tarifa Pacote", rows[1].Input.Description)
+		require.Equal(t, "2026-03-12", rows[0].Input.TransactionDate)
+	})
+
+	t.Run("should keep equal entries apart by their FITID", func(t *testing.T) {
+		rows, err := parseOFXFixture(t, "nubank.ofx", dtos.OFXImportOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, rows[0].Input.Description, rows[1].Input.Description)
+		require.NotEqual(t, rows[0].Input.ExternalID, rows[1].Input.ExternalID)
+		require.Equal(t, "pix", rows[0].Input.PaymentMethod)
+	})
+
+	t.Run("should read an XML statement with decimal comma", func(t *testing.T) {
+		rows, err := parseOFXFixture(t, "inter.ofx", dtos.OFXImportOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, 1234.56, rows[0].Input.Amount)
+		require.Equal(t, "EXPENSE", rows[0].Input.Direction)
+		require.Equal(t, "Boleto & Cia - Pagamento de boleto", rows[0].Input.Description)
+		require.Equal(t, "boleto", rows[0].Input.PaymentMethod)
+		require.Equal(t, 3.21, rows[1].Input.Amount)
+		require.Equal(t, "INCOME", rows[1].Input.Direction)
+	})
+
+	t.Run("should read a statement in a single line", func(t *testing.T) {
+		rows, err := parseOFXFixture(t, "santander.ofx", dtos.OFXImportOptions{PaymentMethod: "pix"})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Equal(t, "SAQUE 24H", rows[0].Input.Description)
+		require.Equal(t, "pix", rows[0].Input.PaymentMethod)
+		require.Equal(t, "ted", rows[1].Input.PaymentMethod)
+		require.Equal(t, "ofx:01020304:S2", rows[1].Input.ExternalID)
+	})
+
+	t.Run("should report unreadable entries in the row", func(t *testing.T) {
+		rows, err := parseOFXFixture(t, "caixa.ofx", dtos.OFXImportOptions{})
+
+		require.NoError(t, err)
+		require.Len(t, rows, 2)
+		require.Len(t, rows[0].Errors, 1)
+		require.Len(t, rows[1].Errors, 1)
+		require.Empty(t, rows[1].Input.ExternalID)
+	})
+
+	t.Run("should apply the first matching category rule ignoring case and accents", func(t *testing.T) {
+		options := dtos.OFXImportOptions{
+			CategoryID: testCategoryID,
+			CategoryRules: []dtos.CategoryRule{
+				{Contains: "  "},
+				{Contains: "cafe sao", CategoryID: testRuleCategoryID, SubcategoryID: testRuleSubcategory},
+				{Contains: "pix", CategoryID: testCategoryID},
+			},
+		}
+
+		rows, err := parseOFXFixture(t, "nubank.ofx", options)
+
+		require.NoError(t, err)
+		require.Equal(t, testRuleCategoryID, rows[0].Input.CategoryID)
+		require.Equal(t, testRuleSubcategory, rows[0].Input.SubcategoryID)
+	})
+
+	t.Run("should reject credit card statements", func(t *testing.T) {
+		_, err := parseOFXFixture(t, "credit_card.ofx", dtos.OFXImportOptions{})
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidImportFile)
+	})
+
+	t.Run("should return error for files that are not OFX", func(t *testing.T) {
+		_, err := importers.ParseOFX(strings.NewReader("date,amount\n2026-03-01,10\n"), dtos.OFXImportOptions{})
+		require.ErrorIs(t, err, transactionDomain.ErrInvalidImportFile)
+	})
+
+	t.Run("should return error for empty file or statement", func(t *testing.T) {
+		_, err := importers.ParseOFX(strings.NewReader(""), dtos.OFXImportOptions{})
+		require.ErrorIs(t, err, transactionDomain.ErrImportEmpty)
+
+		_, err = importers.ParseOFX(strings.NewReader("<OFX><BANKTRANLIST></BANKTRANLIST></OFX>"), dtos.OFXImportOptions{})
+		require.ErrorIs(t, err, transactionDomain.ErrImportEmpty)
+	})
+}
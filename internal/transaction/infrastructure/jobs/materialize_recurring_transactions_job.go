@@ -0,0 +1,70 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	pkgjobs "github.com/jailtonjunior94/financial/pkg/jobs"
+)
+
+// MaterializeRecurringTransactionsJob implementa jobs.Job para gerar as ocorrências
+// vencidas das transações recorrentes.
+type MaterializeRecurringTransactionsJob struct {
+	useCase  usecase.MaterializeRecurringTransactionsUseCase
+	schedule string
+	o11y     observability.Observability
+}
+
+// NewMaterializeRecurringTransactionsJob cria um novo job de materialização de recorrências.
+func NewMaterializeRecurringTransactionsJob(
+	useCase usecase.MaterializeRecurringTransactionsUseCase,
+	schedule string,
+	o11y observability.Observability,
+) pkgjobs.Job {
+	return &MaterializeRecurringTransactionsJob{
+		useCase:  useCase,
+		schedule: schedule,
+		o11y:     o11y,
+	}
+}
+
+// Name retorna o identificador do job.
+func (j *MaterializeRecurringTransactionsJob) Name() string {
+	return "recurring_transactions_materialization"
+}
+
+// Schedule retorna a expressão cron para agendamento.
+// Padrão: "0 2 * * *" - executa diariamente às 2h, após o fechamento de faturas.
+func (j *MaterializeRecurringTransactionsJob) Schedule() string {
+	if j.schedule != "" {
+		return j.schedule
+	}
+	return "0 2 * * *"
+}
+
+// Run cria as transações das ocorrências com data até hoje, recuperando as perdidas.
+func (j *MaterializeRecurringTransactionsJob) Run(ctx context.Context) error {
+	ctx, span := j.o11y.Tracer().Start(ctx, "transaction.materialize_recurring_transactions_job.run")
+	defer span.End()
+
+	materialized, err := j.useCase.Execute(ctx, time.Now().UTC())
+	if err != nil {
+		j.o11y.Logger().Error(ctx, "recurring transactions materialization job failed",
+			observability.Error(err),
+			observability.Int("materialized", materialized),
+		)
+		return fmt.Errorf("recurring transactions materialization job: %w", err)
+	}
+
+	if materialized > 0 {
+		j.o11y.Logger().Info(ctx, "recurring transactions materialization job completed",
+			observability.Int("materialized", materialized),
+		)
+	}
+
+	return nil
+}
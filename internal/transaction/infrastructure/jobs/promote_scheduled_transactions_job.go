@@ -0,0 +1,71 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/application/usecase"
+	pkgjobs "github.com/jailtonjunior94/financial/pkg/jobs"
+)
+
+// PromoteScheduledTransactionsJob implementa jobs.Job para ativar as transações
+// agendadas que chegaram à sua data.
+type PromoteScheduledTransactionsJob struct {
+	useCase  usecase.PromoteScheduledTransactionsUseCase
+	schedule string
+	o11y     observability.Observability
+}
+
+// NewPromoteScheduledTransactionsJob cria um novo job de promoção de transações agendadas.
+func NewPromoteScheduledTransactionsJob(
+	useCase usecase.PromoteScheduledTransactionsUseCase,
+	schedule string,
+	o11y observability.Observability,
+) pkgjobs.Job {
+	return &PromoteScheduledTransactionsJob{
+		useCase:  useCase,
+		schedule: schedule,
+		o11y:     o11y,
+	}
+}
+
+// Name retorna o identificador do job.
+func (j *PromoteScheduledTransactionsJob) Name() string {
+	return "scheduled_transactions_promotion"
+}
+
+// Schedule retorna a expressão cron para agendamento.
+// Padrão: "0 3 * * *" - executa diariamente às 3h, após a materialização de recorrências.
+func (j *PromoteScheduledTransactionsJob) Schedule() string {
+	if j.schedule != "" {
+		return j.schedule
+	}
+	return "0 3 * * *"
+}
+
+// Run ativa as transações agendadas com data até hoje, recuperando as perdidas,
+// e emite o transaction.created de cada uma para o orçamento passar a contá-las.
+func (j *PromoteScheduledTransactionsJob) Run(ctx context.Context) error {
+	ctx, span := j.o11y.Tracer().Start(ctx, "transaction.promote_scheduled_transactions_job.run")
+	defer span.End()
+
+	promoted, err := j.useCase.Execute(ctx, time.Now().UTC())
+	if err != nil {
+		j.o11y.Logger().Error(ctx, "scheduled transactions promotion job failed",
+			observability.Error(err),
+			observability.Int("promoted", promoted),
+		)
+		return fmt.Errorf("scheduled transactions promotion job: %w", err)
+	}
+
+	if promoted > 0 {
+		j.o11y.Logger().Info(ctx, "scheduled transactions promotion job completed",
+			observability.Int("promoted", promoted),
+		)
+	}
+
+	return nil
+}
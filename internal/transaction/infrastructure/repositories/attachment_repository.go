@@ -0,0 +1,244 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+const attachmentColumns = `id, user_id, owner_type, owner_id, file_name, content_type, size_bytes, sha256, created_at, deleted_at`
+
+type attachmentRepository struct {
+	db   database.DBTX
+	o11y observability.Observability
+	tm   *metrics.TransactionMetrics
+}
+
+// NewAttachmentRepository creates a new AttachmentRepository.
+func NewAttachmentRepository(db database.DBTX, o11y observability.Observability, tm *metrics.TransactionMetrics) interfaces.AttachmentRepository {
+	return &attachmentRepository{db: db, o11y: o11y, tm: tm}
+}
+
+func (r *attachmentRepository) Save(ctx context.Context, tx database.DBTX, attachment *entities.Attachment) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "attachment_repository.save")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		INSERT INTO attachments (%s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		attachmentColumns)
+
+	_, err := tx.ExecContext(ctx, query,
+		attachment.ID.Value,
+		attachment.UserID.Value,
+		string(attachment.OwnerType),
+		attachment.OwnerID.Value,
+		attachment.FileName,
+		attachment.ContentType,
+		attachment.Size,
+		attachment.SHA256,
+		attachment.CreatedAt,
+		attachment.DeletedAt,
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "save", err)
+		r.tm.RecordRepositoryFailure(ctx, "save", "attachment", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "save", "attachment", time.Since(start))
+	return nil
+}
+
+func (r *attachmentRepository) Delete(ctx context.Context, tx database.DBTX, attachment *entities.Attachment) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "attachment_repository.delete")
+	defer span.End()
+
+	query := `UPDATE attachments SET deleted_at = $2 WHERE id = $1 AND deleted_at IS NULL`
+
+	if _, err := tx.ExecContext(ctx, query, attachment.ID.Value, attachment.DeletedAt); err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "delete", err)
+		r.tm.RecordRepositoryFailure(ctx, "delete", "attachment", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "delete", "attachment", time.Since(start))
+	return nil
+}
+
+func (r *attachmentRepository) UpdateOwner(ctx context.Context, tx database.DBTX, attachment *entities.Attachment) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "attachment_repository.update_owner")
+	defer span.End()
+
+	query := `UPDATE attachments SET owner_id = $2 WHERE id = $1 AND deleted_at IS NULL`
+
+	if _, err := tx.ExecContext(ctx, query, attachment.ID.Value, attachment.OwnerID.Value); err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "update_owner", err)
+		r.tm.RecordRepositoryFailure(ctx, "update_owner", "attachment", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "update_owner", "attachment", time.Since(start))
+	return nil
+}
+
+func (r *attachmentRepository) FindByID(ctx context.Context, id vos.UUID) (*entities.Attachment, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM attachments
+		WHERE id = $1 AND deleted_at IS NULL`,
+		attachmentColumns)
+
+	return r.find(ctx, "find_by_id", query, id.Value)
+}
+
+func (r *attachmentRepository) FindByOwnerAndHash(
+	ctx context.Context,
+	ownerType entities.AttachmentOwnerType,
+	ownerID vos.UUID,
+	sha256 string,
+) (*entities.Attachment, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM attachments
+		WHERE owner_type = $1 AND owner_id = $2 AND sha256 = $3 AND deleted_at IS NULL`,
+		attachmentColumns)
+
+	return r.find(ctx, "find_by_owner_and_hash", query, string(ownerType), ownerID.Value, sha256)
+}
+
+func (r *attachmentRepository) ListByOwner(ctx context.Context, ownerType entities.AttachmentOwnerType, ownerID vos.UUID) ([]*entities.Attachment, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "attachment_repository.list_by_owner")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM attachments
+		WHERE owner_type = $1 AND owner_id = $2 AND deleted_at IS NULL
+		ORDER BY created_at ASC, id ASC`,
+		attachmentColumns)
+
+	rows, err := r.db.QueryContext(ctx, query, string(ownerType), ownerID.Value)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "list_by_owner", err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_owner", "attachment", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "AttachmentRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	attachments := make([]*entities.Attachment, 0)
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.logFailure(ctx, "list_by_owner", err)
+			r.tm.RecordRepositoryFailure(ctx, "list_by_owner", "attachment", "infra", time.Since(start))
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_owner", "attachment", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "list_by_owner", "attachment", time.Since(start))
+	return attachments, nil
+}
+
+func (r *attachmentRepository) CountByHash(ctx context.Context, sha256 string) (int, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "attachment_repository.count_by_hash")
+	defer span.End()
+
+	query := `SELECT COUNT(*) FROM attachments WHERE sha256 = $1 AND deleted_at IS NULL`
+
+	var count int
+	if err := r.db.QueryRowContext(ctx, query, sha256).Scan(&count); err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "count_by_hash", err)
+		r.tm.RecordRepositoryFailure(ctx, "count_by_hash", "attachment", "infra", time.Since(start))
+		return 0, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "count_by_hash", "attachment", time.Since(start))
+	return count, nil
+}
+
+func (r *attachmentRepository) find(ctx context.Context, operation, query string, args ...any) (*entities.Attachment, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "attachment_repository."+operation)
+	defer span.End()
+
+	attachment, err := scanAttachment(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.tm.RecordRepositoryQuery(ctx, operation, "attachment", time.Since(start))
+			return nil, nil
+		}
+		span.RecordError(err)
+		r.logFailure(ctx, operation, err)
+		r.tm.RecordRepositoryFailure(ctx, operation, "attachment", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, operation, "attachment", time.Since(start))
+	return attachment, nil
+}
+
+func (r *attachmentRepository) logFailure(ctx context.Context, operation string, err error) {
+	r.o11y.Logger().Error(ctx, "query_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "repository"),
+		observability.String("entity", "attachment"),
+		observability.Error(err),
+	)
+}
+
+func scanAttachment(s transactionScanner) (*entities.Attachment, error) {
+	var attachment entities.Attachment
+	var ownerType string
+	if err := s.Scan(
+		&attachment.ID.Value,
+		&attachment.UserID.Value,
+		&ownerType,
+		&attachment.OwnerID.Value,
+		&attachment.FileName,
+		&attachment.ContentType,
+		&attachment.Size,
+		&attachment.SHA256,
+		&attachment.CreatedAt,
+		&attachment.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+	attachment.OwnerType = entities.AttachmentOwnerType(ownerType)
+	return &attachment, nil
+}
@@ -0,0 +1,352 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+const categorizationRuleColumns = `id, user_id, name, priority, enabled, description_contains, description_regex,
+		min_amount, max_amount, payment_method, card_id, category_id, subcategory_id,
+		created_at, updated_at, deleted_at`
+
+type categorizationRuleRepository struct {
+	db   database.DBTX
+	o11y observability.Observability
+	tm   *metrics.TransactionMetrics
+}
+
+// NewCategorizationRuleRepository creates a new CategorizationRuleRepository.
+func NewCategorizationRuleRepository(db database.DBTX, o11y observability.Observability, tm *metrics.TransactionMetrics) interfaces.CategorizationRuleRepository {
+	return &categorizationRuleRepository{db: db, o11y: o11y, tm: tm}
+}
+
+func (r *categorizationRuleRepository) Save(ctx context.Context, tx database.DBTX, rule *entities.CategorizationRule) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "categorization_rule_repository.save")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		INSERT INTO categorization_rules (%s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`,
+		categorizationRuleColumns)
+
+	c := rule.Conditions
+	_, err := tx.ExecContext(ctx, query,
+		rule.ID.Value,
+		rule.UserID.Value,
+		rule.Name,
+		rule.Priority,
+		rule.Enabled,
+		optionalText(c.DescriptionContains),
+		optionalText(c.DescriptionRegex),
+		optionalAmount(c.MinAmount),
+		optionalAmount(c.MaxAmount),
+		optionalText(c.PaymentMethod),
+		optionalUUID(c.CardID),
+		rule.Outcome.CategoryID.Value,
+		optionalUUID(rule.Outcome.SubcategoryID),
+		rule.CreatedAt,
+		rule.UpdatedAt,
+		rule.DeletedAt,
+	)
+	if err == nil {
+		err = r.saveTags(ctx, tx, rule)
+	}
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "save", err)
+		r.tm.RecordRepositoryFailure(ctx, "save", "categorization_rule", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "save", "categorization_rule", time.Since(start))
+	return nil
+}
+
+func (r *categorizationRuleRepository) Update(ctx context.Context, tx database.DBTX, rule *entities.CategorizationRule) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "categorization_rule_repository.update")
+	defer span.End()
+
+	query := `
+		UPDATE categorization_rules SET
+			name = $2,
+			priority = $3,
+			enabled = $4,
+			description_contains = $5,
+			description_regex = $6,
+			min_amount = $7,
+			max_amount = $8,
+			payment_method = $9,
+			card_id = $10,
+			category_id = $11,
+			subcategory_id = $12,
+			updated_at = $13,
+			deleted_at = $14
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	c := rule.Conditions
+	_, err := tx.ExecContext(ctx, query,
+		rule.ID.Value,
+		rule.Name,
+		rule.Priority,
+		rule.Enabled,
+		optionalText(c.DescriptionContains),
+		optionalText(c.DescriptionRegex),
+		optionalAmount(c.MinAmount),
+		optionalAmount(c.MaxAmount),
+		optionalText(c.PaymentMethod),
+		optionalUUID(c.CardID),
+		rule.Outcome.CategoryID.Value,
+		optionalUUID(rule.Outcome.SubcategoryID),
+		rule.UpdatedAt,
+		rule.DeletedAt,
+	)
+	if err == nil {
+		_, err = tx.ExecContext(ctx, `DELETE FROM categorization_rule_tags WHERE rule_id = $1`, rule.ID.Value)
+	}
+	if err == nil && rule.DeletedAt == nil {
+		err = r.saveTags(ctx, tx, rule)
+	}
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "update", err)
+		r.tm.RecordRepositoryFailure(ctx, "update", "categorization_rule", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "update", "categorization_rule", time.Since(start))
+	return nil
+}
+
+func (r *categorizationRuleRepository) FindByID(ctx context.Context, id vos.UUID) (*entities.CategorizationRule, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM categorization_rules
+		WHERE id = $1 AND deleted_at IS NULL`,
+		categorizationRuleColumns)
+
+	rules, err := r.list(ctx, "find_by_id", query, id.Value)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+	return rules[0], nil
+}
+
+func (r *categorizationRuleRepository) ListByUser(ctx context.Context, userID vos.UUID) ([]*entities.CategorizationRule, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM categorization_rules
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY priority ASC, created_at ASC, id ASC`,
+		categorizationRuleColumns)
+
+	return r.list(ctx, "list_by_user", query, userID.Value)
+}
+
+// saveTags links the tags of a rule in a single statement.
+func (r *categorizationRuleRepository) saveTags(ctx context.Context, tx database.DBTX, rule *entities.CategorizationRule) error {
+	if len(rule.Outcome.TagIDs) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(rule.Outcome.TagIDs))
+	args := make([]any, 0, len(rule.Outcome.TagIDs)+1)
+	args = append(args, rule.ID.Value)
+	for _, tagID := range rule.Outcome.TagIDs {
+		args = append(args, tagID.Value)
+		values = append(values, fmt.Sprintf("($1, $%d)", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO categorization_rule_tags (rule_id, tag_id)
+		VALUES %s`, strings.Join(values, ", "))
+
+	_, err := tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *categorizationRuleRepository) list(ctx context.Context, operation, query string, args ...any) ([]*entities.CategorizationRule, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "categorization_rule_repository."+operation)
+	defer span.End()
+
+	rules, err := r.query(ctx, query, args...)
+	if err == nil {
+		err = r.loadTags(ctx, rules)
+	}
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, operation, err)
+		r.tm.RecordRepositoryFailure(ctx, operation, "categorization_rule", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, operation, "categorization_rule", time.Since(start))
+	return rules, nil
+}
+
+func (r *categorizationRuleRepository) query(ctx context.Context, query string, args ...any) ([]*entities.CategorizationRule, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.o11y.Logger().Error(ctx, "CategorizationRuleRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	rules := make([]*entities.CategorizationRule, 0)
+	for rows.Next() {
+		rule, err := scanCategorizationRule(rows)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+// loadTags fills the tag outcome of the given rules with a single query. Deleted tags
+// are left out.
+func (r *categorizationRuleRepository) loadTags(ctx context.Context, rules []*entities.CategorizationRule) error {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.CategorizationRule, len(rules))
+	placeholders := make([]string, len(rules))
+	args := make([]any, len(rules))
+	for i, rule := range rules {
+		byID[rule.ID.Value] = rule
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = rule.ID.Value
+	}
+
+	query := fmt.Sprintf(`
+		SELECT rt.rule_id, rt.tag_id
+		FROM categorization_rule_tags rt
+		JOIN tags tg ON tg.id = rt.tag_id AND tg.deleted_at IS NULL
+		WHERE rt.rule_id IN (%s)
+		ORDER BY rt.rule_id, tg.name`,
+		strings.Join(placeholders, ", "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.o11y.Logger().Error(ctx, "CategorizationRuleRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	for rows.Next() {
+		var ruleID, tagID uuid.UUID
+		if err := rows.Scan(&ruleID, &tagID); err != nil {
+			return err
+		}
+		if rule, ok := byID[ruleID]; ok {
+			rule.Outcome.TagIDs = append(rule.Outcome.TagIDs, vos.UUID{Value: tagID})
+		}
+	}
+	return rows.Err()
+}
+
+func (r *categorizationRuleRepository) logFailure(ctx context.Context, operation string, err error) {
+	r.o11y.Logger().Error(ctx, "query_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "repository"),
+		observability.String("entity", "categorization_rule"),
+		observability.Error(err),
+	)
+}
+
+func scanCategorizationRule(s transactionScanner) (*entities.CategorizationRule, error) {
+	var rule entities.CategorizationRule
+	var contains, regex, minAmount, maxAmount, paymentMethod *string
+	var cardID, subcategoryID *uuid.UUID
+	if err := s.Scan(
+		&rule.ID.Value,
+		&rule.UserID.Value,
+		&rule.Name,
+		&rule.Priority,
+		&rule.Enabled,
+		&contains,
+		&regex,
+		&minAmount,
+		&maxAmount,
+		&paymentMethod,
+		&cardID,
+		&rule.Outcome.CategoryID.Value,
+		&subcategoryID,
+		&rule.CreatedAt,
+		&rule.UpdatedAt,
+		&rule.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if contains != nil {
+		rule.Conditions.DescriptionContains = *contains
+	}
+	if regex != nil {
+		rule.Conditions.DescriptionRegex = *regex
+	}
+	if paymentMethod != nil {
+		rule.Conditions.PaymentMethod = *paymentMethod
+	}
+	for _, limit := range []struct {
+		raw    *string
+		target **vos.Money
+	}{{minAmount, &rule.Conditions.MinAmount}, {maxAmount, &rule.Conditions.MaxAmount}} {
+		if limit.raw == nil {
+			continue
+		}
+		amount, err := vos.NewMoneyFromString(*limit.raw, vos.CurrencyBRL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse amount limit: %w", err)
+		}
+		*limit.target = &amount
+	}
+	if cardID != nil {
+		uid := vos.UUID{Value: *cardID}
+		rule.Conditions.CardID = &uid
+	}
+	if subcategoryID != nil {
+		uid := vos.UUID{Value: *subcategoryID}
+		rule.Outcome.SubcategoryID = &uid
+	}
+	return &rule, nil
+}
+
+func optionalText(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func optionalAmount(value *vos.Money) *float64 {
+	if value == nil {
+		return nil
+	}
+	amount := value.Float()
+	return &amount
+}
@@ -0,0 +1,202 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+const exchangeRateColumns = `id, user_id, currency, base_currency, rate, rate_date, source, created_at, updated_at`
+
+type exchangeRateRepository struct {
+	db   database.DBTX
+	o11y observability.Observability
+	tm   *metrics.TransactionMetrics
+}
+
+// NewExchangeRateRepository creates a new ExchangeRateRepository.
+func NewExchangeRateRepository(db database.DBTX, o11y observability.Observability, tm *metrics.TransactionMetrics) interfaces.ExchangeRateRepository {
+	return &exchangeRateRepository{db: db, o11y: o11y, tm: tm}
+}
+
+func (r *exchangeRateRepository) Upsert(ctx context.Context, tx database.DBTX, rates []*entities.ExchangeRate) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "exchange_rate_repository.upsert")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		INSERT INTO exchange_rates (%s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (user_id, currency, base_currency, rate_date) DO UPDATE
+		SET rate = EXCLUDED.rate,
+		    source = EXCLUDED.source,
+		    updated_at = NOW()`,
+		exchangeRateColumns)
+
+	for _, rate := range rates {
+		_, err := tx.ExecContext(ctx, query,
+			rate.ID.Value,
+			rate.UserID.Value,
+			rate.Currency.String(),
+			rate.BaseCurrency.String(),
+			rate.Rate.Float(),
+			rate.RateDate,
+			rate.Source,
+			rate.CreatedAt,
+			rate.UpdatedAt,
+		)
+		if err != nil {
+			span.RecordError(err)
+			r.logFailure(ctx, "upsert", err)
+			r.tm.RecordRepositoryFailure(ctx, "upsert", "exchange_rate", "infra", time.Since(start))
+			return err
+		}
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "upsert", "exchange_rate", time.Since(start))
+	return nil
+}
+
+func (r *exchangeRateRepository) ListByUser(ctx context.Context, userID vos.UUID, currency string) ([]*entities.ExchangeRate, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "exchange_rate_repository.list_by_user")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM exchange_rates
+		WHERE user_id = $1
+		  AND ($2 = '' OR currency = $2)
+		ORDER BY rate_date DESC, currency ASC`,
+		exchangeRateColumns)
+
+	rows, err := r.db.QueryContext(ctx, query, userID.Value, strings.ToUpper(currency))
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "list_by_user", err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_user", "exchange_rate", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "ExchangeRateRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	rates := make([]*entities.ExchangeRate, 0)
+	for rows.Next() {
+		rate, err := scanExchangeRate(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.logFailure(ctx, "list_by_user", err)
+			r.tm.RecordRepositoryFailure(ctx, "list_by_user", "exchange_rate", "infra", time.Since(start))
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_user", "exchange_rate", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "list_by_user", "exchange_rate", time.Since(start))
+	return rates, nil
+}
+
+func (r *exchangeRateRepository) FindLatest(ctx context.Context, userID vos.UUID, currency vos.Currency, date time.Time) (*entities.ExchangeRate, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "exchange_rate_repository.find_latest")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM exchange_rates
+		WHERE user_id = $1
+		  AND currency = $2
+		  AND base_currency = 'BRL'
+		  AND rate_date <= $3
+		ORDER BY rate_date DESC
+		LIMIT 1`,
+		exchangeRateColumns)
+
+	rate, err := scanExchangeRate(r.db.QueryRowContext(ctx, query, userID.Value, currency.String(), date))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.tm.RecordRepositoryQuery(ctx, "find_latest", "exchange_rate", time.Since(start))
+			return nil, nil
+		}
+		span.RecordError(err)
+		r.logFailure(ctx, "find_latest", err)
+		r.tm.RecordRepositoryFailure(ctx, "find_latest", "exchange_rate", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "find_latest", "exchange_rate", time.Since(start))
+	return rate, nil
+}
+
+func (r *exchangeRateRepository) logFailure(ctx context.Context, operation string, err error) {
+	r.o11y.Logger().Error(ctx, "query_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "repository"),
+		observability.String("entity", "exchange_rate"),
+		observability.Error(err),
+	)
+}
+
+func scanExchangeRate(s transactionScanner) (*entities.ExchangeRate, error) {
+	var rate entities.ExchangeRate
+	var currencyStr, baseCurrencyStr, rateStr string
+	if err := s.Scan(
+		&rate.ID.Value,
+		&rate.UserID.Value,
+		&currencyStr,
+		&baseCurrencyStr,
+		&rateStr,
+		&rate.RateDate,
+		&rate.Source,
+		&rate.CreatedAt,
+		&rate.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	currency, err := vos.NewCurrency(strings.TrimSpace(currencyStr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse currency: %w", err)
+	}
+	rate.Currency = currency
+
+	baseCurrency, err := vos.NewCurrency(strings.TrimSpace(baseCurrencyStr))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse base_currency: %w", err)
+	}
+	rate.BaseCurrency = baseCurrency
+
+	value, err := strconv.ParseFloat(rateStr, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rate: %w", err)
+	}
+	if rate.Rate, err = transactionVos.NewExchangeRate(value); err != nil {
+		return nil, fmt.Errorf("failed to parse rate: %w", err)
+	}
+	return &rate, nil
+}
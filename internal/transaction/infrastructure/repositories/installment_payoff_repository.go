@@ -0,0 +1,65 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+type installmentPayoffRepository struct {
+	db   database.DBTX
+	o11y observability.Observability
+	tm   *metrics.TransactionMetrics
+}
+
+// NewInstallmentPayoffRepository creates a new InstallmentPayoffRepository.
+func NewInstallmentPayoffRepository(db database.DBTX, o11y observability.Observability, tm *metrics.TransactionMetrics) interfaces.InstallmentPayoffRepository {
+	return &installmentPayoffRepository{db: db, o11y: o11y, tm: tm}
+}
+
+func (r *installmentPayoffRepository) Save(ctx context.Context, tx database.DBTX, payoff *entities.InstallmentPayoff) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "installment_payoff_repository.save")
+	defer span.End()
+
+	query := `
+		INSERT INTO installment_payoffs (
+			id, user_id, installment_group_id, transaction_id, invoice_id, installments,
+			original_amount, discount_amount, amount, payoff_date, created_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`
+
+	_, err := tx.ExecContext(ctx, query,
+		payoff.ID.Value,
+		payoff.UserID.Value,
+		payoff.InstallmentGroupID.Value,
+		payoff.TransactionID.Value,
+		payoff.InvoiceID.Value,
+		payoff.Installments,
+		payoff.OriginalAmount.Float(),
+		payoff.Discount.Float(),
+		payoff.Amount.Float(),
+		payoff.PayoffDate,
+		payoff.CreatedAt,
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "save"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "installment_payoff"),
+			observability.Error(err),
+		)
+		r.tm.RecordRepositoryFailure(ctx, "save", "installment_payoff", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "save", "installment_payoff", time.Since(start))
+	return nil
+}
@@ -0,0 +1,305 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+const recurringTransactionColumns = `
+		id, user_id, category_id, subcategory_id, card_id,
+		description, amount, direction, payment_method, rrule,
+		start_date, next_occurrence, occurrence_count, active,
+		created_at, updated_at, deleted_at`
+
+type recurringTransactionRepository struct {
+	db   database.DBTX
+	o11y observability.Observability
+	tm   *metrics.TransactionMetrics
+}
+
+// NewRecurringTransactionRepository creates a new RecurringTransactionRepository.
+func NewRecurringTransactionRepository(db database.DBTX, o11y observability.Observability, tm *metrics.TransactionMetrics) interfaces.RecurringTransactionRepository {
+	return &recurringTransactionRepository{db: db, o11y: o11y, tm: tm}
+}
+
+func (r *recurringTransactionRepository) Save(ctx context.Context, tx database.DBTX, rt *entities.RecurringTransaction) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "recurring_transaction_repository.save")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		INSERT INTO recurring_transactions (%s
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17)`,
+		recurringTransactionColumns)
+
+	_, err := tx.ExecContext(ctx, query,
+		rt.ID.Value,
+		rt.UserID.Value,
+		rt.CategoryID.Value,
+		optionalUUID(rt.SubcategoryID),
+		optionalUUID(rt.CardID),
+		rt.Description,
+		rt.Amount.Float(),
+		rt.Direction.String(),
+		rt.PaymentMethod.String(),
+		rt.Rule.String(),
+		rt.StartDate,
+		rt.NextOccurrence,
+		rt.OccurrenceCount,
+		rt.Active,
+		rt.CreatedAt,
+		rt.UpdatedAt,
+		rt.DeletedAt,
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "save", err)
+		r.tm.RecordRepositoryFailure(ctx, "save", "recurring_transaction", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "save", "recurring_transaction", time.Since(start))
+	return nil
+}
+
+func (r *recurringTransactionRepository) Update(
+	ctx context.Context,
+	tx database.DBTX,
+	rt *entities.RecurringTransaction,
+	expectedNext *time.Time,
+) (bool, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "recurring_transaction_repository.update")
+	defer span.End()
+
+	query := `
+		UPDATE recurring_transactions SET
+			category_id = $2,
+			subcategory_id = $3,
+			description = $4,
+			amount = $5,
+			next_occurrence = $6,
+			occurrence_count = $7,
+			active = $8,
+			updated_at = $9,
+			deleted_at = $10
+		WHERE id = $1
+		  AND deleted_at IS NULL
+		  AND next_occurrence IS NOT DISTINCT FROM $11`
+
+	result, err := tx.ExecContext(ctx, query,
+		rt.ID.Value,
+		rt.CategoryID.Value,
+		optionalUUID(rt.SubcategoryID),
+		rt.Description,
+		rt.Amount.Float(),
+		rt.NextOccurrence,
+		rt.OccurrenceCount,
+		rt.Active,
+		rt.UpdatedAt,
+		rt.DeletedAt,
+		expectedNext,
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "update", err)
+		r.tm.RecordRepositoryFailure(ctx, "update", "recurring_transaction", "infra", time.Since(start))
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "update", "recurring_transaction", "infra", time.Since(start))
+		return false, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "update", "recurring_transaction", time.Since(start))
+	return affected == 1, nil
+}
+
+func (r *recurringTransactionRepository) FindByID(ctx context.Context, id vos.UUID) (*entities.RecurringTransaction, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "recurring_transaction_repository.find_by_id")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM recurring_transactions
+		WHERE id = $1 AND deleted_at IS NULL`,
+		recurringTransactionColumns)
+
+	rt, err := r.scanRecurringTransaction(r.db.QueryRowContext(ctx, query, id.Value))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.tm.RecordRepositoryQuery(ctx, "find_by_id", "recurring_transaction", time.Since(start))
+			return nil, nil
+		}
+		span.RecordError(err)
+		r.logFailure(ctx, "find_by_id", err)
+		r.tm.RecordRepositoryFailure(ctx, "find_by_id", "recurring_transaction", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "find_by_id", "recurring_transaction", time.Since(start))
+	return rt, nil
+}
+
+func (r *recurringTransactionRepository) ListByUser(ctx context.Context, userID vos.UUID) ([]*entities.RecurringTransaction, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM recurring_transactions
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY created_at DESC, id DESC`,
+		recurringTransactionColumns)
+
+	return r.list(ctx, "list_by_user", query, userID.Value)
+}
+
+func (r *recurringTransactionRepository) ListDue(ctx context.Context, today time.Time, limit int) ([]*entities.RecurringTransaction, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM recurring_transactions
+		WHERE active
+		  AND deleted_at IS NULL
+		  AND next_occurrence <= $1
+		ORDER BY next_occurrence ASC, id ASC
+		LIMIT $2`,
+		recurringTransactionColumns)
+
+	return r.list(ctx, "list_due", query, today, limit)
+}
+
+func (r *recurringTransactionRepository) list(ctx context.Context, operation, query string, args ...any) ([]*entities.RecurringTransaction, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "recurring_transaction_repository."+operation)
+	defer span.End()
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, operation, err)
+		r.tm.RecordRepositoryFailure(ctx, operation, "recurring_transaction", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "RecurringTransactionRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	recurring := make([]*entities.RecurringTransaction, 0)
+	for rows.Next() {
+		rt, err := r.scanRecurringTransaction(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.logFailure(ctx, operation, err)
+			r.tm.RecordRepositoryFailure(ctx, operation, "recurring_transaction", "infra", time.Since(start))
+			return nil, err
+		}
+		recurring = append(recurring, rt)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, operation, "recurring_transaction", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, operation, "recurring_transaction", time.Since(start))
+	return recurring, nil
+}
+
+func (r *recurringTransactionRepository) logFailure(ctx context.Context, operation string, err error) {
+	r.o11y.Logger().Error(ctx, "query_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "repository"),
+		observability.String("entity", "recurring_transaction"),
+		observability.Error(err),
+	)
+}
+
+func (r *recurringTransactionRepository) scanRecurringTransaction(s transactionScanner) (*entities.RecurringTransaction, error) {
+	var rt entities.RecurringTransaction
+	var subcategoryID, cardID *uuid.UUID
+	var nextOccurrence, updatedAt, deletedAt *time.Time
+	var amountStr, directionStr, paymentMethodStr, ruleStr string
+
+	err := s.Scan(
+		&rt.ID.Value,
+		&rt.UserID.Value,
+		&rt.CategoryID.Value,
+		&subcategoryID,
+		&cardID,
+		&rt.Description,
+		&amountStr,
+		&directionStr,
+		&paymentMethodStr,
+		&ruleStr,
+		&rt.StartDate,
+		&nextOccurrence,
+		&rt.OccurrenceCount,
+		&rt.Active,
+		&rt.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := vos.NewMoneyFromString(amountStr, vos.CurrencyBRL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse amount: %w", err)
+	}
+	rt.Amount = amount
+
+	direction, err := transactionVos.NewTransactionDirection(directionStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse direction: %w", err)
+	}
+	rt.Direction = direction
+
+	pm, err := transactionVos.NewPaymentMethod(paymentMethodStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payment_method: %w", err)
+	}
+	rt.PaymentMethod = pm
+
+	rule, err := transactionVos.NewRecurrenceRule(ruleStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rrule: %w", err)
+	}
+	rt.Rule = rule
+
+	if subcategoryID != nil {
+		uid := vos.UUID{Value: *subcategoryID}
+		rt.SubcategoryID = &uid
+	}
+	if cardID != nil {
+		uid := vos.UUID{Value: *cardID}
+		rt.CardID = &uid
+	}
+
+	rt.NextOccurrence = nextOccurrence
+	rt.UpdatedAt = updatedAt
+	rt.DeletedAt = deletedAt
+
+	return &rt, nil
+}
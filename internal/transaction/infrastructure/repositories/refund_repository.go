@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+const refundColumns = `id, user_id, transaction_id, invoice_id, amount, reason, refund_date, created_at`
+
+type refundRepository struct {
+	db   database.DBTX
+	o11y observability.Observability
+	tm   *metrics.TransactionMetrics
+}
+
+// NewRefundRepository creates a new RefundRepository.
+func NewRefundRepository(db database.DBTX, o11y observability.Observability, tm *metrics.TransactionMetrics) interfaces.RefundRepository {
+	return &refundRepository{db: db, o11y: o11y, tm: tm}
+}
+
+func (r *refundRepository) Save(ctx context.Context, tx database.DBTX, refund *entities.Refund) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "refund_repository.save")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		INSERT INTO transaction_refunds (%s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+		refundColumns)
+
+	_, err := tx.ExecContext(ctx, query,
+		refund.ID.Value,
+		refund.UserID.Value,
+		refund.TransactionID.Value,
+		refund.InvoiceID.Value,
+		refund.Amount.Float(),
+		refund.Reason,
+		refund.RefundDate,
+		refund.CreatedAt,
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "save", err)
+		r.tm.RecordRepositoryFailure(ctx, "save", "refund", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "save", "refund", time.Since(start))
+	return nil
+}
+
+func (r *refundRepository) ListByTransaction(ctx context.Context, transactionID vos.UUID) ([]*entities.Refund, error) {
+	return r.listByTransaction(ctx, r.db, transactionID)
+}
+
+func (r *refundRepository) ListByTransactionInTx(ctx context.Context, tx database.DBTX, transactionID vos.UUID) ([]*entities.Refund, error) {
+	return r.listByTransaction(ctx, tx, transactionID)
+}
+
+func (r *refundRepository) listByTransaction(ctx context.Context, db database.DBTX, transactionID vos.UUID) ([]*entities.Refund, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "refund_repository.list_by_transaction")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM transaction_refunds
+		WHERE transaction_id = $1
+		ORDER BY refund_date ASC, created_at ASC, id ASC`,
+		refundColumns)
+
+	rows, err := db.QueryContext(ctx, query, transactionID.Value)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "list_by_transaction", err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_transaction", "refund", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "RefundRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	refunds := make([]*entities.Refund, 0)
+	for rows.Next() {
+		refund, err := scanRefund(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.logFailure(ctx, "list_by_transaction", err)
+			r.tm.RecordRepositoryFailure(ctx, "list_by_transaction", "refund", "infra", time.Since(start))
+			return nil, err
+		}
+		refunds = append(refunds, refund)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_transaction", "refund", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "list_by_transaction", "refund", time.Since(start))
+	return refunds, nil
+}
+
+func (r *refundRepository) logFailure(ctx context.Context, operation string, err error) {
+	r.o11y.Logger().Error(ctx, "query_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "repository"),
+		observability.String("entity", "refund"),
+		observability.Error(err),
+	)
+}
+
+func scanRefund(s transactionScanner) (*entities.Refund, error) {
+	var refund entities.Refund
+	var amountStr string
+	if err := s.Scan(
+		&refund.ID.Value,
+		&refund.UserID.Value,
+		&refund.TransactionID.Value,
+		&refund.InvoiceID.Value,
+		&amountStr,
+		&refund.Reason,
+		&refund.RefundDate,
+		&refund.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	amount, err := vos.NewMoneyFromString(amountStr, vos.CurrencyBRL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse amount: %w", err)
+	}
+	refund.Amount = amount
+	return &refund, nil
+}
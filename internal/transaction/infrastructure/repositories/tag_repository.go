@@ -0,0 +1,326 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+const tagColumns = `id, user_id, name, created_at, updated_at, deleted_at`
+
+type tagRepository struct {
+	db   database.DBTX
+	o11y observability.Observability
+	tm   *metrics.TransactionMetrics
+}
+
+// NewTagRepository creates a new TagRepository.
+func NewTagRepository(db database.DBTX, o11y observability.Observability, tm *metrics.TransactionMetrics) interfaces.TagRepository {
+	return &tagRepository{db: db, o11y: o11y, tm: tm}
+}
+
+func (r *tagRepository) Save(ctx context.Context, tx database.DBTX, tag *entities.Tag) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "tag_repository.save")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		INSERT INTO tags (%s)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		tagColumns)
+
+	_, err := tx.ExecContext(ctx, query,
+		tag.ID.Value,
+		tag.UserID.Value,
+		tag.Name,
+		tag.CreatedAt,
+		tag.UpdatedAt,
+		tag.DeletedAt,
+	)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "save", err)
+		r.tm.RecordRepositoryFailure(ctx, "save", "tag", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "save", "tag", time.Since(start))
+	return nil
+}
+
+func (r *tagRepository) Update(ctx context.Context, tx database.DBTX, tag *entities.Tag) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "tag_repository.update")
+	defer span.End()
+
+	query := `
+		UPDATE tags SET
+			name = $2,
+			updated_at = $3,
+			deleted_at = $4
+		WHERE id = $1 AND deleted_at IS NULL`
+
+	if _, err := tx.ExecContext(ctx, query, tag.ID.Value, tag.Name, tag.UpdatedAt, tag.DeletedAt); err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "update", err)
+		r.tm.RecordRepositoryFailure(ctx, "update", "tag", "infra", time.Since(start))
+		return err
+	}
+
+	if tag.DeletedAt != nil {
+		for _, query := range []string{
+			`DELETE FROM transaction_tags WHERE tag_id = $1`,
+			`DELETE FROM categorization_rule_tags WHERE tag_id = $1`,
+		} {
+			if _, err := tx.ExecContext(ctx, query, tag.ID.Value); err != nil {
+				span.RecordError(err)
+				r.logFailure(ctx, "update", err)
+				r.tm.RecordRepositoryFailure(ctx, "update", "tag", "infra", time.Since(start))
+				return err
+			}
+		}
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "update", "tag", time.Since(start))
+	return nil
+}
+
+func (r *tagRepository) FindByID(ctx context.Context, id vos.UUID) (*entities.Tag, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tags
+		WHERE id = $1 AND deleted_at IS NULL`,
+		tagColumns)
+
+	return r.find(ctx, "find_by_id", query, id.Value)
+}
+
+func (r *tagRepository) FindByName(ctx context.Context, userID vos.UUID, name string) (*entities.Tag, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tags
+		WHERE user_id = $1 AND name = $2 AND deleted_at IS NULL`,
+		tagColumns)
+
+	return r.find(ctx, "find_by_name", query, userID.Value, name)
+}
+
+func (r *tagRepository) FindByIDs(ctx context.Context, userID vos.UUID, ids []vos.UUID) ([]*entities.Tag, error) {
+	if len(ids) == 0 {
+		return []*entities.Tag{}, nil
+	}
+
+	placeholders := make([]string, len(ids))
+	args := make([]any, 0, len(ids)+1)
+	args = append(args, userID.Value)
+	for i, id := range ids {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args = append(args, id.Value)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tags
+		WHERE user_id = $1
+		  AND id IN (%s)
+		  AND deleted_at IS NULL
+		ORDER BY name ASC`,
+		tagColumns, strings.Join(placeholders, ", "))
+
+	return r.list(ctx, "find_by_ids", query, args...)
+}
+
+func (r *tagRepository) ListByUser(ctx context.Context, userID vos.UUID) ([]*entities.Tag, error) {
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM tags
+		WHERE user_id = $1 AND deleted_at IS NULL
+		ORDER BY name ASC`,
+		tagColumns)
+
+	return r.list(ctx, "list_by_user", query, userID.Value)
+}
+
+// SumTransactions counts a transaction once per tag, so an installment purchase adds up
+// its installments and a split purchase its whole amount.
+func (r *tagRepository) SumTransactions(ctx context.Context, userID, tagID vos.UUID, from, to *time.Time) (interfaces.TagTotals, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "tag_repository.sum_transactions")
+	defer span.End()
+
+	conditions := []string{
+		"tt.tag_id = $1",
+		"t.user_id = $2",
+		"t.deleted_at IS NULL",
+		"t.status = 'active'",
+	}
+	args := []any{tagID.Value, userID.Value}
+	if from != nil {
+		args = append(args, *from)
+		conditions = append(conditions, fmt.Sprintf("t.transaction_date >= $%d", len(args)))
+	}
+	if to != nil {
+		args = append(args, *to)
+		conditions = append(conditions, fmt.Sprintf("t.transaction_date <= $%d", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		SELECT t.direction, COALESCE(SUM(t.amount), 0)::TEXT, COUNT(*)
+		FROM transactions t
+		JOIN transaction_tags tt ON tt.transaction_id = t.id
+		WHERE %s
+		GROUP BY t.direction`,
+		strings.Join(conditions, " AND "))
+
+	totals := interfaces.TagTotals{}
+	zero, err := vos.NewMoney(0, vos.CurrencyBRL)
+	if err != nil {
+		return totals, err
+	}
+	totals.Expense, totals.Income = zero, zero
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "sum_transactions", err)
+		r.tm.RecordRepositoryFailure(ctx, "sum_transactions", "tag", "infra", time.Since(start))
+		return totals, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "TagRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	for rows.Next() {
+		var direction, sumStr string
+		var count int
+		if err := rows.Scan(&direction, &sumStr, &count); err != nil {
+			span.RecordError(err)
+			r.tm.RecordRepositoryFailure(ctx, "sum_transactions", "tag", "infra", time.Since(start))
+			return totals, err
+		}
+		sum, err := vos.NewMoneyFromString(sumStr, vos.CurrencyBRL)
+		if err != nil {
+			return totals, fmt.Errorf("failed to parse tag total: %w", err)
+		}
+		if direction == transactionVos.DirectionIncome.String() {
+			totals.Income = sum
+		} else {
+			totals.Expense = sum
+		}
+		totals.TransactionCount += count
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "sum_transactions", "tag", "infra", time.Since(start))
+		return totals, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "sum_transactions", "tag", time.Since(start))
+	return totals, nil
+}
+
+func (r *tagRepository) find(ctx context.Context, operation, query string, args ...any) (*entities.Tag, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "tag_repository."+operation)
+	defer span.End()
+
+	tag, err := scanTag(r.db.QueryRowContext(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.tm.RecordRepositoryQuery(ctx, operation, "tag", time.Since(start))
+			return nil, nil
+		}
+		span.RecordError(err)
+		r.logFailure(ctx, operation, err)
+		r.tm.RecordRepositoryFailure(ctx, operation, "tag", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, operation, "tag", time.Since(start))
+	return tag, nil
+}
+
+func (r *tagRepository) list(ctx context.Context, operation, query string, args ...any) ([]*entities.Tag, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "tag_repository."+operation)
+	defer span.End()
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, operation, err)
+		r.tm.RecordRepositoryFailure(ctx, operation, "tag", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "TagRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	tags := make([]*entities.Tag, 0)
+	for rows.Next() {
+		tag, err := scanTag(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.logFailure(ctx, operation, err)
+			r.tm.RecordRepositoryFailure(ctx, operation, "tag", "infra", time.Since(start))
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, operation, "tag", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, operation, "tag", time.Since(start))
+	return tags, nil
+}
+
+func (r *tagRepository) logFailure(ctx context.Context, operation string, err error) {
+	r.o11y.Logger().Error(ctx, "query_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "repository"),
+		observability.String("entity", "tag"),
+		observability.Error(err),
+	)
+}
+
+func scanTag(s transactionScanner) (*entities.Tag, error) {
+	var tag entities.Tag
+	if err := s.Scan(
+		&tag.ID.Value,
+		&tag.UserID.Value,
+		&tag.Name,
+		&tag.CreatedAt,
+		&tag.UpdatedAt,
+		&tag.DeletedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,9 +14,11 @@ import (
 	"github.com/JailtonJunior94/devkit-go/pkg/vos"
 	"github.com/google/uuid"
 
+	transactionDomain "github.com/jailtonjunior94/financial/internal/transaction/domain"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
 	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
 	transactionVos "github.com/jailtonjunior94/financial/internal/transaction/domain/vos"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 	"github.com/jailtonjunior94/financial/pkg/pagination"
 )
@@ -46,10 +49,11 @@ func (r *transactionRepository) Save(ctx context.Context, tx database.DBTX, t *e
 	query := `
 		INSERT INTO transactions (
 			id, user_id, category_id, subcategory_id, card_id,
-			invoice_id, installment_group_id, description, amount,
+			invoice_id, installment_group_id, description, amount, direction,
 			payment_method, transaction_date, installment_number, installment_total,
-			status, created_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
+			status, created_at, external_id, original_currency, original_amount,
+			exchange_rate, iof_amount
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21)`
 
 	stmt, err := tx.PrepareContext(ctx, query)
 	if err != nil {
@@ -82,12 +86,18 @@ func (r *transactionRepository) Save(ctx context.Context, tx database.DBTX, t *e
 		optionalUUID(t.InstallmentGroupID),
 		t.Description,
 		t.Amount.Float(),
+		t.Direction.String(),
 		t.PaymentMethod.String(),
 		t.TransactionDate,
 		t.InstallmentNumber,
 		t.InstallmentTotal,
 		t.Status.String(),
 		t.CreatedAt,
+		t.ExternalID,
+		originalCurrency(t),
+		optionalMoney(t.OriginalAmount),
+		optionalExchangeRate(t.ExchangeRate),
+		optionalMoney(t.IOFAmount),
 	)
 	if err != nil {
 		span.RecordError(err)
@@ -101,6 +111,17 @@ func (r *transactionRepository) Save(ctx context.Context, tx database.DBTX, t *e
 		return err
 	}
 
+	if err := r.saveSplits(ctx, tx, t); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "save", "transaction", "infra", time.Since(start))
+		return err
+	}
+	if err := r.saveTags(ctx, tx, t); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "save", "transaction", "infra", time.Since(start))
+		return err
+	}
+
 	r.o11y.Logger().Debug(ctx, "query_completed",
 		observability.String("operation", "save"),
 		observability.String("layer", "repository"),
@@ -151,9 +172,10 @@ func (r *transactionRepository) FindByID(ctx context.Context, id vos.UUID) (*ent
 
 	query := `
 		SELECT id, user_id, category_id, subcategory_id, card_id,
-		       invoice_id, installment_group_id, description, amount,
+		       invoice_id, installment_group_id, description, amount, direction,
 		       payment_method, transaction_date, installment_number, installment_total,
-		       status, created_at, updated_at, deleted_at
+		       status, created_at, updated_at, deleted_at, external_id,
+		       original_currency, original_amount, exchange_rate, iof_amount, version
 		FROM transactions
 		WHERE id = $1 AND deleted_at IS NULL`
 
@@ -175,6 +197,12 @@ func (r *transactionRepository) FindByID(ctx context.Context, id vos.UUID) (*ent
 		return nil, err
 	}
 
+	if err := r.loadRelations(ctx, []*entities.Transaction{t}); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "find_by_id", "transaction", "infra", time.Since(start))
+		return nil, err
+	}
+
 	r.o11y.Logger().Debug(ctx, "query_completed",
 		observability.String("operation", "find_by_id"),
 		observability.String("layer", "repository"),
@@ -184,6 +212,38 @@ func (r *transactionRepository) FindByID(ctx context.Context, id vos.UUID) (*ent
 	return t, nil
 }
 
+func (r *transactionRepository) LockByID(ctx context.Context, tx database.DBTX, id vos.UUID) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.lock_by_id")
+	defer span.End()
+
+	query := `
+		SELECT id
+		FROM transactions
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE`
+
+	var locked uuid.UUID
+	if err := tx.QueryRowContext(ctx, query, id.Value).Scan(&locked); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			r.tm.RecordRepositoryQuery(ctx, "lock_by_id", "transaction", time.Since(start))
+			return transactionDomain.ErrTransactionNotFound
+		}
+		span.RecordError(err)
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "lock_by_id"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		r.tm.RecordRepositoryFailure(ctx, "lock_by_id", "transaction", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "lock_by_id", "transaction", time.Since(start))
+	return nil
+}
+
 func (r *transactionRepository) FindByInstallmentGroup(ctx context.Context, groupID vos.UUID) ([]*entities.Transaction, error) {
 	start := time.Now()
 	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.find_by_group")
@@ -197,9 +257,10 @@ func (r *transactionRepository) FindByInstallmentGroup(ctx context.Context, grou
 
 	query := `
 		SELECT id, user_id, category_id, subcategory_id, card_id,
-		       invoice_id, installment_group_id, description, amount,
+		       invoice_id, installment_group_id, description, amount, direction,
 		       payment_method, transaction_date, installment_number, installment_total,
-		       status, created_at, updated_at, deleted_at
+		       status, created_at, updated_at, deleted_at, external_id,
+		       original_currency, original_amount, exchange_rate, iof_amount, version
 		FROM transactions
 		WHERE installment_group_id = $1 AND deleted_at IS NULL
 		ORDER BY installment_number ASC`
@@ -248,6 +309,12 @@ func (r *transactionRepository) FindByInstallmentGroup(ctx context.Context, grou
 		return nil, err
 	}
 
+	if err := r.loadRelations(ctx, transactions); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "find_by_group", "transaction", "infra", time.Since(start))
+		return nil, err
+	}
+
 	r.o11y.Logger().Debug(ctx, "query_completed",
 		observability.String("operation", "find_by_group"),
 		observability.String("layer", "repository"),
@@ -276,8 +343,10 @@ func (r *transactionRepository) Update(ctx context.Context, tx database.DBTX, t
 			category_id = $4,
 			subcategory_id = $5,
 			status = $6,
-			updated_at = NOW()
-		WHERE id = $1`
+			installment_total = $7,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = $1 AND version = $8`
 
 	stmt, err := tx.PrepareContext(ctx, query)
 	if err != nil {
@@ -300,13 +369,15 @@ func (r *transactionRepository) Update(ctx context.Context, tx database.DBTX, t
 		}
 	}()
 
-	_, err = stmt.ExecContext(ctx,
+	result, err := stmt.ExecContext(ctx,
 		t.ID.Value,
 		t.Description,
 		t.Amount.Float(),
 		t.CategoryID.Value,
 		optionalUUID(t.SubcategoryID),
 		t.Status.String(),
+		t.InstallmentTotal,
+		t.Version,
 	)
 	if err != nil {
 		span.RecordError(err)
@@ -320,6 +391,31 @@ func (r *transactionRepository) Update(ctx context.Context, tx database.DBTX, t
 		return err
 	}
 
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "update", "transaction", "infra", time.Since(start))
+		return err
+	}
+
+	// No row at the version that was read: someone else changed the transaction meanwhile.
+	if affected == 0 {
+		r.tm.RecordRepositoryFailure(ctx, "update", "transaction", "business", time.Since(start))
+		return customerrors.ErrPreconditionFailed
+	}
+	t.Version++
+
+	if err := r.replaceSplits(ctx, tx, t); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "update", "transaction", "infra", time.Since(start))
+		return err
+	}
+	if err := r.replaceTags(ctx, tx, t); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "update", "transaction", "infra", time.Since(start))
+		return err
+	}
+
 	r.o11y.Logger().Debug(ctx, "query_completed",
 		observability.String("operation", "update"),
 		observability.String("layer", "repository"),
@@ -369,38 +465,23 @@ func (r *transactionRepository) ListPaginated(ctx context.Context, params interf
 		observability.String("user_id", params.UserID.String()),
 	)
 
-	conditions := []string{"user_id = $1", "deleted_at IS NULL", "status = 'active'"}
-	args := []any{params.UserID.Value}
-	argIdx := 2
+	conditions, args, argIdx := listConditions(params)
 
-	if params.PaymentMethod != "" {
-		conditions = append(conditions, fmt.Sprintf("payment_method = $%d", argIdx))
-		args = append(args, params.PaymentMethod)
-		argIdx++
-	}
-	if params.CategoryID != "" {
-		conditions = append(conditions, fmt.Sprintf("category_id = $%d", argIdx))
-		args = append(args, params.CategoryID)
-		argIdx++
-	}
-	if params.StartDate != nil {
-		conditions = append(conditions, fmt.Sprintf("transaction_date >= $%d", argIdx))
-		args = append(args, *params.StartDate)
-		argIdx++
-	}
-	if params.EndDate != nil {
-		conditions = append(conditions, fmt.Sprintf("transaction_date <= $%d", argIdx))
-		args = append(args, *params.EndDate)
-		argIdx++
+	sortColumn, sortOrder := listSort(params)
+	comparison := "<"
+	if sortOrder == interfaces.SortOrderAsc {
+		comparison = ">"
 	}
 
+	// The cursor records the sort it was built for; a cursor of another sort is ignored
+	// and the listing starts over, instead of skipping or repeating rows.
 	cursor, err := pagination.DecodeCursor(params.Cursor)
-	if err == nil {
-		if txDate, ok := cursor.GetString("transaction_date"); ok {
-			if cursorID, ok := cursor.GetString("id"); ok && txDate != "" && cursorID != "" {
+	if err == nil && cursorSort(cursor) == sortColumn+":"+sortOrder {
+		if value, ok := cursor.GetString(sortColumn); ok {
+			if cursorID, ok := cursor.GetString("id"); ok && value != "" && cursorID != "" {
 				conditions = append(conditions,
-					fmt.Sprintf("(transaction_date, id) < ($%d, $%d)", argIdx, argIdx+1))
-				args = append(args, txDate, cursorID)
+					fmt.Sprintf("(%s, id) %s ($%d, $%d)", sortColumn, comparison, argIdx, argIdx+1))
+				args = append(args, value, cursorID)
 				argIdx += 2
 			}
 		}
@@ -413,14 +494,15 @@ func (r *transactionRepository) ListPaginated(ctx context.Context, params interf
 
 	query := fmt.Sprintf(`
 		SELECT id, user_id, category_id, subcategory_id, card_id,
-		       invoice_id, installment_group_id, description, amount,
+		       invoice_id, installment_group_id, description, amount, direction,
 		       payment_method, transaction_date, installment_number, installment_total,
-		       status, created_at, updated_at, deleted_at
+		       status, created_at, updated_at, deleted_at, external_id,
+		       original_currency, original_amount, exchange_rate, iof_amount, version
 		FROM transactions
 		WHERE %s
-		ORDER BY transaction_date DESC, id DESC
+		ORDER BY %s %s, id %s
 		LIMIT $%d`,
-		strings.Join(conditions, " AND "), argIdx)
+		strings.Join(conditions, " AND "), sortColumn, strings.ToUpper(sortOrder), strings.ToUpper(sortOrder), argIdx)
 	args = append(args, limit+1)
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
@@ -465,10 +547,15 @@ func (r *transactionRepository) ListPaginated(ctx context.Context, params interf
 	if len(transactions) > limit {
 		transactions = transactions[:limit]
 		last := transactions[len(transactions)-1]
+		value := last.TransactionDate.Format("2006-01-02")
+		if sortColumn == interfaces.SortByAmount {
+			value = strconv.FormatFloat(last.Amount.Float(), 'f', 2, 64)
+		}
 		c := pagination.Cursor{
 			Fields: map[string]any{
-				"transaction_date": last.TransactionDate.Format("2006-01-02"),
-				"id":               last.ID.String(),
+				"sort":     sortColumn + ":" + sortOrder,
+				sortColumn: value,
+				"id":       last.ID.String(),
 			},
 		}
 		encoded, encErr := pagination.EncodeCursor(c)
@@ -477,6 +564,12 @@ func (r *transactionRepository) ListPaginated(ctx context.Context, params interf
 		}
 	}
 
+	if err := r.loadRelations(ctx, transactions); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_paginated", "transaction", "infra", time.Since(start))
+		return nil, "", err
+	}
+
 	r.o11y.Logger().Debug(ctx, "query_completed",
 		observability.String("operation", "list_paginated"),
 		observability.String("layer", "repository"),
@@ -486,76 +579,851 @@ func (r *transactionRepository) ListPaginated(ctx context.Context, params interf
 	return transactions, nextCursor, nil
 }
 
-type transactionScanner interface {
-	Scan(dest ...any) error
+// listConditions builds the WHERE conditions shared by the listing and the export, and
+// returns the index of the next placeholder.
+func listConditions(params interfaces.ListParams) ([]string, []any, int) {
+	conditions := []string{"user_id = $1", "deleted_at IS NULL"}
+	args := []any{params.UserID.Value}
+	argIdx := 2
+
+	switch params.Status {
+	case "":
+		conditions = append(conditions, "status = 'active'")
+	case interfaces.StatusFilterAll:
+	default:
+		conditions = append(conditions, fmt.Sprintf("status = $%d", argIdx))
+		args = append(args, params.Status)
+		argIdx++
+	}
+
+	if params.PaymentMethod != "" {
+		conditions = append(conditions, fmt.Sprintf("payment_method = $%d", argIdx))
+		args = append(args, params.PaymentMethod)
+		argIdx++
+	}
+	// A split transaction is listed under every category it was split into.
+	if params.CategoryID != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"(category_id = $%[1]d OR EXISTS (SELECT 1 FROM transaction_splits ts WHERE ts.transaction_id = transactions.id AND ts.category_id = $%[1]d))", argIdx))
+		args = append(args, params.CategoryID)
+		argIdx++
+	}
+	if params.SubcategoryID != "" {
+		conditions = append(conditions, fmt.Sprintf(
+			"(subcategory_id = $%[1]d OR EXISTS (SELECT 1 FROM transaction_splits ts WHERE ts.transaction_id = transactions.id AND ts.subcategory_id = $%[1]d))", argIdx))
+		args = append(args, params.SubcategoryID)
+		argIdx++
+	}
+	if params.CardID != "" {
+		conditions = append(conditions, fmt.Sprintf("card_id = $%d", argIdx))
+		args = append(args, params.CardID)
+		argIdx++
+	}
+	if params.InvoiceID != "" {
+		conditions = append(conditions, fmt.Sprintf("invoice_id = $%d", argIdx))
+		args = append(args, params.InvoiceID)
+		argIdx++
+	}
+	if params.InstallmentGroupID != "" {
+		conditions = append(conditions, fmt.Sprintf("installment_group_id = $%d", argIdx))
+		args = append(args, params.InstallmentGroupID)
+		argIdx++
+	}
+	for _, tagID := range params.TagIDs {
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM transaction_tags tt WHERE tt.transaction_id = transactions.id AND tt.tag_id = $%d)", argIdx))
+		args = append(args, tagID)
+		argIdx++
+	}
+	if params.Direction != "" {
+		conditions = append(conditions, fmt.Sprintf("direction = $%d", argIdx))
+		args = append(args, params.Direction)
+		argIdx++
+	}
+	if params.MinAmount != nil {
+		conditions = append(conditions, fmt.Sprintf("amount >= $%d", argIdx))
+		args = append(args, *params.MinAmount)
+		argIdx++
+	}
+	if params.MaxAmount != nil {
+		conditions = append(conditions, fmt.Sprintf("amount <= $%d", argIdx))
+		args = append(args, *params.MaxAmount)
+		argIdx++
+	}
+	if params.Search != "" {
+		conditions = append(conditions, fmt.Sprintf("description ILIKE $%d", argIdx))
+		args = append(args, "%"+escapeLike(params.Search)+"%")
+		argIdx++
+	}
+	if params.StartDate != nil {
+		conditions = append(conditions, fmt.Sprintf("transaction_date >= $%d", argIdx))
+		args = append(args, *params.StartDate)
+		argIdx++
+	}
+	if params.EndDate != nil {
+		conditions = append(conditions, fmt.Sprintf("transaction_date <= $%d", argIdx))
+		args = append(args, *params.EndDate)
+		argIdx++
+	}
+	return conditions, args, argIdx
 }
 
-func (r *transactionRepository) scanTransaction(s transactionScanner) (*entities.Transaction, error) {
-	var t entities.Transaction
-	var subcategoryID, cardID, invoiceID, installmentGroupID *uuid.UUID
-	var installmentNumber, installmentTotal *int
-	var updatedAt, deletedAt *time.Time
-	var amountStr string
-	var paymentMethodStr, statusStr string
+// listSort returns the sort column and order of the listing, defaulting to the newest first.
+// Both are checked against fixed values, since they are written into the query.
+func listSort(params interfaces.ListParams) (string, string) {
+	column := interfaces.SortByTransactionDate
+	if params.SortBy == interfaces.SortByAmount {
+		column = interfaces.SortByAmount
+	}
+	order := interfaces.SortOrderDesc
+	if params.SortOrder == interfaces.SortOrderAsc {
+		order = interfaces.SortOrderAsc
+	}
+	return column, order
+}
 
-	err := s.Scan(
-		&t.ID.Value,
-		&t.UserID.Value,
-		&t.CategoryID.Value,
-		&subcategoryID,
-		&cardID,
-		&invoiceID,
-		&installmentGroupID,
-		&t.Description,
-		&amountStr,
-		&paymentMethodStr,
-		&t.TransactionDate,
-		&installmentNumber,
-		&installmentTotal,
-		&statusStr,
-		&t.CreatedAt,
-		&updatedAt,
-		&deletedAt,
+// cursorSort returns the sort a cursor was built for. Cursors issued before sorting was
+// configurable have no sort and always follow the newest first.
+func cursorSort(cursor pagination.Cursor) string {
+	if sort, ok := cursor.GetString("sort"); ok {
+		return sort
+	}
+	return interfaces.SortByTransactionDate + ":" + interfaces.SortOrderDesc
+}
+
+// escapeLike escapes the LIKE wildcards of a search term, so it is matched literally.
+func escapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// StreamForExport calls fn for every transaction matching the listing filters (limit and
+// cursor are ignored), oldest first. Rows are read from the open result set one at a time,
+// so the export never holds the whole history in memory; an error returned by fn stops it.
+func (r *transactionRepository) StreamForExport(ctx context.Context, params interfaces.ListParams, fn func(row *interfaces.ExportRow) error) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.stream_for_export")
+	defer span.End()
+
+	r.o11y.Logger().Debug(ctx, "query_started",
+		observability.String("operation", "stream_for_export"),
+		observability.String("layer", "repository"),
+		observability.String("entity", "transaction"),
+		observability.String("user_id", params.UserID.String()),
 	)
+
+	conditions, args, _ := listConditions(params)
+	query := fmt.Sprintf(`
+		SELECT t.id, t.user_id, t.category_id, t.subcategory_id, t.card_id,
+		       t.invoice_id, t.installment_group_id, t.description, t.amount, t.direction,
+		       t.payment_method, t.transaction_date, t.installment_number, t.installment_total,
+		       t.status, t.created_at, t.updated_at, t.deleted_at, t.external_id,
+		       t.original_currency, t.original_amount, t.exchange_rate, t.iof_amount, t.version,
+		       c.name, s.name, cd.name
+		FROM (
+			SELECT *
+			FROM transactions
+			WHERE %s
+		) t
+		JOIN categories c ON c.id = t.category_id
+		LEFT JOIN subcategories s ON s.id = t.subcategory_id
+		LEFT JOIN cards cd ON cd.id = t.card_id
+		ORDER BY t.transaction_date ASC, t.id ASC`,
+		strings.Join(conditions, " AND "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		span.RecordError(err)
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "stream_for_export"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		r.tm.RecordRepositoryFailure(ctx, "stream_for_export", "transaction", "infra", time.Since(start))
+		return err
 	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "StreamForExport: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
 
-	amount, err := vos.NewMoneyFromString(amountStr, vos.CurrencyBRL)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse amount: %w", err)
+	for rows.Next() {
+		row := &interfaces.ExportRow{}
+		t, err := r.scanTransaction(rows, &row.CategoryName, &row.SubcategoryName, &row.CardName)
+		if err != nil {
+			span.RecordError(err)
+			r.tm.RecordRepositoryFailure(ctx, "stream_for_export", "transaction", "infra", time.Since(start))
+			return err
+		}
+		row.Transaction = t
+		if err := fn(row); err != nil {
+			span.RecordError(err)
+			return err
+		}
 	}
-	t.Amount = amount
 
-	pm, err := transactionVos.NewPaymentMethod(paymentMethodStr)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse payment_method: %w", err)
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "stream_for_export", "transaction", "infra", time.Since(start))
+		return err
 	}
-	t.PaymentMethod = pm
 
-	status, err := transactionVos.NewTransactionStatus(statusStr)
+	r.o11y.Logger().Debug(ctx, "query_completed",
+		observability.String("operation", "stream_for_export"),
+		observability.String("layer", "repository"),
+		observability.String("entity", "transaction"),
+	)
+	r.tm.RecordRepositoryQuery(ctx, "stream_for_export", "transaction", time.Since(start))
+	return nil
+}
+
+// ListByDateRange returns the active transactions of the user dated between from and to
+// (inclusive), with their splits and tags.
+func (r *transactionRepository) ListByDateRange(ctx context.Context, userID vos.UUID, from, to time.Time) ([]*entities.Transaction, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.list_by_date_range")
+	defer span.End()
+
+	query := `
+		SELECT id, user_id, category_id, subcategory_id, card_id,
+		       invoice_id, installment_group_id, description, amount, direction,
+		       payment_method, transaction_date, installment_number, installment_total,
+		       status, created_at, updated_at, deleted_at, external_id,
+		       original_currency, original_amount, exchange_rate, iof_amount, version
+		FROM transactions
+		WHERE user_id = $1
+		  AND deleted_at IS NULL
+		  AND status = 'active'
+		  AND transaction_date BETWEEN $2 AND $3
+		ORDER BY transaction_date ASC, id ASC`
+
+	rows, err := r.db.QueryContext(ctx, query, userID.Value, from, to)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse status: %w", err)
+		span.RecordError(err)
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "list_by_date_range"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_date_range", "transaction", "infra", time.Since(start))
+		return nil, err
 	}
-	t.Status = status
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "ListByDateRange: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
 
-	if subcategoryID != nil {
-		uid := vos.UUID{Value: *subcategoryID}
-		t.SubcategoryID = &uid
+	transactions := make([]*entities.Transaction, 0)
+	for rows.Next() {
+		t, err := r.scanTransaction(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.tm.RecordRepositoryFailure(ctx, "list_by_date_range", "transaction", "infra", time.Since(start))
+			return nil, err
+		}
+		transactions = append(transactions, t)
 	}
-	if cardID != nil {
-		uid := vos.UUID{Value: *cardID}
-		t.CardID = &uid
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_date_range", "transaction", "infra", time.Since(start))
+		return nil, err
 	}
-	if invoiceID != nil {
-		uid := vos.UUID{Value: *invoiceID}
-		t.InvoiceID = &uid
+
+	if err := r.loadRelations(ctx, transactions); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_date_range", "transaction", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "list_by_date_range", "transaction", time.Since(start))
+	return transactions, nil
+}
+
+// ListDueScheduled returns the scheduled transactions of every user dated up to today,
+// oldest first, starting right after the cursor (keyset on transaction_date, id), with
+// their splits and tags.
+func (r *transactionRepository) ListDueScheduled(ctx context.Context, today time.Time, after *interfaces.ScheduledCursor, limit int) ([]*entities.Transaction, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.list_due_scheduled")
+	defer span.End()
+
+	query := `
+		SELECT id, user_id, category_id, subcategory_id, card_id,
+		       invoice_id, installment_group_id, description, amount, direction,
+		       payment_method, transaction_date, installment_number, installment_total,
+		       status, created_at, updated_at, deleted_at, external_id,
+		       original_currency, original_amount, exchange_rate, iof_amount, version
+		FROM transactions
+		WHERE status = 'scheduled'
+		  AND deleted_at IS NULL
+		  AND transaction_date <= $1
+		  AND ($3::date IS NULL OR (transaction_date, id) > ($3::date, $4::uuid))
+		ORDER BY transaction_date ASC, id ASC
+		LIMIT $2`
+
+	var afterDate, afterID any
+	if after != nil {
+		afterDate = after.TransactionDate
+		afterID = after.ID.Value
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, today, limit, afterDate, afterID)
+	if err != nil {
+		span.RecordError(err)
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "list_due_scheduled"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		r.tm.RecordRepositoryFailure(ctx, "list_due_scheduled", "transaction", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "ListDueScheduled: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	transactions := make([]*entities.Transaction, 0)
+	for rows.Next() {
+		t, err := r.scanTransaction(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.tm.RecordRepositoryFailure(ctx, "list_due_scheduled", "transaction", "infra", time.Since(start))
+			return nil, err
+		}
+		transactions = append(transactions, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_due_scheduled", "transaction", "infra", time.Since(start))
+		return nil, err
+	}
+
+	if err := r.loadRelations(ctx, transactions); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_due_scheduled", "transaction", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "list_due_scheduled", "transaction", time.Since(start))
+	return transactions, nil
+}
+
+func (r *transactionRepository) ResolveScheduled(ctx context.Context, tx database.DBTX, t *entities.Transaction) (bool, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.resolve_scheduled")
+	defer span.End()
+
+	query := `
+		UPDATE transactions SET
+			status = $2,
+			transaction_date = $3,
+			updated_at = NOW(),
+			version = version + 1
+		WHERE id = $1
+		  AND deleted_at IS NULL
+		  AND status = 'scheduled'`
+
+	result, err := tx.ExecContext(ctx, query, t.ID.Value, t.Status.String(), t.TransactionDate)
+	if err != nil {
+		span.RecordError(err)
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "resolve_scheduled"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		r.tm.RecordRepositoryFailure(ctx, "resolve_scheduled", "transaction", "infra", time.Since(start))
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "resolve_scheduled", "transaction", "infra", time.Since(start))
+		return false, err
+	}
+
+	if affected == 1 {
+		t.Version++
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "resolve_scheduled", "transaction", time.Since(start))
+	return affected == 1, nil
+}
+
+// FindExistingExternalIDs returns which of the given external IDs were already imported by
+// the user, including transactions cancelled afterwards, so a re-import never recreates them.
+func (r *transactionRepository) FindExistingExternalIDs(ctx context.Context, userID vos.UUID, externalIDs []string) (map[string]bool, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.find_existing_external_ids")
+	defer span.End()
+
+	existing := make(map[string]bool, len(externalIDs))
+	if len(externalIDs) == 0 {
+		return existing, nil
+	}
+
+	placeholders := make([]string, len(externalIDs))
+	args := make([]any, 0, len(externalIDs)+1)
+	args = append(args, userID.Value)
+	for i, id := range externalIDs {
+		placeholders[i] = fmt.Sprintf("$%d", i+2)
+		args = append(args, id)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT external_id
+		FROM transactions
+		WHERE user_id = $1
+		  AND external_id IN (%s)`,
+		strings.Join(placeholders, ", "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		span.RecordError(err)
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "find_existing_external_ids"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		r.tm.RecordRepositoryFailure(ctx, "find_existing_external_ids", "transaction", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "FindExistingExternalIDs: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			span.RecordError(err)
+			r.tm.RecordRepositoryFailure(ctx, "find_existing_external_ids", "transaction", "infra", time.Since(start))
+			return nil, err
+		}
+		existing[id] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "find_existing_external_ids", "transaction", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "find_existing_external_ids", "transaction", time.Since(start))
+	return existing, nil
+}
+
+// saveSplits inserts the splits of a transaction in a single statement.
+func (r *transactionRepository) saveSplits(ctx context.Context, tx database.DBTX, t *entities.Transaction) error {
+	if len(t.Splits) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(t.Splits))
+	args := make([]any, 0, len(t.Splits)*6)
+	for i, split := range t.Splits {
+		n := i * 6
+		values = append(values, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4, n+5, n+6))
+		args = append(args,
+			split.ID.Value,
+			t.ID.Value,
+			split.CategoryID.Value,
+			optionalUUID(split.SubcategoryID),
+			split.Amount.Float(),
+			split.CreatedAt,
+		)
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO transaction_splits (
+			id, transaction_id, category_id, subcategory_id, amount, created_at
+		) VALUES %s`, strings.Join(values, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "save_splits"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// replaceSplits deletes the stored splits of a transaction and saves its current ones.
+func (r *transactionRepository) replaceSplits(ctx context.Context, tx database.DBTX, t *entities.Transaction) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM transaction_splits WHERE transaction_id = $1`, t.ID.Value); err != nil {
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "replace_splits"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		return err
+	}
+	return r.saveSplits(ctx, tx, t)
+}
+
+// loadSplits fills the splits of the given transactions with a single query. Split IDs
+// are UUIDv7, so ordering by id keeps the order in which the splits were entered.
+func (r *transactionRepository) loadSplits(ctx context.Context, ts []*entities.Transaction) error {
+	if len(ts) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.Transaction, len(ts))
+	placeholders := make([]string, len(ts))
+	args := make([]any, len(ts))
+	for i, t := range ts {
+		byID[t.ID.Value] = t
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = t.ID.Value
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, transaction_id, category_id, subcategory_id, amount, created_at
+		FROM transaction_splits
+		WHERE transaction_id IN (%s)
+		ORDER BY transaction_id, id`,
+		strings.Join(placeholders, ", "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "load_splits"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		return err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.o11y.Logger().Error(ctx, "loadSplits: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	for rows.Next() {
+		var split entities.TransactionSplit
+		var subcategoryID *uuid.UUID
+		var amountStr string
+		if err := rows.Scan(
+			&split.ID.Value,
+			&split.TransactionID.Value,
+			&split.CategoryID.Value,
+			&subcategoryID,
+			&amountStr,
+			&split.CreatedAt,
+		); err != nil {
+			return err
+		}
+		amount, err := vos.NewMoneyFromString(amountStr, vos.CurrencyBRL)
+		if err != nil {
+			return fmt.Errorf("failed to parse split amount: %w", err)
+		}
+		split.Amount = amount
+		if subcategoryID != nil {
+			uid := vos.UUID{Value: *subcategoryID}
+			split.SubcategoryID = &uid
+		}
+		if t, ok := byID[split.TransactionID.Value]; ok {
+			t.Splits = append(t.Splits, &split)
+		}
+	}
+	return rows.Err()
+}
+
+// loadRelations fills the splits and the tags of the given transactions.
+func (r *transactionRepository) loadRelations(ctx context.Context, ts []*entities.Transaction) error {
+	if err := r.loadSplits(ctx, ts); err != nil {
+		return err
+	}
+	return r.loadTags(ctx, ts)
+}
+
+// SetTags replaces the stored tags of each transaction with its current ones.
+func (r *transactionRepository) SetTags(ctx context.Context, tx database.DBTX, ts []*entities.Transaction) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.set_tags")
+	defer span.End()
+
+	for _, t := range ts {
+		if err := r.replaceTags(ctx, tx, t); err != nil {
+			span.RecordError(err)
+			r.tm.RecordRepositoryFailure(ctx, "set_tags", "transaction", "infra", time.Since(start))
+			return err
+		}
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "set_tags", "transaction", time.Since(start))
+	return nil
+}
+
+// AddTags links every tag to every transaction in a single statement; links that
+// already exist are kept as they are.
+func (r *transactionRepository) AddTags(ctx context.Context, tx database.DBTX, transactionIDs, tagIDs []vos.UUID) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_repository.add_tags")
+	defer span.End()
+
+	if len(transactionIDs) == 0 || len(tagIDs) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(transactionIDs)*len(tagIDs))
+	args := make([]any, 0, len(transactionIDs)*len(tagIDs)*2)
+	for _, transactionID := range transactionIDs {
+		for _, tagID := range tagIDs {
+			values = append(values, fmt.Sprintf("($%d, $%d)", len(args)+1, len(args)+2))
+			args = append(args, transactionID.Value, tagID.Value)
+		}
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO transaction_tags (transaction_id, tag_id)
+		VALUES %s
+		ON CONFLICT (transaction_id, tag_id) DO NOTHING`,
+		strings.Join(values, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		span.RecordError(err)
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "add_tags"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		r.tm.RecordRepositoryFailure(ctx, "add_tags", "transaction", "infra", time.Since(start))
+		return err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "add_tags", "transaction", time.Since(start))
+	return nil
+}
+
+// saveTags links the tags of a transaction in a single statement.
+func (r *transactionRepository) saveTags(ctx context.Context, tx database.DBTX, t *entities.Transaction) error {
+	if len(t.Tags) == 0 {
+		return nil
+	}
+
+	values := make([]string, 0, len(t.Tags))
+	args := make([]any, 0, len(t.Tags)+1)
+	args = append(args, t.ID.Value)
+	for _, tag := range t.Tags {
+		args = append(args, tag.ID.Value)
+		values = append(values, fmt.Sprintf("($1, $%d)", len(args)))
+	}
+
+	query := fmt.Sprintf(`
+		INSERT INTO transaction_tags (transaction_id, tag_id)
+		VALUES %s`, strings.Join(values, ", "))
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "save_tags"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// replaceTags deletes the stored tags of a transaction and saves its current ones.
+func (r *transactionRepository) replaceTags(ctx context.Context, tx database.DBTX, t *entities.Transaction) error {
+	if _, err := tx.ExecContext(ctx, `DELETE FROM transaction_tags WHERE transaction_id = $1`, t.ID.Value); err != nil {
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "replace_tags"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		return err
+	}
+	return r.saveTags(ctx, tx, t)
+}
+
+// loadTags fills the tags of the given transactions with a single query, ordered by name.
+func (r *transactionRepository) loadTags(ctx context.Context, ts []*entities.Transaction) error {
+	if len(ts) == 0 {
+		return nil
+	}
+
+	byID := make(map[uuid.UUID]*entities.Transaction, len(ts))
+	placeholders := make([]string, len(ts))
+	args := make([]any, len(ts))
+	for i, t := range ts {
+		byID[t.ID.Value] = t
+		placeholders[i] = fmt.Sprintf("$%d", i+1)
+		args[i] = t.ID.Value
+	}
+
+	query := fmt.Sprintf(`
+		SELECT tt.transaction_id, tg.id, tg.user_id, tg.name, tg.created_at, tg.updated_at, tg.deleted_at
+		FROM transaction_tags tt
+		JOIN tags tg ON tg.id = tt.tag_id AND tg.deleted_at IS NULL
+		WHERE tt.transaction_id IN (%s)
+		ORDER BY tt.transaction_id, tg.name`,
+		strings.Join(placeholders, ", "))
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		r.o11y.Logger().Error(ctx, "query_failed",
+			observability.String("operation", "load_tags"),
+			observability.String("layer", "repository"),
+			observability.String("entity", "transaction"),
+			observability.Error(err),
+		)
+		return err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			r.o11y.Logger().Error(ctx, "loadTags: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	for rows.Next() {
+		var transactionID uuid.UUID
+		var tag entities.Tag
+		if err := rows.Scan(
+			&transactionID,
+			&tag.ID.Value,
+			&tag.UserID.Value,
+			&tag.Name,
+			&tag.CreatedAt,
+			&tag.UpdatedAt,
+			&tag.DeletedAt,
+		); err != nil {
+			return err
+		}
+		if t, ok := byID[transactionID]; ok {
+			t.Tags = append(t.Tags, &tag)
+		}
+	}
+	return rows.Err()
+}
+
+type transactionScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanTransaction scans the transaction columns followed by the optional extra columns.
+func (r *transactionRepository) scanTransaction(s transactionScanner, extra ...any) (*entities.Transaction, error) {
+	var t entities.Transaction
+	var subcategoryID, cardID, invoiceID, installmentGroupID *uuid.UUID
+	var installmentNumber, installmentTotal *int
+	var updatedAt, deletedAt *time.Time
+	var amountStr string
+	var directionStr, paymentMethodStr, statusStr string
+	var originalCurrency, originalAmountStr, exchangeRateStr, iofAmountStr *string
+
+	dest := []any{
+		&t.ID.Value,
+		&t.UserID.Value,
+		&t.CategoryID.Value,
+		&subcategoryID,
+		&cardID,
+		&invoiceID,
+		&installmentGroupID,
+		&t.Description,
+		&amountStr,
+		&directionStr,
+		&paymentMethodStr,
+		&t.TransactionDate,
+		&installmentNumber,
+		&installmentTotal,
+		&statusStr,
+		&t.CreatedAt,
+		&updatedAt,
+		&deletedAt,
+		&t.ExternalID,
+		&originalCurrency,
+		&originalAmountStr,
+		&exchangeRateStr,
+		&iofAmountStr,
+		&t.Version,
+	}
+	if err := s.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	amount, err := vos.NewMoneyFromString(amountStr, vos.CurrencyBRL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse amount: %w", err)
+	}
+	t.Amount = amount
+
+	direction, err := transactionVos.NewTransactionDirection(directionStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse direction: %w", err)
+	}
+	t.Direction = direction
+
+	pm, err := transactionVos.NewPaymentMethod(paymentMethodStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse payment_method: %w", err)
+	}
+	t.PaymentMethod = pm
+
+	status, err := transactionVos.NewTransactionStatus(statusStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse status: %w", err)
+	}
+	t.Status = status
+
+	if subcategoryID != nil {
+		uid := vos.UUID{Value: *subcategoryID}
+		t.SubcategoryID = &uid
+	}
+	if cardID != nil {
+		uid := vos.UUID{Value: *cardID}
+		t.CardID = &uid
+	}
+	if invoiceID != nil {
+		uid := vos.UUID{Value: *invoiceID}
+		t.InvoiceID = &uid
 	}
 	if installmentGroupID != nil {
 		uid := vos.UUID{Value: *installmentGroupID}
 		t.InstallmentGroupID = &uid
 	}
 
+	if originalCurrency != nil && originalAmountStr != nil && exchangeRateStr != nil {
+		if err := scanForeignAmount(&t, *originalCurrency, *originalAmountStr, *exchangeRateStr, iofAmountStr); err != nil {
+			return nil, err
+		}
+	}
+
 	t.InstallmentNumber = installmentNumber
 	t.InstallmentTotal = installmentTotal
 	t.UpdatedAt = updatedAt
@@ -564,6 +1432,61 @@ func (r *transactionRepository) scanTransaction(s transactionScanner) (*entities
 	return &t, nil
 }
 
+// scanForeignAmount sets the original amount, exchange rate and IOF of a foreign
+// currency transaction.
+func scanForeignAmount(t *entities.Transaction, currencyStr, originalAmountStr, exchangeRateStr string, iofAmountStr *string) error {
+	currency, err := vos.NewCurrency(strings.TrimSpace(currencyStr))
+	if err != nil {
+		return fmt.Errorf("failed to parse original_currency: %w", err)
+	}
+	originalAmount, err := vos.NewMoneyFromString(originalAmountStr, currency)
+	if err != nil {
+		return fmt.Errorf("failed to parse original_amount: %w", err)
+	}
+	rateValue, err := strconv.ParseFloat(exchangeRateStr, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse exchange_rate: %w", err)
+	}
+	rate, err := transactionVos.NewExchangeRate(rateValue)
+	if err != nil {
+		return fmt.Errorf("failed to parse exchange_rate: %w", err)
+	}
+	t.OriginalAmount = &originalAmount
+	t.ExchangeRate = &rate
+	if iofAmountStr != nil {
+		iofAmount, err := vos.NewMoneyFromString(*iofAmountStr, vos.CurrencyBRL)
+		if err != nil {
+			return fmt.Errorf("failed to parse iof_amount: %w", err)
+		}
+		t.IOFAmount = &iofAmount
+	}
+	return nil
+}
+
+func originalCurrency(t *entities.Transaction) *string {
+	if t.OriginalAmount == nil {
+		return nil
+	}
+	currency := t.OriginalAmount.Currency().String()
+	return &currency
+}
+
+func optionalMoney(m *vos.Money) *float64 {
+	if m == nil {
+		return nil
+	}
+	value := m.Float()
+	return &value
+}
+
+func optionalExchangeRate(r *transactionVos.ExchangeRate) *float64 {
+	if r == nil {
+		return nil
+	}
+	value := r.Float()
+	return &value
+}
+
 func optionalUUID(u *vos.UUID) *uuid.UUID {
 	if u == nil {
 		return nil
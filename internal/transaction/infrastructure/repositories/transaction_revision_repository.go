@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+	"github.com/JailtonJunior94/devkit-go/pkg/vos"
+	"github.com/google/uuid"
+
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/entities"
+	"github.com/jailtonjunior94/financial/internal/transaction/domain/interfaces"
+	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
+)
+
+const transactionRevisionColumns = `id, transaction_id, user_id, changed_by, action, changes, created_at`
+
+type transactionRevisionRepository struct {
+	db   database.DBTX
+	o11y observability.Observability
+	tm   *metrics.TransactionMetrics
+}
+
+// NewTransactionRevisionRepository creates a new TransactionRevisionRepository.
+func NewTransactionRevisionRepository(db database.DBTX, o11y observability.Observability, tm *metrics.TransactionMetrics) interfaces.TransactionRevisionRepository {
+	return &transactionRevisionRepository{db: db, o11y: o11y, tm: tm}
+}
+
+func (r *transactionRevisionRepository) SaveAll(ctx context.Context, tx database.DBTX, revisions []*entities.TransactionRevision) error {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_revision_repository.save_all")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		INSERT INTO transaction_revisions (%s)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		transactionRevisionColumns)
+
+	for _, revision := range revisions {
+		changes, err := json.Marshal(revision.Changes)
+		if err != nil {
+			span.RecordError(err)
+			return fmt.Errorf("failed to encode changes: %w", err)
+		}
+		_, err = tx.ExecContext(ctx, query,
+			revision.ID.Value,
+			revision.TransactionID.Value,
+			revision.UserID.Value,
+			optionalUUID(revision.ChangedBy),
+			revision.Action,
+			changes,
+			revision.CreatedAt,
+		)
+		if err != nil {
+			span.RecordError(err)
+			r.logFailure(ctx, "save_all", err)
+			r.tm.RecordRepositoryFailure(ctx, "save_all", "transaction_revision", "infra", time.Since(start))
+			return err
+		}
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "save_all", "transaction_revision", time.Since(start))
+	return nil
+}
+
+func (r *transactionRevisionRepository) ListByTransaction(ctx context.Context, transactionID vos.UUID) ([]*entities.TransactionRevision, error) {
+	start := time.Now()
+	ctx, span := r.o11y.Tracer().Start(ctx, "transaction_revision_repository.list_by_transaction")
+	defer span.End()
+
+	query := fmt.Sprintf(`
+		SELECT %s
+		FROM transaction_revisions
+		WHERE transaction_id = $1
+		ORDER BY created_at ASC, id ASC`,
+		transactionRevisionColumns)
+
+	rows, err := r.db.QueryContext(ctx, query, transactionID.Value)
+	if err != nil {
+		span.RecordError(err)
+		r.logFailure(ctx, "list_by_transaction", err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_transaction", "transaction_revision", "infra", time.Since(start))
+		return nil, err
+	}
+	defer func() {
+		if closeErr := rows.Close(); closeErr != nil {
+			span.RecordError(closeErr)
+			r.o11y.Logger().Error(ctx, "TransactionRevisionRepository: failed to close rows",
+				observability.Error(closeErr),
+			)
+		}
+	}()
+
+	revisions := make([]*entities.TransactionRevision, 0)
+	for rows.Next() {
+		revision, err := scanTransactionRevision(rows)
+		if err != nil {
+			span.RecordError(err)
+			r.logFailure(ctx, "list_by_transaction", err)
+			r.tm.RecordRepositoryFailure(ctx, "list_by_transaction", "transaction_revision", "infra", time.Since(start))
+			return nil, err
+		}
+		revisions = append(revisions, revision)
+	}
+
+	if err := rows.Err(); err != nil {
+		span.RecordError(err)
+		r.tm.RecordRepositoryFailure(ctx, "list_by_transaction", "transaction_revision", "infra", time.Since(start))
+		return nil, err
+	}
+
+	r.tm.RecordRepositoryQuery(ctx, "list_by_transaction", "transaction_revision", time.Since(start))
+	return revisions, nil
+}
+
+func (r *transactionRevisionRepository) logFailure(ctx context.Context, operation string, err error) {
+	r.o11y.Logger().Error(ctx, "query_failed",
+		observability.String("operation", operation),
+		observability.String("layer", "repository"),
+		observability.String("entity", "transaction_revision"),
+		observability.Error(err),
+	)
+}
+
+func scanTransactionRevision(s transactionScanner) (*entities.TransactionRevision, error) {
+	var revision entities.TransactionRevision
+	var changedBy *uuid.UUID
+	var changes []byte
+	if err := s.Scan(
+		&revision.ID.Value,
+		&revision.TransactionID.Value,
+		&revision.UserID.Value,
+		&changedBy,
+		&revision.Action,
+		&changes,
+		&revision.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if changedBy != nil {
+		revision.ChangedBy = &vos.UUID{Value: *changedBy}
+	}
+	if err := json.Unmarshal(changes, &revision.Changes); err != nil {
+		return nil, fmt.Errorf("failed to parse changes: %w", err)
+	}
+	return &revision, nil
+}
@@ -14,13 +14,25 @@ import (
 	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
 	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
 	"github.com/jailtonjunior94/financial/pkg/auth"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
 	"github.com/jailtonjunior94/financial/pkg/observability/metrics"
 	"github.com/jailtonjunior94/financial/pkg/outbox"
+	"github.com/jailtonjunior94/financial/pkg/storage"
 )
 
 // TransactionModule wires the transaction bounded context.
 type TransactionModule struct {
-	TransactionRouter *transactionhttp.TransactionRouter
+	TransactionRouter          *transactionhttp.TransactionRouter
+	RecurringTransactionRouter *transactionhttp.RecurringTransactionRouter
+	TagRouter                  *transactionhttp.TagRouter
+	AttachmentRouter           *transactionhttp.AttachmentRouter
+	CategorizationRuleRouter   *transactionhttp.CategorizationRuleRouter
+	DuplicateRouter            *transactionhttp.DuplicateRouter
+	RefundRouter               *transactionhttp.RefundRouter
+	InstallmentGroupRouter     *transactionhttp.InstallmentGroupRouter
+	ScheduledTransactionRouter *transactionhttp.ScheduledTransactionRouter
+	ExchangeRateRouter         *transactionhttp.ExchangeRateRouter
+	TransactionHistoryRouter   *transactionhttp.TransactionHistoryRouter
 }
 
 // NewTransactionModule creates and wires all dependencies for the transaction module.
@@ -30,29 +42,124 @@ func NewTransactionModule(
 	tokenValidator auth.TokenValidator,
 	invoiceProvider transactionInterfaces.InvoiceProvider,
 	cardProvider invoiceInterfaces.CardProvider,
+	categoryProvider transactionInterfaces.CategoryProvider,
 	outboxService outbox.Service,
+	blobStore storage.BlobStore,
 ) (TransactionModule, error) {
 	errorHandler := httperrors.NewErrorHandler(o11y, ErrorMappings())
 	authMiddleware := middlewares.NewAuthorization(tokenValidator, o11y, errorHandler)
+	idempotencyMiddleware := middlewares.NewIdempotency(idempotency.NewRepository(db, o11y), idempotency.DefaultTTL, o11y, errorHandler)
 
 	transactionMetrics := metrics.NewTransactionMetrics(o11y)
 	transactionRepository := repositories.NewTransactionRepository(db, o11y, transactionMetrics)
+	recurringRepository := repositories.NewRecurringTransactionRepository(db, o11y, transactionMetrics)
+	tagRepository := repositories.NewTagRepository(db, o11y, transactionMetrics)
+	attachmentRepository := repositories.NewAttachmentRepository(db, o11y, transactionMetrics)
+	categorizationRuleRepository := repositories.NewCategorizationRuleRepository(db, o11y, transactionMetrics)
+	refundRepository := repositories.NewRefundRepository(db, o11y, transactionMetrics)
+	payoffRepository := repositories.NewInstallmentPayoffRepository(db, o11y, transactionMetrics)
+	exchangeRateRepository := repositories.NewExchangeRateRepository(db, o11y, transactionMetrics)
+	revisionRepository := repositories.NewTransactionRevisionRepository(db, o11y, transactionMetrics)
 
 	unitOfWork, err := uow.NewUnitOfWork(db)
 	if err != nil {
 		return TransactionModule{}, err
 	}
 
-	createUC := usecase.NewCreateTransactionUseCase(o11y, unitOfWork, transactionRepository, invoiceProvider, cardProvider, outboxService)
-	updateUC := usecase.NewUpdateTransactionUseCase(o11y, unitOfWork, transactionRepository, invoiceProvider)
-	reverseUC := usecase.NewReverseTransactionUseCase(o11y, unitOfWork, transactionRepository, invoiceProvider)
+	createUC := usecase.NewCreateTransactionUseCase(o11y, unitOfWork, transactionRepository, tagRepository, categorizationRuleRepository, exchangeRateRepository, revisionRepository, invoiceProvider, cardProvider, outboxService)
+	updateUC := usecase.NewUpdateTransactionUseCase(o11y, unitOfWork, transactionRepository, revisionRepository, tagRepository, invoiceProvider, outboxService)
+	reverseUC := usecase.NewReverseTransactionUseCase(o11y, unitOfWork, transactionRepository, revisionRepository, invoiceProvider, outboxService)
 	listUC := usecase.NewListTransactionsUseCase(o11y, transactionRepository)
-	getUC := usecase.NewGetTransactionUseCase(o11y, transactionRepository)
+	getUC := usecase.NewGetTransactionUseCase(o11y, transactionRepository, refundRepository)
+	exportUC := usecase.NewExportTransactionsUseCase(o11y, transactionRepository)
+	importUC := usecase.NewImportTransactionsUseCase(o11y, unitOfWork, transactionRepository, tagRepository, categorizationRuleRepository, exchangeRateRepository, revisionRepository, invoiceProvider, cardProvider, categoryProvider, outboxService)
 
-	transactionHandler := transactionhttp.NewTransactionHandler(o11y, errorHandler, createUC, updateUC, reverseUC, listUC, getUC)
-	transactionRouter := transactionhttp.NewTransactionRouter(transactionHandler, authMiddleware)
+	transactionHandler := transactionhttp.NewTransactionHandler(o11y, errorHandler, createUC, updateUC, reverseUC, listUC, getUC, importUC, exportUC)
+	transactionRouter := transactionhttp.NewTransactionRouter(transactionHandler, authMiddleware, idempotencyMiddleware)
+
+	createRecurringUC := usecase.NewCreateRecurringTransactionUseCase(o11y, unitOfWork, recurringRepository, cardProvider)
+	updateRecurringUC := usecase.NewUpdateRecurringTransactionUseCase(o11y, unitOfWork, recurringRepository)
+	deleteRecurringUC := usecase.NewDeleteRecurringTransactionUseCase(o11y, unitOfWork, recurringRepository)
+	listRecurringUC := usecase.NewListRecurringTransactionsUseCase(o11y, recurringRepository)
+	getRecurringUC := usecase.NewGetRecurringTransactionUseCase(o11y, recurringRepository)
+
+	recurringHandler := transactionhttp.NewRecurringTransactionHandler(o11y, errorHandler, createRecurringUC, updateRecurringUC, deleteRecurringUC, listRecurringUC, getRecurringUC)
+	recurringRouter := transactionhttp.NewRecurringTransactionRouter(recurringHandler, authMiddleware, idempotencyMiddleware)
+
+	createTagUC := usecase.NewCreateTagUseCase(o11y, unitOfWork, tagRepository)
+	updateTagUC := usecase.NewUpdateTagUseCase(o11y, unitOfWork, tagRepository)
+	deleteTagUC := usecase.NewDeleteTagUseCase(o11y, unitOfWork, tagRepository)
+	listTagsUC := usecase.NewListTagsUseCase(o11y, tagRepository)
+	tagSummaryUC := usecase.NewGetTagSummaryUseCase(o11y, tagRepository)
+	applyTagsUC := usecase.NewApplyTagsUseCase(o11y, unitOfWork, transactionRepository, tagRepository)
+
+	tagHandler := transactionhttp.NewTagHandler(o11y, errorHandler, createTagUC, updateTagUC, deleteTagUC, listTagsUC, tagSummaryUC, applyTagsUC)
+	tagRouter := transactionhttp.NewTagRouter(tagHandler, authMiddleware, idempotencyMiddleware)
+
+	uploadAttachmentUC := usecase.NewUploadAttachmentUseCase(o11y, unitOfWork, attachmentRepository, transactionRepository, invoiceProvider, blobStore)
+	listAttachmentsUC := usecase.NewListAttachmentsUseCase(o11y, attachmentRepository, transactionRepository, invoiceProvider)
+	downloadAttachmentUC := usecase.NewDownloadAttachmentUseCase(o11y, attachmentRepository, transactionRepository, invoiceProvider, blobStore)
+	deleteAttachmentUC := usecase.NewDeleteAttachmentUseCase(o11y, unitOfWork, attachmentRepository, transactionRepository, invoiceProvider, blobStore)
+
+	attachmentHandler := transactionhttp.NewAttachmentHandler(o11y, errorHandler, uploadAttachmentUC, listAttachmentsUC, downloadAttachmentUC, deleteAttachmentUC)
+	attachmentRouter := transactionhttp.NewAttachmentRouter(attachmentHandler, authMiddleware, idempotencyMiddleware)
+
+	createRuleUC := usecase.NewCreateCategorizationRuleUseCase(o11y, unitOfWork, categorizationRuleRepository, tagRepository)
+	updateRuleUC := usecase.NewUpdateCategorizationRuleUseCase(o11y, unitOfWork, categorizationRuleRepository, tagRepository)
+	deleteRuleUC := usecase.NewDeleteCategorizationRuleUseCase(o11y, unitOfWork, categorizationRuleRepository)
+	listRulesUC := usecase.NewListCategorizationRulesUseCase(o11y, categorizationRuleRepository)
+	previewRulesUC := usecase.NewPreviewCategorizationRulesUseCase(o11y, categorizationRuleRepository)
+	applyRulesUC := usecase.NewApplyCategorizationRulesUseCase(o11y, unitOfWork, transactionRepository, revisionRepository, categorizationRuleRepository, invoiceProvider, outboxService)
+
+	ruleHandler := transactionhttp.NewCategorizationRuleHandler(o11y, errorHandler, createRuleUC, updateRuleUC, deleteRuleUC, listRulesUC, previewRulesUC, applyRulesUC)
+	ruleRouter := transactionhttp.NewCategorizationRuleRouter(ruleHandler, authMiddleware, idempotencyMiddleware)
+
+	listDuplicatesUC := usecase.NewListDuplicatesUseCase(o11y, transactionRepository)
+	mergeTransactionsUC := usecase.NewMergeTransactionsUseCase(o11y, unitOfWork, transactionRepository, revisionRepository, attachmentRepository, invoiceProvider, outboxService)
+
+	duplicateHandler := transactionhttp.NewDuplicateHandler(o11y, errorHandler, listDuplicatesUC, mergeTransactionsUC)
+	duplicateRouter := transactionhttp.NewDuplicateRouter(duplicateHandler, authMiddleware, idempotencyMiddleware)
+
+	refundUC := usecase.NewRefundTransactionUseCase(o11y, unitOfWork, transactionRepository, refundRepository, invoiceProvider, cardProvider, outboxService)
+
+	refundHandler := transactionhttp.NewRefundHandler(o11y, errorHandler, refundUC)
+	refundRouter := transactionhttp.NewRefundRouter(refundHandler, authMiddleware, idempotencyMiddleware)
+
+	updateGroupUC := usecase.NewUpdateInstallmentGroupUseCase(o11y, unitOfWork, transactionRepository, revisionRepository, invoiceProvider, cardProvider, outboxService)
+	payOffUC := usecase.NewPayOffInstallmentsUseCase(o11y, unitOfWork, transactionRepository, revisionRepository, payoffRepository, invoiceProvider, cardProvider, outboxService)
+
+	installmentGroupHandler := transactionhttp.NewInstallmentGroupHandler(o11y, errorHandler, updateGroupUC, payOffUC)
+	installmentGroupRouter := transactionhttp.NewInstallmentGroupRouter(installmentGroupHandler, authMiddleware, idempotencyMiddleware)
+
+	confirmScheduledUC := usecase.NewConfirmScheduledTransactionUseCase(o11y, unitOfWork, transactionRepository, revisionRepository, outboxService)
+	skipScheduledUC := usecase.NewSkipScheduledTransactionUseCase(o11y, unitOfWork, transactionRepository, revisionRepository, outboxService)
+
+	scheduledHandler := transactionhttp.NewScheduledTransactionHandler(o11y, errorHandler, confirmScheduledUC, skipScheduledUC)
+	scheduledRouter := transactionhttp.NewScheduledTransactionRouter(scheduledHandler, authMiddleware, idempotencyMiddleware)
+
+	saveExchangeRateUC := usecase.NewSaveExchangeRateUseCase(o11y, unitOfWork, exchangeRateRepository)
+	listExchangeRatesUC := usecase.NewListExchangeRatesUseCase(o11y, exchangeRateRepository)
+	importExchangeRatesUC := usecase.NewImportExchangeRatesUseCase(o11y, unitOfWork, exchangeRateRepository)
+
+	exchangeRateHandler := transactionhttp.NewExchangeRateHandler(o11y, errorHandler, saveExchangeRateUC, listExchangeRatesUC, importExchangeRatesUC)
+	exchangeRateRouter := transactionhttp.NewExchangeRateRouter(exchangeRateHandler, authMiddleware, idempotencyMiddleware)
+
+	historyUC := usecase.NewGetTransactionHistoryUseCase(o11y, transactionRepository, revisionRepository)
+
+	historyHandler := transactionhttp.NewTransactionHistoryHandler(o11y, errorHandler, historyUC)
+	historyRouter := transactionhttp.NewTransactionHistoryRouter(historyHandler, authMiddleware)
 
 	return TransactionModule{
-		TransactionRouter: transactionRouter,
+		TransactionRouter:          transactionRouter,
+		RecurringTransactionRouter: recurringRouter,
+		TagRouter:                  tagRouter,
+		AttachmentRouter:           attachmentRouter,
+		CategorizationRuleRouter:   ruleRouter,
+		DuplicateRouter:            duplicateRouter,
+		RefundRouter:               refundRouter,
+		InstallmentGroupRouter:     installmentGroupRouter,
+		ScheduledTransactionRouter: scheduledRouter,
+		ExchangeRateRouter:         exchangeRateRouter,
+		TransactionHistoryRouter:   historyRouter,
 	}, nil
 }
@@ -0,0 +1,41 @@
+package http
+
+import (
+	stdhttp "net/http"
+	"strconv"
+	"strings"
+
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
+)
+
+// ETag retorna a versão do recurso como entity tag forte.
+func ETag(version int) string {
+	return `"` + strconv.Itoa(version) + `"`
+}
+
+// SetETag escreve a versão do recurso no header ETag da resposta.
+func SetETag(w stdhttp.ResponseWriter, version int) {
+	w.Header().Set("ETag", ETag(version))
+}
+
+// IfMatch retorna a versão exigida pelo header If-Match da requisição.
+// Retorna nil quando o header está ausente ou é "*", mantendo a última escrita.
+// Uma entity tag que não corresponde a nenhuma versão (fraca, lista ou malformada)
+// nunca é satisfeita e resulta em ErrPreconditionFailed.
+func IfMatch(r *stdhttp.Request) (*int, error) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	if len(value) < 2 || !strings.HasPrefix(value, `"`) || !strings.HasSuffix(value, `"`) {
+		return nil, customerrors.ErrPreconditionFailed
+	}
+
+	version, err := strconv.Atoi(value[1 : len(value)-1])
+	if err != nil || version < 1 {
+		return nil, customerrors.ErrPreconditionFailed
+	}
+
+	return &version, nil
+}
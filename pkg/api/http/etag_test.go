@@ -0,0 +1,55 @@
+package http_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	apihttp "github.com/jailtonjunior94/financial/pkg/api/http"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
+)
+
+func TestSetETag(t *testing.T) {
+	w := httptest.NewRecorder()
+
+	apihttp.SetETag(w, 3)
+
+	assert.Equal(t, `"3"`, w.Header().Get("ETag"))
+}
+
+func TestIfMatch(t *testing.T) {
+	scenarios := []struct {
+		name    string
+		header  string
+		version *int
+		err     error
+	}{
+		{name: "should return nil without the header", header: ""},
+		{name: "should return nil for any version", header: "*"},
+		{name: "should parse a strong entity tag", header: `"7"`, version: intPtr(7)},
+		{name: "should reject a weak entity tag", header: `W/"7"`, err: customerrors.ErrPreconditionFailed},
+		{name: "should reject an unquoted version", header: "7", err: customerrors.ErrPreconditionFailed},
+		{name: "should reject a list of entity tags", header: `"6", "7"`, err: customerrors.ErrPreconditionFailed},
+		{name: "should reject a non numeric entity tag", header: `"abc"`, err: customerrors.ErrPreconditionFailed},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/cards/1", nil)
+			if scenario.header != "" {
+				req.Header.Set("If-Match", scenario.header)
+			}
+
+			version, err := apihttp.IfMatch(req)
+
+			assert.ErrorIs(t, err, scenario.err)
+			assert.Equal(t, scenario.version, version)
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
@@ -129,6 +129,10 @@ func buildDomainErrorMappings() map[error]ErrorMapping {
 			Status:  http.StatusBadRequest,
 			Message: "Value cannot be more than 255 characters",
 		},
+		customerrors.ErrIdempotencyKeyTooLong: {
+			Status:  http.StatusBadRequest,
+			Message: "Idempotency-Key cannot be more than 255 characters",
+		},
 
 		// Not found errors → 404 Not Found
 		customerrors.ErrSubcategoryNotFound: {
@@ -141,6 +145,28 @@ func buildDomainErrorMappings() map[error]ErrorMapping {
 			Status:  http.StatusUnprocessableEntity,
 			Message: "Invalid parent category",
 		},
+		customerrors.ErrIdempotencyKeyReused: {
+			Status:  http.StatusUnprocessableEntity,
+			Message: "Idempotency-Key was already used with a different request",
+		},
+
+		// Precondition errors → 412 Precondition Failed
+		customerrors.ErrPreconditionFailed: {
+			Status:  http.StatusPreconditionFailed,
+			Message: "The resource was modified since it was read; fetch it again and retry",
+		},
+
+		// Payload errors → 413 Request Entity Too Large
+		customerrors.ErrIdempotentBodyTooLarge: {
+			Status:  http.StatusRequestEntityTooLarge,
+			Message: "Request body exceeds the maximum size accepted with Idempotency-Key",
+		},
+
+		// Conflict errors → 409 Conflict
+		customerrors.ErrIdempotencyKeyInProgress: {
+			Status:  http.StatusConflict,
+			Message: "A request with this Idempotency-Key is still in progress",
+		},
 
 		// Authorization errors → 403 Forbidden
 		customerrors.ErrForbidden: {
@@ -0,0 +1,274 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	customerrors "github.com/jailtonjunior94/financial/pkg/custom_errors"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+)
+
+const (
+	// IdempotencyKeyHeader é o header com a chave informada pelo cliente.
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marca as respostas reaproveitadas de uma requisição anterior.
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodySize acompanha o maior limite dos handlers (upload de anexo: 10 MB do
+	// arquivo + 1 MB do multipart); o corpo é lido inteiro para o hash antes do handler.
+	maxIdempotentBodySize = 11 << 20
+)
+
+// replayedHeaders são os headers da resposta original armazenados e reenviados nas retentativas.
+var replayedHeaders = []string{"Content-Type", "ETag", "Location"}
+
+type (
+	// Idempotency define a interface para o middleware de idempotência.
+	Idempotency interface {
+		Idempotency(next http.Handler) http.Handler
+	}
+
+	idempotencyMiddleware struct {
+		repository   idempotency.Repository
+		ttl          time.Duration
+		o11y         observability.Observability
+		errorHandler httperrors.ErrorHandler
+	}
+
+	// responseRecorder repassa a resposta ao cliente guardando uma cópia para armazenamento.
+	responseRecorder struct {
+		http.ResponseWriter
+		status int
+		body   bytes.Buffer
+	}
+)
+
+// NewIdempotency cria uma nova instância do middleware de idempotência.
+// As respostas ficam disponíveis para retentativas durante ttl.
+func NewIdempotency(
+	repository idempotency.Repository,
+	ttl time.Duration,
+	o11y observability.Observability,
+	errorHandler httperrors.ErrorHandler,
+) Idempotency {
+	return &idempotencyMiddleware{
+		repository:   repository,
+		ttl:          ttl,
+		o11y:         o11y,
+		errorHandler: errorHandler,
+	}
+}
+
+// Idempotency é o middleware que honra o header Idempotency-Key por usuário.
+// Deve ser registrado após o middleware de autenticação.
+// Fluxo:
+// 1. Requisições sem o header ou com métodos seguros seguem sem alteração
+// 2. Reserva a chave com o hash do método, caminho, query string e corpo da requisição;
+// corpos acima de maxIdempotentBodySize retornam 413
+// 3. Se a chave já existe com outro hash, retorna 422
+// 4. Se a requisição original ainda está em andamento, retorna 409
+// 5. Se a resposta original já foi armazenada, ela é reenviada com seus headers (Content-Type, ETag, Location)
+// 6. Caso contrário, chama o próximo handler e armazena a resposta; respostas 5xx liberam a chave.
+func (m *idempotencyMiddleware) Idempotency(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(IdempotencyKeyHeader)
+		if key == "" || isSafeMethod(r.Method) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := r.Context()
+
+		if len(key) > maxIdempotencyKeyLength {
+			m.errorHandler.HandleError(w, r, customerrors.ErrIdempotencyKeyTooLong)
+			return
+		}
+
+		user, err := GetUserFromContext(ctx)
+		if err != nil {
+			m.errorHandler.HandleError(w, r, err)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxIdempotentBodySize))
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				err = customerrors.ErrIdempotentBodyTooLarge
+			}
+			m.errorHandler.HandleError(w, r, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		now := time.Now().UTC()
+		record := &idempotency.Record{
+			UserID:      user.ID,
+			Key:         key,
+			RequestHash: hashRequest(r, body),
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(m.ttl),
+		}
+
+		claimed, err := m.repository.Claim(ctx, record)
+		if err != nil {
+			m.errorHandler.HandleError(w, r, err)
+			return
+		}
+
+		if !claimed {
+			m.replay(w, r, record)
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: w}
+		next.ServeHTTP(recorder, r)
+
+		// O cliente pode ter derrubado a conexão; a chave precisa ser liberada ou concluída
+		// mesmo assim, senão as retentativas recebem 409 até a chave expirar.
+		ctx = context.WithoutCancel(ctx)
+
+		if recorder.status == 0 || recorder.status >= http.StatusInternalServerError {
+			if err := m.repository.Release(ctx, user.ID, key); err != nil {
+				m.o11y.Logger().Warn(ctx, "idempotency_release_failed",
+					observability.Error(err),
+					observability.String("user_id", user.ID),
+				)
+			}
+			return
+		}
+
+		if err := m.repository.Complete(ctx, user.ID, key, recorder.status, storedHeaders(recorder.Header()), recorder.body.Bytes()); err != nil {
+			m.o11y.Logger().Warn(ctx, "idempotency_complete_failed",
+				observability.Error(err),
+				observability.String("user_id", user.ID),
+			)
+		}
+	})
+}
+
+// replay responde a uma requisição cuja chave já foi reservada por outra.
+func (m *idempotencyMiddleware) replay(w http.ResponseWriter, r *http.Request, record *idempotency.Record) {
+	ctx := r.Context()
+
+	stored, err := m.repository.Find(ctx, record.UserID, record.Key)
+	if err != nil {
+		m.errorHandler.HandleError(w, r, err)
+		return
+	}
+
+	// A requisição original falhou e liberou a chave entre o Claim e o Find.
+	if stored == nil {
+		m.errorHandler.HandleError(w, r, customerrors.ErrIdempotencyKeyInProgress)
+		return
+	}
+
+	if stored.RequestHash != record.RequestHash {
+		m.errorHandler.HandleError(w, r, customerrors.ErrIdempotencyKeyReused)
+		return
+	}
+
+	if !stored.Completed() {
+		m.errorHandler.HandleError(w, r, customerrors.ErrIdempotencyKeyInProgress)
+		return
+	}
+
+	m.o11y.Logger().Info(ctx, "idempotent_replay",
+		observability.String("user_id", record.UserID),
+		observability.String("path", r.URL.Path),
+	)
+
+	for name, value := range stored.ResponseHeaders {
+		w.Header().Set(name, value)
+	}
+	w.Header().Set(IdempotentReplayedHeader, strconv.FormatBool(true))
+	w.WriteHeader(*stored.StatusCode)
+	_, _ = w.Write(stored.ResponseBody)
+}
+
+// storedHeaders seleciona os headers da resposta que devem ser reenviados nas retentativas.
+func storedHeaders(header http.Header) map[string]string {
+	headers := make(map[string]string, len(replayedHeaders))
+	for _, name := range replayedHeaders {
+		if value := header.Get(name); value != "" {
+			headers[name] = value
+		}
+	}
+	return headers
+}
+
+// hashRequest identifica a requisição pelo método, caminho, query string e corpo.
+// Corpos multipart entram pelas partes (nome do campo, arquivo, tipo e conteúdo), e não pelos
+// bytes brutos: a retentativa de um upload gera outro boundary e precisa ter o mesmo hash.
+func hashRequest(r *http.Request, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(r.Method + " " + r.URL.Path + "?" + r.URL.RawQuery + "\n"))
+	if !hashMultipart(hash, r.Header.Get("Content-Type"), body) {
+		hash.Write(body)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// hashMultipart escreve no hash as partes de um corpo multipart/form-data.
+// Retorna false quando o corpo não é multipart ou não pode ser lido como tal.
+func hashMultipart(hash io.Writer, contentType string, body []byte) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return false
+	}
+
+	var parts bytes.Buffer
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return false
+		}
+		content := sha256.New()
+		if _, err := io.Copy(content, part); err != nil {
+			return false
+		}
+		fmt.Fprintf(&parts, "%q %q %q %x\n", part.FormName(), part.FileName(), part.Header.Get("Content-Type"), content.Sum(nil))
+	}
+
+	_, _ = hash.Write(parts.Bytes())
+	return true
+}
+
+// isSafeMethod indica os métodos que não alteram estado e dispensam idempotência.
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	if r.status == 0 {
+		r.status = status
+	}
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	if r.status == 0 {
+		r.status = http.StatusOK
+	}
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
@@ -0,0 +1,324 @@
+package middlewares_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability/fake"
+	"github.com/jailtonjunior94/financial/pkg/api/httperrors"
+	"github.com/jailtonjunior94/financial/pkg/api/middlewares"
+	"github.com/jailtonjunior94/financial/pkg/auth"
+	"github.com/jailtonjunior94/financial/pkg/idempotency"
+	idempotencyMocks "github.com/jailtonjunior94/financial/pkg/idempotency/mocks"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const idempotencyUserID = "550e8400-e29b-41d4-a716-446655440000"
+
+func setupIdempotencyMiddleware(repository idempotency.Repository) middlewares.Idempotency {
+	obs := fake.NewProvider()
+	return middlewares.NewIdempotency(repository, idempotency.DefaultTTL, obs, httperrors.NewErrorHandler(obs))
+}
+
+func makeIdempotentRequest(method, key, body string) *http.Request {
+	req := httptest.NewRequest(method, "/api/v1/transactions", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(middlewares.IdempotencyKeyHeader, key)
+	}
+	user := auth.NewAuthenticatedUser(idempotencyUserID, "user@example.com", nil)
+	return req.WithContext(middlewares.AddUserToContext(req.Context(), user))
+}
+
+func createdHandler(calls *int) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"1"}`))
+	})
+}
+
+func TestIdempotencyMiddleware_PassesThroughWithoutKey(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	calls := 0
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, "", `{}`))
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusCreated, w.Code)
+}
+
+func TestIdempotencyMiddleware_PassesThroughSafeMethods(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	calls := 0
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodGet, "key-1", ""))
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestIdempotencyMiddleware_StoresFirstResponse(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	repository.EXPECT().Claim(mock.Anything, mock.MatchedBy(func(record *idempotency.Record) bool {
+		return record.UserID == idempotencyUserID && record.Key == "key-1" && len(record.RequestHash) == 64 &&
+			record.ExpiresAt.Sub(record.CreatedAt) == idempotency.DefaultTTL
+	})).Return(true, nil).Once()
+	repository.EXPECT().Complete(mock.Anything, idempotencyUserID, "key-1", http.StatusCreated, map[string]string{"Content-Type": "application/json"}, []byte(`{"id":"1"}`)).Return(nil).Once()
+	calls := 0
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, "key-1", `{"amount":10}`))
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Empty(t, w.Header().Get(middlewares.IdempotentReplayedHeader))
+}
+
+func TestIdempotencyMiddleware_ReplaysIdenticalRetry(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	var claimed *idempotency.Record
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, record *idempotency.Record) { claimed = record }).
+		Return(false, nil).Once()
+	status := http.StatusCreated
+	repository.EXPECT().Find(mock.Anything, idempotencyUserID, "key-1").
+		RunAndReturn(func(_ context.Context, _, _ string) (*idempotency.Record, error) {
+			return &idempotency.Record{RequestHash: claimed.RequestHash, StatusCode: &status, ResponseHeaders: map[string]string{"Content-Type": "application/json"}, ResponseBody: []byte(`{"id":"1"}`)}, nil
+		}).Once()
+	calls := 0
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, "key-1", `{"amount":10}`))
+
+	assert.Zero(t, calls)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "true", w.Header().Get(middlewares.IdempotentReplayedHeader))
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"id":"1"}`, w.Body.String())
+}
+
+func TestIdempotencyMiddleware_RejectsKeyReusedWithDifferentBody(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	status := http.StatusCreated
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).Return(false, nil).Once()
+	repository.EXPECT().Find(mock.Anything, idempotencyUserID, "key-1").
+		Return(&idempotency.Record{RequestHash: "another-request", StatusCode: &status}, nil).Once()
+	calls := 0
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, "key-1", `{"amount":20}`))
+
+	assert.Zero(t, calls)
+	assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+}
+
+func TestIdempotencyMiddleware_ConflictsWhileOriginalInProgress(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	var claimed *idempotency.Record
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, record *idempotency.Record) { claimed = record }).
+		Return(false, nil).Once()
+	repository.EXPECT().Find(mock.Anything, idempotencyUserID, "key-1").
+		RunAndReturn(func(_ context.Context, _, _ string) (*idempotency.Record, error) {
+			return &idempotency.Record{RequestHash: claimed.RequestHash}, nil
+		}).Once()
+	calls := 0
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, "key-1", `{"amount":10}`))
+
+	assert.Zero(t, calls)
+	assert.Equal(t, http.StatusConflict, w.Code)
+}
+
+func TestIdempotencyMiddleware_ReleasesKeyOnServerError(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).Return(true, nil).Once()
+	repository.EXPECT().Release(mock.Anything, idempotencyUserID, "key-1").Return(nil).Once()
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(next).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, "key-1", `{}`))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestIdempotencyMiddleware_RejectsTooLongKey(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	calls := 0
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, strings.Repeat("k", 256), `{}`))
+
+	assert.Zero(t, calls)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestIdempotencyMiddleware_ClaimError(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).Return(false, errors.New("db error")).Once()
+	calls := 0
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, "key-1", `{}`))
+
+	assert.Zero(t, calls)
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
+
+func TestIdempotencyMiddleware_StoresResponseWhenClientDisconnects(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).Return(true, nil).Once()
+	var storeErr error
+	repository.EXPECT().Complete(mock.Anything, idempotencyUserID, "key-1", http.StatusCreated, map[string]string{"Content-Type": "application/json"}, []byte(`{"id":"1"}`)).
+		RunAndReturn(func(ctx context.Context, _, _ string, _ int, _ map[string]string, _ []byte) error {
+			storeErr = ctx.Err()
+			return storeErr
+		}).Once()
+
+	req := makeIdempotentRequest(http.MethodPost, "key-1", `{}`)
+	ctx, cancel := context.WithCancel(req.Context())
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		createdHandler(new(int)).ServeHTTP(w, r)
+		cancel()
+	})
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(next).ServeHTTP(w, req.WithContext(ctx))
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NoError(t, storeErr)
+}
+
+func TestIdempotencyMiddleware_ReleasesKeyWhenClientDisconnects(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).Return(true, nil).Once()
+	var releaseErr error
+	repository.EXPECT().Release(mock.Anything, idempotencyUserID, "key-1").
+		RunAndReturn(func(ctx context.Context, _, _ string) error {
+			releaseErr = ctx.Err()
+			return releaseErr
+		}).Once()
+
+	req := makeIdempotentRequest(http.MethodPost, "key-1", `{}`)
+	ctx, cancel := context.WithCancel(req.Context())
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	w := httptest.NewRecorder()
+	setupIdempotencyMiddleware(repository).Idempotency(next).ServeHTTP(w, req.WithContext(ctx))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NoError(t, releaseErr)
+}
+
+func TestIdempotencyMiddleware_StoresAndReplaysETag(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	headers := map[string]string{"Content-Type": "application/json", "ETag": `"3"`}
+	var stored *idempotency.Record
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).
+		Run(func(_ context.Context, record *idempotency.Record) { stored = record }).
+		Return(true, nil).Once()
+	repository.EXPECT().Complete(mock.Anything, idempotencyUserID, "key-1", http.StatusOK, headers, []byte(`{"version":3}`)).
+		Run(func(_ context.Context, _, _ string, status int, headers map[string]string, body []byte) {
+			stored.StatusCode = &status
+			stored.ResponseHeaders = headers
+			stored.ResponseBody = body
+		}).
+		Return(nil).Once()
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).Return(false, nil).Once()
+	repository.EXPECT().Find(mock.Anything, idempotencyUserID, "key-1").
+		RunAndReturn(func(_ context.Context, _, _ string) (*idempotency.Record, error) { return stored, nil }).Once()
+	calls := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("ETag", `"3"`)
+		w.Header().Set("X-Request-Id", "abc")
+		_, _ = w.Write([]byte(`{"version":3}`))
+	})
+	middleware := setupIdempotencyMiddleware(repository).Idempotency(next)
+
+	first := httptest.NewRecorder()
+	middleware.ServeHTTP(first, makeIdempotentRequest(http.MethodPut, "key-1", `{"amount":10}`))
+	retry := httptest.NewRecorder()
+	middleware.ServeHTTP(retry, makeIdempotentRequest(http.MethodPut, "key-1", `{"amount":10}`))
+
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, http.StatusOK, retry.Code)
+	assert.Equal(t, `"3"`, retry.Header().Get("ETag"))
+	assert.Equal(t, "application/json", retry.Header().Get("Content-Type"))
+	assert.Empty(t, retry.Header().Get("X-Request-Id"))
+	assert.Equal(t, "true", retry.Header().Get(middlewares.IdempotentReplayedHeader))
+	assert.JSONEq(t, `{"version":3}`, retry.Body.String())
+}
+
+func TestIdempotencyMiddleware_RejectsBodyTooLarge(t *testing.T) {
+	repository := idempotencyMocks.NewRepository(t)
+	calls := 0
+
+	w := httptest.NewRecorder()
+	body := strings.Repeat("a", 11<<20+1)
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(w, makeIdempotentRequest(http.MethodPost, "key-1", body))
+
+	assert.Equal(t, 0, calls)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
+
+// claimedHash envia a requisição pelo middleware e devolve o hash reservado para ela.
+func claimedHash(t *testing.T, req *http.Request) string {
+	t.Helper()
+	repository := idempotencyMocks.NewRepository(t)
+	var hash string
+	repository.EXPECT().Claim(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, record *idempotency.Record) (bool, error) {
+			hash = record.RequestHash
+			return true, nil
+		}).Once()
+	repository.EXPECT().Complete(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+	calls := 0
+
+	setupIdempotencyMiddleware(repository).Idempotency(createdHandler(&calls)).ServeHTTP(httptest.NewRecorder(), req)
+	return hash
+}
+
+func TestIdempotencyMiddleware_HashIncludesQueryString(t *testing.T) {
+	dryRun := makeIdempotentRequest(http.MethodPost, "key-1", `{}`)
+	dryRun.URL.RawQuery = "dry_run=true"
+
+	assert.NotEqual(t, claimedHash(t, makeIdempotentRequest(http.MethodPost, "key-1", `{}`)), claimedHash(t, dryRun))
+}
+
+func TestIdempotencyMiddleware_HashesMultipartByParts(t *testing.T) {
+	upload := func(boundary, content string) *http.Request {
+		var body bytes.Buffer
+		writer := multipart.NewWriter(&body)
+		_ = writer.SetBoundary(boundary)
+		part, _ := writer.CreateFormFile("file", "extrato.csv")
+		_, _ = part.Write([]byte(content))
+		_ = writer.Close()
+
+		req := makeIdempotentRequest(http.MethodPost, "key-1", body.String())
+		req.Header.Set("Content-Type", writer.FormDataContentType())
+		return req
+	}
+
+	first := claimedHash(t, upload("boundary-first", "date,amount\n2026-03-01,10.00\n"))
+
+	assert.Equal(t, first, claimedHash(t, upload("boundary-retry", "date,amount\n2026-03-01,10.00\n")))
+	assert.NotEqual(t, first, claimedHash(t, upload("boundary-retry", "date,amount\n2026-03-01,20.00\n")))
+}
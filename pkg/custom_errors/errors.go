@@ -30,4 +30,13 @@ var (
 
 	// Authorization errors.
 	ErrForbidden = errors.New("you do not have permission to access this resource")
+
+	// Concurrency errors.
+	ErrPreconditionFailed = errors.New("resource was modified since it was read")
+
+	// Idempotency errors.
+	ErrIdempotencyKeyTooLong    = errors.New("idempotency key cannot be more than 255 characters")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still in progress")
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was already used with a different request")
+	ErrIdempotentBodyTooLarge   = errors.New("request body is too large to be stored for idempotency")
 )
@@ -0,0 +1,39 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+)
+
+// Cleaner remove as chaves de idempotência expiradas.
+type Cleaner interface {
+	Cleanup(ctx context.Context) (int64, error)
+}
+
+type cleaner struct {
+	repository Repository
+	o11y       observability.Observability
+}
+
+// NewCleaner cria uma nova instância do cleaner.
+func NewCleaner(repository Repository, o11y observability.Observability) Cleaner {
+	return &cleaner{
+		repository: repository,
+		o11y:       o11y,
+	}
+}
+
+func (c *cleaner) Cleanup(ctx context.Context) (int64, error) {
+	ctx, span := c.o11y.Tracer().Start(ctx, "idempotency.cleaner.cleanup")
+	defer span.End()
+
+	deleted, err := c.repository.DeleteExpired(ctx)
+	if err != nil {
+		c.o11y.Logger().Error(ctx, "idempotency cleanup failed", observability.Error(err))
+		return 0, fmt.Errorf("cleanup: %w", err)
+	}
+
+	return deleted, nil
+}
@@ -0,0 +1,64 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jailtonjunior94/financial/pkg/jobs"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+)
+
+// CleanupJob implementa jobs.Job para limpeza das chaves de idempotência expiradas.
+type CleanupJob struct {
+	cleaner  Cleaner
+	schedule string
+	o11y     observability.Observability
+}
+
+// NewCleanupJob cria um novo job de cleanup.
+func NewCleanupJob(
+	cleaner Cleaner,
+	schedule string,
+	o11y observability.Observability,
+) jobs.Job {
+	return &CleanupJob{
+		cleaner:  cleaner,
+		schedule: schedule,
+		o11y:     o11y,
+	}
+}
+
+// Name retorna o identificador do job.
+func (j *CleanupJob) Name() string {
+	return "idempotency_cleanup"
+}
+
+// Schedule retorna a expressão cron para agendamento.
+// Padrão: "@hourly" - as chaves expiram em horas, não em dias.
+func (j *CleanupJob) Schedule() string {
+	if j.schedule != "" {
+		return j.schedule
+	}
+	return "@hourly"
+}
+
+// Run executa a limpeza das chaves expiradas.
+func (j *CleanupJob) Run(ctx context.Context) error {
+	ctx, span := j.o11y.Tracer().Start(ctx, "idempotency.cleanup_job.run")
+	defer span.End()
+
+	deleted, err := j.cleaner.Cleanup(ctx)
+	if err != nil {
+		j.o11y.Logger().Error(ctx, "idempotency cleanup job failed",
+			observability.Error(err),
+		)
+		return fmt.Errorf("idempotency cleanup job: %w", err)
+	}
+
+	j.o11y.Logger().Info(ctx, "idempotency cleanup job completed",
+		observability.Int64("deleted", deleted),
+	)
+
+	return nil
+}
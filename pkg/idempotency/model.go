@@ -0,0 +1,24 @@
+package idempotency
+
+import "time"
+
+// DefaultTTL é o tempo durante o qual uma resposta fica disponível para retentativas.
+const DefaultTTL = 24 * time.Hour
+
+// Record representa uma requisição enviada com o header Idempotency-Key.
+// Espelha a tabela idempotency_keys do schema.
+type Record struct {
+	UserID          string
+	Key             string
+	RequestHash     string
+	StatusCode      *int
+	ResponseHeaders map[string]string
+	ResponseBody    []byte
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+}
+
+// Completed indica se a resposta da requisição original já foi armazenada.
+func (r *Record) Completed() bool {
+	return r.StatusCode != nil
+}
@@ -0,0 +1,182 @@
+package idempotency
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/JailtonJunior94/devkit-go/pkg/database"
+	"github.com/JailtonJunior94/devkit-go/pkg/observability"
+)
+
+// Repository gerencia as chaves de idempotência e as respostas armazenadas.
+type Repository interface {
+	// Claim reserva a chave para a requisição atual.
+	// Usa INSERT ... ON CONFLICT para que apenas uma requisição concorrente obtenha a chave;
+	// uma chave expirada é reaproveitada como se não existisse.
+	// Retorna false se a chave já está reservada por outra requisição.
+	Claim(ctx context.Context, record *Record) (bool, error)
+	// Find busca a chave do usuário. Retorna nil se ela não existir.
+	Find(ctx context.Context, userID, key string) (*Record, error)
+	// Complete armazena a resposta da requisição que reservou a chave, com os headers a reenviar.
+	Complete(ctx context.Context, userID, key string, statusCode int, headers map[string]string, body []byte) error
+	// Release remove a chave, permitindo que a requisição seja reenviada com ela.
+	Release(ctx context.Context, userID, key string) error
+	// DeleteExpired remove as chaves cujo prazo de retentativa já passou.
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type repository struct {
+	db   database.DBTX
+	o11y observability.Observability
+}
+
+// NewRepository cria uma nova instância do repository.
+func NewRepository(db database.DBTX, o11y observability.Observability) Repository {
+	return &repository{
+		db:   db,
+		o11y: o11y,
+	}
+}
+
+func (r *repository) Claim(ctx context.Context, record *Record) (bool, error) {
+	ctx, span := r.o11y.Tracer().Start(ctx, "idempotency_repository.claim")
+	defer span.End()
+
+	query := `
+		INSERT INTO idempotency_keys (user_id, idempotency_key, request_hash, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, idempotency_key) DO UPDATE
+		SET request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			response_headers = NULL,
+			response_body = NULL,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= EXCLUDED.created_at
+	`
+
+	result, err := r.db.ExecContext(ctx, query, record.UserID, record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt)
+	if err != nil {
+		span.RecordError(err)
+		return false, fmt.Errorf("failed to claim idempotency key: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected == 1, nil
+}
+
+func (r *repository) Find(ctx context.Context, userID, key string) (*Record, error) {
+	ctx, span := r.o11y.Tracer().Start(ctx, "idempotency_repository.find")
+	defer span.End()
+
+	query := `
+		SELECT user_id, idempotency_key, request_hash, status_code, response_headers, response_body, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = $1 AND idempotency_key = $2
+	`
+
+	var (
+		record     Record
+		statusCode sql.NullInt64
+		headers    []byte
+	)
+	err := r.db.QueryRowContext(ctx, query, userID, key).Scan(
+		&record.UserID,
+		&record.Key,
+		&record.RequestHash,
+		&statusCode,
+		&headers,
+		&record.ResponseBody,
+		&record.CreatedAt,
+		&record.ExpiresAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, fmt.Errorf("failed to find idempotency key: %w", err)
+	}
+
+	if statusCode.Valid {
+		code := int(statusCode.Int64)
+		record.StatusCode = &code
+	}
+	if len(headers) > 0 {
+		if err := json.Unmarshal(headers, &record.ResponseHeaders); err != nil {
+			return nil, fmt.Errorf("failed to decode idempotency response headers: %w", err)
+		}
+	}
+
+	return &record, nil
+}
+
+func (r *repository) Complete(ctx context.Context, userID, key string, statusCode int, headers map[string]string, body []byte) error {
+	ctx, span := r.o11y.Tracer().Start(ctx, "idempotency_repository.complete")
+	defer span.End()
+
+	encodedHeaders, err := json.Marshal(headers)
+	if err != nil {
+		return fmt.Errorf("failed to encode idempotency response headers: %w", err)
+	}
+
+	query := `
+		UPDATE idempotency_keys
+		SET status_code = $3, response_headers = $4, response_body = $5
+		WHERE user_id = $1 AND idempotency_key = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, key, statusCode, encodedHeaders, body); err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) Release(ctx context.Context, userID, key string) error {
+	ctx, span := r.o11y.Tracer().Start(ctx, "idempotency_repository.release")
+	defer span.End()
+
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE user_id = $1 AND idempotency_key = $2
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, userID, key); err != nil {
+		span.RecordError(err)
+		return fmt.Errorf("failed to release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) DeleteExpired(ctx context.Context) (int64, error) {
+	ctx, span := r.o11y.Tracer().Start(ctx, "idempotency_repository.delete_expired")
+	defer span.End()
+
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE expires_at <= NOW()
+	`
+
+	result, err := r.db.ExecContext(ctx, query)
+	if err != nil {
+		span.RecordError(err)
+		return 0, fmt.Errorf("failed to delete expired idempotency keys: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected, nil
+}
@@ -90,6 +90,7 @@ const (
 	OperationDelete = "delete"
 	OperationFind   = "find"
 	OperationFindBy = "find_by"
+	OperationLimit  = "limit"
 )
 
 // Constantes para tipos de erro.
@@ -3,6 +3,7 @@ package outbox
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/JailtonJunior94/devkit-go/pkg/database"
@@ -238,7 +239,12 @@ func (d *dispatcher) handlePublishFailure(ctx context.Context, repository Reposi
 }
 
 // buildRoutingKey constrói a routing key para o evento.
-// Formato: {aggregate_type}.{event_type}.
+// Formato: {aggregate_type}.{event_type}. Quando o event_type já vem qualificado
+// com o aggregate_type (ex.: "transaction.created"), é usado como está para não
+// gerar "transaction.transaction.created".
 func (d *dispatcher) buildRoutingKey(event *OutboxEvent) string {
+	if strings.HasPrefix(event.EventType, event.AggregateType+".") {
+		return event.EventType
+	}
 	return fmt.Sprintf("%s.%s", event.AggregateType, event.EventType)
 }
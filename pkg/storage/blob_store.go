@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// DriverLocal grava os blobs no sistema de arquivos local.
+const DriverLocal = "local"
+
+var (
+	// ErrBlobNotFound indica que não existe conteúdo para a chave informada.
+	ErrBlobNotFound = errors.New("blob not found")
+
+	// ErrInvalidKey indica que a chave é vazia ou tenta sair da raiz do store.
+	ErrInvalidKey = errors.New("invalid blob key")
+)
+
+// BlobStore guarda conteúdos binários (ex.: comprovantes) endereçados por chave.
+// As chaves usam "/" como separador, independente da implementação.
+type BlobStore interface {
+	// Put grava o conteúdo na chave, substituindo o anterior. A gravação é atômica:
+	// leitores nunca veem um conteúdo parcial.
+	Put(ctx context.Context, key string, content io.Reader) error
+
+	// Get abre o conteúdo da chave. Retorna ErrBlobNotFound quando a chave não existe.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Exists informa se a chave existe.
+	Exists(ctx context.Context, key string) (bool, error)
+
+	// Delete remove o conteúdo da chave. Remover uma chave inexistente não é erro.
+	Delete(ctx context.Context, key string) error
+}
+
+// NewBlobStore cria o BlobStore do driver configurado. Sem driver, usa o local.
+func NewBlobStore(driver, localPath string) (BlobStore, error) {
+	switch driver {
+	case "", DriverLocal:
+		return NewLocalBlobStore(localPath)
+	default:
+		return nil, fmt.Errorf("storage: unsupported driver %q", driver)
+	}
+}
@@ -0,0 +1,71 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore_PutGetDelete(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	key := "sha256/ab/abcdef"
+	exists, err := store.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	require.NoError(t, store.Put(ctx, key, strings.NewReader("receipt")))
+	require.NoError(t, store.Put(ctx, key, strings.NewReader("receipt v2")))
+
+	exists, err = store.Exists(ctx, key)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	reader, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "receipt v2", string(content))
+
+	require.NoError(t, store.Delete(ctx, key))
+	require.NoError(t, store.Delete(ctx, key))
+
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, ErrBlobNotFound)
+}
+
+func TestLocalBlobStore_InvalidKeys(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../outside", "a/../../b", "a//b", `a\b`} {
+		t.Run(key, func(t *testing.T) {
+			assert.ErrorIs(t, store.Put(ctx, key, strings.NewReader("x")), ErrInvalidKey)
+			_, err := store.Get(ctx, key)
+			assert.ErrorIs(t, err, ErrInvalidKey)
+			assert.ErrorIs(t, store.Delete(ctx, key), ErrInvalidKey)
+		})
+	}
+}
+
+func TestNewLocalBlobStore_RequiresPath(t *testing.T) {
+	_, err := NewLocalBlobStore(" ")
+	assert.Error(t, err)
+}
+
+func TestNewBlobStore(t *testing.T) {
+	store, err := NewBlobStore("", t.TempDir())
+	require.NoError(t, err)
+	assert.NotNil(t, store)
+
+	_, err = NewBlobStore("s3", t.TempDir())
+	assert.Error(t, err)
+}